@@ -0,0 +1,44 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/projecteru2/core/types"
+)
+
+const (
+	// SinkFile write audit logs into file as json lines
+	SinkFile = "file"
+	// SinkEtcd write audit logs into store with retention
+	SinkEtcd = "etcd"
+	// SinkSyslog write audit logs into syslog
+	SinkSyslog = "syslog"
+)
+
+// Sink define where audit logs go
+type Sink interface {
+	Write(ctx context.Context, log *types.AuditLog) error
+	Query(ctx context.Context, query *types.AuditLogQuery) ([]*types.AuditLog, error)
+}
+
+// Store can save and list audit logs, cluster does
+type Store interface {
+	AddAuditLog(ctx context.Context, log *types.AuditLog) error
+	ListAuditLogs(ctx context.Context, query *types.AuditLogQuery) ([]*types.AuditLog, error)
+}
+
+// NewSink return sink by config, nil if audit disabled
+func NewSink(config types.AuditConfig, store Store) (Sink, error) {
+	switch config.Sink {
+	case "":
+		return nil, nil
+	case SinkFile:
+		return NewFileSink(config.Path)
+	case SinkEtcd:
+		return NewStoreSink(store), nil
+	case SinkSyslog:
+		return NewSyslogSink(config.Tag)
+	default:
+		return nil, types.NewDetailedErr(types.ErrBadAuditSink, config.Sink)
+	}
+}
@@ -0,0 +1,85 @@
+package audit
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/projecteru2/core/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// FileSink append audit logs into file, one json each line
+type FileSink struct {
+	sync.Mutex
+	path string
+	file *os.File
+}
+
+// NewFileSink return file sink, file and its dir are created if not exists
+func NewFileSink(path string) (*FileSink, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, err
+	}
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+	return &FileSink{path: path, file: file}, nil
+}
+
+// Write append audit log
+func (f *FileSink) Write(ctx context.Context, auditLog *types.AuditLog) error {
+	bytes, err := json.Marshal(auditLog)
+	if err != nil {
+		return err
+	}
+	f.Lock()
+	defer f.Unlock()
+	_, err = f.file.Write(append(bytes, '\n'))
+	return err
+}
+
+// Query scan whole file for audit logs matched, newest first
+func (f *FileSink) Query(ctx context.Context, query *types.AuditLogQuery) ([]*types.AuditLog, error) {
+	file, err := os.Open(f.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	logs := []*types.AuditLog{}
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		auditLog := &types.AuditLog{}
+		if err := json.Unmarshal(scanner.Bytes(), auditLog); err != nil {
+			log.Errorf("[Query] Bad audit log %s %v", scanner.Bytes(), err)
+			continue
+		}
+		if query.Matches(auditLog) {
+			logs = append(logs, auditLog)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	// 文件里是按写入顺序的
+	for i, j := 0, len(logs)-1; i < j; i, j = i+1, j-1 {
+		logs[i], logs[j] = logs[j], logs[i]
+	}
+	if query.Limit > 0 && len(logs) > query.Limit {
+		logs = logs[:query.Limit]
+	}
+	return logs, nil
+}
+
+// Close close file
+func (f *FileSink) Close() error {
+	f.Lock()
+	defer f.Unlock()
+	return f.file.Close()
+}
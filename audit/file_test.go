@@ -0,0 +1,58 @@
+package audit
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileSink(t *testing.T) {
+	dir, err := ioutil.TempDir("", "audit")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ctx := context.Background()
+
+	sink, err := NewFileSink(filepath.Join(dir, "sub", "audit.log"))
+	assert.NoError(t, err)
+	defer sink.Close()
+	assert.NoError(t, sink.Write(ctx, &types.AuditLog{Time: 10, Method: "AddPod", Username: "u1"}))
+	assert.NoError(t, sink.Write(ctx, &types.AuditLog{Time: 20, Method: "RemovePod", Username: "u2"}))
+	assert.NoError(t, sink.Write(ctx, &types.AuditLog{Time: 30, Method: "AddNode", Username: "u1"}))
+
+	logs, err := sink.Query(ctx, &types.AuditLogQuery{})
+	assert.NoError(t, err)
+	assert.Len(t, logs, 3)
+	assert.Equal(t, "AddNode", logs[0].Method)
+	logs, err = sink.Query(ctx, &types.AuditLogQuery{Start: 15, End: 30})
+	assert.NoError(t, err)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, "RemovePod", logs[0].Method)
+	logs, err = sink.Query(ctx, &types.AuditLogQuery{Username: "u1", Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, "AddNode", logs[0].Method)
+
+	// reopen appends
+	sink2, err := NewSink(types.AuditConfig{Sink: SinkFile, Path: sink.path}, nil)
+	assert.NoError(t, err)
+	assert.NoError(t, sink2.Write(ctx, &types.AuditLog{Time: 40, Method: "SetNode"}))
+	logs, err = sink.Query(ctx, &types.AuditLogQuery{})
+	assert.NoError(t, err)
+	assert.Len(t, logs, 4)
+}
+
+func TestNewSink(t *testing.T) {
+	sink, err := NewSink(types.AuditConfig{}, nil)
+	assert.NoError(t, err)
+	assert.Nil(t, sink)
+	_, err = NewSink(types.AuditConfig{Sink: "kafka"}, nil)
+	assert.Error(t, err)
+	sink, err = NewSink(types.AuditConfig{Sink: SinkEtcd}, nil)
+	assert.NoError(t, err)
+	assert.IsType(t, &StoreSink{}, sink)
+}
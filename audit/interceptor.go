@@ -0,0 +1,138 @@
+package audit
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+// mutatingMethods are audited, read only methods are not
+var mutatingMethods = map[string]bool{
+	"AddPod":                true,
+	"RemovePod":             true,
+	"SetPodOvercommit":      true,
+	"AddNode":               true,
+	"RemoveNode":            true,
+	"SetNode":               true,
+	"FixNodeResource":       true,
+	"DrainNode":             true,
+	"AuditContainers":       true,
+	"SetContainersStatus":   true,
+	"Send":                  true,
+	"BuildImage":            true,
+	"CacheImage":            true,
+	"RemoveImage":           true,
+	"CreateContainer":       true,
+	"ReplaceContainer":      true,
+	"SetDeployment":         true,
+	"RemoveDeployment":      true,
+	"SetAutoscalePolicy":    true,
+	"RemoveAutoscalePolicy": true,
+	"SetQuota":              true,
+	"RemoveQuota":           true,
+	"RemoveContainer":       true,
+	"DissociateContainer":   true,
+	"ControlContainer":      true,
+	"ReallocResource":       true,
+	"RunAndWait":            true,
+	"ExecuteContainer":      true,
+	"SetSecret":             true,
+	"RemoveSecret":          true,
+	"RotateSecret":          true,
+}
+
+// Auditor write audit log of mutating requests into sink
+type Auditor struct {
+	sink Sink
+}
+
+// NewAuditor return auditor
+func NewAuditor(sink Sink) *Auditor {
+	return &Auditor{sink: sink}
+}
+
+// UnaryInterceptor audit unary requests
+// it should be the outermost interceptor, so requests denied by auth are audited too
+func (a *Auditor) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	method := methodName(info.FullMethod)
+	if !mutatingMethods[method] {
+		return handler(ctx, req)
+	}
+	start := time.Now()
+	ctx = utils.ContextWithIdentity(ctx)
+	resp, err := handler(ctx, req)
+	a.record(ctx, method, summarize(req), start, err)
+	return resp, err
+}
+
+// StreamInterceptor audit stream requests, request is the first message received
+func (a *Auditor) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	method := methodName(info.FullMethod)
+	if !mutatingMethods[method] {
+		return handler(srv, stream)
+	}
+	start := time.Now()
+	s := &auditStream{ServerStream: utils.StreamWithContext(stream, utils.ContextWithIdentity(stream.Context()))}
+	err := handler(srv, s)
+	a.record(s.Context(), method, s.summary(), start, err)
+	return err
+}
+
+func (a *Auditor) record(ctx context.Context, method, request string, start time.Time, err error) {
+	auditLog := &types.AuditLog{
+		Time:     start.UnixNano(),
+		Method:   method,
+		Username: utils.IdentityFromContext(ctx),
+		Request:  request,
+		Duration: int64(time.Since(start)),
+		Success:  err == nil,
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		auditLog.Peer = p.Addr.String()
+	}
+	if err != nil {
+		auditLog.Error = err.Error()
+	}
+	// 请求可能已经结束, 不用它的 ctx
+	if err := a.sink.Write(context.Background(), auditLog); err != nil {
+		log.Errorf("[Auditor] Write audit log of %s failed %v", method, err)
+	}
+}
+
+// auditStream keep summary of first message received, carry identity in context
+type auditStream struct {
+	grpc.ServerStream
+	sync.Mutex
+	request  string
+	received bool
+}
+
+// RecvMsg receive message and summarize the first one
+func (s *auditStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	s.Lock()
+	defer s.Unlock()
+	if err == nil && !s.received {
+		s.request = summarize(m)
+		s.received = true
+	}
+	return err
+}
+
+func (s *auditStream) summary() string {
+	s.Lock()
+	defer s.Unlock()
+	return s.request
+}
+
+// methodName get method name from /pb.CoreRPC/Method
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
@@ -0,0 +1,118 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	grpcmocks "github.com/projecteru2/core/3rdmocks"
+	clustermocks "github.com/projecteru2/core/cluster/mocks"
+	pb "github.com/projecteru2/core/rpc/gen"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+)
+
+func TestUnaryInterceptor(t *testing.T) {
+	store := &clustermocks.Cluster{}
+	auditor := NewAuditor(NewStoreSink(store))
+	ctx := utils.ContextWithUsername(context.Background(), "u1")
+	ctx = peer.NewContext(ctx, &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1), Port: 1234}})
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) { return req, nil }
+
+	// read only method not audited
+	_, err := auditor.UnaryInterceptor(ctx, &pb.GetPodOptions{Name: "p1"}, &grpc.UnaryServerInfo{FullMethod: "/pb.CoreRPC/GetPod"}, handler)
+	assert.NoError(t, err)
+	store.AssertNotCalled(t, "AddAuditLog", mock.Anything, mock.Anything)
+
+	// secrets scrubbed, failure of sink doesn't fail request
+	store.On("AddAuditLog", mock.Anything, mock.Anything).Return(types.ErrNoETCD).Once()
+	req := &pb.AddNodeOptions{Nodename: "n1", Key: "secret"}
+	resp, err := auditor.UnaryInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/pb.CoreRPC/AddNode"}, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, req, resp)
+	assert.Equal(t, "secret", req.Key)
+	store.AssertCalled(t, "AddAuditLog", mock.Anything, mock.MatchedBy(func(l *types.AuditLog) bool {
+		return l.Method == "AddNode" && l.Username == "u1" && l.Peer == "127.0.0.1:1234" && l.Success &&
+			l.Time > 0 && l.Request == `nodename:"n1"`
+	}))
+
+	// failed request
+	store.On("AddAuditLog", mock.Anything, mock.Anything).Return(nil).Once()
+	_, err = auditor.UnaryInterceptor(ctx, &pb.RemovePodOptions{Name: "p1"}, &grpc.UnaryServerInfo{FullMethod: "/pb.CoreRPC/RemovePod"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, types.ErrPodHasNodes
+	})
+	assert.Equal(t, types.ErrPodHasNodes, err)
+	store.AssertCalled(t, "AddAuditLog", mock.Anything, mock.MatchedBy(func(l *types.AuditLog) bool {
+		return l.Method == "RemovePod" && !l.Success && l.Error == types.ErrPodHasNodes.Error()
+	}))
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	store := &clustermocks.Cluster{}
+	auditor := NewAuditor(NewStoreSink(store))
+	stream := &grpcmocks.ServerStream{}
+	stream.On("Context").Return(utils.ContextWithUsername(context.Background(), "u1"))
+	stream.On("RecvMsg", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(0).(*pb.SendOptions).Ids = []string{"c1"}
+	})
+	store.On("AddAuditLog", mock.Anything, mock.Anything).Return(nil)
+
+	err := auditor.StreamInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/pb.CoreRPC/Send"}, func(srv interface{}, ss grpc.ServerStream) error {
+		opts := &pb.SendOptions{}
+		if err := ss.RecvMsg(opts); err != nil {
+			return err
+		}
+		// only first message is summarized
+		if err := ss.RecvMsg(&pb.SendOptions{}); err != nil {
+			return err
+		}
+		return errors.New("failed")
+	})
+	assert.Error(t, err)
+	store.AssertCalled(t, "AddAuditLog", mock.Anything, mock.MatchedBy(func(l *types.AuditLog) bool {
+		return l.Method == "Send" && l.Username == "u1" && l.Request == `ids:"c1"` && !l.Success && l.Error == "failed"
+	}))
+}
+
+func TestAuditDenied(t *testing.T) {
+	store := &clustermocks.Cluster{}
+	auditor := NewAuditor(NewStoreSink(store))
+	store.On("AddAuditLog", mock.Anything, mock.Anything).Return(nil)
+	// auth inside auditor knows user then denies
+	auth := func(ctx context.Context, req interface{}) (interface{}, error) {
+		utils.ContextWithUsername(ctx, "u2")
+		return nil, types.ErrPermissionDenied
+	}
+	_, err := auditor.UnaryInterceptor(context.Background(), &pb.RemovePodOptions{Name: "p1"}, &grpc.UnaryServerInfo{FullMethod: "/pb.CoreRPC/RemovePod"}, auth)
+	assert.Equal(t, types.ErrPermissionDenied, err)
+	store.AssertCalled(t, "AddAuditLog", mock.Anything, mock.MatchedBy(func(l *types.AuditLog) bool {
+		return l.Method == "RemovePod" && l.Username == "u2" && !l.Success
+	}))
+
+	// stream denied before any message received
+	stream := &grpcmocks.ServerStream{}
+	stream.On("Context").Return(context.Background())
+	err = auditor.StreamInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/pb.CoreRPC/Send"}, func(srv interface{}, ss grpc.ServerStream) error {
+		utils.ContextWithUsername(ss.Context(), "u3")
+		return types.ErrPermissionDenied
+	})
+	assert.Equal(t, types.ErrPermissionDenied, err)
+	store.AssertCalled(t, "AddAuditLog", mock.Anything, mock.MatchedBy(func(l *types.AuditLog) bool {
+		return l.Method == "Send" && l.Username == "u3" && !l.Success
+	}))
+}
+
+func TestSummarize(t *testing.T) {
+	assert.Equal(t, `data:<key:"f" value:"" >`, summarize(&pb.SendOptions{Data: map[string][]byte{"f": []byte("secret")}}))
+	assert.Equal(t, `deployOpt:<name:"app" data:<key:"f" value:"" > >`, summarize(&pb.ReplaceOptions{DeployOpt: &pb.DeployOptions{Name: "app", Data: map[string][]byte{"f": []byte("secret")}}}))
+	assert.Equal(t, `name:"db" apps:"app"`, summarize(&pb.SetSecretOptions{Name: "db", Value: []byte("secret"), Apps: []string{"app"}}))
+	assert.Equal(t, `name:"db" replace:true`, summarize(&pb.RotateSecretOptions{Name: "db", Value: []byte("secret"), Replace: true}))
+	assert.Equal(t, "1", summarize(1))
+	long := summarize(&pb.AddPodOptions{Desc: string(make([]byte, 2*maxSummaryLength))})
+	assert.Len(t, long, maxSummaryLength+3)
+}
@@ -0,0 +1,27 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/projecteru2/core/types"
+)
+
+// StoreSink save audit logs in store, expired by audit ttl
+type StoreSink struct {
+	store Store
+}
+
+// NewStoreSink return store sink
+func NewStoreSink(store Store) *StoreSink {
+	return &StoreSink{store: store}
+}
+
+// Write save audit log
+func (s *StoreSink) Write(ctx context.Context, log *types.AuditLog) error {
+	return s.store.AddAuditLog(ctx, log)
+}
+
+// Query list audit logs from store
+func (s *StoreSink) Query(ctx context.Context, query *types.AuditLogQuery) ([]*types.AuditLog, error) {
+	return s.store.ListAuditLogs(ctx, query)
+}
@@ -0,0 +1,67 @@
+package audit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	pb "github.com/projecteru2/core/rpc/gen"
+)
+
+const maxSummaryLength = 1024
+
+// summarize render request in short, secrets and payloads scrubbed
+func summarize(req interface{}) string {
+	var summary string
+	if msg, ok := req.(proto.Message); ok {
+		msg = proto.Clone(msg)
+		scrub(msg)
+		summary = strings.TrimSpace(proto.CompactTextString(msg))
+	} else {
+		summary = fmt.Sprintf("%v", req)
+	}
+	if len(summary) > maxSummaryLength {
+		summary = summary[:maxSummaryLength] + "..."
+	}
+	return summary
+}
+
+func scrub(msg proto.Message) {
+	switch opts := msg.(type) {
+	case *pb.AddNodeOptions:
+		opts.Ca, opts.Cert, opts.Key = "", "", ""
+	case *pb.SendOptions:
+		opts.Data = scrubData(opts.Data)
+	case *pb.BuildImageOptions:
+		opts.Tar = nil
+	case *pb.DeployOptions:
+		opts.Data = scrubData(opts.Data)
+	case *pb.ReplaceOptions:
+		if opts.DeployOpt != nil {
+			opts.DeployOpt.Data = scrubData(opts.DeployOpt.Data)
+		}
+	case *pb.RunAndWaitOptions:
+		if opts.DeployOptions != nil {
+			opts.DeployOptions.Data = scrubData(opts.DeployOptions.Data)
+		}
+		opts.Cmd = nil
+	case *pb.ExecuteContainerOptions:
+		opts.ReplCmd = nil
+	case *pb.SetSecretOptions:
+		opts.Value = nil
+	case *pb.RotateSecretOptions:
+		opts.Value = nil
+	}
+}
+
+// scrubData keep only filenames
+func scrubData(data map[string][]byte) map[string][]byte {
+	if data == nil {
+		return nil
+	}
+	scrubbed := map[string][]byte{}
+	for name := range data {
+		scrubbed[name] = nil
+	}
+	return scrubbed
+}
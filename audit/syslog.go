@@ -0,0 +1,37 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"log/syslog"
+
+	"github.com/projecteru2/core/types"
+)
+
+// SyslogSink send audit logs to local syslog, can't be queried
+type SyslogSink struct {
+	writer *syslog.Writer
+}
+
+// NewSyslogSink return syslog sink
+func NewSyslogSink(tag string) (*SyslogSink, error) {
+	writer, err := syslog.New(syslog.LOG_INFO|syslog.LOG_AUTH, tag)
+	if err != nil {
+		return nil, err
+	}
+	return &SyslogSink{writer: writer}, nil
+}
+
+// Write send audit log
+func (s *SyslogSink) Write(ctx context.Context, log *types.AuditLog) error {
+	bytes, err := json.Marshal(log)
+	if err != nil {
+		return err
+	}
+	return s.writer.Info(string(bytes))
+}
+
+// Query isn't supported by syslog
+func (s *SyslogSink) Query(ctx context.Context, query *types.AuditLogQuery) ([]*types.AuditLog, error) {
+	return nil, types.NewDetailedErr(types.ErrNotSupport, "query syslog")
+}
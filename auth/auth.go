@@ -3,8 +3,12 @@ package auth
 import (
 	"context"
 
+	"github.com/projecteru2/core/auth/cert"
+	"github.com/projecteru2/core/auth/rbac"
 	"github.com/projecteru2/core/auth/simple"
+	"github.com/projecteru2/core/auth/token"
 	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
 	"google.golang.org/grpc"
 )
 
@@ -14,10 +18,54 @@ type Auth interface {
 	UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error)
 }
 
-// NewAuth return auth obj
-func NewAuth(auth types.AuthConfig) Auth {
-	// TODO 这里可以组装其他的方法
-	return simple.NewBasicAuth(auth.Username, auth.Password)
+// NewAuths return auth objs by config, should be chained in order
+// identity from client cert goes first, then bearer token, then rbac or simple auth
+// requests identified before are passed by the later ones, rbac replaces simple auth
+// requests without identity are rejected at last if neither rbac nor simple auth enabled
+func NewAuths(config types.Config, cluster rbac.Cluster) ([]Auth, error) {
+	auths := []Auth{}
+	if config.TLS.CA != "" {
+		// 客户端证书可选又没有别的认证, 没带证书的请求就直接放过了
+		if !config.TLS.VerifyClient && config.Token.Secret == "" && !config.RBAC.Enable && config.Auth.Username == "" {
+			return nil, types.ErrInsecureAuth
+		}
+		auths = append(auths, cert.NewCertAuth())
+	}
+	if config.Token.Secret != "" {
+		auths = append(auths, token.NewJWTAuth(config.Token.Secret))
+	}
+	switch {
+	case config.RBAC.Enable:
+		r, err := rbac.New(config.RBAC, cluster)
+		if err != nil {
+			return nil, err
+		}
+		auths = append(auths, r)
+	case config.Auth.Username != "":
+		auths = append(auths, simple.NewBasicAuth(config.Auth.Username, config.Auth.Password))
+	case len(auths) > 0:
+		auths = append(auths, &identityRequired{})
+	}
+	return auths, nil
+}
+
+// identityRequired reject requests not identified by auths before
+type identityRequired struct{}
+
+// StreamInterceptor define stream interceptor
+func (i *identityRequired) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if utils.UsernameFromContext(stream.Context()) == "" {
+		return types.ErrUnauthenticated
+	}
+	return handler(srv, stream)
+}
+
+// UnaryInterceptor define unary interceptor
+func (i *identityRequired) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if utils.UsernameFromContext(ctx) == "" {
+		return nil, types.ErrUnauthenticated
+	}
+	return handler(ctx, req)
 }
 
 // Credential for client
@@ -28,6 +76,10 @@ type Credential interface {
 
 // NewCredential return credential obj
 func NewCredential(auth types.AuthConfig) Credential {
-	// TODO 这里可以组装其他的方法
 	return simple.NewBasicCredential(auth.Username, auth.Password)
 }
+
+// NewTokenCredential return bearer token credential obj, only sent over tls
+func NewTokenCredential(t string) Credential {
+	return token.NewCredential(t)
+}
@@ -0,0 +1,143 @@
+package auth
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/projecteru2/core/auth/cert"
+	"github.com/projecteru2/core/auth/rbac"
+	"github.com/projecteru2/core/auth/simple"
+	"github.com/projecteru2/core/auth/token"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNewAuths(t *testing.T) {
+	config := types.Config{}
+	auths, err := NewAuths(config, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, auths)
+
+	config.Auth = types.AuthConfig{Username: "u", Password: "p"}
+	auths, err = NewAuths(config, nil)
+	assert.NoError(t, err)
+	assert.Len(t, auths, 1)
+	assert.IsType(t, &simple.BasicAuth{}, auths[0])
+
+	// simple auth kept with token
+	config.Token.Secret = "secret"
+	config.TLS.CA = "ca.crt"
+	auths, err = NewAuths(config, nil)
+	assert.NoError(t, err)
+	assert.Len(t, auths, 3)
+	assert.IsType(t, &cert.CertAuth{}, auths[0])
+	assert.IsType(t, &token.JWTAuth{}, auths[1])
+	assert.IsType(t, &simple.BasicAuth{}, auths[2])
+
+	// rbac replaces simple auth
+	config.RBAC.Enable = true
+	auths, err = NewAuths(config, nil)
+	assert.NoError(t, err)
+	assert.Len(t, auths, 3)
+	assert.IsType(t, &rbac.RBAC{}, auths[2])
+	config.RBAC.Source = "file"
+	_, err = NewAuths(config, nil)
+	assert.Error(t, err)
+
+	// identity required if nothing else checks it
+	config = types.Config{Token: types.TokenConfig{Secret: "secret"}}
+	auths, err = NewAuths(config, nil)
+	assert.NoError(t, err)
+	assert.Len(t, auths, 2)
+	assert.IsType(t, &identityRequired{}, auths[1])
+
+	// client cert optional without other auth
+	config = types.Config{TLS: types.TLSConfig{CA: "ca.crt"}}
+	_, err = NewAuths(config, nil)
+	assert.Equal(t, types.ErrInsecureAuth, err)
+	config.TLS.VerifyClient = true
+	auths, err = NewAuths(config, nil)
+	assert.NoError(t, err)
+	assert.Len(t, auths, 2)
+}
+
+// callChain call auths in order like grpc server does, returns username handler got
+func callChain(ctx context.Context, auths []Auth) (string, error) {
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return utils.UsernameFromContext(ctx), nil
+	}
+	for i := len(auths) - 1; i >= 0; i-- {
+		auth, next := auths[i], handler
+		handler = func(ctx context.Context, req interface{}) (interface{}, error) {
+			return auth.UnaryInterceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/pb.CoreRPC/ListPods"}, next)
+		}
+	}
+	username, err := handler(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	return username.(string), nil
+}
+
+func bearerContext(t *testing.T, username string, ttl time.Duration) context.Context {
+	tk, err := token.Issue("secret", username, ttl)
+	assert.NoError(t, err)
+	return metadata.NewIncomingContext(context.Background(), metadata.MD{"authorization": []string{"Bearer " + tk}})
+}
+
+func TestChainTokenAndPassword(t *testing.T) {
+	auths, err := NewAuths(types.Config{Token: types.TokenConfig{Secret: "secret"}, Auth: types.AuthConfig{Username: "u", Password: "p"}}, nil)
+	assert.NoError(t, err)
+
+	username, err := callChain(bearerContext(t, "u1", time.Minute), auths)
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", username)
+	// password clients still work
+	username, err = callChain(metadata.NewIncomingContext(context.Background(), metadata.MD{"u": []string{"p"}}), auths)
+	assert.NoError(t, err)
+	assert.Equal(t, "u", username)
+	_, err = callChain(metadata.NewIncomingContext(context.Background(), metadata.MD{"u": []string{"x"}}), auths)
+	assert.Equal(t, types.ErrInvaildPassword, err)
+	_, err = callChain(bearerContext(t, "u1", -time.Minute), auths)
+	assert.Equal(t, types.ErrTokenExpired, err)
+	_, err = callChain(context.Background(), auths)
+	assert.Error(t, err)
+}
+
+func TestChainTokenAndRBAC(t *testing.T) {
+	config := types.Config{Token: types.TokenConfig{Secret: "secret"}}
+	config.RBAC.Enable = true
+	config.RBAC.Users = []types.RBACUser{
+		{Username: "viewer", Roles: []string{"readonly"}},
+		{Username: "admin", Password: "admin", Roles: []string{"readonly"}},
+	}
+	config.RBAC.Roles = []types.RBACRole{{Name: "readonly", Methods: []string{"List*"}}}
+	auths, err := NewAuths(config, nil)
+	assert.NoError(t, err)
+
+	username, err := callChain(bearerContext(t, "viewer", time.Minute), auths)
+	assert.NoError(t, err)
+	assert.Equal(t, "viewer", username)
+	// password of rbac reachable without token
+	username, err = callChain(metadata.NewIncomingContext(context.Background(), metadata.MD{"admin": []string{"admin"}}), auths)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", username)
+	_, err = callChain(bearerContext(t, "nobody", time.Minute), auths)
+	assert.Error(t, err)
+	_, err = callChain(context.Background(), auths)
+	assert.Error(t, err)
+}
+
+func TestChainTokenOnly(t *testing.T) {
+	auths, err := NewAuths(types.Config{Token: types.TokenConfig{Secret: "secret"}}, nil)
+	assert.NoError(t, err)
+	username, err := callChain(bearerContext(t, "u1", time.Minute), auths)
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", username)
+	_, err = callChain(context.Background(), auths)
+	assert.Equal(t, types.ErrUnauthenticated, err)
+}
@@ -0,0 +1,49 @@
+package cert
+
+import (
+	"context"
+
+	"github.com/projecteru2/core/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+// CertAuth take common name of verified client cert as username
+// requests without client cert are passed for other auth
+type CertAuth struct{}
+
+// NewCertAuth return a cert auth obj
+func NewCertAuth() *CertAuth {
+	return &CertAuth{}
+}
+
+// StreamInterceptor define stream interceptor
+func (c *CertAuth) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := stream.Context()
+	if username := commonName(ctx); username != "" {
+		stream = utils.StreamWithContext(stream, utils.ContextWithUsername(ctx, username))
+	}
+	return handler(srv, stream)
+}
+
+// UnaryInterceptor define unary interceptor
+func (c *CertAuth) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if username := commonName(ctx); username != "" {
+		ctx = utils.ContextWithUsername(ctx, username)
+	}
+	return handler(ctx, req)
+}
+
+// commonName of verified client cert, empty if not given
+func commonName(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return ""
+	}
+	return tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+}
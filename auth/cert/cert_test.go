@@ -0,0 +1,47 @@
+package cert
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	grpcmocks "github.com/projecteru2/core/3rdmocks"
+	"github.com/projecteru2/core/utils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+func TestCertAuth(t *testing.T) {
+	c := NewCertAuth()
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return utils.UsernameFromContext(ctx), nil
+	}
+	ctx := context.Background()
+
+	// no peer or no cert, passed without username
+	username, err := c.UnaryInterceptor(ctx, nil, nil, handler)
+	assert.NoError(t, err)
+	assert.Empty(t, username)
+	username, err = c.UnaryInterceptor(peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{}}), nil, nil, handler)
+	assert.NoError(t, err)
+	assert.Empty(t, username)
+
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "u1"}}
+	tlsInfo := credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}}
+	peerCtx := peer.NewContext(ctx, &peer.Peer{AuthInfo: tlsInfo})
+	username, err = c.UnaryInterceptor(peerCtx, nil, nil, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", username)
+
+	stream := &grpcmocks.ServerStream{}
+	stream.On("Context").Return(peerCtx)
+	err = c.StreamInterceptor(nil, stream, nil, func(srv interface{}, stream grpc.ServerStream) error {
+		assert.Equal(t, "u1", utils.UsernameFromContext(stream.Context()))
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,200 @@
+package rbac
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	// SourceConfig load policy from config
+	SourceConfig = "config"
+	// SourceEtcd load policy from store
+	SourceEtcd = "etcd"
+)
+
+// Cluster is what rbac needs from cluster
+type Cluster interface {
+	GetNode(ctx context.Context, nodename string) (*types.Node, error)
+	GetContainers(ctx context.Context, IDs []string) ([]*types.Container, error)
+	GetRBACPolicy(ctx context.Context) (*types.RBACPolicy, error)
+}
+
+// RBAC authenticate users by password, and authorize requests by roles of user
+// users already authenticated by cert or token are found by name
+type RBAC struct {
+	sync.Mutex
+	config   types.RBACConfig
+	cluster  Cluster
+	policy   *types.RBACPolicy
+	loadedAt time.Time
+}
+
+// New return rbac obj
+func New(config types.RBACConfig, cluster Cluster) (*RBAC, error) {
+	r := &RBAC{config: config, cluster: cluster}
+	switch config.Source {
+	case "", SourceConfig:
+		r.policy = &r.config.RBACPolicy
+	case SourceEtcd:
+	default:
+		return nil, types.NewDetailedErr(types.ErrBadRBACSource, config.Source)
+	}
+	return r, nil
+}
+
+// StreamInterceptor define stream interceptor
+// scope is checked when the request is received
+func (r *RBAC) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := stream.Context()
+	user, roles, err := r.doAuth(ctx, methodName(info.FullMethod))
+	if err != nil {
+		return err
+	}
+	return handler(srv, &rbacStream{
+		ServerStream: utils.StreamWithContext(stream, utils.ContextWithUsername(ctx, user.Username)),
+		check: func(req interface{}) error {
+			return r.checkScope(ctx, roles, req)
+		},
+	})
+}
+
+// UnaryInterceptor define unary interceptor
+func (r *RBAC) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	user, roles, err := r.doAuth(ctx, methodName(info.FullMethod))
+	if err != nil {
+		return nil, err
+	}
+	if err := r.checkScope(ctx, roles, req); err != nil {
+		return nil, err
+	}
+	return handler(utils.ContextWithUsername(ctx, user.Username), req)
+}
+
+// doAuth find user and roles of user allowing method
+func (r *RBAC) doAuth(ctx context.Context, method string) (*types.RBACUser, []*types.RBACRole, error) {
+	policy, err := r.getPolicy(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	user, err := r.getUser(ctx, policy)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// 没权限也要让审计知道是谁
+	utils.SetIdentity(ctx, user.Username)
+	roles := []*types.RBACRole{}
+	for _, role := range policy.GetRoles(user) {
+		if role.AllowMethod(method) {
+			roles = append(roles, role)
+		}
+	}
+	if len(roles) == 0 {
+		log.Warnf("[RBAC] User %s not allowed to call %s", user.Username, method)
+		return nil, nil, types.NewDetailedErr(types.ErrPermissionDenied, method)
+	}
+	return user, roles, nil
+}
+
+// getUser find user authenticated before, or by password in meta
+func (r *RBAC) getUser(ctx context.Context, policy *types.RBACPolicy) (*types.RBACUser, error) {
+	if username := utils.UsernameFromContext(ctx); username != "" {
+		if user := policy.GetUser(username); user != nil {
+			return user, nil
+		}
+		return nil, types.NewDetailedErr(types.ErrInvaildUsername, username)
+	}
+	meta, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, types.ErrBadMeta
+	}
+	for i := range policy.Users {
+		passwords := meta.Get(policy.Users[i].Username)
+		if len(passwords) == 0 {
+			continue
+		}
+		// 没有密码的用户只能用证书或 token
+		if policy.Users[i].Password == "" || passwords[0] != policy.Users[i].Password {
+			return nil, types.ErrInvaildPassword
+		}
+		return &policy.Users[i], nil
+	}
+	return nil, types.ErrInvaildUsername
+}
+
+// checkScope check request is in scope of any role
+func (r *RBAC) checkScope(ctx context.Context, roles []*types.RBACRole, req interface{}) error {
+	for _, role := range roles {
+		if !role.Scoped() {
+			return nil
+		}
+	}
+	s := requestScope(req)
+	if err := s.resolve(ctx, r.cluster); err != nil {
+		return types.NewDetailedErr(types.ErrPermissionDenied, err)
+	}
+	for _, role := range roles {
+		if role.AllowScope(s.podnames, s.appnames) {
+			return nil
+		}
+	}
+	return types.NewDetailedErr(types.ErrPermissionDenied,
+		"pods "+strings.Join(s.podnames, ",")+" apps "+strings.Join(s.appnames, ","))
+}
+
+// getPolicy return policy, policy in store is cached and reloaded after refresh interval
+// old policy is used if reload failed
+func (r *RBAC) getPolicy(ctx context.Context) (*types.RBACPolicy, error) {
+	r.Lock()
+	defer r.Unlock()
+	if r.config.Source != SourceEtcd || (r.policy != nil && time.Since(r.loadedAt) < r.config.Refresh) {
+		return r.policy, nil
+	}
+	policy, err := r.cluster.GetRBACPolicy(ctx)
+	if err != nil {
+		if r.policy == nil {
+			return nil, err
+		}
+		log.Errorf("[RBAC] Reload policy failed %v", err)
+		policy = r.policy
+	}
+	r.policy, r.loadedAt = policy, time.Now()
+	return r.policy, nil
+}
+
+// rbacStream check scope of first message received, carry username in context
+type rbacStream struct {
+	grpc.ServerStream
+	check   func(req interface{}) error
+	checked bool
+	err     error
+}
+
+// RecvMsg receive message and check the first one
+func (s *rbacStream) RecvMsg(m interface{}) error {
+	if s.err != nil {
+		return s.err
+	}
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if !s.checked {
+		// 没通过的后面也不能再收
+		s.checked = true
+		s.err = s.check(m)
+	}
+	return s.err
+}
+
+// methodName get method name from /pb.CoreRPC/Method
+func methodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
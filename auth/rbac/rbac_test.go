@@ -0,0 +1,174 @@
+package rbac
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	grpcmocks "github.com/projecteru2/core/3rdmocks"
+	clustermocks "github.com/projecteru2/core/cluster/mocks"
+	pb "github.com/projecteru2/core/rpc/gen"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var testPolicy = types.RBACPolicy{
+	Users: []types.RBACUser{
+		{Username: "admin", Password: "admin", Roles: []string{"admin"}},
+		{Username: "viewer", Password: "viewer", Roles: []string{"readonly"}},
+		{Username: "team", Password: "team", Roles: []string{"readonly", "team"}},
+	},
+	Roles: []types.RBACRole{
+		{Name: "admin", Methods: []string{"*"}},
+		{Name: "readonly", Methods: []string{"List*", "Get*"}},
+		{Name: "team", Methods: []string{"CreateContainer", "RemoveContainer", "RemovePod"}, Pods: []string{"p1"}},
+	},
+}
+
+func unaryHandler(ctx context.Context, req interface{}) (interface{}, error) {
+	return utils.UsernameFromContext(ctx), nil
+}
+
+func userContext(username, password string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.MD{username: []string{password}})
+}
+
+func unaryInfo(method string) *grpc.UnaryServerInfo {
+	return &grpc.UnaryServerInfo{FullMethod: "/pb.CoreRPC/" + method}
+}
+
+func TestNew(t *testing.T) {
+	_, err := New(types.RBACConfig{Source: "file"}, nil)
+	assert.Error(t, err)
+	_, err = New(types.RBACConfig{Source: SourceEtcd}, nil)
+	assert.NoError(t, err)
+}
+
+func TestUnaryInterceptor(t *testing.T) {
+	cluster := &clustermocks.Cluster{}
+	r, err := New(types.RBACConfig{RBACPolicy: testPolicy}, cluster)
+	assert.NoError(t, err)
+
+	// no meta
+	_, err = r.UnaryInterceptor(context.Background(), &pb.Empty{}, unaryInfo("ListPods"), unaryHandler)
+	assert.Equal(t, types.ErrBadMeta, err)
+	// unknown user
+	_, err = r.UnaryInterceptor(userContext("nobody", "pass"), &pb.Empty{}, unaryInfo("ListPods"), unaryHandler)
+	assert.Equal(t, types.ErrInvaildUsername, err)
+	// wrong password
+	_, err = r.UnaryInterceptor(userContext("viewer", "pass"), &pb.Empty{}, unaryInfo("ListPods"), unaryHandler)
+	assert.Equal(t, types.ErrInvaildPassword, err)
+
+	// read only
+	resp, err := r.UnaryInterceptor(userContext("viewer", "viewer"), &pb.Empty{}, unaryInfo("ListPods"), unaryHandler)
+	assert.NoError(t, err)
+	assert.Equal(t, "viewer", resp)
+	// denied user still known by interceptors outside
+	ctx := utils.ContextWithIdentity(userContext("viewer", "viewer"))
+	_, err = r.UnaryInterceptor(ctx, &pb.RemovePodOptions{Name: "p1"}, unaryInfo("RemovePod"), unaryHandler)
+	assert.True(t, errors.Is(err, types.ErrPermissionDenied))
+	assert.Equal(t, "viewer", utils.IdentityFromContext(ctx))
+
+	// admin
+	resp, err = r.UnaryInterceptor(userContext("admin", "admin"), &pb.AddNodeOptions{Podname: "p2"}, unaryInfo("AddNode"), unaryHandler)
+	assert.NoError(t, err)
+	assert.Equal(t, "admin", resp)
+
+	// team only in its pod
+	_, err = r.UnaryInterceptor(userContext("team", "team"), &pb.RemovePodOptions{Name: "p1"}, unaryInfo("RemovePod"), unaryHandler)
+	assert.NoError(t, err)
+	_, err = r.UnaryInterceptor(userContext("team", "team"), &pb.RemovePodOptions{Name: "p2"}, unaryInfo("RemovePod"), unaryHandler)
+	assert.True(t, errors.Is(err, types.ErrPermissionDenied))
+	// unscoped read only role
+	_, err = r.UnaryInterceptor(userContext("team", "team"), &pb.GetPodOptions{Name: "p2"}, unaryInfo("GetPod"), unaryHandler)
+	assert.NoError(t, err)
+	cluster.AssertNotCalled(t, "GetContainers", mock.Anything, mock.Anything)
+}
+
+func TestStreamInterceptor(t *testing.T) {
+	cluster := &clustermocks.Cluster{}
+	r, err := New(types.RBACConfig{RBACPolicy: testPolicy}, cluster)
+	assert.NoError(t, err)
+	cluster.On("GetContainers", mock.Anything, []string{"c1"}).Return([]*types.Container{{ID: "c1", Name: "app_entry_aaaaaa", Podname: "p1"}}, nil)
+	cluster.On("GetContainers", mock.Anything, []string{"c2"}).Return([]*types.Container{{ID: "c2", Name: "app_entry_bbbbbb", Podname: "p2"}}, nil)
+	info := &grpc.StreamServerInfo{FullMethod: "/pb.CoreRPC/RemoveContainer"}
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		assert.Equal(t, "team", utils.UsernameFromContext(stream.Context()))
+		opts := &pb.RemoveContainerOptions{}
+		if err := stream.RecvMsg(opts); err != nil {
+			return err
+		}
+		// always failed after denied
+		return stream.RecvMsg(opts)
+	}
+	newStream := func(ID string) *grpcmocks.ServerStream {
+		stream := &grpcmocks.ServerStream{}
+		stream.On("Context").Return(userContext("team", "team"))
+		stream.On("RecvMsg", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			args.Get(0).(*pb.RemoveContainerOptions).Ids = []string{ID}
+		})
+		return stream
+	}
+
+	// container in pod of team
+	assert.NoError(t, r.StreamInterceptor(nil, newStream("c1"), info, handler))
+	// container in other pod
+	stream := newStream("c2")
+	err = r.StreamInterceptor(nil, stream, info, handler)
+	assert.True(t, errors.Is(err, types.ErrPermissionDenied))
+	stream.AssertNumberOfCalls(t, "RecvMsg", 1)
+	// method not allowed
+	info = &grpc.StreamServerInfo{FullMethod: "/pb.CoreRPC/DrainNode"}
+	err = r.StreamInterceptor(nil, newStream("c1"), info, handler)
+	assert.True(t, errors.Is(err, types.ErrPermissionDenied))
+}
+
+func TestPolicyFromStore(t *testing.T) {
+	cluster := &clustermocks.Cluster{}
+	r, err := New(types.RBACConfig{Source: SourceEtcd, Refresh: time.Minute}, cluster)
+	assert.NoError(t, err)
+	ctx := userContext("viewer", "viewer")
+
+	// no policy
+	cluster.On("GetRBACPolicy", mock.Anything).Return(nil, types.ErrBadCount).Once()
+	_, err = r.UnaryInterceptor(ctx, &pb.Empty{}, unaryInfo("ListPods"), unaryHandler)
+	assert.Error(t, err)
+
+	// cached
+	policy := testPolicy
+	cluster.On("GetRBACPolicy", mock.Anything).Return(&policy, nil).Once()
+	_, err = r.UnaryInterceptor(ctx, &pb.Empty{}, unaryInfo("ListPods"), unaryHandler)
+	assert.NoError(t, err)
+	_, err = r.UnaryInterceptor(ctx, &pb.Empty{}, unaryInfo("ListPods"), unaryHandler)
+	assert.NoError(t, err)
+	cluster.AssertNumberOfCalls(t, "GetRBACPolicy", 2)
+
+	// reload failed, old policy used
+	r.loadedAt = time.Time{}
+	cluster.On("GetRBACPolicy", mock.Anything).Return(nil, types.ErrBadCount).Once()
+	_, err = r.UnaryInterceptor(ctx, &pb.Empty{}, unaryInfo("ListPods"), unaryHandler)
+	assert.NoError(t, err)
+	cluster.AssertNumberOfCalls(t, "GetRBACPolicy", 3)
+}
+
+func TestAuthenticatedUser(t *testing.T) {
+	policy := testPolicy
+	policy.Users = append(policy.Users, types.RBACUser{Username: "certuser", Roles: []string{"readonly"}})
+	r, err := New(types.RBACConfig{RBACPolicy: policy}, &clustermocks.Cluster{})
+	assert.NoError(t, err)
+
+	// authenticated by cert or token
+	resp, err := r.UnaryInterceptor(utils.ContextWithUsername(context.Background(), "certuser"), &pb.Empty{}, unaryInfo("ListPods"), unaryHandler)
+	assert.NoError(t, err)
+	assert.Equal(t, "certuser", resp)
+	_, err = r.UnaryInterceptor(utils.ContextWithUsername(context.Background(), "nobody"), &pb.Empty{}, unaryInfo("ListPods"), unaryHandler)
+	assert.True(t, errors.Is(err, types.ErrInvaildUsername))
+	// user without password can't login by password
+	_, err = r.UnaryInterceptor(userContext("certuser", ""), &pb.Empty{}, unaryInfo("ListPods"), unaryHandler)
+	assert.Equal(t, types.ErrInvaildPassword, err)
+}
@@ -0,0 +1,172 @@
+package rbac
+
+import (
+	"context"
+
+	pb "github.com/projecteru2/core/rpc/gen"
+	"github.com/projecteru2/core/utils"
+)
+
+// scope is what a request acts on
+// nodes and containers are resolved to pods and apps before checked
+type scope struct {
+	podnames  []string
+	appnames  []string
+	nodenames []string
+	IDs       []string
+}
+
+func (s *scope) addPod(podname string) {
+	if podname != "" {
+		s.podnames = append(s.podnames, podname)
+	}
+}
+
+func (s *scope) addApp(appname string) {
+	if appname != "" {
+		s.appnames = append(s.appnames, appname)
+	}
+}
+
+func (s *scope) addNode(nodename string) {
+	if nodename != "" {
+		s.nodenames = append(s.nodenames, nodename)
+	}
+}
+
+func (s *scope) addContainers(IDs ...string) {
+	for _, ID := range IDs {
+		if ID != "" {
+			s.IDs = append(s.IDs, ID)
+		}
+	}
+}
+
+func (s *scope) addDeploy(opts *pb.DeployOptions) {
+	if opts != nil {
+		s.addPod(opts.Podname)
+		s.addApp(opts.Name)
+	}
+}
+
+// requestScope get scope from fields of request
+func requestScope(req interface{}) *scope {
+	s := &scope{}
+	switch opts := req.(type) {
+	case *pb.AddPodOptions:
+		s.addPod(opts.Name)
+	case *pb.RemovePodOptions:
+		s.addPod(opts.Name)
+	case *pb.GetPodOptions:
+		s.addPod(opts.Name)
+	case *pb.SetPodOvercommitOptions:
+		s.addPod(opts.Name)
+	case *pb.ListNodesOptions:
+		s.addPod(opts.Podname)
+	case *pb.ListNetworkOptions:
+		s.addPod(opts.Podname)
+	case *pb.AuditOptions:
+		s.addPod(opts.Podname)
+	case *pb.AddNodeOptions:
+		s.addPod(opts.Podname)
+	case *pb.RemoveNodeOptions:
+		s.addNode(opts.Nodename)
+	case *pb.GetNodeOptions:
+		s.addNode(opts.Nodename)
+	case *pb.SetNodeOptions:
+		s.addNode(opts.Nodename)
+	case *pb.FixNodeResourceOptions:
+		s.addNode(opts.Nodename)
+	case *pb.DrainNodeOptions:
+		s.addNode(opts.Nodename)
+	case *pb.CacheImageOptions:
+		s.addPod(opts.Podname)
+		s.addNode(opts.Nodename)
+	case *pb.RemoveImageOptions:
+		s.addPod(opts.Podname)
+		s.addNode(opts.Nodename)
+	case *pb.ListContainersOptions:
+		s.addApp(opts.Appname)
+		s.addNode(opts.Nodename)
+	case *pb.ContainerStatusStreamOptions:
+		s.addApp(opts.Appname)
+		s.addNode(opts.Nodename)
+	case *pb.WatchEventsOptions:
+		s.addPod(opts.Podname)
+		s.addApp(opts.Appname)
+		s.addNode(opts.Nodename)
+	case *pb.ContainerID:
+		s.addContainers(opts.Id)
+	case *pb.ContainerIDs:
+		s.addContainers(opts.Ids...)
+	case *pb.RemoveContainerOptions:
+		s.addContainers(opts.Ids...)
+	case *pb.DissociateContainerOptions:
+		s.addContainers(opts.Ids...)
+	case *pb.ReallocOptions:
+		s.addContainers(opts.Ids...)
+	case *pb.ControlContainerOptions:
+		s.addContainers(opts.Ids...)
+	case *pb.SendOptions:
+		s.addContainers(opts.Ids...)
+	case *pb.ExecuteContainerOptions:
+		s.addContainers(opts.ContainerId)
+	case *pb.CopyOptions:
+		for ID := range opts.Targets {
+			s.addContainers(ID)
+		}
+	case *pb.SetContainersStatusOptions:
+		for _, status := range opts.Status {
+			s.addContainers(status.Id)
+		}
+	case *pb.DeployOptions:
+		s.addDeploy(opts)
+	case *pb.ReplaceOptions:
+		s.addDeploy(opts.DeployOpt)
+		s.addContainers(opts.Ids...)
+	case *pb.RunAndWaitOptions:
+		s.addDeploy(opts.DeployOptions)
+	case *pb.RemoveDeploymentOptions:
+		s.addApp(opts.Appname)
+	case *pb.AutoscalePolicy:
+		s.addApp(opts.Appname)
+	case *pb.RemoveAutoscalePolicyOptions:
+		s.addApp(opts.Appname)
+	case *pb.ListAutoscaleDecisionsOptions:
+		s.addApp(opts.Appname)
+	case *pb.Quota:
+		s.addApp(opts.Appname)
+		s.addPod(opts.Podname)
+	case *pb.RemoveQuotaOptions:
+		s.addApp(opts.Appname)
+		s.addPod(opts.Podname)
+	case *pb.ListQuotaUsagesOptions:
+		s.addApp(opts.Appname)
+	}
+	return s
+}
+
+// resolve nodes and containers into pods and apps
+func (s *scope) resolve(ctx context.Context, cluster Cluster) error {
+	for _, nodename := range s.nodenames {
+		node, err := cluster.GetNode(ctx, nodename)
+		if err != nil {
+			return err
+		}
+		s.addPod(node.Podname)
+	}
+	if len(s.IDs) == 0 {
+		return nil
+	}
+	containers, err := cluster.GetContainers(ctx, s.IDs)
+	if err != nil {
+		return err
+	}
+	for _, container := range containers {
+		s.addPod(container.Podname)
+		if appname, _, _, err := utils.ParseContainerName(container.Name); err == nil {
+			s.addApp(appname)
+		}
+	}
+	return nil
+}
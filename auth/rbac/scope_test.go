@@ -0,0 +1,45 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	clustermocks "github.com/projecteru2/core/cluster/mocks"
+	pb "github.com/projecteru2/core/rpc/gen"
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRequestScope(t *testing.T) {
+	s := requestScope(&pb.DeployOptions{Name: "app", Podname: "p1"})
+	assert.Equal(t, []string{"p1"}, s.podnames)
+	assert.Equal(t, []string{"app"}, s.appnames)
+	s = requestScope(&pb.ReplaceOptions{DeployOpt: &pb.DeployOptions{Name: "app", Podname: "p1"}, Ids: []string{"c1"}})
+	assert.Equal(t, []string{"c1"}, s.IDs)
+	s = requestScope(&pb.RunAndWaitOptions{})
+	assert.Empty(t, s.podnames)
+	s = requestScope(&pb.Quota{Appname: "app"})
+	assert.Empty(t, s.podnames)
+	assert.Equal(t, []string{"app"}, s.appnames)
+	s = requestScope(&pb.Empty{})
+	assert.Empty(t, s)
+
+	cluster := &clustermocks.Cluster{}
+	ctx := context.Background()
+	s = requestScope(&pb.SetNodeOptions{Nodename: "n1"})
+	cluster.On("GetNode", mock.Anything, "n1").Return(nil, types.ErrBadCount).Once()
+	assert.Error(t, s.resolve(ctx, cluster))
+	cluster.On("GetNode", mock.Anything, "n1").Return(&types.Node{Name: "n1", Podname: "p1"}, nil)
+	assert.NoError(t, s.resolve(ctx, cluster))
+	assert.Equal(t, []string{"p1"}, s.podnames)
+
+	s = requestScope(&pb.ControlContainerOptions{Ids: []string{"c1", "c2"}})
+	cluster.On("GetContainers", mock.Anything, []string{"c1", "c2"}).Return([]*types.Container{
+		{ID: "c1", Name: "app_entry_aaaaaa", Podname: "p1"},
+		{ID: "c2", Name: "bad", Podname: "p2"},
+	}, nil)
+	assert.NoError(t, s.resolve(ctx, cluster))
+	assert.Equal(t, []string{"p1", "p2"}, s.podnames)
+	assert.Equal(t, []string{"app"}, s.appnames)
+}
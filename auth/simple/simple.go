@@ -4,11 +4,13 @@ import (
 	"context"
 
 	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
 
 // BasicAuth use token to auth grcp request
+// requests already authenticated, by client cert or bearer token, are passed
 type BasicAuth struct {
 	username string
 	password string
@@ -22,18 +24,24 @@ func NewBasicAuth(username, password string) *BasicAuth {
 // StreamInterceptor define stream interceptor
 func (b *BasicAuth) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
 	ctx := stream.Context()
+	if utils.UsernameFromContext(ctx) != "" {
+		return handler(srv, stream)
+	}
 	if err := b.doAuth(ctx); err != nil {
 		return err
 	}
-	return handler(srv, stream)
+	return handler(srv, utils.StreamWithContext(stream, utils.ContextWithUsername(ctx, b.username)))
 }
 
 // UnaryInterceptor define unary interceptor
 func (b *BasicAuth) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	if utils.UsernameFromContext(ctx) != "" {
+		return handler(ctx, req)
+	}
 	if err := b.doAuth(ctx); err != nil {
 		return nil, err
 	}
-	return handler(ctx, req)
+	return handler(utils.ContextWithUsername(ctx, b.username), req)
 }
 
 func (b *BasicAuth) doAuth(ctx context.Context) error {
@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	grpcmocks "github.com/projecteru2/core/3rdmocks"
+	"github.com/projecteru2/core/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
 )
@@ -93,3 +94,43 @@ func TestBasicAuthUnary(t *testing.T) {
 	assert.True(t, ok)
 	assert.Equal(t, s, defaultSrv)
 }
+
+func TestBasicAuthUsername(t *testing.T) {
+	user := "test"
+	pass := "pass"
+	ba := NewBasicAuth(user, pass)
+	incomingCtx := metadata.NewIncomingContext(context.Background(), metadata.MD{user: []string{pass}})
+
+	_, err := ba.UnaryInterceptor(incomingCtx, defaultSrv, nil, func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.Equal(t, user, utils.UsernameFromContext(ctx))
+		return req, nil
+	})
+	assert.NoError(t, err)
+
+	mockServerStream := &grpcmocks.ServerStream{}
+	mockServerStream.On("Context").Return(incomingCtx)
+	err = ba.StreamInterceptor(defaultSrv, mockServerStream, nil, func(srv interface{}, stream grpc.ServerStream) error {
+		assert.Equal(t, user, utils.UsernameFromContext(stream.Context()))
+		return nil
+	})
+	assert.NoError(t, err)
+}
+
+func TestBasicAuthAuthenticated(t *testing.T) {
+	ba := NewBasicAuth("test", "pass")
+	// identified by cert or token before, no password needed
+	ctx := utils.ContextWithUsername(context.Background(), "u1")
+	_, err := ba.UnaryInterceptor(ctx, defaultSrv, nil, func(ctx context.Context, req interface{}) (interface{}, error) {
+		assert.Equal(t, "u1", utils.UsernameFromContext(ctx))
+		return req, nil
+	})
+	assert.NoError(t, err)
+
+	mockServerStream := &grpcmocks.ServerStream{}
+	mockServerStream.On("Context").Return(ctx)
+	err = ba.StreamInterceptor(defaultSrv, mockServerStream, nil, func(srv interface{}, stream grpc.ServerStream) error {
+		assert.Equal(t, "u1", utils.UsernameFromContext(stream.Context()))
+		return nil
+	})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,66 @@
+package token
+
+import (
+	"context"
+	"strings"
+
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+const (
+	authorizationKey = "authorization"
+	bearerPrefix     = "Bearer "
+)
+
+// JWTAuth authenticate requests by bearer jwt signed with secret
+// requests already authenticated, by client cert for example, or without token are passed
+// only bad or expired tokens are rejected
+type JWTAuth struct {
+	secret string
+}
+
+// NewJWTAuth return a jwt auth obj
+func NewJWTAuth(secret string) *JWTAuth {
+	return &JWTAuth{secret}
+}
+
+// StreamInterceptor define stream interceptor
+func (j *JWTAuth) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := j.doAuth(stream.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, utils.StreamWithContext(stream, ctx))
+}
+
+// UnaryInterceptor define unary interceptor
+func (j *JWTAuth) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := j.doAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (j *JWTAuth) doAuth(ctx context.Context) (context.Context, error) {
+	if utils.UsernameFromContext(ctx) != "" {
+		return ctx, nil
+	}
+	// 没带 token 的交给后面的认证, 比如 rbac 的密码
+	meta, _ := metadata.FromIncomingContext(ctx)
+	values := meta.Get(authorizationKey)
+	if len(values) < 1 {
+		return ctx, nil
+	}
+	if !strings.HasPrefix(values[0], bearerPrefix) {
+		return nil, types.ErrInvaildToken
+	}
+	username, err := Verify(j.secret, strings.TrimPrefix(values[0], bearerPrefix))
+	if err != nil {
+		return nil, err
+	}
+	return utils.ContextWithUsername(ctx, username), nil
+}
@@ -0,0 +1,25 @@
+package token
+
+import "context"
+
+// Credential send bearer token, only over tls
+type Credential struct {
+	token string
+}
+
+// NewCredential new a token credential
+func NewCredential(token string) *Credential {
+	return &Credential{token}
+}
+
+// GetRequestMetadata for bearer token
+func (c Credential) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		authorizationKey: bearerPrefix + c.token,
+	}, nil
+}
+
+// RequireTransportSecurity for ssl require
+func (c Credential) RequireTransportSecurity() bool {
+	return true
+}
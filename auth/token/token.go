@@ -0,0 +1,66 @@
+package token
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/projecteru2/core/types"
+)
+
+// header of HS256 jwt, the only alg supported
+var header = encode([]byte(`{"alg":"HS256","typ":"JWT"}`))
+
+type claims struct {
+	Subject   string `json:"sub"`
+	ExpiresAt int64  `json:"exp"`
+	IssuedAt  int64  `json:"iat"`
+}
+
+// Issue sign a jwt for username, expired after ttl
+func Issue(secret, username string, ttl time.Duration) (string, error) {
+	now := time.Now()
+	payload, err := json.Marshal(&claims{Subject: username, ExpiresAt: now.Add(ttl).Unix(), IssuedAt: now.Unix()})
+	if err != nil {
+		return "", err
+	}
+	unsigned := header + "." + encode(payload)
+	return unsigned + "." + sign(secret, unsigned), nil
+}
+
+// Verify check signature and expiry of jwt, returns username in it
+func Verify(secret, token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 || parts[0] != header {
+		return "", types.ErrInvaildToken
+	}
+	if !hmac.Equal([]byte(parts[2]), []byte(sign(secret, parts[0]+"."+parts[1]))) {
+		return "", types.ErrInvaildToken
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", types.ErrInvaildToken
+	}
+	c := &claims{}
+	if err := json.Unmarshal(payload, c); err != nil || c.Subject == "" {
+		return "", types.ErrInvaildToken
+	}
+	// 不接受不过期的 token
+	if c.ExpiresAt <= time.Now().Unix() {
+		return "", types.ErrTokenExpired
+	}
+	return c.Subject, nil
+}
+
+func sign(secret, unsigned string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(unsigned))
+	return encode(mac.Sum(nil))
+}
+
+func encode(data []byte) string {
+	return base64.RawURLEncoding.EncodeToString(data)
+}
@@ -0,0 +1,89 @@
+package token
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	grpcmocks "github.com/projecteru2/core/3rdmocks"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestIssueAndVerify(t *testing.T) {
+	token, err := Issue("secret", "u1", time.Minute)
+	assert.NoError(t, err)
+	username, err := Verify("secret", token)
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", username)
+
+	// wrong secret
+	_, err = Verify("other", token)
+	assert.Equal(t, types.ErrInvaildToken, err)
+	// tampered payload
+	parts := strings.Split(token, ".")
+	forged, err := Issue("other", "admin", time.Minute)
+	assert.NoError(t, err)
+	_, err = Verify("secret", parts[0]+"."+strings.Split(forged, ".")[1]+"."+parts[2])
+	assert.Equal(t, types.ErrInvaildToken, err)
+	// bad format
+	_, err = Verify("secret", "abc")
+	assert.Equal(t, types.ErrInvaildToken, err)
+	_, err = Verify("secret", "a.b.c")
+	assert.Equal(t, types.ErrInvaildToken, err)
+	// expired
+	token, err = Issue("secret", "u1", -time.Second)
+	assert.NoError(t, err)
+	_, err = Verify("secret", token)
+	assert.Equal(t, types.ErrTokenExpired, err)
+}
+
+func TestJWTAuth(t *testing.T) {
+	j := NewJWTAuth("secret")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return utils.UsernameFromContext(ctx), nil
+	}
+	ctx := context.Background()
+
+	// no token, passed to next auth
+	username, err := j.UnaryInterceptor(ctx, nil, nil, handler)
+	assert.NoError(t, err)
+	assert.Empty(t, username)
+	username, err = j.UnaryInterceptor(metadata.NewIncomingContext(ctx, metadata.MD{"u1": []string{"pass"}}), nil, nil, handler)
+	assert.NoError(t, err)
+	assert.Empty(t, username)
+	// bad or expired token rejected
+	_, err = j.UnaryInterceptor(metadata.NewIncomingContext(ctx, metadata.MD{"authorization": []string{"Basic abc"}}), nil, nil, handler)
+	assert.Equal(t, types.ErrInvaildToken, err)
+	expired, err := Issue("secret", "u1", -time.Minute)
+	assert.NoError(t, err)
+	_, err = j.UnaryInterceptor(metadata.NewIncomingContext(ctx, metadata.MD{"authorization": []string{"Bearer " + expired}}), nil, nil, handler)
+	assert.Equal(t, types.ErrTokenExpired, err)
+
+	token, err := Issue("secret", "u1", time.Minute)
+	assert.NoError(t, err)
+	md, err := NewCredential(token).GetRequestMetadata(ctx)
+	assert.NoError(t, err)
+	incomingCtx := metadata.NewIncomingContext(ctx, metadata.New(md))
+	username, err = j.UnaryInterceptor(incomingCtx, nil, nil, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "u1", username)
+
+	// authenticated before
+	username, err = j.UnaryInterceptor(utils.ContextWithUsername(ctx, "u2"), nil, nil, handler)
+	assert.NoError(t, err)
+	assert.Equal(t, "u2", username)
+
+	stream := &grpcmocks.ServerStream{}
+	stream.On("Context").Return(incomingCtx)
+	err = j.StreamInterceptor(nil, stream, nil, func(srv interface{}, stream grpc.ServerStream) error {
+		assert.Equal(t, "u1", utils.UsernameFromContext(stream.Context()))
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.True(t, NewCredential(token).RequireTransportSecurity())
+}
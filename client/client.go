@@ -4,8 +4,10 @@ import (
 	"github.com/projecteru2/core/auth"
 	pb "github.com/projecteru2/core/rpc/gen"
 	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 // Client contain grpc conn
@@ -14,10 +16,29 @@ type Client struct {
 	conn *grpc.ClientConn
 }
 
+// Config holds how client connects to core
+type Config struct {
+	Auth  types.AuthConfig // password auth
+	Token string           // bearer token, requires tls
+	TLS   *types.TLSConfig // connect with tls if set
+}
+
 // NewClient new a client
 func NewClient(addr string, authConfig types.AuthConfig) *Client {
-	conn := connect(addr, authConfig)
-	return &Client{addr: addr, conn: conn}
+	client, err := NewClientWithConfig(addr, Config{Auth: authConfig})
+	if err != nil {
+		log.Fatalf("[ConnectEru] Can not connect %v", err)
+	}
+	return client
+}
+
+// NewClientWithConfig new a client with tls or token
+func NewClientWithConfig(addr string, config Config) (*Client, error) {
+	conn, err := connect(addr, config)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{addr: addr, conn: conn}, nil
 }
 
 // GetConn return connection
@@ -30,16 +51,26 @@ func (c *Client) GetRPCClient() pb.CoreRPCClient {
 	return pb.NewCoreRPCClient(c.conn)
 }
 
-func connect(addr string, authConfig types.AuthConfig) *grpc.ClientConn {
+func connect(addr string, config Config) (*grpc.ClientConn, error) {
 	opts := []grpc.DialOption{grpc.WithInsecure()}
-	if authConfig.Username != "" {
-		opts = append(opts, grpc.WithPerRPCCredentials(auth.NewCredential(authConfig)))
+	if config.TLS != nil {
+		tlsConfig, err := utils.NewClientTLSConfig(*config.TLS)
+		if err != nil {
+			return nil, err
+		}
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig))}
+	}
+	if config.Auth.Username != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(auth.NewCredential(config.Auth)))
+	}
+	if config.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(auth.NewTokenCredential(config.Token)))
 	}
 
 	conn, err := grpc.Dial(addr, opts...)
 	if err != nil {
-		log.Fatalf("[ConnectEru] Can not connect %v", err)
+		return nil, err
 	}
 	log.Debugf("[ConnectEru] Init eru connection %s", addr)
-	return conn
+	return conn, nil
 }
@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/projecteru2/core/auth"
+	"github.com/projecteru2/core/auth/token"
+	clustermocks "github.com/projecteru2/core/cluster/mocks"
+	"github.com/projecteru2/core/rpc"
+	pb "github.com/projecteru2/core/rpc/gen"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+)
+
+type testCert struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+// genCert sign a cert by parent, self-signed if parent is nil
+func genCert(t *testing.T, dir, name string, parent *testCert) *testCert {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		DNSNames:     []string{"localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+	}
+	signer, signerKey := template, key
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+	} else {
+		signer, signerKey = parent.cert, parent.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, signer, &key.PublicKey, signerKey)
+	assert.NoError(t, err)
+	c, err := x509.ParseCertificate(der)
+	assert.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name+".crt"), pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, name+".key"), pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0600))
+	return &testCert{cert: c, key: key}
+}
+
+func tlsFiles(dir, ca, name string) *types.TLSConfig {
+	return &types.TLSConfig{
+		CA:   filepath.Join(dir, ca+".crt"),
+		Cert: filepath.Join(dir, name+".crt"),
+		Key:  filepath.Join(dir, name+".key"),
+	}
+}
+
+// startServer start core with cert and token auth, returns address and username of last request
+func startServer(t *testing.T, config types.TLSConfig) (string, *string, func()) {
+	tlsConfig, err := utils.NewServerTLSConfig(config)
+	assert.NoError(t, err)
+	cluster := &clustermocks.Cluster{}
+	cluster.On("ListPods", mock.Anything).Return([]*types.Pod{{Name: "p1"}}, nil)
+
+	username := ""
+	record := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		username = utils.UsernameFromContext(ctx)
+		return handler(ctx, req)
+	}
+	auths, err := auth.NewAuths(types.Config{TLS: config, Token: types.TokenConfig{Secret: "secret"}}, nil)
+	assert.NoError(t, err)
+	interceptors := []grpc.UnaryServerInterceptor{}
+	for _, a := range auths {
+		interceptors = append(interceptors, a.UnaryInterceptor)
+	}
+	server := grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.UnaryInterceptor(rpc.ChainUnaryInterceptors(append(interceptors, record)...)),
+	)
+	pb.RegisterCoreRPCServer(server, rpc.New(cluster, types.Config{}, nil, make(chan struct{})))
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	go server.Serve(l)
+	return l.Addr().String(), &username, server.Stop
+}
+
+func listPods(addr string, config Config) error {
+	client, err := NewClientWithConfig(addr, config)
+	if err != nil {
+		return err
+	}
+	defer client.GetConn().Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	_, err = client.GetRPCClient().ListPods(ctx, &pb.Empty{}, grpc.WaitForReady(false))
+	return err
+}
+
+func TestTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ca := genCert(t, dir, "ca", nil)
+	genCert(t, dir, "server", ca)
+	genCert(t, dir, "u1", ca)
+	other := genCert(t, dir, "other", nil)
+	genCert(t, dir, "u2", other)
+
+	addr, username, stop := startServer(t, *tlsFiles(dir, "ca", "server"))
+	defer stop()
+
+	// identity from client cert
+	assert.NoError(t, listPods(addr, Config{TLS: tlsFiles(dir, "ca", "u1")}))
+	assert.Equal(t, "u1", *username)
+	// identity from token
+	tk, err := token.Issue("secret", "u3", time.Minute)
+	assert.NoError(t, err)
+	assert.NoError(t, listPods(addr, Config{TLS: &types.TLSConfig{CA: filepath.Join(dir, "ca.crt")}, Token: tk}))
+	assert.Equal(t, "u3", *username)
+	// neither cert nor token
+	assert.Error(t, listPods(addr, Config{TLS: &types.TLSConfig{CA: filepath.Join(dir, "ca.crt")}}))
+	// expired token
+	tk, err = token.Issue("secret", "u3", -time.Minute)
+	assert.NoError(t, err)
+	assert.Error(t, listPods(addr, Config{TLS: &types.TLSConfig{CA: filepath.Join(dir, "ca.crt")}, Token: tk}))
+	// client cert signed by other ca
+	assert.Error(t, listPods(addr, Config{TLS: tlsFiles(dir, "ca", "u2")}))
+	// server cert not trusted
+	assert.Error(t, listPods(addr, Config{TLS: tlsFiles(dir, "other", "u1")}))
+	// plaintext
+	assert.Error(t, listPods(addr, Config{}))
+	// token never sent over plaintext
+	assert.Error(t, listPods(addr, Config{Token: tk}))
+}
+
+func TestVerifyClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tls")
+	assert.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ca := genCert(t, dir, "ca", nil)
+	genCert(t, dir, "server", ca)
+	genCert(t, dir, "u1", ca)
+
+	config := *tlsFiles(dir, "ca", "server")
+	config.VerifyClient = true
+	addr, username, stop := startServer(t, config)
+	defer stop()
+
+	assert.NoError(t, listPods(addr, Config{TLS: tlsFiles(dir, "ca", "u1")}))
+	assert.Equal(t, "u1", *username)
+	// cert required even with token
+	tk, err := token.Issue("secret", "u3", time.Minute)
+	assert.NoError(t, err)
+	assert.Error(t, listPods(addr, Config{TLS: &types.TLSConfig{CA: filepath.Join(dir, "ca.crt")}, Token: tk}))
+
+	// bad files
+	_, err = utils.NewServerTLSConfig(types.TLSConfig{Cert: filepath.Join(dir, "none.crt")})
+	assert.Error(t, err)
+	_, err = NewClientWithConfig(addr, Config{TLS: &types.TLSConfig{CA: filepath.Join(dir, "u1.key")}})
+	assert.Error(t, err)
+}
@@ -0,0 +1,182 @@
+package calcium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/projecteru2/core/cluster"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// AuditContainers compare containers in engines with containers in store node by node
+// orphans are only in engine, ghosts are only in store
+// nodes with containers being created are skipped
+func (c *Calcium) AuditContainers(ctx context.Context, opts *types.AuditOptions) (chan *types.AuditNodeMessage, error) {
+	if opts.OrphanAction != "" && opts.OrphanAction != cluster.AuditAdopt && opts.OrphanAction != cluster.AuditRemove {
+		return nil, types.NewDetailedErr(types.ErrBadAuditAction, opts.OrphanAction)
+	}
+	if opts.GhostAction != "" && opts.GhostAction != cluster.AuditDissociate {
+		return nil, types.NewDetailedErr(types.ErrBadAuditAction, opts.GhostAction)
+	}
+
+	podnames := []string{opts.Podname}
+	if opts.Podname == "" {
+		pods, err := c.ListPods(ctx)
+		if err != nil {
+			return nil, err
+		}
+		podnames = []string{}
+		for _, pod := range pods {
+			podnames = append(podnames, pod.Name)
+		}
+	}
+	nodes := []*types.Node{}
+	for _, podname := range podnames {
+		ns, err := c.ListPodNodes(ctx, podname, nil, true)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, ns...)
+	}
+
+	ch := make(chan *types.AuditNodeMessage)
+	go func() {
+		defer close(ch)
+		for _, node := range nodes {
+			ch <- c.doAuditNode(ctx, node, opts)
+		}
+	}()
+	return ch, nil
+}
+
+func (c *Calcium) doAuditNode(ctx context.Context, node *types.Node, opts *types.AuditOptions) *types.AuditNodeMessage {
+	msg := &types.AuditNodeMessage{Nodename: node.Name, Orphans: []string{}, Ghosts: []string{}, Handled: []string{}}
+	if node.Engine == nil {
+		msg.Error = types.ErrNilEngine
+		return msg
+	}
+	// 节点锁内对比, 部署和删除都插不进来; 正在部署的容器可能已经在 engine 里但还没记到 store, 这种节点跳过
+	var containers []*types.Container
+	running := map[string]bool{}
+	if err := c.withNodeLocked(ctx, node.Name, func(node *types.Node) (err error) {
+		if err = c.doCheckNodeProcessing(ctx, node.Name); err != nil {
+			return err
+		}
+		containers, err = c.doAuditOrphans(ctx, node, opts, running, msg)
+		return err
+	}); err != nil {
+		log.Errorf("[AuditContainers] Audit %s failed %v", node.Name, err)
+		msg.Error = err
+		return msg
+	}
+	for _, container := range containers {
+		if !running[container.ID] {
+			msg.Ghosts = append(msg.Ghosts, container.ID)
+		}
+	}
+	if opts.GhostAction != cluster.AuditDissociate || len(msg.Ghosts) == 0 {
+		return msg
+	}
+	// 资源会在 dissociate 时还给 node
+	dch, err := c.DissociateContainer(ctx, msg.Ghosts)
+	if err != nil {
+		msg.Error = err
+		return msg
+	}
+	for m := range dch {
+		if m.Error != nil {
+			msg.Error = types.NewDetailedErr(m.Error, fmt.Sprintf("dissociate %s", m.ContainerID))
+			continue
+		}
+		msg.Handled = append(msg.Handled, m.ContainerID)
+	}
+	return msg
+}
+
+// doAuditOrphans find and handle orphans on node, returns containers in store
+// must be called with node locked, running is filled with containers in engine
+// msg is marked unsupported if engine can't list containers, orphans can't be found then
+func (c *Calcium) doAuditOrphans(ctx context.Context, node *types.Node, opts *types.AuditOptions, running map[string]bool, msg *types.AuditNodeMessage) ([]*types.Container, error) {
+	infos, err := node.Engine.VirtualizationList(ctx, map[string]string{cluster.ERUMark: "1"})
+	if errors.Is(err, types.ErrNotSupport) {
+		log.Warnf("[AuditContainers] Engine of %s can't list containers, only ghosts audited", node.Name)
+		msg.Unsupported = true
+		return c.doInspectNodeContainers(ctx, node, running)
+	}
+	if err != nil {
+		return nil, err
+	}
+	containers, err := c.store.ListNodeContainers(ctx, node.Name, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	stored := map[string]bool{}
+	for _, container := range containers {
+		stored[container.ID] = true
+	}
+	for _, info := range infos {
+		running[info.ID] = true
+		if stored[info.ID] {
+			continue
+		}
+		msg.Orphans = append(msg.Orphans, info.ID)
+		switch opts.OrphanAction {
+		case cluster.AuditAdopt:
+			if _, _, _, err := utils.ParseContainerName(info.Name); err != nil {
+				log.Errorf("[AuditContainers] Can't adopt container %s on %s %v", info.ID, node.Name, err)
+				msg.Error = err
+				continue
+			}
+			// env 里可能有解出来的 secret, 不落到 store 里
+			container := &types.Container{
+				ID:       info.ID,
+				Name:     info.Name,
+				Podname:  node.Podname,
+				Nodename: node.Name,
+				Image:    info.Image,
+				Labels:   info.Labels,
+			}
+			if err := c.store.AddContainer(ctx, container); err != nil {
+				log.Errorf("[AuditContainers] Adopt container %s on %s failed %v", info.ID, node.Name, err)
+				msg.Error = err
+				continue
+			}
+			log.Infof("[AuditContainers] Container %s on %s adopted", info.ID, node.Name)
+			msg.Handled = append(msg.Handled, info.ID)
+		case cluster.AuditRemove:
+			if err := node.Engine.VirtualizationRemove(ctx, info.ID, true, true); err != nil {
+				log.Errorf("[AuditContainers] Remove container %s on %s failed %v", info.ID, node.Name, err)
+				msg.Error = err
+				continue
+			}
+			log.Infof("[AuditContainers] Container %s on %s removed", info.ID, node.Name)
+			msg.Handled = append(msg.Handled, info.ID)
+		}
+	}
+	return containers, nil
+}
+
+// doInspectNodeContainers fill running with containers in store found by inspecting them one by one
+// for engines can't list containers, e.g. yavirt
+func (c *Calcium) doInspectNodeContainers(ctx context.Context, node *types.Node, running map[string]bool) ([]*types.Container, error) {
+	// engine 连不上的时候不能把容器都当成 ghost
+	if _, err := node.Engine.Info(ctx); err != nil {
+		return nil, err
+	}
+	containers, err := c.store.ListNodeContainers(ctx, node.Name, nil)
+	if err != nil {
+		return nil, err
+	}
+	for _, container := range containers {
+		if _, err := node.Engine.VirtualizationInspect(ctx, container.ID); err != nil {
+			log.Warnf("[AuditContainers] Inspect container %s on %s failed %v", container.ID, node.Name, err)
+			continue
+		}
+		running[container.ID] = true
+	}
+	return containers, nil
+}
@@ -0,0 +1,142 @@
+package calcium
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	enginemocks "github.com/projecteru2/core/engine/mocks"
+	enginetypes "github.com/projecteru2/core/engine/types"
+	lockmocks "github.com/projecteru2/core/lock/mocks"
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditContainers(t *testing.T) {
+	c := NewTestCluster()
+	c.config.GlobalTimeout = time.Minute
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+
+	// bad action
+	_, err := c.AuditContainers(ctx, &types.AuditOptions{OrphanAction: "kill"})
+	assert.Error(t, err)
+	_, err = c.AuditContainers(ctx, &types.AuditOptions{GhostAction: "remove"})
+	assert.Error(t, err)
+
+	// failed by ListPods
+	store.On("GetAllPods", mock.Anything).Return(nil, types.ErrNoETCD).Once()
+	_, err = c.AuditContainers(ctx, &types.AuditOptions{})
+	assert.Error(t, err)
+
+	engine := &enginemocks.API{}
+	node := &types.Node{Name: "n1", Podname: "p1", Engine: engine}
+	store.On("GetAllPods", mock.Anything).Return([]*types.Pod{{Name: "p1"}}, nil)
+	store.On("GetNodesByPod", mock.Anything, "p1", mock.Anything, true).Return([]*types.Node{node}, nil)
+	infos := []*enginetypes.VirtualizationInfo{
+		{ID: "c1", Name: "app_entry_aaaaaa"},
+		{ID: "c2", Name: "app_entry_bbbbbb", Image: "image", Labels: map[string]string{"ERU": "1"}, Env: []string{"DB_PASSWORD=pass"}},
+		{ID: "c3", Name: "unknown"},
+	}
+	engine.On("VirtualizationList", mock.Anything, map[string]string{"ERU": "1"}).Return(infos, nil)
+	containers := []*types.Container{
+		{ID: "c1", Nodename: "n1", Podname: "p1"},
+		{ID: "c4", Nodename: "n1", Podname: "p1", Memory: 1},
+	}
+	store.On("ListNodeContainers", mock.Anything, "n1", mock.Anything).Return(containers, nil)
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
+	store.On("GetNode", mock.Anything, "n1").Return(node, nil)
+
+	// containers being created on node may be in engine but not in store yet, node skipped
+	store.On("ListProcessing", mock.Anything, "").Return([]*types.Processing{
+		{Appname: "app", Entrypoint: "entry", Nodename: "n1", Count: 1, UpdatedAt: time.Now().UnixNano()},
+	}, nil).Once()
+	ch, err := c.AuditContainers(ctx, &types.AuditOptions{OrphanAction: "remove"})
+	assert.NoError(t, err)
+	for m := range ch {
+		assert.True(t, errors.Is(m.Error, types.ErrNodeInProcessing))
+		assert.Empty(t, m.Orphans)
+	}
+	engine.AssertNotCalled(t, "VirtualizationList", mock.Anything, mock.Anything)
+	store.On("ListProcessing", mock.Anything, "").Return([]*types.Processing{}, nil)
+
+	// report only
+	ch, err = c.AuditContainers(ctx, &types.AuditOptions{})
+	assert.NoError(t, err)
+	msgs := []*types.AuditNodeMessage{}
+	for m := range ch {
+		msgs = append(msgs, m)
+	}
+	assert.Len(t, msgs, 1)
+	assert.NoError(t, msgs[0].Error)
+	assert.Equal(t, "n1", msgs[0].Nodename)
+	assert.Equal(t, []string{"c2", "c3"}, msgs[0].Orphans)
+	assert.Equal(t, []string{"c4"}, msgs[0].Ghosts)
+	assert.Empty(t, msgs[0].Handled)
+	store.AssertNotCalled(t, "AddContainer", mock.Anything, mock.Anything)
+	engine.AssertNotCalled(t, "VirtualizationRemove", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// adopt orphans, c3 can't be adopted by its name
+	store.On("AddContainer", mock.Anything, mock.Anything).Return(nil)
+	ch, err = c.AuditContainers(ctx, &types.AuditOptions{Podname: "p1", OrphanAction: "adopt"})
+	assert.NoError(t, err)
+	for m := range ch {
+		assert.Error(t, m.Error)
+		assert.Equal(t, []string{"c2"}, m.Handled)
+	}
+	store.AssertCalled(t, "AddContainer", mock.Anything, mock.MatchedBy(func(container *types.Container) bool {
+		return container.ID == "c2" && container.Name == "app_entry_bbbbbb" && container.Podname == "p1" && container.Nodename == "n1" && container.Image == "image"
+	}))
+	// env not stored
+	store.AssertNotCalled(t, "AddContainer", mock.Anything, mock.MatchedBy(func(container *types.Container) bool {
+		return len(container.Env) > 0
+	}))
+
+	// remove orphans
+	engine.On("VirtualizationRemove", mock.Anything, mock.Anything, true, true).Return(nil)
+	ch, err = c.AuditContainers(ctx, &types.AuditOptions{Podname: "p1", OrphanAction: "remove"})
+	assert.NoError(t, err)
+	for m := range ch {
+		assert.NoError(t, m.Error)
+		assert.Equal(t, []string{"c2", "c3"}, m.Handled)
+	}
+
+	// dissociate ghosts
+	store.On("GetContainers", mock.Anything, []string{"c4"}).Return([]*types.Container{containers[1]}, nil)
+	store.On("RemoveContainer", mock.Anything, containers[1]).Return(nil)
+	store.On("UpdateNodeResource", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	ch, err = c.AuditContainers(ctx, &types.AuditOptions{Podname: "p1", GhostAction: "dissociate"})
+	assert.NoError(t, err)
+	for m := range ch {
+		assert.NoError(t, m.Error)
+		assert.Equal(t, []string{"c4"}, m.Handled)
+	}
+	store.AssertCalled(t, "RemoveContainer", mock.Anything, containers[1])
+
+	// engine can't list, ghosts found by inspecting
+	virt := &enginemocks.API{}
+	virt.On("VirtualizationList", mock.Anything, mock.Anything).Return(nil, types.NewDetailedErr(types.ErrNotSupport, "VirtualizationList"))
+	vnode := &types.Node{Name: "v1", Engine: virt}
+	store.On("GetNode", mock.Anything, "v1").Return(vnode, nil)
+	store.On("ListNodeContainers", mock.Anything, "v1", mock.Anything).Return([]*types.Container{{ID: "g1"}, {ID: "g2"}}, nil)
+	virt.On("VirtualizationInspect", mock.Anything, "g1").Return(&enginetypes.VirtualizationInfo{ID: "g1"}, nil)
+	virt.On("VirtualizationInspect", mock.Anything, "g2").Return(nil, types.ErrNoETCD)
+	// engine unreachable, nothing is ghost
+	virt.On("Info", mock.Anything).Return(nil, types.ErrNoETCD).Once()
+	msg := c.doAuditNode(ctx, vnode, &types.AuditOptions{OrphanAction: "remove"})
+	assert.Error(t, msg.Error)
+	assert.Empty(t, msg.Ghosts)
+	virt.On("Info", mock.Anything).Return(&enginetypes.Info{}, nil)
+	msg = c.doAuditNode(ctx, vnode, &types.AuditOptions{OrphanAction: "remove"})
+	assert.NoError(t, msg.Error)
+	assert.True(t, msg.Unsupported)
+	assert.Empty(t, msg.Orphans)
+	assert.Equal(t, []string{"g2"}, msg.Ghosts)
+	virt.AssertNotCalled(t, "VirtualizationRemove", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
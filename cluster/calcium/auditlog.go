@@ -0,0 +1,17 @@
+package calcium
+
+import (
+	"context"
+
+	"github.com/projecteru2/core/types"
+)
+
+// AddAuditLog save audit log in store
+func (c *Calcium) AddAuditLog(ctx context.Context, log *types.AuditLog) error {
+	return c.store.AddAuditLog(ctx, log)
+}
+
+// ListAuditLogs list audit logs in store
+func (c *Calcium) ListAuditLogs(ctx context.Context, query *types.AuditLogQuery) ([]*types.AuditLog, error) {
+	return c.store.ListAuditLogs(ctx, query)
+}
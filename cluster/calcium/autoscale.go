@@ -0,0 +1,142 @@
+package calcium
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"time"
+
+	"github.com/projecteru2/core/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// SetAutoscalePolicy set autoscale policy of a desired deployment
+func (c *Calcium) SetAutoscalePolicy(ctx context.Context, policy *types.AutoscalePolicy) error {
+	if policy.Min < 0 || policy.Max < policy.Min {
+		return types.NewDetailedErr(types.ErrBadAutoscalePolicy, "min must be in [0, max]")
+	}
+	if policy.Metric == "" || policy.Threshold <= 0 {
+		return types.NewDetailedErr(types.ErrBadAutoscalePolicy, "metric and positive threshold must be set")
+	}
+	if policy.Cooldown < 0 {
+		return types.NewDetailedErr(types.ErrBadAutoscalePolicy, "cooldown can't be negative")
+	}
+	// 扩缩容通过修改 deployment 实现, 所以必须先声明 deployment
+	if _, err := c.store.GetDeployment(ctx, policy.Appname, policy.Entrypoint); err != nil {
+		log.Errorf("[SetAutoscalePolicy] Error during GetDeployment for %s %s: %v", policy.Appname, policy.Entrypoint, err)
+		return err
+	}
+	return c.store.SetAutoscalePolicy(ctx, policy)
+}
+
+// RemoveAutoscalePolicy remove autoscale policy, deployment will be kept
+func (c *Calcium) RemoveAutoscalePolicy(ctx context.Context, appname, entrypoint string) error {
+	return c.store.RemoveAutoscalePolicy(ctx, appname, entrypoint)
+}
+
+// ListAutoscalePolicies list all autoscale policies
+func (c *Calcium) ListAutoscalePolicies(ctx context.Context) ([]*types.AutoscalePolicy, error) {
+	return c.store.ListAutoscalePolicies(ctx)
+}
+
+// ListAutoscaleDecisions list recent decisions of an app entrypoint, newest first
+func (c *Calcium) ListAutoscaleDecisions(ctx context.Context, appname, entrypoint string, limit int64) ([]*types.AutoscaleDecision, error) {
+	return c.store.ListAutoscaleDecisions(ctx, appname, entrypoint, limit)
+}
+
+func (c *Calcium) doAutoscaleAll(ctx context.Context) {
+	policies, err := c.store.ListAutoscalePolicies(ctx)
+	if err != nil {
+		log.Errorf("[doAutoscaleAll] List autoscale policies failed %v", err)
+		return
+	}
+	for _, policy := range policies {
+		if err := c.doAutoscale(ctx, policy); err != nil {
+			log.Errorf("[doAutoscaleAll] Autoscale %s %s failed %v", policy.Appname, policy.Entrypoint, err)
+		}
+	}
+}
+
+func (c *Calcium) doAutoscale(ctx context.Context, policy *types.AutoscalePolicy) error {
+	now := time.Now()
+	decisions, err := c.store.ListAutoscaleDecisions(ctx, policy.Appname, policy.Entrypoint, 1)
+	if err != nil {
+		return err
+	}
+	if len(decisions) > 0 && now.Unix()-decisions[0].Time < policy.Cooldown {
+		log.Debugf("[doAutoscale] %s %s in cooldown", policy.Appname, policy.Entrypoint)
+		return nil
+	}
+
+	deployment, err := c.store.GetDeployment(ctx, policy.Appname, policy.Entrypoint)
+	if err != nil {
+		return err
+	}
+	alive, err := c.doListAliveContainers(ctx, deployment)
+	if err != nil {
+		return err
+	}
+	value, ok := averageMetric(alive, policy.Metric)
+	if !ok {
+		log.Debugf("[doAutoscale] %s %s no metric %s reported", policy.Appname, policy.Entrypoint, policy.Metric)
+		return nil
+	}
+	desired := calcDesiredCount(len(alive), value, policy)
+	if desired == deployment.Count {
+		return nil
+	}
+
+	decision := &types.AutoscaleDecision{
+		Appname:    policy.Appname,
+		Entrypoint: policy.Entrypoint,
+		Time:       now.Unix(),
+		From:       deployment.Count,
+		To:         desired,
+		Value:      value,
+	}
+	log.Infof("[doAutoscale] Scale %s %s from %d to %d, %s is %f", policy.Appname, policy.Entrypoint, deployment.Count, desired, policy.Metric, value)
+	deployment.Count = desired
+	if err = c.store.SetDeployment(ctx, deployment); err == nil {
+		err = c.doReconcileDeployment(ctx, deployment)
+	}
+	if err != nil {
+		decision.Error = err.Error()
+	}
+	if rerr := c.store.AddAutoscaleDecision(ctx, decision); rerr != nil {
+		log.Errorf("[doAutoscale] Record decision failed %v", rerr)
+	}
+	return err
+}
+
+// averageMetric 只统计上报了该指标的容器
+func averageMetric(containers []*types.Container, metric string) (float64, bool) {
+	sum, count := 0.0, 0
+	for _, container := range containers {
+		if container.StatusMeta == nil || len(container.StatusMeta.Extension) == 0 {
+			continue
+		}
+		extension := map[string]interface{}{}
+		if err := json.Unmarshal(container.StatusMeta.Extension, &extension); err != nil {
+			continue
+		}
+		if v, ok := extension[metric].(float64); ok {
+			sum += v
+			count++
+		}
+	}
+	if count == 0 {
+		return 0, false
+	}
+	return sum / float64(count), true
+}
+
+func calcDesiredCount(current int, value float64, policy *types.AutoscalePolicy) int {
+	desired := int(math.Ceil(float64(current) * value / policy.Threshold))
+	if desired < policy.Min {
+		desired = policy.Min
+	}
+	if desired > policy.Max {
+		desired = policy.Max
+	}
+	return desired
+}
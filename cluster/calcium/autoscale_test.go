@@ -0,0 +1,93 @@
+package calcium
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetAutoscalePolicy(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	policy := &types.AutoscalePolicy{Appname: "app", Entrypoint: "entry", Min: 2, Max: 1, Metric: "qps", Threshold: 100}
+
+	// failed by min max
+	assert.Error(t, c.SetAutoscalePolicy(ctx, policy))
+	policy.Max = 5
+	// failed by threshold
+	policy.Threshold = 0
+	assert.Error(t, c.SetAutoscalePolicy(ctx, policy))
+	policy.Threshold = 100
+	// failed by no deployment
+	store.On("GetDeployment", mock.Anything, "app", "entry").Return(nil, types.ErrKeyNotExists).Once()
+	assert.Error(t, c.SetAutoscalePolicy(ctx, policy))
+
+	store.On("GetDeployment", mock.Anything, "app", "entry").Return(&types.Deployment{}, nil)
+	store.On("SetAutoscalePolicy", mock.Anything, policy).Return(nil)
+	assert.NoError(t, c.SetAutoscalePolicy(ctx, policy))
+}
+
+func TestCalcDesiredCount(t *testing.T) {
+	policy := &types.AutoscalePolicy{Min: 1, Max: 10, Threshold: 100}
+	assert.Equal(t, 2, calcDesiredCount(2, 100, policy))
+	assert.Equal(t, 3, calcDesiredCount(2, 101, policy))
+	assert.Equal(t, 1, calcDesiredCount(2, 10, policy))
+	assert.Equal(t, 10, calcDesiredCount(4, 1000, policy))
+}
+
+func TestAverageMetric(t *testing.T) {
+	containers := []*types.Container{
+		{ID: "c1"},
+		{ID: "c2", StatusMeta: &types.StatusMeta{Extension: []byte(`{"qps": 100}`)}},
+		{ID: "c3", StatusMeta: &types.StatusMeta{Extension: []byte(`{"qps": 200}`)}},
+		{ID: "c4", StatusMeta: &types.StatusMeta{Extension: []byte(`{"qps": "bad"}`)}},
+		{ID: "c5", StatusMeta: &types.StatusMeta{Extension: []byte(`not json`)}},
+	}
+	v, ok := averageMetric(containers, "qps")
+	assert.True(t, ok)
+	assert.Equal(t, 150.0, v)
+	_, ok = averageMetric(containers, "cpu")
+	assert.False(t, ok)
+}
+
+func TestAutoscale(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	policy := &types.AutoscalePolicy{Appname: "app", Entrypoint: "entry", Min: 1, Max: 1, Metric: "qps", Threshold: 100, Cooldown: 60}
+
+	// in cooldown
+	store.On("ListAutoscaleDecisions", mock.Anything, "app", "entry", int64(1)).Return([]*types.AutoscaleDecision{{Time: time.Now().Unix()}}, nil).Once()
+	assert.NoError(t, c.doAutoscale(ctx, policy))
+	store.AssertNotCalled(t, "GetDeployment", mock.Anything, mock.Anything, mock.Anything)
+
+	store.On("ListAutoscaleDecisions", mock.Anything, "app", "entry", int64(1)).Return([]*types.AutoscaleDecision{}, nil)
+	deployment := &types.Deployment{Appname: "app", Entrypoint: "entry", Podname: "pod", Count: 2}
+	store.On("GetDeployment", mock.Anything, "app", "entry").Return(deployment, nil)
+	containers := []*types.Container{
+		{ID: "c1", Podname: "pod", StatusMeta: &types.StatusMeta{Running: true, Extension: []byte(`{"qps": 10}`)}},
+		{ID: "c2", Podname: "pod", StatusMeta: &types.StatusMeta{Running: true, Extension: []byte(`{"qps": 10}`)}},
+	}
+	store.On("ListContainers", mock.Anything, "app", "entry", "", int64(0), mock.Anything).Return(containers, nil).Once()
+	// scale down to min but set deployment failed, decision recorded with error
+	store.On("SetDeployment", mock.Anything, mock.Anything).Return(types.ErrNoETCD).Once()
+	store.On("AddAutoscaleDecision", mock.Anything, mock.Anything).Return(nil)
+	assert.Error(t, c.doAutoscale(ctx, policy))
+	decision := store.Calls[len(store.Calls)-1].Arguments.Get(1).(*types.AutoscaleDecision)
+	assert.Equal(t, 2, decision.From)
+	assert.Equal(t, 1, decision.To)
+	assert.Equal(t, 10.0, decision.Value)
+	assert.NotEmpty(t, decision.Error)
+
+	// already desired, nothing to do
+	deployment.Count = 1
+	store.On("ListContainers", mock.Anything, "app", "entry", "", int64(0), mock.Anything).Return(containers[:1], nil).Once()
+	assert.NoError(t, c.doAutoscale(ctx, policy))
+	store.AssertNumberOfCalls(t, "AddAutoscaleDecision", 1)
+}
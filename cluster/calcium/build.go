@@ -3,14 +3,17 @@ package calcium
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 
 	"github.com/projecteru2/core/source"
 
+	"github.com/projecteru2/core/cluster"
 	enginetypes "github.com/projecteru2/core/engine/types"
 	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -50,7 +53,7 @@ func (c *Calcium) selectBuildNode(ctx context.Context) (*types.Node, error) {
 		return nil, types.ErrInsufficientNodes
 	}
 	// get idle max node
-	return c.scheduler.MaxIdleNode(nodes)
+	return c.getScheduler(c.config.Docker.BuildPod).MaxIdleNode(nodes)
 }
 
 func (c *Calcium) buildWithContent(
@@ -78,6 +81,13 @@ func (c *Calcium) buildWithContent(
 
 func (c *Calcium) doBuildImage(ctx context.Context, resp io.ReadCloser, node *types.Node, tags []string) (chan *types.BuildImageMessage, error) {
 	ch := make(chan *types.BuildImageMessage)
+	ident := utils.RandomString(16)
+	record := func(tag string, err error) {
+		c.doRecordEvent(ctx, &types.Event{
+			Kind: cluster.EventImage, Action: cluster.EventBuild, Target: tag, ProcessIdent: ident,
+			Podname: node.Podname, Nodename: node.Name,
+		}, err)
+	}
 
 	go func() {
 		defer resp.Close()
@@ -107,6 +117,9 @@ func (c *Calcium) doBuildImage(ctx context.Context, resp io.ReadCloser, node *ty
 
 		if lastMessage.Error != "" {
 			log.Errorf("[BuildImage] Build image failed %v", lastMessage.ErrorDetail.Message)
+			for _, tag := range tags {
+				record(tag, errors.New(lastMessage.Error))
+			}
 			return
 		}
 
@@ -116,6 +129,7 @@ func (c *Calcium) doBuildImage(ctx context.Context, resp io.ReadCloser, node *ty
 			log.Infof("[BuildImage] Push image %s", tag)
 			rc, err := node.Engine.ImagePush(ctx, tag)
 			if err != nil {
+				record(tag, err)
 				ch <- makeErrorBuildImageMessage(err)
 				continue
 			}
@@ -154,6 +168,7 @@ func (c *Calcium) doBuildImage(ctx context.Context, resp io.ReadCloser, node *ty
 				log.Infof("[BuildImage] Clean cached image and release space %d", spaceReclaimed)
 			}(tag)
 
+			record(tag, nil)
 			ch <- &types.BuildImageMessage{Stream: fmt.Sprintf("finished %s\n", tag), Status: "finished", Progress: tag}
 		}
 	}()
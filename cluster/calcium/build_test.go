@@ -12,6 +12,7 @@ import (
 
 	enginemocks "github.com/projecteru2/core/engine/mocks"
 	enginetypes "github.com/projecteru2/core/engine/types"
+	"github.com/projecteru2/core/scheduler"
 	schedulermocks "github.com/projecteru2/core/scheduler/mocks"
 	storemocks "github.com/projecteru2/core/store/mocks"
 	"github.com/projecteru2/core/types"
@@ -65,6 +66,7 @@ func TestBuild(t *testing.T) {
 	c.config.Docker.BuildPod = "test"
 	// failed by ListPodNodes failed
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	store.On("GetNodesByPod", mock.AnythingOfType("*context.emptyCtx"), mock.Anything, mock.Anything, mock.Anything).Return(nil, types.ErrBadMeta).Once()
 	c.store = store
 	ch, err := c.BuildImage(ctx, opts)
@@ -81,13 +83,14 @@ func TestBuild(t *testing.T) {
 		Engine:    engine,
 	}
 	store.On("GetNodesByPod", mock.AnythingOfType("*context.emptyCtx"), mock.Anything, mock.Anything, mock.Anything).Return([]*types.Node{node}, nil)
-	scheduler := &schedulermocks.Scheduler{}
-	c.scheduler = scheduler
+	// build pod has its own scheduler, default one not used
+	sched := &schedulermocks.Scheduler{}
+	c.podSchedulers = map[string]scheduler.Scheduler{"test": sched}
 	// failed by MaxIdleNode
-	scheduler.On("MaxIdleNode", mock.AnythingOfType("[]*types.Node")).Return(nil, types.ErrBadMeta).Once()
+	sched.On("MaxIdleNode", mock.AnythingOfType("[]*types.Node")).Return(nil, types.ErrBadMeta).Once()
 	ch, err = c.BuildImage(ctx, opts)
 	assert.Error(t, err)
-	scheduler.On("MaxIdleNode", mock.AnythingOfType("[]*types.Node")).Return(node, nil)
+	sched.On("MaxIdleNode", mock.AnythingOfType("[]*types.Node")).Return(node, nil)
 	// create image
 	c.config.Docker.Hub = "test.com"
 	c.config.Docker.Namespace = "test"
@@ -128,3 +131,21 @@ func TestBuild(t *testing.T) {
 		assert.NoError(t, err)
 	}
 }
+
+func TestSelectBuildNode(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	c.config.Docker.BuildPod = "build"
+	nodes := []*types.Node{{Name: "n1"}, {Name: "n2"}}
+	store.On("GetNodesByPod", mock.Anything, "build", mock.Anything, false).Return(nodes, nil)
+
+	// build pod has its own scheduler
+	sched := &schedulermocks.Scheduler{}
+	sched.On("MaxIdleNode", nodes).Return(nodes[1], nil)
+	c.podSchedulers = map[string]scheduler.Scheduler{"build": sched}
+	node, err := c.selectBuildNode(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, "n2", node.Name)
+	c.scheduler.(*schedulermocks.Scheduler).AssertNotCalled(t, "MaxIdleNode", mock.Anything)
+}
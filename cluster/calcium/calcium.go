@@ -17,22 +17,29 @@ import (
 
 //Calcium implement the cluster
 type Calcium struct {
-	config    types.Config
-	store     store.Store
-	scheduler scheduler.Scheduler
-	source    source.Source
+	config        types.Config
+	store         store.Store
+	scheduler     scheduler.Scheduler
+	podSchedulers map[string]scheduler.Scheduler
+	source        source.Source
 }
 
 // New returns a new cluster config
 func New(config types.Config, embededStorage bool) (*Calcium, error) {
-	// set store
-	store, err := etcdv3.New(config, embededStorage)
+	// set scheduler
+	podSchedulers := map[string]scheduler.Scheduler{}
+	scheduler, err := newScheduler(config, config.Scheduler.Type, config.Scheduler.Strategy)
 	if err != nil {
 		return nil, err
 	}
+	for podname, strategy := range config.Scheduler.Pods {
+		if podSchedulers[podname], err = newScheduler(config, cluster.SchedulerScoring, strategy); err != nil {
+			return nil, err
+		}
+	}
 
-	// set scheduler
-	scheduler, err := complexscheduler.New(config)
+	// set store
+	store, err := etcdv3.New(config, embededStorage)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +56,26 @@ func New(config types.Config, embededStorage bool) (*Calcium, error) {
 		log.Warn("[Calcium] SCM not set, build API disabled")
 	}
 
-	return &Calcium{store: store, config: config, scheduler: scheduler, source: scm}, nil
+	return &Calcium{store: store, config: config, scheduler: scheduler, podSchedulers: podSchedulers, source: scm}, nil
+}
+
+func newScheduler(config types.Config, t, strategy string) (scheduler.Scheduler, error) {
+	switch t {
+	case "", cluster.SchedulerPotassium:
+		return complexscheduler.New(config)
+	case cluster.SchedulerScoring:
+		return complexscheduler.NewScoring(config, strategy)
+	default:
+		return nil, types.NewDetailedErr(types.ErrBadSchedulerType, t)
+	}
+}
+
+// getScheduler pod may have its own scheduler
+func (c *Calcium) getScheduler(podname string) scheduler.Scheduler {
+	if s, ok := c.podSchedulers[podname]; ok {
+		return s
+	}
+	return c.scheduler
 }
 
 // Finalizer use for defer
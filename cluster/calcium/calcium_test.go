@@ -6,7 +6,9 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
+	complexscheduler "github.com/projecteru2/core/scheduler/complex"
 	schedulermocks "github.com/projecteru2/core/scheduler/mocks"
 	sourcemocks "github.com/projecteru2/core/source/mocks"
 	storemocks "github.com/projecteru2/core/store/mocks"
@@ -30,10 +32,18 @@ func (d *dummyLock) Unlock(ctx context.Context) error {
 	return nil
 }
 
+// Done never lost
+func (d *dummyLock) Done() <-chan struct{} {
+	return nil
+}
+
 func NewTestCluster() *Calcium {
 	c := &Calcium{}
 	c.config = types.Config{}
-	c.store = &storemocks.Store{}
+	store := &storemocks.Store{}
+	// 事件记录不影响测试的操作
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil).Maybe()
+	c.store = store
 	c.scheduler = &schedulermocks.Scheduler{}
 	c.source = &sourcemocks.Source{}
 	return c
@@ -51,6 +61,22 @@ func TestNewCluster(t *testing.T) {
 	c, err = New(types.Config{Git: types.GitConfig{SCMType: "github"}}, true)
 	c.Finalizer()
 	assert.NoError(t, err)
+	_, err = New(types.Config{Scheduler: types.SchedConfig{Type: "unknown"}}, true)
+	assert.Error(t, err)
+	_, err = New(types.Config{Scheduler: types.SchedConfig{Pods: map[string]string{"p1": "unknown"}}}, true)
+	assert.Error(t, err)
+}
+
+func TestGetScheduler(t *testing.T) {
+	config := types.Config{Scheduler: types.SchedConfig{Type: "scoring", Strategy: "binpack", Pods: map[string]string{"p1": "spread"}}}
+	c, err := New(config, true)
+	assert.NoError(t, err)
+	defer c.Finalizer()
+	assert.IsType(t, &complexscheduler.Scoring{}, c.getScheduler("p2"))
+	assert.True(t, c.getScheduler("p1") != c.getScheduler("p2"))
+
+	c = NewTestCluster()
+	assert.Equal(t, c.scheduler, c.getScheduler("p1"))
 }
 
 func TestFinalizer(t *testing.T) {
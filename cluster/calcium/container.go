@@ -15,8 +15,8 @@ func (c *Calcium) ListContainers(ctx context.Context, opts *types.ListContainers
 }
 
 // ListNodeContainers list containers belong to one node
-func (c *Calcium) ListNodeContainers(ctx context.Context, nodename string, labels map[string]string) ([]*types.Container, error) {
-	return c.store.ListNodeContainers(ctx, nodename, labels)
+func (c *Calcium) ListNodeContainers(ctx context.Context, nodename string, selector types.LabelSelector) ([]*types.Container, error) {
+	return c.store.ListNodeContainers(ctx, nodename, selector)
 }
 
 // GetContainer get a container
@@ -7,12 +7,14 @@ import (
 
 	"github.com/projecteru2/core/cluster"
 	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
 	log "github.com/sirupsen/logrus"
 )
 
 // ControlContainer control containers status
 func (c *Calcium) ControlContainer(ctx context.Context, IDs []string, t string, force bool) (chan *types.ControlContainerMessage, error) {
 	ch := make(chan *types.ControlContainerMessage)
+	ident := utils.RandomString(16)
 
 	go func() {
 		defer close(ch)
@@ -22,7 +24,9 @@ func (c *Calcium) ControlContainer(ctx context.Context, IDs []string, t string,
 			go func(ID string) {
 				defer wg.Done()
 				var message []*bytes.Buffer
+				var controlled *types.Container
 				err := c.withContainerLocked(ctx, ID, func(container *types.Container) error {
+					controlled = container
 					var err error
 					switch t {
 					case cluster.ContainerStop:
@@ -47,6 +51,7 @@ func (c *Calcium) ControlContainer(ctx context.Context, IDs []string, t string,
 					log.Info("[ControlContainer] Hook Output:")
 					log.Info(string(types.HookOutput(message)))
 				}
+				c.doRecordEvent(ctx, newContainerEvent(t, ident, ID, controlled), err)
 				ch <- &types.ControlContainerMessage{
 					ContainerID: ID,
 					Error:       err,
@@ -19,6 +19,7 @@ func TestControlStart(t *testing.T) {
 	c := NewTestCluster()
 	ctx := context.Background()
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	lock := &lockmocks.DistributedLock{}
 	lock.On("Lock", mock.Anything).Return(nil)
 	lock.On("Unlock", mock.Anything).Return(nil)
@@ -110,6 +111,7 @@ func TestControlStop(t *testing.T) {
 	c := NewTestCluster()
 	ctx := context.Background()
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	lock := &lockmocks.DistributedLock{}
 	lock.On("Lock", mock.Anything).Return(nil)
 	lock.On("Unlock", mock.Anything).Return(nil)
@@ -155,6 +157,7 @@ func TestControlRestart(t *testing.T) {
 	c := NewTestCluster()
 	ctx := context.Background()
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	lock := &lockmocks.DistributedLock{}
 	lock.On("Lock", mock.Anything).Return(nil)
 	lock.On("Unlock", mock.Anything).Return(nil)
@@ -3,7 +3,11 @@ package calcium
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	"github.com/projecteru2/core/cluster"
 	enginetypes "github.com/projecteru2/core/engine/types"
@@ -25,19 +29,46 @@ func (c *Calcium) CreateContainer(ctx context.Context, opts *types.DeployOptions
 	}
 	log.Infof("[CreateContainer %s] Creating container with options:", opts.ProcessIdent)
 	litter.Dump(opts)
+	if err := validateDeployOptions(opts); err != nil {
+		return nil, err
+	}
+	return c.doCreateContainer(ctx, opts, pod)
+}
+
+func validateDeployOptions(opts *types.DeployOptions) error {
 	// Count 要大于0
 	if opts.Count <= 0 {
-		return nil, types.NewDetailedErr(types.ErrBadCount, opts.Count)
+		return types.NewDetailedErr(types.ErrBadCount, opts.Count)
 	}
 	// 创建时内存不为 0
 	if opts.Memory <= 0 {
-		return nil, types.NewDetailedErr(types.ErrBadMemory, opts.Memory)
+		return types.NewDetailedErr(types.ErrBadMemory, opts.Memory)
 	}
 	// CPUQuota 也需要大于 0
 	if opts.CPUQuota <= 0 {
-		return nil, types.NewDetailedErr(types.ErrBadCPU, opts.CPUQuota)
+		return types.NewDetailedErr(types.ErrBadCPU, opts.CPUQuota)
 	}
-	return c.doCreateContainer(ctx, opts, pod)
+	if opts.Priority < 0 {
+		return types.NewDetailedErr(types.ErrBadPriority, opts.Priority)
+	}
+	if opts.Bandwidth < 0 {
+		return types.NewDetailedErr(types.ErrBadBandwidth, opts.Bandwidth)
+	}
+	for kind, count := range opts.Devices {
+		if count < 0 {
+			return types.NewDetailedErr(types.ErrBadDevice, kind)
+		}
+	}
+	if opts.Affinity != nil {
+		if opts.Affinity.MaxPerNode < 0 {
+			return types.NewDetailedErr(types.ErrBadCount, opts.Affinity.MaxPerNode)
+		}
+		// 打散本身就是一种分配方式, 只能替代 auto
+		if opts.Affinity.SpreadLabel != "" && opts.DeployMethod != cluster.DeployAuto {
+			return types.NewDetailedErr(types.ErrBadDeployMethod, "spread only works with auto deploy")
+		}
+	}
+	return nil
 }
 
 func (c *Calcium) doCreateContainer(ctx context.Context, opts *types.DeployOptions, pod *types.Pod) (chan *types.CreateContainerMessage, error) {
@@ -45,17 +76,35 @@ func (c *Calcium) doCreateContainer(ctx context.Context, opts *types.DeployOptio
 	// RFC 计算当前 app 部署情况的时候需要保证同一时间只有这个 app 的这个 entrypoint 在跑
 	// 因此需要在这里加个全局锁，直到部署完毕才释放
 	// 通过 Processing 状态跟踪达成 18 Oct, 2018
-	nodesInfo, err := c.doAllocResource(ctx, opts)
-	if err != nil {
+	nodesInfo, evicted, err := c.doAllocResource(ctx, opts)
+	if err != nil && len(evicted) == 0 {
 		log.Errorf("[doCreateContainer] Error during alloc resource: %v", err)
 		return ch, err
 	}
+	if err != nil {
+		// 已经驱逐的容器回不来了, 连同错误一起告诉调用方
+		log.Errorf("[doCreateContainer] Error during alloc resource after %d containers evicted: %v", len(evicted), err)
+		go func() {
+			defer close(ch)
+			for _, m := range evicted {
+				ch <- m
+			}
+			ch <- &types.CreateContainerMessage{Podname: opts.Podname, Error: err}
+		}()
+		return ch, nil
+	}
 
 	go func() {
 		defer close(ch)
+		for _, m := range evicted {
+			ch <- m
+		}
 		wg := sync.WaitGroup{}
 		wg.Add(len(nodesInfo))
 		index := 0
+		// all or nothing 模式下先收集结果, 全部完成后再决定是否回滚
+		mutex := sync.Mutex{}
+		results := []*types.CreateContainerMessage{}
 
 		// do deployment by each node
 		for _, nodeInfo := range nodesInfo {
@@ -65,13 +114,19 @@ func (c *Calcium) doCreateContainer(ctx context.Context, opts *types.DeployOptio
 				defer c.store.DeleteProcessing(ctx, opts, nodeInfo)
 				messages := c.doCreateContainerOnNode(ctx, nodeInfo, opts, index)
 				for i, m := range messages {
-					ch <- m
+					c.doRecordEvent(ctx, &types.Event{
+						Kind: cluster.EventContainer, Action: cluster.EventCreate, Target: m.ContainerID, ProcessIdent: opts.ProcessIdent,
+						Appname: opts.Name, Podname: opts.Podname, Nodename: nodeInfo.Name,
+					}, m.Error)
+					if opts.AllOrNothing {
+						mutex.Lock()
+						results = append(results, m)
+						mutex.Unlock()
+					} else {
+						ch <- m
+					}
 					if m.Error != nil && m.ContainerID == "" {
-						if err := c.withNodeLocked(ctx, nodeInfo.Name, func(node *types.Node) error {
-							return c.store.UpdateNodeResource(ctx, node, m.CPU, opts.CPUQuota, opts.Memory, opts.Storage, m.VolumePlan.IntoVolumeMap(), store.ActionIncr)
-						}); err != nil {
-							log.Errorf("[doCreateContainer] Reset node %s failed %v", nodeInfo.Name, err)
-						}
+						c.doReturnResource(ctx, opts, nodeInfo.Name, m)
 					} else if m.Error != nil && m.ContainerID != "" {
 						log.Warnf("[doCreateContainer] Create container failed %v, and container %s not removed", m.Error, m.ContainerID)
 					}
@@ -84,11 +139,86 @@ func (c *Calcium) doCreateContainer(ctx context.Context, opts *types.DeployOptio
 			index += nodeInfo.Deploy
 		}
 		wg.Wait()
+
+		if !opts.AllOrNothing {
+			return
+		}
+		summary := c.doRollbackIfPartialFailed(ctx, opts, results)
+		for _, m := range results {
+			ch <- m
+		}
+		ch <- summary
 	}()
 
 	return ch, nil
 }
 
+// doRollbackIfPartialFailed remove all created containers if any of them failed
+// returns a summary message without container id, RolledBack is false if all of them created
+func (c *Calcium) doRollbackIfPartialFailed(ctx context.Context, opts *types.DeployOptions, messages []*types.CreateContainerMessage) *types.CreateContainerMessage {
+	success := 0
+	for _, m := range messages {
+		if m.Success {
+			success++
+		}
+	}
+	if success == len(messages) {
+		return &types.CreateContainerMessage{Podname: opts.Podname, Success: true}
+	}
+
+	log.Warnf("[doRollbackIfPartialFailed] Only %d of %d containers created, rollback", success, len(messages))
+	summary := &types.CreateContainerMessage{Podname: opts.Podname, RolledBack: true}
+	summary.Error = types.NewDetailedErr(types.ErrCreateRolledBack, fmt.Sprintf("%d of %d created", success, len(messages)))
+	failed := []string{}
+	for _, m := range messages {
+		// 没有创建出来的容器资源已经归还了
+		if m.ContainerID == "" {
+			continue
+		}
+		if err := c.doRemoveCreatedContainer(ctx, m); err != nil {
+			log.Errorf("[doRollbackIfPartialFailed] Remove container %s failed %v", m.ContainerID, err)
+			failed = append(failed, m.ContainerID)
+			m.Success = false
+			m.Error = types.NewDetailedErr(types.ErrRollbackFailed, err)
+			continue
+		}
+		c.doReturnResource(ctx, opts, m.Nodename, m)
+		m.ContainerID = ""
+		m.Success = false
+		m.RolledBack = true
+		if m.Error == nil {
+			m.Error = types.ErrCreateRolledBack
+		}
+	}
+	if len(failed) > 0 {
+		summary.Error = types.NewDetailedErr(types.ErrRollbackFailed, strings.Join(failed, ","))
+	}
+	return summary
+}
+
+func (c *Calcium) doRemoveCreatedContainer(ctx context.Context, m *types.CreateContainerMessage) error {
+	node, err := c.GetNode(ctx, m.Nodename)
+	if err != nil {
+		return err
+	}
+	container := &types.Container{
+		ID:       m.ContainerID,
+		Name:     m.ContainerName,
+		Podname:  m.Podname,
+		Nodename: m.Nodename,
+		Engine:   node.Engine,
+	}
+	return c.doRemoveContainer(ctx, container, true)
+}
+
+func (c *Calcium) doReturnResource(ctx context.Context, opts *types.DeployOptions, nodename string, m *types.CreateContainerMessage) {
+	if err := c.withNodeLocked(ctx, nodename, func(node *types.Node) error {
+		return c.store.UpdateNodeResource(ctx, node, m.CPU, opts.CPUQuota, opts.Memory, opts.Storage, opts.Bandwidth, m.VolumePlan.IntoVolumeMap(), m.Devices, store.ActionIncr)
+	}); err != nil {
+		log.Errorf("[doReturnResource] Reset node %s failed %v", nodename, err)
+	}
+}
+
 func (c *Calcium) doCreateContainerOnNode(ctx context.Context, nodeInfo types.NodeInfo, opts *types.DeployOptions, index int) []*types.CreateContainerMessage {
 	ms := make([]*types.CreateContainerMessage, nodeInfo.Deploy)
 
@@ -104,10 +234,15 @@ func (c *Calcium) doCreateContainerOnNode(ctx context.Context, nodeInfo types.No
 			if len(nodeInfo.VolumePlans) > 0 {
 				volumePlan = nodeInfo.VolumePlans[i]
 			}
+			devices := types.DeviceMap{}
+			if len(nodeInfo.DevicePlans) > 0 {
+				devices = nodeInfo.DevicePlans[i]
+			}
 			ms[i] = &types.CreateContainerMessage{
 				Error:      err,
 				CPU:        cpu,
 				VolumePlan: volumePlan,
+				Devices:    devices,
 			}
 		}
 		return ms
@@ -123,7 +258,11 @@ func (c *Calcium) doCreateContainerOnNode(ctx context.Context, nodeInfo types.No
 		if len(nodeInfo.VolumePlans) > 0 {
 			volumePlan = nodeInfo.VolumePlans[i]
 		}
-		ms[i] = c.doCreateAndStartContainer(ctx, i+index, node, opts, cpu, volumePlan)
+		devices := types.DeviceMap{}
+		if len(nodeInfo.DevicePlans) > 0 {
+			devices = nodeInfo.DevicePlans[i]
+		}
+		ms[i] = c.doCreateAndStartContainer(ctx, i+index, node, opts, cpu, volumePlan, devices)
 		if !ms[i].Success {
 			log.Errorf("[doCreateContainerOnNode] Error when create and start a container, %v", ms[i].Error)
 			continue
@@ -149,6 +288,7 @@ func (c *Calcium) doCreateAndStartContainer(
 	opts *types.DeployOptions,
 	cpu types.CPUMap,
 	volumePlan types.VolumePlan,
+	devices types.DeviceMap,
 ) *types.CreateContainerMessage {
 	container := &types.Container{
 		Podname:    opts.Podname,
@@ -157,15 +297,23 @@ func (c *Calcium) doCreateAndStartContainer(
 		Quota:      opts.CPUQuota,
 		Memory:     opts.Memory,
 		Storage:    opts.Storage,
+		Bandwidth:  opts.Bandwidth,
 		Hook:       opts.Entrypoint.Hook,
 		Privileged: opts.Entrypoint.Privileged,
 		Engine:     node.Engine,
 		SoftLimit:  opts.SoftLimit,
 		Image:      opts.Image,
 		Env:        opts.Env,
+		SecretEnv:  opts.SecretEnv,
+		SecretData: opts.SecretData,
+		Entrypoint: recordEntrypoint(opts),
+		Network:    opts.NetworkMode,
+		Networks:   recordNetworks(opts.Networks),
 		User:       opts.User,
 		Volumes:    opts.Volumes,
 		VolumePlan: volumePlan,
+		Devices:    devices,
+		Priority:   opts.Priority,
 	}
 	createContainerMessage := &types.CreateContainerMessage{
 		Podname:    container.Podname,
@@ -175,7 +323,9 @@ func (c *Calcium) doCreateAndStartContainer(
 		Quota:      opts.CPUQuota,
 		Memory:     opts.Memory,
 		Storage:    opts.Storage,
+		Bandwidth:  opts.Bandwidth,
 		VolumePlan: volumePlan,
+		Devices:    devices,
 		Publish:    map[string][]string{},
 	}
 	var err error
@@ -191,8 +341,15 @@ func (c *Calcium) doCreateAndStartContainer(
 		}
 	}()
 
+	// 密钥只在创建时解开, 不会存进 container 的 meta
+	var secretEnv []string
+	if secretEnv, err = c.doResolveSecretEnv(ctx, opts); err != nil {
+		return createContainerMessage
+	}
+
 	// get config
-	config := c.doMakeContainerOptions(no, cpu, volumePlan, opts, node)
+	config := c.doMakeContainerOptions(no, cpu, volumePlan, devices, opts, node)
+	config.Env = append(config.Env, secretEnv...)
 	container.Name = config.Name
 	container.Labels = config.Labels
 	createContainerMessage.ContainerName = container.Name
@@ -214,6 +371,11 @@ func (c *Calcium) doCreateAndStartContainer(
 			}
 		}
 	}
+	for dst, name := range opts.SecretData {
+		if err = c.doSendSecretToContainer(ctx, node.Engine, containerCreated.ID, dst, name, opts.Name); err != nil {
+			return createContainerMessage
+		}
+	}
 
 	// deal with hook
 	if len(opts.AfterCreate) > 0 && container.Hook != nil {
@@ -252,12 +414,36 @@ func (c *Calcium) doCreateAndStartContainer(
 		return createContainerMessage
 	}
 
+	// wait healthy, unhealthy container will be removed
+	if opts.WaitHealthy > 0 {
+		if err = c.doWaitContainerHealthy(ctx, container, time.Duration(opts.WaitHealthy)*time.Second); err != nil {
+			return createContainerMessage
+		}
+	}
+
 	// mark success
 	createContainerMessage.Success = true
 	return createContainerMessage
 }
 
-func (c *Calcium) doMakeContainerOptions(index int, cpumap types.CPUMap, volumePlan types.VolumePlan, opts *types.DeployOptions, node *types.Node) *enginetypes.VirtualizationCreateOptions {
+// recordEntrypoint keep entrypoint with container, so it can be re-created without desired deployment
+func recordEntrypoint(opts *types.DeployOptions) *types.Entrypoint {
+	entry := *opts.Entrypoint
+	entry.Command = strings.TrimSpace(fmt.Sprintf("%s %s", entry.Command, opts.ExtraArgs))
+	return &entry
+}
+
+// recordNetworks keep network names only, ips can't be reused on other nodes
+func recordNetworks(networks map[string]string) []string {
+	names := []string{}
+	for name := range networks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (c *Calcium) doMakeContainerOptions(index int, cpumap types.CPUMap, volumePlan types.VolumePlan, devices types.DeviceMap, opts *types.DeployOptions, node *types.Node) *enginetypes.VirtualizationCreateOptions {
 	config := &enginetypes.VirtualizationCreateOptions{}
 	// general
 	config.Seq = index
@@ -275,6 +461,7 @@ func (c *Calcium) doMakeContainerOptions(index int, cpumap types.CPUMap, volumeP
 	config.Stdin = opts.OpenStdin
 	config.Hosts = opts.ExtraHosts
 	config.Volumes = opts.Volumes.ApplyPlan(volumePlan).ToStringSlice(false, true)
+	config.Devices = devices
 	config.Debug = opts.Debug
 	config.Network = opts.NetworkMode
 	config.Networks = opts.Networks
@@ -304,6 +491,9 @@ func (c *Calcium) doMakeContainerOptions(index int, cpumap types.CPUMap, volumeP
 	env = append(env, fmt.Sprintf("ERU_CONTAINER_NO=%d", index))
 	env = append(env, fmt.Sprintf("ERU_MEMORY=%d", opts.Memory))
 	env = append(env, fmt.Sprintf("ERU_STORAGE=%d", opts.Storage))
+	if opts.Bandwidth > 0 {
+		env = append(env, fmt.Sprintf("ERU_BANDWIDTH=%d", opts.Bandwidth))
+	}
 	config.Env = env
 	// basic labels, bind to LabelMeta
 	config.Labels = map[string]string{
@@ -313,6 +503,9 @@ func (c *Calcium) doMakeContainerOptions(index int, cpumap types.CPUMap, volumeP
 			HealthCheck: entry.HealthCheck,
 		}),
 	}
+	if opts.Bandwidth > 0 {
+		config.Labels[cluster.LabelBandwidth] = strconv.FormatInt(opts.Bandwidth, 10)
+	}
 	for key, value := range opts.Labels {
 		config.Labels[key] = value
 	}
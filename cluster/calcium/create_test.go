@@ -2,8 +2,11 @@ package calcium
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	enginemocks "github.com/projecteru2/core/engine/mocks"
+	lockmocks "github.com/projecteru2/core/lock/mocks"
 	storemocks "github.com/projecteru2/core/store/mocks"
 	"github.com/projecteru2/core/types"
 	"github.com/stretchr/testify/assert"
@@ -37,4 +40,78 @@ func TestCreateContainer(t *testing.T) {
 	opts.CPUQuota = 0
 	_, err = c.CreateContainer(ctx, opts)
 	assert.Error(t, err)
+	opts.CPUQuota = 1
+
+	// failed by priority
+	opts.Priority = -1
+	_, err = c.CreateContainer(ctx, opts)
+	assert.True(t, errors.Is(err, types.ErrBadPriority))
+	opts.Priority = 0
+
+	// failed by device count
+	opts.Devices = map[string]int{"gpu": -1}
+	_, err = c.CreateContainer(ctx, opts)
+	assert.True(t, errors.Is(err, types.ErrBadDevice))
+	opts.Devices = nil
+
+	// failed by bandwidth
+	opts.Bandwidth = -1
+	_, err = c.CreateContainer(ctx, opts)
+	assert.True(t, errors.Is(err, types.ErrBadBandwidth))
+}
+
+func TestRollbackIfPartialFailed(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	opts := &types.DeployOptions{Podname: "p1", AllOrNothing: true}
+	store := c.store.(*storemocks.Store)
+
+	// all success, nothing to do but summary
+	messages := []*types.CreateContainerMessage{
+		{Nodename: "n1", ContainerID: "c1", ContainerName: "app_entry_abcdef", Success: true},
+	}
+	summary := c.doRollbackIfPartialFailed(ctx, opts, messages)
+	assert.True(t, summary.Success)
+	assert.False(t, summary.RolledBack)
+	assert.Empty(t, summary.ContainerID)
+	assert.Nil(t, summary.Error)
+	assert.Equal(t, "c1", messages[0].ContainerID)
+
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
+	engine := &enginemocks.API{}
+	node := &types.Node{Name: "n1", Engine: engine}
+	store.On("GetNode", mock.Anything, mock.Anything).Return(node, nil)
+	store.On("RemoveContainer", mock.Anything, mock.Anything).Return(nil)
+	store.On("UpdateNodeResource", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	// partial failed, but remove failed, all failed IDs reported
+	messages = append(messages,
+		&types.CreateContainerMessage{Nodename: "n1", ContainerID: "c2", ContainerName: "app_entry_bcdefg", Success: true},
+		&types.CreateContainerMessage{Nodename: "n1", Error: types.ErrNoImage},
+	)
+	engine.On("VirtualizationRemove", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(types.ErrNilEngine).Twice()
+	summary = c.doRollbackIfPartialFailed(ctx, opts, messages)
+	assert.False(t, summary.Success)
+	assert.True(t, summary.RolledBack)
+	assert.True(t, errors.Is(summary.Error, types.ErrRollbackFailed))
+	assert.Contains(t, summary.Error.Error(), "c1,c2")
+	assert.Equal(t, "c1", messages[0].ContainerID)
+	assert.False(t, messages[0].Success)
+	assert.True(t, errors.Is(messages[1].Error, types.ErrRollbackFailed))
+
+	// partial failed, rollback
+	engine.On("VirtualizationRemove", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	summary = c.doRollbackIfPartialFailed(ctx, opts, messages)
+	assert.False(t, summary.Success)
+	assert.True(t, summary.RolledBack)
+	assert.True(t, errors.Is(summary.Error, types.ErrCreateRolledBack))
+	for _, m := range messages {
+		assert.False(t, m.Success)
+		assert.Empty(t, m.ContainerID)
+	}
+	assert.True(t, messages[0].RolledBack)
+	store.AssertNumberOfCalls(t, "UpdateNodeResource", 2)
 }
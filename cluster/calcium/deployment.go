@@ -0,0 +1,44 @@
+package calcium
+
+import (
+	"context"
+
+	"github.com/projecteru2/core/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// SetDeployment declare desired deployment of an app entrypoint
+func (c *Calcium) SetDeployment(ctx context.Context, opts *types.DeployOptions) (*types.Deployment, error) {
+	if opts.Entrypoint == nil {
+		return nil, types.ErrNoEntryInSpec
+	}
+	if _, err := c.store.GetPod(ctx, opts.Podname); err != nil {
+		log.Errorf("[SetDeployment] Error during GetPod for %s: %v", opts.Podname, err)
+		return nil, err
+	}
+	if err := validateDeployOptions(opts); err != nil {
+		return nil, err
+	}
+	options := *opts
+	// 文件是临时文件, 不能持久化
+	options.Data = nil
+	options.ProcessIdent = ""
+	deployment := &types.Deployment{
+		Appname:    opts.Name,
+		Entrypoint: opts.Entrypoint.Name,
+		Podname:    opts.Podname,
+		Count:      opts.Count,
+		Options:    &options,
+	}
+	return deployment, c.store.SetDeployment(ctx, deployment)
+}
+
+// RemoveDeployment remove desired deployment, containers will be kept
+func (c *Calcium) RemoveDeployment(ctx context.Context, appname, entrypoint string) error {
+	return c.store.RemoveDeployment(ctx, appname, entrypoint)
+}
+
+// ListDeployments list all desired deployments
+func (c *Calcium) ListDeployments(ctx context.Context) ([]*types.Deployment, error) {
+	return c.store.ListDeployments(ctx)
+}
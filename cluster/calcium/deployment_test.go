@@ -0,0 +1,59 @@
+package calcium
+
+import (
+	"context"
+	"testing"
+
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetDeployment(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	opts := &types.DeployOptions{
+		Name:     "app",
+		Podname:  "pod",
+		Count:    2,
+		Memory:   1,
+		CPUQuota: 1,
+		Data:     map[string]string{"/etc/conf": "/tmp/xxx"},
+	}
+
+	// failed by no entrypoint
+	_, err := c.SetDeployment(ctx, opts)
+	assert.Error(t, err)
+	opts.Entrypoint = &types.Entrypoint{Name: "entry"}
+
+	// failed by GetPod
+	store.On("GetPod", mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
+	_, err = c.SetDeployment(ctx, opts)
+	assert.Error(t, err)
+	store.On("GetPod", mock.Anything, mock.Anything).Return(&types.Pod{Name: "pod"}, nil)
+
+	// failed by count
+	opts.Count = 0
+	_, err = c.SetDeployment(ctx, opts)
+	assert.Error(t, err)
+	opts.Count = 2
+
+	store.On("SetDeployment", mock.Anything, mock.Anything).Return(nil)
+	deployment, err := c.SetDeployment(ctx, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, "app", deployment.Appname)
+	assert.Equal(t, "entry", deployment.Entrypoint)
+	assert.Equal(t, 2, deployment.Count)
+	assert.Nil(t, deployment.Options.Data)
+	assert.NotNil(t, opts.Data)
+
+	store.On("ListDeployments", mock.Anything).Return([]*types.Deployment{deployment}, nil)
+	ds, err := c.ListDeployments(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, ds, 1)
+
+	store.On("RemoveDeployment", mock.Anything, "app", "entry").Return(nil)
+	assert.NoError(t, c.RemoveDeployment(ctx, "app", "entry"))
+}
@@ -3,29 +3,35 @@ package calcium
 import (
 	"context"
 
+	"github.com/projecteru2/core/cluster"
 	"github.com/projecteru2/core/store"
 	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
 	log "github.com/sirupsen/logrus"
 )
 
 // DissociateContainer dissociate container from eru, return it resource but not modity it
 func (c *Calcium) DissociateContainer(ctx context.Context, IDs []string) (chan *types.DissociateContainerMessage, error) {
 	ch := make(chan *types.DissociateContainerMessage)
+	ident := utils.RandomString(16)
 	go func() {
 		defer close(ch)
 		for _, ID := range IDs {
+			var dissociated *types.Container
 			err := c.withContainerLocked(ctx, ID, func(container *types.Container) error {
+				dissociated = container
 				return c.withNodeLocked(ctx, container.Nodename, func(node *types.Node) (err error) {
 					if err := c.store.RemoveContainer(ctx, container); err != nil {
 						return err
 					}
 					log.Infof("[DissociateContainer] Container %s dissociated", container.ID)
-					return c.store.UpdateNodeResource(ctx, node, container.CPU, container.Quota, container.Memory, container.Storage, container.VolumePlan.IntoVolumeMap(), store.ActionIncr)
+					return c.store.UpdateNodeResource(ctx, node, container.CPU, container.Quota, container.Memory, container.Storage, container.Bandwidth, container.VolumePlan.IntoVolumeMap(), container.Devices, store.ActionIncr)
 				})
 			})
 			if err != nil {
 				log.Errorf("[DissociateContainer] Dissociate container %s failed, err: %v", ID, err)
 			}
+			c.doRecordEvent(ctx, newContainerEvent(cluster.EventDissociate, ident, ID, dissociated), err)
 			ch <- &types.DissociateContainerMessage{ContainerID: ID, Error: err}
 		}
 	}()
@@ -0,0 +1,194 @@
+package calcium
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/projecteru2/core/cluster"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// DrainNode mark node unavailable and evacuate its containers to other nodes in the same pod
+// containers are re-created from desired deployment with their own resources, volumes and labels
+func (c *Calcium) DrainNode(ctx context.Context, opts *types.DrainNodeOptions) (chan *types.DrainNodeMessage, error) {
+	if _, err := c.GetNode(ctx, opts.Nodename); err != nil {
+		return nil, err
+	}
+	if !opts.DryRun {
+		if err := c.doCordonNode(ctx, opts.Nodename); err != nil {
+			return nil, err
+		}
+	}
+	containers := []*types.Container{}
+	if !opts.CordonOnly {
+		var err error
+		if containers, err = c.store.ListNodeContainers(ctx, opts.Nodename, nil); err != nil {
+			return nil, err
+		}
+	}
+
+	ch := make(chan *types.DrainNodeMessage)
+	go func() {
+		defer close(ch)
+		// 一个一个迁移, 保证服务可用
+		for _, container := range containers {
+			ch <- c.doEvacuateContainer(ctx, container, opts.DryRun)
+		}
+	}()
+	return ch, nil
+}
+
+// doCordonNode only mark node unavailable
+// unlike SetNode, containers on it are still considered running
+func (c *Calcium) doCordonNode(ctx context.Context, nodename string) error {
+	return c.withNodeLocked(ctx, nodename, func(node *types.Node) error {
+		if !node.Available {
+			return nil
+		}
+		node.Available = false
+		return c.store.UpdateNode(ctx, node)
+	})
+}
+
+func (c *Calcium) doEvacuateContainer(ctx context.Context, container *types.Container, dryRun bool) *types.DrainNodeMessage {
+	msg := &types.DrainNodeMessage{ContainerID: container.ID}
+	opts, err := c.doMakeEvacuateOptions(ctx, container)
+	if err != nil {
+		log.Errorf("[DrainNode] Container %s can't be evacuated %v", container.ID, err)
+		msg.Error = err
+		return msg
+	}
+	if dryRun {
+		msg.Error = c.doPlanEvacuate(ctx, container, opts)
+		msg.Success = msg.Error == nil
+		return msg
+	}
+
+	// 先起新的
+	ch, err := c.CreateContainer(ctx, opts)
+	if err != nil {
+		msg.Error = err
+		return msg
+	}
+	for m := range ch {
+		if m.Evicted != nil {
+			continue
+		}
+		if m.Error != nil {
+			msg.Error = m.Error
+			continue
+		}
+		msg.NewContainerID = m.ContainerID
+		msg.NewNodename = m.Nodename
+	}
+	if msg.Error != nil {
+		log.Errorf("[DrainNode] Create new container for %s failed %v", container.ID, msg.Error)
+		return msg
+	}
+	log.Infof("[DrainNode] Container %s evacuated to %s on %s", container.ID, msg.NewContainerID, msg.NewNodename)
+
+	// 再停老的, 删老的
+	msg.Remove = &types.RemoveContainerMessage{ContainerID: container.ID}
+	if msg.Remove.Hook, err = c.doStopContainer(ctx, container, false); err != nil {
+		log.Errorf("[DrainNode] Stop container %s failed %v", container.ID, err)
+		msg.Error = err
+		return msg
+	}
+	rch, err := c.RemoveContainer(ctx, []string{container.ID}, true, 1)
+	if err != nil {
+		msg.Error = err
+		return msg
+	}
+	for m := range rch {
+		msg.Remove.Success = m.Success
+		msg.Remove.Hook = append(msg.Remove.Hook, m.Hook...)
+	}
+	if !msg.Remove.Success {
+		msg.Error = types.NewDetailedErr(types.ErrRemoveContainerFailed, container.ID)
+		return msg
+	}
+	msg.Success = true
+	return msg
+}
+
+// doMakeEvacuateOptions re-create container from its own record
+// desired deployment is used as base if there is one
+func (c *Calcium) doMakeEvacuateOptions(ctx context.Context, container *types.Container) (*types.DeployOptions, error) {
+	appname, entrypoint, _, err := utils.ParseContainerName(container.Name)
+	if err != nil {
+		return nil, err
+	}
+	opts := &types.DeployOptions{Name: appname}
+	if deployment, err := c.store.GetDeployment(ctx, appname, entrypoint); err == nil {
+		options := *deployment.Options
+		opts = &options
+	} else {
+		// 老的容器记录没有 entrypoint, 不知道怎么启动
+		if container.Entrypoint == nil {
+			return nil, types.NewDetailedErr(types.ErrNoEntryInSpec, fmt.Sprintf("no deployment or entrypoint of %s %s", appname, entrypoint))
+		}
+		opts.Entrypoint = container.Entrypoint
+		opts.NetworkMode = container.Network
+		opts.Networks = map[string]string{}
+		for _, network := range container.Networks {
+			opts.Networks[network] = ""
+		}
+	}
+	opts.Podname = container.Podname
+	opts.Nodename = ""
+	opts.Count = 1
+	opts.Image = container.Image
+	opts.Env = container.Env
+	opts.SecretEnv = container.SecretEnv
+	opts.SecretData = container.SecretData
+	opts.User = container.User
+	opts.CPUQuota = container.Quota
+	opts.CPUBind = len(container.CPU) > 0
+	opts.Memory = container.Memory
+	opts.Storage = container.Storage
+	opts.SoftLimit = container.SoftLimit
+	opts.Volumes = container.Volumes
+	opts.Bandwidth = container.Bandwidth
+	// 换节点了, 只要同样数量的设备
+	opts.Devices = map[string]int{}
+	for kind, IDs := range container.Devices {
+		opts.Devices[kind] = len(IDs)
+	}
+	opts.Labels = userLabels(container.Labels)
+	opts.Priority = container.Priority
+	opts.AllOrNothing = false
+	// 只迁移一个, 不沿用原来的部署策略
+	opts.DeployMethod = cluster.DeployAuto
+	return opts, nil
+}
+
+// userLabels strip labels added by core, they are added again when created
+func userLabels(labels map[string]string) map[string]string {
+	r := map[string]string{}
+	for key, value := range labels {
+		if strings.HasPrefix(key, cluster.ERUMark) {
+			continue
+		}
+		r[key] = value
+	}
+	return r
+}
+
+// doPlanEvacuate check other nodes in pod can hold the container
+func (c *Calcium) doPlanEvacuate(ctx context.Context, container *types.Container, opts *types.DeployOptions) error {
+	ns, err := c.GetNodes(ctx, opts.Podname, "", opts.NodeLabels, false)
+	if err != nil {
+		return err
+	}
+	nodes := map[string]*types.Node{}
+	for _, node := range ns {
+		if node.Name != container.Nodename {
+			nodes[node.Name] = node
+		}
+	}
+	_, _, err = c.doPlanResource(ctx, opts, nodes)
+	return err
+}
@@ -0,0 +1,115 @@
+package calcium
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	lockmocks "github.com/projecteru2/core/lock/mocks"
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestDrainNode(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	opts := &types.DrainNodeOptions{Nodename: "n1", CordonOnly: true}
+
+	// failed by GetNode
+	store.On("GetNode", mock.Anything, "n1").Return(nil, types.ErrNoETCD).Once()
+	_, err := c.DrainNode(ctx, opts)
+	assert.Error(t, err)
+
+	node := &types.Node{Name: "n1", Podname: "pod", Available: true}
+	store.On("GetNode", mock.Anything, "n1").Return(node, nil)
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
+	store.On("UpdateNode", mock.Anything, mock.Anything).Return(nil)
+
+	// cordon only
+	ch, err := c.DrainNode(ctx, opts)
+	assert.NoError(t, err)
+	for range ch {
+		assert.Fail(t, "no container should be evacuated")
+	}
+	assert.False(t, node.Available)
+	store.AssertNotCalled(t, "ListNodeContainers", mock.Anything, mock.Anything, mock.Anything)
+
+	// dry run
+	node.Available = true
+	opts = &types.DrainNodeOptions{Nodename: "n1", DryRun: true}
+	containers := []*types.Container{
+		{ID: "c1", Name: "app_entry_abcdef", Podname: "pod", Nodename: "n1", Memory: 1, Quota: 1, SecretEnv: map[string]string{"DB": "db"}},
+		{ID: "c2", Name: "app_other_abcdef", Podname: "pod", Nodename: "n1", Memory: 1, Quota: 1},
+	}
+	store.On("ListNodeContainers", mock.Anything, "n1", mock.Anything).Return(containers, nil)
+	deployment := &types.Deployment{
+		Appname: "app", Entrypoint: "entry", Podname: "pod", Count: 1,
+		Options: &types.DeployOptions{Name: "app", Entrypoint: &types.Entrypoint{Name: "entry"}, Podname: "pod", Memory: 100, CPUQuota: 2},
+	}
+	store.On("GetDeployment", mock.Anything, "app", "entry").Return(deployment, nil)
+	store.On("GetDeployment", mock.Anything, "app", "other").Return(nil, types.ErrKeyNotExists)
+	// only the drained node in pod
+	store.On("GetNodesByPod", mock.Anything, "pod", mock.Anything, false).Return([]*types.Node{node}, nil)
+	ch, err = c.DrainNode(ctx, opts)
+	assert.NoError(t, err)
+	msgs := []*types.DrainNodeMessage{}
+	for m := range ch {
+		msgs = append(msgs, m)
+	}
+	assert.True(t, node.Available)
+	assert.Len(t, msgs, 2)
+	assert.Equal(t, types.ErrInsufficientNodes, msgs[0].Error)
+	// no deployment nor entrypoint recorded
+	assert.True(t, errors.Is(msgs[1].Error, types.ErrNoEntryInSpec))
+
+	// evacuate options keep container resources
+	evacuateOpts, err := c.doMakeEvacuateOptions(ctx, containers[0])
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), evacuateOpts.Memory)
+	assert.Equal(t, 1.0, evacuateOpts.CPUQuota)
+	assert.Equal(t, 1, evacuateOpts.Count)
+	assert.Equal(t, map[string]string{"DB": "db"}, evacuateOpts.SecretEnv)
+	assert.Equal(t, int64(100), deployment.Options.Memory)
+
+	// evacuate options made from container record without deployment, labels added by core stripped
+	recorded := &types.Container{
+		ID: "c3", Name: "app_other_fedcba", Podname: "pod", Image: "image", Memory: 1, Quota: 1,
+		Env:        []string{"A=1"},
+		Entrypoint: &types.Entrypoint{Name: "other", Command: "run --debug"},
+		Network:    "bridge",
+		Networks:   []string{"calico"},
+		Labels:     map[string]string{"ERU": "1", "ERU_META": "{}", "ERU_BANDWIDTH": "100", "team": "core"},
+		Bandwidth:  100,
+		Devices:    types.DeviceMap{"nvidia.com/gpu": {"0", "1"}},
+	}
+	evacuateOpts, err = c.doMakeEvacuateOptions(ctx, recorded)
+	assert.NoError(t, err)
+	assert.Equal(t, "app", evacuateOpts.Name)
+	assert.Equal(t, "image", evacuateOpts.Image)
+	assert.Equal(t, []string{"A=1"}, evacuateOpts.Env)
+	assert.Equal(t, recorded.Entrypoint, evacuateOpts.Entrypoint)
+	assert.Equal(t, "bridge", evacuateOpts.NetworkMode)
+	assert.Equal(t, map[string]string{"calico": ""}, evacuateOpts.Networks)
+	assert.Equal(t, map[string]string{"team": "core"}, evacuateOpts.Labels)
+	assert.Equal(t, int64(100), evacuateOpts.Bandwidth)
+	assert.Equal(t, map[string]int{"nvidia.com/gpu": 2}, evacuateOpts.Devices)
+	assert.Equal(t, 1, evacuateOpts.Count)
+
+	// create failed, old container kept
+	opts.DryRun = false
+	store.On("GetPod", mock.Anything, "pod").Return(nil, types.ErrNoETCD)
+	ch, err = c.DrainNode(ctx, opts)
+	assert.NoError(t, err)
+	for m := range ch {
+		assert.False(t, m.Success)
+		assert.Nil(t, m.Remove)
+	}
+	assert.False(t, node.Available)
+	store.AssertNotCalled(t, "RemoveContainer", mock.Anything, mock.Anything)
+}
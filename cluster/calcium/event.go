@@ -0,0 +1,62 @@
+package calcium
+
+import (
+	"context"
+	"time"
+
+	"github.com/projecteru2/core/cluster"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// WatchEvents watch events matched options until ctx done
+func (c *Calcium) WatchEvents(ctx context.Context, opts *types.WatchEventsOptions) chan *types.Event {
+	ch := make(chan *types.Event)
+	go func() {
+		defer close(ch)
+		for event := range c.store.EventStream(ctx, opts.Since) {
+			if !opts.Matches(event) {
+				continue
+			}
+			// 调用方不读了也要能退出
+			select {
+			case ch <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// doRecordEvent save event with result of operation, failure only logged
+func (c *Calcium) doRecordEvent(ctx context.Context, event *types.Event, err error) {
+	event.Time = time.Now().UnixNano()
+	event.Success = err == nil
+	if err != nil {
+		event.Error = err.Error()
+	}
+	if err := c.store.AddEvent(ctx, event); err != nil {
+		log.Errorf("[doRecordEvent] Record %s %s event of %s failed %v", event.Kind, event.Action, event.Target, err)
+	}
+}
+
+// newEvent for operation done in one step
+func newEvent(kind, action, target string) *types.Event {
+	return &types.Event{Kind: kind, Action: action, Target: target, ProcessIdent: utils.RandomString(16)}
+}
+
+// newContainerEvent fill event with meta of container if it is got
+func newContainerEvent(action, ident, ID string, container *types.Container) *types.Event {
+	event := &types.Event{Kind: cluster.EventContainer, Action: action, Target: ID, ProcessIdent: ident}
+	if container == nil {
+		return event
+	}
+	event.Podname = container.Podname
+	event.Nodename = container.Nodename
+	if appname, _, _, err := utils.ParseContainerName(container.Name); err == nil {
+		event.Appname = appname
+	}
+	return event
+}
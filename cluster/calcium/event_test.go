@@ -0,0 +1,78 @@
+package calcium
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestWatchEvents(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+
+	events := make(chan *types.Event, 3)
+	events <- &types.Event{Kind: "container", Target: "c1", Podname: "p1"}
+	events <- &types.Event{Kind: "node", Target: "n1", Podname: "p1"}
+	events <- &types.Event{Kind: "container", Target: "c2", Podname: "p2"}
+	close(events)
+	store.On("EventStream", mock.Anything, int64(10)).Return(events)
+
+	targets := []string{}
+	for event := range c.WatchEvents(ctx, &types.WatchEventsOptions{Kinds: []string{"container"}, Podname: "p1", Since: 10}) {
+		targets = append(targets, event.Target)
+	}
+	assert.Equal(t, []string{"c1"}, targets)
+
+	// watcher not reading any more exits when ctx done
+	ctx, cancel := context.WithCancel(ctx)
+	events = make(chan *types.Event, 1)
+	events <- &types.Event{Kind: "container", Target: "c3"}
+	store.On("EventStream", mock.Anything, int64(20)).Return(events)
+	ch := c.WatchEvents(ctx, &types.WatchEventsOptions{Since: 20})
+	time.Sleep(10 * time.Millisecond)
+	cancel()
+	for range ch {
+	}
+}
+
+func TestRecordEvent(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := &storemocks.Store{}
+	c.store = store
+
+	store.On("AddPod", mock.Anything, "p1", "").Return(nil, types.ErrNoETCD).Once()
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(types.ErrNoETCD).Once()
+	// failed to record won't change result
+	_, err := c.AddPod(ctx, "p1", "")
+	assert.Equal(t, types.ErrNoETCD, err)
+	store.AssertCalled(t, "AddEvent", mock.Anything, mock.MatchedBy(func(event *types.Event) bool {
+		return event.Kind == "pod" && event.Action == "add" && event.Target == "p1" && event.Podname == "p1" &&
+			!event.Success && event.Error == types.ErrNoETCD.Error() && event.ProcessIdent != "" && event.Time > 0
+	}))
+
+	pod := &types.Pod{Name: "p1"}
+	store.On("AddPod", mock.Anything, "p1", "").Return(pod, nil)
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
+	p, err := c.AddPod(ctx, "p1", "")
+	assert.NoError(t, err)
+	assert.Equal(t, pod, p)
+	store.AssertCalled(t, "AddEvent", mock.Anything, mock.MatchedBy(func(event *types.Event) bool {
+		return event.Kind == "pod" && event.Success && event.Error == ""
+	}))
+
+	// container event with meta of container
+	event := newContainerEvent("remove", "ident", "c1", &types.Container{ID: "c1", Name: "app_entry_abcdef", Podname: "p1", Nodename: "n1"})
+	assert.Equal(t, "app", event.Appname)
+	assert.Equal(t, "p1", event.Podname)
+	assert.Equal(t, "n1", event.Nodename)
+	event = newContainerEvent("remove", "ident", "c1", nil)
+	assert.Equal(t, "c1", event.Target)
+	assert.Empty(t, event.Appname)
+}
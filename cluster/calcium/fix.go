@@ -0,0 +1,145 @@
+package calcium
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// FixNodeResource recompute free resource of node from its containers
+// containers not found in engine are only flagged, their resource are still counted
+// refused while containers are being created on node, their resource is reserved but not recorded yet
+// processing left by crashed deploys doesn't block it
+func (c *Calcium) FixNodeResource(ctx context.Context, nodename string, dryRun bool) (*types.NodeResourceFix, error) {
+	fix := &types.NodeResourceFix{Name: nodename, Diffs: []string{}, MissingContainers: []string{}}
+	return fix, c.withNodeLocked(ctx, nodename, func(node *types.Node) error {
+		if err := c.doCheckNodeProcessing(ctx, nodename); err != nil {
+			return err
+		}
+		containers, err := c.store.ListNodeContainers(ctx, nodename, nil)
+		if err != nil {
+			return err
+		}
+		for _, container := range containers {
+			if _, err := container.Inspect(ctx); err != nil {
+				log.Warnf("[FixNodeResource] Container %s on %s inspect failed %v", container.ID, nodename, err)
+				fix.MissingContainers = append(fix.MissingContainers, container.ID)
+			}
+		}
+
+		// 在副本上计算, dry run 不影响原来的记录
+		fixed := *node
+		fix.Diffs = recalcNodeResource(&fixed, containers)
+		if dryRun || len(fix.Diffs) == 0 {
+			return nil
+		}
+		log.Infof("[FixNodeResource] Fix node %s: %v", nodename, fix.Diffs)
+		if err := c.store.UpdateNode(ctx, &fixed); err != nil {
+			return err
+		}
+		fix.Fixed = true
+		return nil
+	})
+}
+
+// recalcNodeResource set free resource of node by its init resource and containers
+// returns what changed
+func recalcNodeResource(node *types.Node, containers []*types.Container) []string {
+	cpu := types.CPUMap{}
+	cpu.Add(node.InitCPU)
+	cpuUsed := 0.0
+	memCap := node.InitMemCap
+	storageCap := node.InitStorageCap
+	bandwidth := node.InitBandwidth
+	volume := types.VolumeMap{}
+	volume.Add(node.InitVolume)
+	volumeUsed := int64(0)
+	numaMemory := types.NUMAMemory{}
+	for nodeID, memory := range node.InitNUMAMemory {
+		numaMemory[nodeID] = memory
+	}
+	devices := node.InitDevices.Copy()
+
+	for _, container := range containers {
+		cpu.Sub(container.CPU)
+		cpuUsed = utils.Round(cpuUsed + container.Quota)
+		memCap -= container.Memory
+		storageCap -= container.Storage
+		bandwidth -= container.Bandwidth
+		containerVolume := container.VolumePlan.IntoVolumeMap()
+		volume.Sub(containerVolume)
+		volumeUsed += containerVolume.Total()
+		if nodeID := node.GetNUMANode(container.CPU); nodeID != "" {
+			if _, ok := numaMemory[nodeID]; ok {
+				numaMemory[nodeID] -= container.Memory
+			}
+		}
+		devices.Sub(container.Devices)
+	}
+
+	diffs := []string{}
+	for _, ID := range sortedKeys(node.CPU, cpu) {
+		if node.CPU[ID] != cpu[ID] {
+			diffs = append(diffs, fmt.Sprintf("cpu %s: %d -> %d", ID, node.CPU[ID], cpu[ID]))
+		}
+	}
+	if node.CPUUsed != cpuUsed {
+		diffs = append(diffs, fmt.Sprintf("cpu used: %v -> %v", node.CPUUsed, cpuUsed))
+	}
+	if node.MemCap != memCap {
+		diffs = append(diffs, fmt.Sprintf("memory: %d -> %d", node.MemCap, memCap))
+	}
+	if node.StorageCap != storageCap {
+		diffs = append(diffs, fmt.Sprintf("storage: %d -> %d", node.StorageCap, storageCap))
+	}
+	if node.Bandwidth != bandwidth {
+		diffs = append(diffs, fmt.Sprintf("bandwidth: %d -> %d", node.Bandwidth, bandwidth))
+	}
+	for _, dir := range sortedKeys(node.Volume, volume) {
+		if node.Volume[dir] != volume[dir] {
+			diffs = append(diffs, fmt.Sprintf("volume %s: %d -> %d", dir, node.Volume[dir], volume[dir]))
+		}
+	}
+	if node.VolumeUsed != volumeUsed {
+		diffs = append(diffs, fmt.Sprintf("volume used: %d -> %d", node.VolumeUsed, volumeUsed))
+	}
+	for _, nodeID := range sortedKeys(node.NUMAMemory, numaMemory) {
+		if node.NUMAMemory[nodeID] != numaMemory[nodeID] {
+			diffs = append(diffs, fmt.Sprintf("numa memory %s: %d -> %d", nodeID, node.NUMAMemory[nodeID], numaMemory[nodeID]))
+		}
+	}
+	if !devices.Contains(node.Devices) || !node.Devices.Contains(devices) {
+		diffs = append(diffs, fmt.Sprintf("devices: %v -> %v", node.Devices, devices))
+	}
+
+	node.CPU = cpu
+	node.CPUUsed = cpuUsed
+	node.MemCap = memCap
+	node.StorageCap = storageCap
+	node.Bandwidth = bandwidth
+	node.Volume = volume
+	node.VolumeUsed = volumeUsed
+	node.NUMAMemory = numaMemory
+	node.Devices = devices
+	return diffs
+}
+
+// sortedKeys returns keys of all maps, in order
+func sortedKeys(ms ...map[string]int64) []string {
+	exists := map[string]bool{}
+	keys := []string{}
+	for _, m := range ms {
+		for key := range m {
+			if !exists[key] {
+				exists[key] = true
+				keys = append(keys, key)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,89 @@
+package calcium
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	enginemocks "github.com/projecteru2/core/engine/mocks"
+	enginetypes "github.com/projecteru2/core/engine/types"
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestFixNodeResource(t *testing.T) {
+	c := NewTestCluster()
+	c.config.GlobalTimeout = time.Minute
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	engine := &enginemocks.API{}
+	node := &types.Node{
+		Name:           "n1",
+		CPU:            types.CPUMap{"0": 0, "1": 100},
+		InitCPU:        types.CPUMap{"0": 100, "1": 100},
+		CPUUsed:        2,
+		MemCap:         10,
+		InitMemCap:     100,
+		StorageCap:     50,
+		InitStorageCap: 50,
+		NUMA:           types.NUMA{"0": "n0", "1": "n1"},
+		NUMAMemory:     types.NUMAMemory{"n0": 50, "n1": 50},
+		InitNUMAMemory: types.NUMAMemory{"n0": 50, "n1": 50},
+		Devices:        types.DeviceMap{"gpu": {"1"}},
+		InitDevices:    types.DeviceMap{"gpu": {"0", "1"}},
+	}
+	containers := []*types.Container{
+		{ID: "c1", CPU: types.CPUMap{"0": 50}, Quota: 0.5, Memory: 30, Storage: 10, Engine: engine},
+		{ID: "c2", Quota: 1, Memory: 20, Devices: types.DeviceMap{"gpu": {"0"}}, Engine: engine},
+	}
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(&dummyLock{}, nil)
+	store.On("GetNode", mock.Anything, "n1").Return(node, nil)
+	store.On("ListNodeContainers", mock.Anything, "n1", mock.Anything).Return(containers, nil)
+	engine.On("VirtualizationInspect", mock.Anything, "c1").Return(&enginetypes.VirtualizationInfo{}, nil)
+	engine.On("VirtualizationInspect", mock.Anything, "c2").Return(nil, types.ErrNoETCD)
+
+	// containers being created on node
+	store.On("ListProcessing", mock.Anything, "").Return([]*types.Processing{
+		{Appname: "app", Entrypoint: "web", Nodename: "n1", Count: 1, UpdatedAt: time.Now().UnixNano()},
+	}, nil).Once()
+	_, err := c.FixNodeResource(ctx, "n1", false)
+	assert.True(t, errors.Is(err, types.ErrNodeInProcessing))
+	store.AssertNotCalled(t, "ListNodeContainers", mock.Anything, mock.Anything, mock.Anything)
+	// others and leftovers of crashed deploys are fine
+	store.On("ListProcessing", mock.Anything, "").Return([]*types.Processing{
+		{Appname: "app", Entrypoint: "web", Nodename: "n2", Count: 1, UpdatedAt: time.Now().UnixNano()},
+		{Appname: "app", Entrypoint: "web", Nodename: "n1", Count: 0},
+		{Appname: "app", Entrypoint: "job", Nodename: "n1", Count: 1, UpdatedAt: time.Now().Add(-time.Hour).UnixNano()},
+	}, nil)
+
+	// dry run
+	fix, err := c.FixNodeResource(ctx, "n1", true)
+	assert.NoError(t, err)
+	assert.False(t, fix.Fixed)
+	assert.Equal(t, []string{"c2"}, fix.MissingContainers)
+	assert.Equal(t, []string{
+		"cpu 0: 0 -> 50",
+		"cpu used: 2 -> 1.5",
+		"memory: 10 -> 50",
+		"storage: 50 -> 40",
+		"numa memory n0: 50 -> 20",
+	}, fix.Diffs)
+	assert.Equal(t, int64(10), node.MemCap)
+	store.AssertNotCalled(t, "UpdateNode", mock.Anything, mock.Anything)
+
+	// fix
+	store.On("UpdateNode", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		n := args.Get(1).(*types.Node)
+		assert.Equal(t, types.CPUMap{"0": 50, "1": 100}, n.CPU)
+		assert.Equal(t, int64(50), n.MemCap)
+		assert.Equal(t, int64(20), n.NUMAMemory["n0"])
+		assert.Equal(t, types.DeviceMap{"gpu": {"1"}}, n.Devices)
+	}).Once()
+	fix, err = c.FixNodeResource(ctx, "n1", false)
+	assert.NoError(t, err)
+	assert.True(t, fix.Fixed)
+	store.AssertNumberOfCalls(t, "UpdateNode", 1)
+}
@@ -0,0 +1,169 @@
+package calcium
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/projecteru2/core/cluster"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// HealthCheck check containers health periodically and write status into store, until ctx done
+// only the core holding the health check lock acts
+func (c *Calcium) HealthCheck(ctx context.Context) {
+	c.withLeader(ctx, cluster.HealthCheckLock, c.config.HealthCheck.Interval, c.doHealthCheckLoop)
+}
+
+func (c *Calcium) doHealthCheckLoop(ctx context.Context) {
+	log.Infof("[HealthCheck] Health check started, interval %v", c.config.HealthCheck.Interval)
+	ticker := time.NewTicker(c.config.HealthCheck.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("[HealthCheck] Health check stopped")
+			return
+		case <-ticker.C:
+			c.doHealthCheckAll(ctx)
+		}
+	}
+}
+
+func (c *Calcium) doHealthCheckAll(ctx context.Context) {
+	containers, err := c.store.ListContainers(ctx, "", "", "", 0, nil)
+	if err != nil {
+		log.Errorf("[doHealthCheckAll] List containers failed %v", err)
+		return
+	}
+	// 固定数量的 worker, 容器再多也不会一下子起太多探测
+	queue := make(chan *types.Container)
+	wg := sync.WaitGroup{}
+	concurrency := c.config.HealthCheck.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	for i := 0; i < utils.Min(concurrency, len(containers)); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for container := range queue {
+				c.doHealthCheckContainer(ctx, container)
+			}
+		}()
+	}
+	for _, container := range containers {
+		queue <- container
+	}
+	close(queue)
+	wg.Wait()
+}
+
+func (c *Calcium) doHealthCheckContainer(ctx context.Context, container *types.Container) {
+	status, err := c.doCheckContainerHealth(ctx, container)
+	if err != nil {
+		log.Warnf("[doHealthCheckAll] Check container %s failed %v", container.ID, err)
+		return
+	}
+	if err := c.doSetContainerStatus(ctx, container, status); err != nil {
+		log.Warnf("[doHealthCheckAll] Set container %s status failed %v", container.ID, err)
+	}
+}
+
+// doWaitContainerHealthy probe container until it is healthy
+func (c *Calcium) doWaitContainerHealthy(ctx context.Context, container *types.Container, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for {
+		status, err := c.doCheckContainerHealth(ctx, container)
+		if err == nil && status.Healthy {
+			return c.doSetContainerStatus(ctx, container, status)
+		}
+		select {
+		case <-ctx.Done():
+			return types.NewDetailedErr(types.ErrHealthCheckTimeout, container.ID)
+		case <-time.After(healthCheckPollInterval):
+		}
+	}
+}
+
+func (c *Calcium) doCheckContainerHealth(ctx context.Context, container *types.Container) (*types.StatusMeta, error) {
+	info, err := container.Inspect(ctx)
+	if err != nil {
+		return nil, err
+	}
+	status := &types.StatusMeta{ID: container.ID, Networks: info.Networks, Running: info.Running}
+	if info.Running {
+		meta := utils.DecodeMetaInLabel(container.Labels)
+		status.Healthy = checkHealth(meta.HealthCheck, info.Networks, c.config.HealthCheck.Timeout)
+	}
+	return status, nil
+}
+
+func (c *Calcium) doSetContainerStatus(ctx context.Context, container *types.Container, status *types.StatusMeta) error {
+	// 保留 agent 上报的 extension
+	if old, err := c.store.GetContainerStatus(ctx, container.ID); err == nil && old != nil {
+		status.Extension = old.Extension
+	}
+	container.StatusMeta = status
+	return c.store.SetContainerStatus(ctx, container, c.config.HealthCheck.TTL)
+}
+
+// checkHealth probe published addresses, container without healthcheck is healthy once running
+func checkHealth(healthCheck *types.HealthCheck, networks map[string]string, timeout time.Duration) bool {
+	if healthCheck == nil {
+		return true
+	}
+	for _, addrs := range utils.MakePublishInfo(networks, healthCheck.TCPPorts) {
+		for _, addr := range addrs {
+			if !checkTCP(addr, timeout) {
+				return false
+			}
+		}
+	}
+	if healthCheck.HTTPPort == "" {
+		return true
+	}
+	for _, addrs := range utils.MakePublishInfo(networks, []string{healthCheck.HTTPPort}) {
+		for _, addr := range addrs {
+			if !checkHTTP(addr, healthCheck.HTTPURL, healthCheck.HTTPCode, timeout) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func checkTCP(addr string, timeout time.Duration) bool {
+	conn, err := net.DialTimeout("tcp", addr, timeout)
+	if err != nil {
+		log.Debugf("[checkTCP] Dial %s failed %v", addr, err)
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+func checkHTTP(addr, url string, code int, timeout time.Duration) bool {
+	if !strings.HasPrefix(url, "/") {
+		url = "/" + url
+	}
+	client := http.Client{Timeout: timeout}
+	resp, err := client.Get(fmt.Sprintf("http://%s%s", addr, url))
+	if err != nil {
+		log.Debugf("[checkHTTP] Get %s%s failed %v", addr, url, err)
+		return false
+	}
+	defer resp.Body.Close()
+	// 没有指定 code 的话 2xx 和 3xx 都算健康
+	if code == 0 {
+		return resp.StatusCode < http.StatusBadRequest
+	}
+	return resp.StatusCode == code
+}
@@ -0,0 +1,138 @@
+package calcium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/projecteru2/core/cluster"
+	enginemocks "github.com/projecteru2/core/engine/mocks"
+	enginetypes "github.com/projecteru2/core/engine/types"
+	lockmocks "github.com/projecteru2/core/lock/mocks"
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCheckHealth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/healthz" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+	_, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	assert.NoError(t, err)
+	networks := map[string]string{"bridge": "127.0.0.1"}
+
+	assert.True(t, checkHealth(nil, networks, time.Second))
+	assert.True(t, checkHealth(&types.HealthCheck{TCPPorts: []string{port}}, networks, time.Second))
+	assert.True(t, checkHealth(&types.HealthCheck{HTTPPort: port, HTTPURL: "healthz"}, networks, time.Second))
+	assert.True(t, checkHealth(&types.HealthCheck{HTTPPort: port, HTTPURL: "/x", HTTPCode: 404}, networks, time.Second))
+	assert.False(t, checkHealth(&types.HealthCheck{HTTPPort: port, HTTPURL: "/x"}, networks, time.Second))
+
+	// closed port
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	_, closed, _ := net.SplitHostPort(l.Addr().String())
+	l.Close()
+	assert.False(t, checkHealth(&types.HealthCheck{TCPPorts: []string{port, closed}}, networks, time.Second))
+	assert.False(t, checkHealth(&types.HealthCheck{HTTPPort: closed}, networks, time.Second))
+}
+
+func TestHealthCheck(t *testing.T) {
+	c := NewTestCluster()
+	c.config.HealthCheck.TTL = 10
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	engine := &enginemocks.API{}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+	_, closed, _ := net.SplitHostPort(l.Addr().String())
+	l.Close()
+	container := &types.Container{
+		ID:     "c1",
+		Name:   "app_entry_abcdef",
+		Engine: engine,
+		Labels: map[string]string{
+			cluster.LabelMeta: utils.EncodeMetaInLabel(&types.LabelMeta{HealthCheck: &types.HealthCheck{TCPPorts: []string{closed}}}),
+		},
+	}
+	info := &enginetypes.VirtualizationInfo{Running: true, Networks: map[string]string{"bridge": "127.0.0.1"}}
+	engine.On("VirtualizationInspect", mock.Anything, mock.Anything).Return(info, nil)
+	store.On("GetContainerStatus", mock.Anything, mock.Anything).Return(&types.StatusMeta{Extension: []byte("ext")}, nil)
+
+	// list failed
+	store.On("ListContainers", mock.Anything, "", "", "", int64(0), mock.Anything).Return(nil, types.ErrNoETCD).Once()
+	c.doHealthCheckAll(ctx)
+	store.AssertNotCalled(t, "SetContainerStatus", mock.Anything, mock.Anything, mock.Anything)
+
+	// unhealthy but running, extension kept
+	store.On("ListContainers", mock.Anything, "", "", "", int64(0), mock.Anything).Return([]*types.Container{container}, nil)
+	store.On("SetContainerStatus", mock.Anything, mock.Anything, int64(10)).Return(nil)
+	c.doHealthCheckAll(ctx)
+	assert.True(t, container.StatusMeta.Running)
+	assert.False(t, container.StatusMeta.Healthy)
+	assert.Equal(t, []byte("ext"), container.StatusMeta.Extension)
+
+	// wait healthy timeout
+	healthCheckPollInterval = 10 * time.Millisecond
+	err = c.doWaitContainerHealthy(ctx, container, 50*time.Millisecond)
+	assert.True(t, errors.Is(err, types.ErrHealthCheckTimeout))
+
+	// no healthcheck, healthy once running
+	container.Labels = nil
+	assert.NoError(t, c.doWaitContainerHealthy(ctx, container, time.Second))
+	assert.True(t, container.StatusMeta.Healthy)
+
+	// loop stopped by ctx, only leader checks
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	lock.On("Done").Return(nil)
+	store.On("CreateLock", cluster.HealthCheckLock, mock.Anything).Return(lock, nil)
+	c.config.HealthCheck.Interval = time.Millisecond
+	ctx, cancel := context.WithTimeout(ctx, 20*time.Millisecond)
+	defer cancel()
+	c.HealthCheck(ctx)
+	lock.AssertCalled(t, "Unlock", mock.Anything)
+}
+
+func TestHealthCheckConcurrency(t *testing.T) {
+	c := NewTestCluster()
+	c.config.HealthCheck.Concurrency = 2
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	engine := &enginemocks.API{}
+	containers := []*types.Container{}
+	for i := 0; i < 10; i++ {
+		containers = append(containers, &types.Container{ID: fmt.Sprintf("c%d", i), Engine: engine})
+	}
+	store.On("ListContainers", mock.Anything, "", "", "", int64(0), mock.Anything).Return(containers, nil)
+	store.On("GetContainerStatus", mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD)
+	store.On("SetContainerStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+	var running, max int32
+	engine.On("VirtualizationInspect", mock.Anything, mock.Anything).Return(&enginetypes.VirtualizationInfo{}, nil).Run(func(mock.Arguments) {
+		n := atomic.AddInt32(&running, 1)
+		for {
+			m := atomic.LoadInt32(&max)
+			if n <= m || atomic.CompareAndSwapInt32(&max, m, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		atomic.AddInt32(&running, -1)
+	})
+	c.doHealthCheckAll(ctx)
+	store.AssertNumberOfCalls(t, "SetContainerStatus", 10)
+	assert.True(t, atomic.LoadInt32(&max) <= 2)
+}
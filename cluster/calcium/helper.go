@@ -134,7 +134,8 @@ func makeCopyMessage(id, status, name, path string, err error, data io.ReadClose
 	}
 }
 
-func getNodesInfo(nodes map[string]*types.Node, cpu float64, memory, storage int64) []types.NodeInfo {
+// getNodesInfo makes scheduler input from nodes, capacity is overcommitted by pod
+func getNodesInfo(pod *types.Pod, nodes map[string]*types.Node, cpu float64, memory, storage int64) []types.NodeInfo {
 	result := []types.NodeInfo{}
 	for _, node := range nodes {
 		nodeInfo := types.NodeInfo{
@@ -142,23 +143,50 @@ func getNodesInfo(nodes map[string]*types.Node, cpu float64, memory, storage int
 			CPUMap:        node.CPU,
 			VolumeMap:     node.Volume,
 			InitVolumeMap: node.InitVolume,
-			MemCap:        node.MemCap,
+			DeviceMap:     node.Devices,
+			MemCap:        pod.MemCap(node),
 			StorageCap:    node.AvailableStorage(),
+			BandwidthCap:  node.AvailableBandwidth(),
 			CPURate:       cpu / float64(len(node.InitCPU)),
 			MemRate:       float64(memory) / float64(node.InitMemCap),
 			StorageRate:   float64(storage) / float64(node.InitStorageCap),
+			CPUOvercommit: pod.CPUOvercommit,
 			CPUUsed:       node.CPUUsed / float64(len(node.InitCPU)),
 			MemUsage:      1.0 - float64(node.MemCap)/float64(node.InitMemCap),
 			StorageUsage:  node.StorageUsage(),
 			Capacity:      0,
 			Count:         0,
 			Deploy:        0,
+			Labels:        node.Labels,
 		}
 		result = append(result, nodeInfo)
 	}
 	return result
 }
 
+// checkOvercommit make sure deploying on node won't go beyond overcommit of pod
+// memory of containers bound to one numa node is checked against that numa node too
+func checkOvercommit(pod *types.Pod, node *types.Node, deploy int, cpuPlan []types.CPUMap, quota float64, memory int64) error {
+	if memory > 0 && pod.MemCap(node) < memory*int64(deploy) {
+		return types.NewDetailedErr(types.ErrInsufficientMEM, fmt.Sprintf("node %s beyond memory overcommit %v", node.Name, pod.MemoryRatio()))
+	}
+	if cap, ok := pod.CPUQuotaCap(node); ok && quota > 0 && cap < utils.Round(quota*float64(deploy)) {
+		return types.NewDetailedErr(types.ErrInsufficientCPU, fmt.Sprintf("node %s beyond cpu overcommit %v", node.Name, pod.CPUOvercommit))
+	}
+	numaMemory := map[string]int64{}
+	for _, cpu := range cpuPlan {
+		if nodeID := node.GetNUMANode(cpu); nodeID != "" {
+			numaMemory[nodeID] += memory
+		}
+	}
+	for nodeID, memory := range numaMemory {
+		if _, ok := node.NUMAMemory[nodeID]; ok && memory > 0 && pod.NUMAMemCap(node, nodeID) < memory {
+			return types.NewDetailedErr(types.ErrInsufficientMEM, fmt.Sprintf("node %s numa node %s beyond memory overcommit %v", node.Name, nodeID, pod.MemoryRatio()))
+		}
+	}
+	return nil
+}
+
 func processVirtualizationInStream(
 	ctx context.Context,
 	inStream io.WriteCloser,
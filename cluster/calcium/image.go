@@ -5,13 +5,16 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/projecteru2/core/cluster"
 	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
 	log "github.com/sirupsen/logrus"
 )
 
 // RemoveImage remove images
 func (c *Calcium) RemoveImage(ctx context.Context, podname, nodename string, images []string, step int, prune bool) (chan *types.RemoveImageMessage, error) {
 	ch := make(chan *types.RemoveImageMessage)
+	ident := utils.RandomString(16)
 	if step < 1 {
 		step = 1
 	}
@@ -39,7 +42,8 @@ func (c *Calcium) RemoveImage(ctx context.Context, podname, nodename string, ima
 						Image:    image,
 						Messages: []string{},
 					}
-					if removeItems, err := node.Engine.ImageRemove(ctx, image, false, true); err != nil {
+					removeItems, err := node.Engine.ImageRemove(ctx, image, false, true)
+					if err != nil {
 						m.Messages = append(m.Messages, err.Error())
 					} else {
 						m.Success = true
@@ -47,6 +51,10 @@ func (c *Calcium) RemoveImage(ctx context.Context, podname, nodename string, ima
 							m.Messages = append(m.Messages, fmt.Sprintf("Clean: %s", item))
 						}
 					}
+					c.doRecordEvent(ctx, &types.Event{
+						Kind: cluster.EventImage, Action: cluster.EventRemove, Target: image, ProcessIdent: ident,
+						Podname: node.Podname, Nodename: node.Name,
+					}, err)
 					ch <- m
 				}
 				if prune {
@@ -72,6 +80,7 @@ func (c *Calcium) RemoveImage(ctx context.Context, podname, nodename string, ima
 // 实际上就是在所有的node上去pull一次
 func (c *Calcium) CacheImage(ctx context.Context, podname, nodename string, images []string, step int) (chan *types.CacheImageMessage, error) {
 	ch := make(chan *types.CacheImageMessage)
+	ident := utils.RandomString(16)
 	if step < 1 {
 		step = 1
 	}
@@ -100,10 +109,15 @@ func (c *Calcium) CacheImage(ctx context.Context, podname, nodename string, imag
 						Nodename: node.Name,
 						Message:  "",
 					}
-					if err := pullImage(ctx, node, image); err != nil {
+					err := pullImage(ctx, node, image)
+					if err != nil {
 						m.Success = false
 						m.Message = err.Error()
 					}
+					c.doRecordEvent(ctx, &types.Event{
+						Kind: cluster.EventImage, Action: cluster.EventCache, Target: image, ProcessIdent: ident,
+						Podname: node.Podname, Nodename: node.Name,
+					}, err)
 					ch <- m
 				}
 			}(node)
@@ -17,6 +17,7 @@ func TestRemoveImage(t *testing.T) {
 	c := NewTestCluster()
 	ctx := context.Background()
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	c.store = store
 	// fail by get nodes
 	store.On("GetNodesByPod", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, types.ErrBadCount).Once()
@@ -58,6 +59,7 @@ func TestCacheImage(t *testing.T) {
 	c := NewTestCluster()
 	ctx := context.Background()
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	c.store = store
 	// fail by get nodes
 	store.On("GetNodesByPod", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, types.ErrBadCount).Once()
@@ -34,10 +34,18 @@ func (c *Calcium) RunAndWait(ctx context.Context, opts *types.DeployOptions, inC
 	runMsgCh := make(chan *types.AttachContainerMessage)
 	wg := &sync.WaitGroup{}
 	for message := range createChan {
-		if !message.Success || message.ContainerID == "" {
+		if message.Evicted != nil {
+			log.Infof("[RunAndWait] Container %s evicted", message.Evicted.ContainerID)
+			continue
+		}
+		if !message.Success {
 			log.Errorf("[RunAndWait] Create container failed %s", message.Error)
 			continue
 		}
+		// all or nothing 的汇总消息没有容器
+		if message.ContainerID == "" {
+			continue
+		}
 
 		lambda := func(message *types.CreateContainerMessage) {
 			defer wg.Done()
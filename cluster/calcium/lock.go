@@ -17,6 +17,10 @@ func (c *Calcium) doLock(ctx context.Context, name string, timeout time.Duration
 		return nil, err
 	}
 	if err = lock.Lock(ctx); err != nil {
+		// 没拿到锁也要关掉 session, 不然每次竞选都漏一个 lease
+		if uerr := lock.Unlock(context.Background()); uerr != nil {
+			log.Errorf("[doLock] Release %s failed %v", name, uerr)
+		}
 		return nil, err
 	}
 	return lock, nil
@@ -37,6 +41,36 @@ func (c *Calcium) doUnlockAll(locks map[string]lock.DistributedLock) {
 	}
 }
 
+// withLeader run f while holding the lock named, until ctx done
+// f's ctx is canceled once lock is lost, and leadership is campaigned again
+func (c *Calcium) withLeader(ctx context.Context, name string, interval time.Duration, f func(ctx context.Context)) {
+	for {
+		if leaderLock, err := c.doLock(ctx, name, c.config.LockTimeout); err == nil {
+			log.Infof("[withLeader] Became leader of %s", name)
+			leaderCtx, cancel := context.WithCancel(ctx)
+			go func() {
+				// session 过期后锁可能已经被别的 core 拿走了
+				select {
+				case <-leaderLock.Done():
+					log.Warnf("[withLeader] Leadership of %s lost", name)
+					cancel()
+				case <-leaderCtx.Done():
+				}
+			}()
+			f(leaderCtx)
+			cancel()
+			if err := c.doUnlock(leaderLock, name); err != nil {
+				log.Errorf("[withLeader] Unlock %s failed %v", name, err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(interval):
+		}
+	}
+}
+
 func (c *Calcium) withContainerLocked(ctx context.Context, ID string, f func(container *types.Container) error) error {
 	return c.withContainersLocked(ctx, []string{ID}, func(containers map[string]*types.Container) error {
 		return f(containers[ID])
@@ -49,6 +83,42 @@ func (c *Calcium) withNodeLocked(ctx context.Context, nodename string, f func(no
 	})
 }
 
+func (c *Calcium) withSecretLocked(ctx context.Context, name string, f func() error) error {
+	secretLock, err := c.doLock(ctx, fmt.Sprintf(cluster.SecretLock, name), c.config.LockTimeout)
+	if err != nil {
+		return err
+	}
+	defer func() { c.doUnlockAll(map[string]lock.DistributedLock{name: secretLock}) }()
+	return f()
+}
+
+// withQuotaLocked lock app if it has quotas, so usage won't be checked by concurrent deploys
+func (c *Calcium) withQuotaLocked(ctx context.Context, appname string, f func() error) error {
+	quotas, err := c.store.ListQuotas(ctx, appname)
+	if err != nil {
+		return err
+	}
+	if len(quotas) == 0 {
+		return f()
+	}
+	appLock, err := c.doLock(ctx, fmt.Sprintf(cluster.AppLock, appname), c.config.LockTimeout)
+	if err != nil {
+		return err
+	}
+	defer func() { c.doUnlockAll(map[string]lock.DistributedLock{appname: appLock}) }()
+	return f()
+}
+
+// withQuotasLocked lock apps one by one, in the order given
+func (c *Calcium) withQuotasLocked(ctx context.Context, appnames []string, f func() error) error {
+	if len(appnames) == 0 {
+		return f()
+	}
+	return c.withQuotaLocked(ctx, appnames[0], func() error {
+		return c.withQuotasLocked(ctx, appnames[1:], f)
+	})
+}
+
 func (c *Calcium) withContainersLocked(ctx context.Context, IDs []string, f func(containers map[string]*types.Container) error) error {
 	containers := map[string]*types.Container{}
 	locks := map[string]lock.DistributedLock{}
@@ -68,17 +138,18 @@ func (c *Calcium) withContainersLocked(ctx context.Context, IDs []string, f func
 	return f(containers)
 }
 
-func (c *Calcium) withNodesLocked(ctx context.Context, podname, nodename string, labels map[string]string, all bool, f func(nodes map[string]*types.Node) error) error {
+func (c *Calcium) withNodesLocked(ctx context.Context, podname, nodename string, selector types.LabelSelector, all bool, f func(nodes map[string]*types.Node) error) error {
 	nodes := map[string]*types.Node{}
 	locks := map[string]lock.DistributedLock{}
 	defer func() { c.doUnlockAll(locks) }()
-	ns, err := c.GetNodes(ctx, podname, nodename, labels, all)
+	ns, err := c.GetNodes(ctx, podname, nodename, selector, all)
 	if err != nil {
 		return err
 	}
 
 	for _, n := range ns {
-		lock, err := c.doLock(ctx, fmt.Sprintf(cluster.NodeLock, podname, n.Name), c.config.LockTimeout)
+		// 用节点自己的 pod, 单独锁节点时和按 pod 锁的是同一把
+		lock, err := c.doLock(ctx, fmt.Sprintf(cluster.NodeLock, n.Podname, n.Name), c.config.LockTimeout)
 		if err != nil {
 			return err
 		}
@@ -26,10 +26,12 @@ func TestDoLock(t *testing.T) {
 
 	lock := &lockmocks.DistributedLock{}
 	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
-	// lock failed
+	// lock failed, session released
 	lock.On("Lock", mock.Anything).Return(types.ErrNoETCD).Once()
+	lock.On("Unlock", mock.Anything).Return(nil).Once()
 	_, err = c.doLock(ctx, "somename", 1)
 	assert.Error(t, err)
+	lock.AssertNumberOfCalls(t, "Unlock", 1)
 	// success
 	lock.On("Lock", mock.Anything).Return(nil)
 	_, err = c.doLock(ctx, "somename", 1)
@@ -135,7 +137,7 @@ func TestWithNodesLocked(t *testing.T) {
 	store.On("GetNodesByPod", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*types.Node{}, nil).Once()
 	// failed by filter
 	var ns map[string]*types.Node
-	err = c.withNodesLocked(ctx, "test", "", map[string]string{"eru": "2"}, false, func(nodes map[string]*types.Node) error {
+	err = c.withNodesLocked(ctx, "test", "", types.MakeLabelSelector(map[string]string{"eru": "2"}), false, func(nodes map[string]*types.Node) error {
 		ns = nodes
 		return nil
 	})
@@ -0,0 +1,138 @@
+package calcium
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/projecteru2/core/cluster"
+	"github.com/projecteru2/core/metrics"
+	"github.com/projecteru2/core/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// NodeMonitor probe engines of all nodes periodically until ctx done
+// unreachable nodes are marked down, and marked up again after recovered
+// only the core holding the node monitor lock acts
+func (c *Calcium) NodeMonitor(ctx context.Context) {
+	c.withLeader(ctx, cluster.NodeMonitorLock, c.config.NodeMonitor.Interval, c.doNodeMonitorLoop)
+}
+
+func (c *Calcium) doNodeMonitorLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.config.NodeMonitor.Interval)
+	defer ticker.Stop()
+	// 连续失败次数, 换 leader 后重新计
+	failures := map[string]int{}
+	for {
+		c.doMonitorNodes(ctx, failures)
+		select {
+		case <-ctx.Done():
+			log.Info("[NodeMonitor] Node monitor stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Calcium) doMonitorNodes(ctx context.Context, failures map[string]int) {
+	pods, err := c.ListPods(ctx)
+	if err != nil {
+		log.Errorf("[doMonitorNodes] List pods failed %v", err)
+		return
+	}
+	nodes := []*types.Node{}
+	for _, pod := range pods {
+		ns, err := c.ListPodNodes(ctx, pod.Name, nil, true)
+		if err != nil {
+			log.Errorf("[doMonitorNodes] List nodes of pod %s failed %v", pod.Name, err)
+			return
+		}
+		nodes = append(nodes, ns...)
+	}
+
+	reachable := make([]bool, len(nodes))
+	wg := sync.WaitGroup{}
+	for i, node := range nodes {
+		wg.Add(1)
+		go func(i int, node *types.Node) {
+			defer wg.Done()
+			if err := c.doProbeNode(ctx, node); err != nil {
+				log.Warnf("[doMonitorNodes] Probe node %s failed %v", node.Name, err)
+				return
+			}
+			reachable[i] = true
+		}(i, node)
+	}
+	wg.Wait()
+
+	exists := map[string]bool{}
+	for i, node := range nodes {
+		exists[node.Name] = true
+		if reachable[i] {
+			delete(failures, node.Name)
+			if !node.Available && node.Unreachable {
+				c.doMarkNodeReachable(ctx, node.Name, true)
+			}
+			continue
+		}
+		failures[node.Name]++
+		if failures[node.Name] >= c.config.NodeMonitor.Threshold && node.Available {
+			c.doMarkNodeReachable(ctx, node.Name, false)
+		}
+	}
+	// 删掉的 node 不再计数
+	for nodename := range failures {
+		if !exists[nodename] {
+			delete(failures, nodename)
+		}
+	}
+}
+
+func (c *Calcium) doProbeNode(ctx context.Context, node *types.Node) error {
+	if node.Engine == nil {
+		return types.ErrNilEngine
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.config.NodeMonitor.Timeout)
+	defer cancel()
+	_, err := node.Engine.Info(ctx)
+	return err
+}
+
+// doMarkNodeReachable mark node down like SetNode does, or bring node marked down by monitor back
+func (c *Calcium) doMarkNodeReachable(ctx context.Context, nodename string, reachable bool) {
+	var event *types.Event
+	err := c.withNodeLocked(ctx, nodename, func(node *types.Node) error {
+		status, transition := cluster.NodeDown, "down"
+		if reachable {
+			// 只恢复 monitor 自己下掉的 node
+			if node.Available || !node.Unreachable {
+				return nil
+			}
+			status, transition = cluster.NodeUp, "up"
+		} else if !node.Available {
+			return nil
+		}
+		if err := c.doSetNodeStatus(ctx, node, status); err != nil {
+			return err
+		}
+		node.Unreachable = !reachable
+		if err := c.store.UpdateNode(ctx, node); err != nil {
+			return err
+		}
+		event = newEvent(cluster.EventNode, cluster.EventSet, nodename)
+		event.Podname, event.Nodename = node.Podname, nodename
+		log.Infof("[NodeMonitor] Node %s marked %s", nodename, transition)
+		// node 在锁外还会被改, metrics 用拷贝
+		n := *node
+		go metrics.Client.SendNodeTransition(&n, transition)
+		return nil
+	})
+	if err != nil {
+		log.Errorf("[NodeMonitor] Mark node %s reachable %v failed %v", nodename, reachable, err)
+		return
+	}
+	// 没有变化不记录
+	if event != nil {
+		c.doRecordEvent(ctx, event, nil)
+	}
+}
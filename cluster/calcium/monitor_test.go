@@ -0,0 +1,77 @@
+package calcium
+
+import (
+	"context"
+	"testing"
+
+	enginemocks "github.com/projecteru2/core/engine/mocks"
+	enginetypes "github.com/projecteru2/core/engine/types"
+	lockmocks "github.com/projecteru2/core/lock/mocks"
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMonitorNodes(t *testing.T) {
+	c := NewTestCluster()
+	c.config.NodeMonitor.Threshold = 2
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	failures := map[string]int{}
+
+	// failed by ListPods, nothing changed
+	store.On("GetAllPods", mock.Anything).Return(nil, types.ErrNoETCD).Once()
+	c.doMonitorNodes(ctx, failures)
+	assert.Empty(t, failures)
+
+	engine := &enginemocks.API{}
+	node := &types.Node{Name: "n1", Podname: "p1", Available: true, Engine: engine}
+	store.On("GetAllPods", mock.Anything).Return([]*types.Pod{{Name: "p1"}}, nil)
+	store.On("GetNodesByPod", mock.Anything, "p1", mock.Anything, true).Return([]*types.Node{node}, nil)
+	store.On("GetNode", mock.Anything, "n1").Return(node, nil)
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
+	store.On("UpdateNode", mock.Anything, mock.Anything).Return(nil)
+	container := &types.Container{ID: "c1", Nodename: "n1"}
+	store.On("ListNodeContainers", mock.Anything, "n1", mock.Anything).Return([]*types.Container{container}, nil)
+	store.On("SetContainerStatus", mock.Anything, container, int64(0)).Return(nil)
+
+	// first failure, still available
+	engine.On("Info", mock.Anything).Return(nil, types.ErrNilEngine).Twice()
+	c.doMonitorNodes(ctx, failures)
+	assert.Equal(t, 1, failures["n1"])
+	assert.True(t, node.Available)
+	store.AssertNotCalled(t, "UpdateNode", mock.Anything, mock.Anything)
+
+	// reach threshold, marked down and containers unhealthy
+	c.doMonitorNodes(ctx, failures)
+	assert.Equal(t, 2, failures["n1"])
+	assert.False(t, node.Available)
+	assert.True(t, node.Unreachable)
+	assert.False(t, container.StatusMeta.Healthy)
+	store.AssertNumberOfCalls(t, "UpdateNode", 1)
+
+	// recovered, marked up
+	engine.On("Info", mock.Anything).Return(&enginetypes.Info{}, nil).Once()
+	c.doMonitorNodes(ctx, failures)
+	assert.Empty(t, failures)
+	assert.True(t, node.Available)
+	assert.False(t, node.Unreachable)
+	store.AssertNumberOfCalls(t, "UpdateNode", 2)
+
+	// node set down by hand won't be brought back
+	node.Available = false
+	engine.On("Info", mock.Anything).Return(&enginetypes.Info{}, nil).Once()
+	c.doMonitorNodes(ctx, failures)
+	assert.False(t, node.Available)
+	store.AssertNumberOfCalls(t, "UpdateNode", 2)
+
+	// removed node not counted
+	failures["n2"] = 1
+	engine.On("Info", mock.Anything).Return(&enginetypes.Info{}, nil).Once()
+	c.doMonitorNodes(ctx, failures)
+	assert.Empty(t, failures)
+}
@@ -2,6 +2,7 @@ package calcium
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/projecteru2/core/cluster"
 	"github.com/projecteru2/core/types"
@@ -16,19 +17,31 @@ func (c *Calcium) AddNode(ctx context.Context, nodename, endpoint, podname, ca,
 	numa types.NUMA, numaMemory types.NUMAMemory) (*types.Node, error) {
 */
 func (c *Calcium) AddNode(ctx context.Context, opts *types.AddNodeOptions) (*types.Node, error) {
-	return c.store.AddNode(ctx, opts)
+	node, err := c.store.AddNode(ctx, opts)
+	event := newEvent(cluster.EventNode, cluster.EventAdd, opts.Nodename)
+	event.Podname, event.Nodename = opts.Podname, opts.Nodename
+	c.doRecordEvent(ctx, event, err)
+	return node, err
 }
 
 // RemoveNode remove a node
 func (c *Calcium) RemoveNode(ctx context.Context, nodename string) error {
-	return c.withNodeLocked(ctx, nodename, func(node *types.Node) error {
+	var podname string
+	err := c.withNodeLocked(ctx, nodename, func(node *types.Node) error {
+		if node != nil {
+			podname = node.Podname
+		}
 		return c.store.RemoveNode(ctx, node)
 	})
+	event := newEvent(cluster.EventNode, cluster.EventRemove, nodename)
+	event.Podname, event.Nodename = podname, nodename
+	c.doRecordEvent(ctx, event, err)
+	return err
 }
 
 // ListPodNodes list nodes belong to pod
-func (c *Calcium) ListPodNodes(ctx context.Context, podname string, labels map[string]string, all bool) ([]*types.Node, error) {
-	return c.store.GetNodesByPod(ctx, podname, labels, all)
+func (c *Calcium) ListPodNodes(ctx context.Context, podname string, selector types.LabelSelector, all bool) ([]*types.Node, error) {
+	return c.store.GetNodesByPod(ctx, podname, selector, all)
 }
 
 // GetNode get node
@@ -37,7 +50,7 @@ func (c *Calcium) GetNode(ctx context.Context, nodename string) (*types.Node, er
 }
 
 // GetNodes get nodes
-func (c *Calcium) GetNodes(ctx context.Context, podname, nodename string, labels map[string]string, all bool) ([]*types.Node, error) {
+func (c *Calcium) GetNodes(ctx context.Context, podname, nodename string, selector types.LabelSelector, all bool) ([]*types.Node, error) {
 	var ns []*types.Node
 	var err error
 	if nodename != "" {
@@ -45,7 +58,7 @@ func (c *Calcium) GetNodes(ctx context.Context, podname, nodename string, labels
 		node, err = c.GetNode(ctx, nodename)
 		ns = []*types.Node{node}
 	} else {
-		ns, err = c.ListPodNodes(ctx, podname, labels, all)
+		ns, err = c.ListPodNodes(ctx, podname, selector, all)
 	}
 	return ns, err
 }
@@ -53,31 +66,16 @@ func (c *Calcium) GetNodes(ctx context.Context, podname, nodename string, labels
 // SetNode set node available or not
 func (c *Calcium) SetNode(ctx context.Context, opts *types.SetNodeOptions) (*types.Node, error) {
 	var n *types.Node
-	return n, c.withNodeLocked(ctx, opts.Nodename, func(node *types.Node) error {
+	err := c.withNodeLocked(ctx, opts.Nodename, func(node *types.Node) error {
 		n = node
 		litter.Dump(opts)
 		// status
-		switch opts.Status {
-		case cluster.NodeUp:
-			n.Available = true
-		case cluster.NodeDown:
-			n.Available = false
-			containers, err := c.store.ListNodeContainers(ctx, opts.Nodename, nil)
-			if err != nil {
-				return err
-			}
-			for _, container := range containers {
-				if container.StatusMeta == nil {
-					container.StatusMeta = &types.StatusMeta{ID: container.ID}
-				}
-				container.StatusMeta.Running = false
-				container.StatusMeta.Healthy = false
-
-				// mark container which belongs to this node as unhealthy
-				if err = c.store.SetContainerStatus(ctx, container, 0); err != nil {
-					log.Errorf("[SetNodeAvailable] Set container %s on node %s inactive failed %v", container.ID, opts.Nodename, err)
-				}
-			}
+		if opts.Status != cluster.KeepNodeStatus {
+			// 手动设置过的 node 不再由 monitor 恢复
+			n.Unreachable = false
+		}
+		if err := c.doSetNodeStatus(ctx, n, opts.Status); err != nil {
+			return err
 		}
 		// update key value
 		if len(opts.Labels) != 0 {
@@ -105,6 +103,14 @@ func (c *Calcium) SetNode(ctx context.Context, opts *types.SetNodeOptions) (*typ
 				return types.ErrBadStorage
 			}
 		}
+		if opts.DeltaBandwidth != 0 {
+			// update bandwidth
+			n.Bandwidth += opts.DeltaBandwidth
+			n.InitBandwidth += opts.DeltaBandwidth
+			if n.Bandwidth < 0 {
+				return types.ErrBadBandwidth
+			}
+		}
 		if opts.DeltaMemory != 0 {
 			// update memory
 			n.MemCap += opts.DeltaMemory
@@ -145,6 +151,58 @@ func (c *Calcium) SetNode(ctx context.Context, opts *types.SetNodeOptions) (*typ
 				}
 			}
 		}
+		// update devices, IDs in use must be kept
+		for kind, IDs := range opts.Devices {
+			used := types.DeviceMap{kind: n.InitDevices[kind]}
+			used.Sub(types.DeviceMap{kind: n.Devices[kind]})
+			if !(types.DeviceMap{kind: IDs}).Contains(used) {
+				return types.NewDetailedErr(types.ErrBadDevice, fmt.Sprintf("%s %v in use", kind, used[kind]))
+			}
+			if len(IDs) == 0 {
+				delete(n.Devices, kind)
+				delete(n.InitDevices, kind)
+				continue
+			}
+			free := types.DeviceMap{kind: []string{}}
+			free.Add(types.DeviceMap{kind: IDs})
+			n.InitDevices[kind] = free.Copy()[kind]
+			free.Sub(used)
+			n.Devices[kind] = free[kind]
+		}
 		return c.store.UpdateNode(ctx, n)
 	})
+	event := newEvent(cluster.EventNode, cluster.EventSet, opts.Nodename)
+	event.Nodename = opts.Nodename
+	if n != nil {
+		event.Podname = n.Podname
+	}
+	c.doRecordEvent(ctx, event, err)
+	return n, err
+}
+
+// doSetNodeStatus mark node up or down, containers on down node are marked unhealthy
+func (c *Calcium) doSetNodeStatus(ctx context.Context, node *types.Node, status int) error {
+	switch status {
+	case cluster.NodeUp:
+		node.Available = true
+	case cluster.NodeDown:
+		node.Available = false
+		containers, err := c.store.ListNodeContainers(ctx, node.Name, nil)
+		if err != nil {
+			return err
+		}
+		for _, container := range containers {
+			if container.StatusMeta == nil {
+				container.StatusMeta = &types.StatusMeta{ID: container.ID}
+			}
+			container.StatusMeta.Running = false
+			container.StatusMeta.Healthy = false
+
+			// mark container which belongs to this node as unhealthy
+			if err = c.store.SetContainerStatus(ctx, container, 0); err != nil {
+				log.Errorf("[SetNodeAvailable] Set container %s on node %s inactive failed %v", container.ID, node.Name, err)
+			}
+		}
+	}
+	return nil
 }
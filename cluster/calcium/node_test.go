@@ -21,6 +21,7 @@ func TestAddNode(t *testing.T) {
 	}
 
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	store.On("AddNode",
 		mock.Anything,
 		mock.Anything, mock.Anything, mock.Anything,
@@ -41,6 +42,7 @@ func TestRemoveNode(t *testing.T) {
 	name := "test"
 	node := &types.Node{Name: name}
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	lock := &lockmocks.DistributedLock{}
 	lock.On("Lock", mock.Anything).Return(nil)
 	lock.On("Unlock", mock.Anything).Return(nil)
@@ -70,6 +72,7 @@ func TestListPodNodes(t *testing.T) {
 	}
 
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	c.store = store
 	store.On("GetNodesByPod", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
 	_, err := c.ListPodNodes(ctx, "", nil, false)
@@ -93,6 +96,7 @@ func TestGetNode(t *testing.T) {
 	}
 
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	store.On("GetNode", mock.Anything, mock.Anything).Return(node, nil)
 	c.store = store
 
@@ -109,6 +113,7 @@ func TestSetNode(t *testing.T) {
 	node.Init()
 
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	c.store = store
 	lock := &lockmocks.DistributedLock{}
 	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
@@ -198,6 +203,21 @@ func TestSetNode(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, n.MemCap, int64(0))
 	setOpts.DeltaMemory = 0
+	// failed set bandwidth
+	n.Bandwidth = 1
+	n.InitBandwidth = 2
+	setOpts.DeltaBandwidth = -10
+	_, err = c.SetNode(ctx, setOpts)
+	assert.True(t, errors.Is(err, types.ErrBadBandwidth))
+	// succ set bandwidth
+	n.Bandwidth = 1
+	n.InitBandwidth = 2
+	setOpts.DeltaBandwidth = 10
+	n, err = c.SetNode(ctx, setOpts)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(11), n.Bandwidth)
+	assert.Equal(t, int64(12), n.InitBandwidth)
+	setOpts.DeltaBandwidth = 0
 	// failed by set cpu
 	n.CPU = types.CPUMap{"1": 1}
 	n.InitCPU = types.CPUMap{"1": 2}
@@ -232,4 +252,17 @@ func TestSetNode(t *testing.T) {
 	setOpts.DeltaVolume = types.VolumeMap{"/sda0": -100}
 	n, err = c.SetNode(ctx, setOpts)
 	assert.True(t, errors.Is(err, types.ErrBadVolume))
+	// set devices, gpu 1 is in use
+	setOpts.DeltaVolume = nil
+	n.InitDevices = types.DeviceMap{"gpu": {"0", "1"}, "fpga": {"f0"}}
+	n.Devices = types.DeviceMap{"gpu": {"0"}, "fpga": {"f0"}}
+	setOpts.Devices = types.DeviceMap{"gpu": {"1", "2"}, "fpga": {}}
+	n, err = c.SetNode(ctx, setOpts)
+	assert.NoError(t, err)
+	assert.Equal(t, types.DeviceMap{"gpu": {"1", "2"}}, n.InitDevices)
+	assert.Equal(t, types.DeviceMap{"gpu": {"2"}}, n.Devices)
+	// failed by removing device in use
+	setOpts.Devices = types.DeviceMap{"gpu": {"2"}}
+	_, err = c.SetNode(ctx, setOpts)
+	assert.True(t, errors.Is(err, types.ErrBadDevice))
 }
@@ -3,21 +3,55 @@ package calcium
 import (
 	"context"
 
+	"github.com/projecteru2/core/cluster"
 	"github.com/projecteru2/core/types"
 )
 
 // AddPod add pod
 func (c *Calcium) AddPod(ctx context.Context, podname, desc string) (*types.Pod, error) {
-	return c.store.AddPod(ctx, podname, desc)
+	pod, err := c.store.AddPod(ctx, podname, desc)
+	c.doRecordEvent(ctx, newPodEvent(cluster.EventAdd, podname), err)
+	return pod, err
+}
+
+// SetPodOvercommit set overcommit ratios of a pod, 0 to disable
+func (c *Calcium) SetPodOvercommit(ctx context.Context, podname string, cpu, memory float64) (*types.Pod, error) {
+	if cpu < 0 || memory < 0 {
+		return nil, types.NewDetailedErr(types.ErrBadOvercommit, "ratio can't be negative")
+	}
+	var pod *types.Pod
+	// 和部署互斥, 避免调度到一半比例变了
+	err := c.withNodesLocked(ctx, podname, "", nil, true, func(nodes map[string]*types.Node) error {
+		var err error
+		if pod, err = c.store.GetPod(ctx, podname); err != nil {
+			return err
+		}
+		pod.CPUOvercommit = cpu
+		pod.MemoryOvercommit = memory
+		return c.store.UpdatePod(ctx, pod)
+	})
+	c.doRecordEvent(ctx, newPodEvent(cluster.EventSet, podname), err)
+	if err != nil {
+		return nil, err
+	}
+	return pod, nil
 }
 
 // RemovePod remove pod
 func (c *Calcium) RemovePod(ctx context.Context, podname string) error {
-	return c.withNodesLocked(ctx, podname, "", nil, true, func(nodes map[string]*types.Node) error {
+	err := c.withNodesLocked(ctx, podname, "", nil, true, func(nodes map[string]*types.Node) error {
 		// TODO dissociate container to node
 		// TODO remove node first
 		return c.store.RemovePod(ctx, podname)
 	})
+	c.doRecordEvent(ctx, newPodEvent(cluster.EventRemove, podname), err)
+	return err
+}
+
+func newPodEvent(action, podname string) *types.Event {
+	event := newEvent(cluster.EventPod, action, podname)
+	event.Podname = podname
+	return event
 }
 
 // GetPod get one pod
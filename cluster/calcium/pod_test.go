@@ -2,6 +2,7 @@ package calcium
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	lockmocks "github.com/projecteru2/core/lock/mocks"
@@ -20,6 +21,7 @@ func TestAddPod(t *testing.T) {
 	}
 
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	store.On("AddPod", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(pod, nil)
 	c.store = store
 
@@ -32,6 +34,7 @@ func TestRemovePod(t *testing.T) {
 	c := NewTestCluster()
 	ctx := context.Background()
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	c.store = store
 	store.On("RemovePod", mock.Anything, mock.Anything).Return(nil)
 	node := &types.Node{Name: "n1", Available: true}
@@ -54,6 +57,7 @@ func TestListPods(t *testing.T) {
 	}
 
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	store.On("GetAllPods", mock.Anything).Return(pods, nil)
 	c.store = store
 
@@ -70,6 +74,7 @@ func TestGetPod(t *testing.T) {
 	name := "test"
 	pod := &types.Pod{Name: name}
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	store.On("GetPod", mock.Anything, mock.Anything).Return(pod, nil)
 	c.store = store
 
@@ -77,3 +82,67 @@ func TestGetPod(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, p.Name, name)
 }
+
+func TestSetPodOvercommit(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+
+	_, err := c.SetPodOvercommit(ctx, "p1", -1, 2)
+	assert.Error(t, err)
+
+	store.On("GetNodesByPod", mock.Anything, "p1", mock.Anything, true).Return([]*types.Node{}, nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(&dummyLock{}, nil)
+	store.On("GetPod", mock.Anything, "p1").Return(nil, types.ErrNoETCD).Once()
+	_, err = c.SetPodOvercommit(ctx, "p1", 4, 2)
+	assert.Error(t, err)
+
+	store.On("GetPod", mock.Anything, "p1").Return(&types.Pod{Name: "p1", Desc: "dev"}, nil)
+	store.On("UpdatePod", mock.Anything, mock.Anything).Return(nil)
+	pod, err := c.SetPodOvercommit(ctx, "p1", 4, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, "dev", pod.Desc)
+	assert.Equal(t, 4.0, pod.CPUOvercommit)
+	assert.Equal(t, 2.0, pod.MemoryOvercommit)
+}
+
+func TestOvercommitNodesInfo(t *testing.T) {
+	nodes := map[string]*types.Node{
+		"n1": {Name: "n1", InitCPU: types.CPUMap{"0": 100, "1": 100}, CPUUsed: 1, MemCap: 20, InitMemCap: 100},
+	}
+	nodesInfo := getNodesInfo(&types.Pod{}, nodes, 1, 10, 0)
+	assert.Equal(t, int64(20), nodesInfo[0].MemCap)
+	assert.Equal(t, 0.0, nodesInfo[0].CPUOvercommit)
+
+	nodesInfo = getNodesInfo(&types.Pod{CPUOvercommit: 3, MemoryOvercommit: 1.5}, nodes, 1, 10, 0)
+	assert.Equal(t, int64(70), nodesInfo[0].MemCap)
+	assert.Equal(t, 3.0, nodesInfo[0].CPUOvercommit)
+	// physical usage is kept for scoring
+	assert.InDelta(t, 0.8, nodesInfo[0].MemUsage, 1e-9)
+}
+
+func TestCheckOvercommit(t *testing.T) {
+	node := &types.Node{
+		Name: "n1", InitCPU: types.CPUMap{"0": 100, "1": 100}, CPUUsed: 1, MemCap: 20, InitMemCap: 100,
+		NUMA: types.NUMA{"0": "0", "1": "1"}, NUMAMemory: types.NUMAMemory{"0": 10, "1": 10}, InitNUMAMemory: types.NUMAMemory{"0": 50, "1": 50},
+	}
+	pod := &types.Pod{}
+	assert.NoError(t, checkOvercommit(pod, node, 2, nil, 0.5, 10))
+	assert.True(t, errors.Is(checkOvercommit(pod, node, 3, nil, 0.5, 10), types.ErrInsufficientMEM))
+	// cpu not limited without overcommit
+	assert.NoError(t, checkOvercommit(pod, node, 1, nil, 4, 0))
+	// returning resource never fails
+	assert.NoError(t, checkOvercommit(pod, node, 1, nil, -4, -100))
+	// numa node memory
+	assert.NoError(t, checkOvercommit(pod, node, 2, []types.CPUMap{{"0": 10}, {"1": 10}}, 0.1, 10))
+	err := checkOvercommit(pod, node, 2, []types.CPUMap{{"0": 10}, {"0": 10}}, 0.1, 10)
+	assert.True(t, errors.Is(err, types.ErrInsufficientMEM))
+	assert.Contains(t, err.Error(), "numa")
+
+	pod = &types.Pod{CPUOvercommit: 2, MemoryOvercommit: 1.5}
+	assert.NoError(t, checkOvercommit(pod, node, 2, []types.CPUMap{{"0": 10}, {"0": 10}}, 0.1, 10))
+	assert.NoError(t, checkOvercommit(pod, node, 7, nil, 0, 10))
+	assert.True(t, errors.Is(checkOvercommit(pod, node, 8, nil, 0, 10), types.ErrInsufficientMEM))
+	assert.NoError(t, checkOvercommit(pod, node, 2, nil, 1.5, 0))
+	assert.True(t, errors.Is(checkOvercommit(pod, node, 2, nil, 2, 0), types.ErrInsufficientCPU))
+}
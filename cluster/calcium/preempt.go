@@ -0,0 +1,168 @@
+package calcium
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// doPickVictims pick containers with lower priority on nodes to evict so that the deploy can be planned
+// must be called with nodes locked, nothing is evicted here
+// returns nothing if evicting all of them is still not enough
+func (c *Calcium) doPickVictims(ctx context.Context, opts *types.DeployOptions, nodes map[string]*types.Node) ([]*types.Container, error) {
+	candidates, err := c.doListPreemptCandidates(ctx, opts, nodes)
+	if err != nil || len(candidates) == 0 {
+		return nil, err
+	}
+	return c.doSelectVictims(ctx, opts, nodes, candidates)
+}
+
+// doPreempt evict victims and return their resources to nodes
+// must be called without nodes locked, containers are locked before their nodes just like RemoveContainer
+func (c *Calcium) doPreempt(ctx context.Context, victims []*types.Container) ([]*types.CreateContainerMessage, error) {
+	messages := []*types.CreateContainerMessage{}
+	for _, victim := range victims {
+		m := &types.CreateContainerMessage{
+			Podname:  victim.Podname,
+			Nodename: victim.Nodename,
+			Evicted:  &types.RemoveContainerMessage{ContainerID: victim.ID, Hook: []*bytes.Buffer{}},
+		}
+		messages = append(messages, m)
+		// 记录以锁内取到的为准, 选中之后可能已经被删了或者挪走了
+		if err := c.withContainerLocked(ctx, victim.ID, func(container *types.Container) error {
+			if container.Nodename != victim.Nodename {
+				return types.NewDetailedErr(types.ErrRemoveContainerFailed, fmt.Sprintf("container %s moved to %s", container.ID, container.Nodename))
+			}
+			return c.withNodeLocked(ctx, container.Nodename, func(node *types.Node) error {
+				return c.doRemoveContainerAndReleaseResource(ctx, container, node, true)
+			})
+		}); err != nil {
+			log.Errorf("[doPreempt] Evict container %s failed %v", victim.ID, err)
+			m.Evicted.Hook = append(m.Evicted.Hook, bytes.NewBufferString(err.Error()))
+			return messages, err
+		}
+		m.Evicted.Success = true
+		log.Infof("[doPreempt] Container %s with priority %d evicted from %s", victim.ID, victim.Priority, victim.Nodename)
+	}
+	return messages, nil
+}
+
+// doListPreemptCandidates list containers with lower priority on nodes, lowest first
+// containers of the deploying entrypoint itself are never evicted
+func (c *Calcium) doListPreemptCandidates(ctx context.Context, opts *types.DeployOptions, nodes map[string]*types.Node) ([]*types.Container, error) {
+	candidates := []*types.Container{}
+	for nodename := range nodes {
+		containers, err := c.store.ListNodeContainers(ctx, nodename, nil)
+		if err != nil {
+			return nil, err
+		}
+		for _, container := range containers {
+			if container.Priority >= opts.Priority {
+				continue
+			}
+			if appname, entrypoint, _, err := utils.ParseContainerName(container.Name); err == nil && appname == opts.Name && entrypoint == opts.Entrypoint.Name {
+				continue
+			}
+			candidates = append(candidates, container)
+		}
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Priority != candidates[j].Priority {
+			return candidates[i].Priority < candidates[j].Priority
+		}
+		if candidates[i].Nodename != candidates[j].Nodename {
+			return candidates[i].Nodename < candidates[j].Nodename
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+	return candidates, nil
+}
+
+// doSelectVictims release candidates one by one until the plan can be made
+// victims on nodes which deploy nothing in the plan are spared
+func (c *Calcium) doSelectVictims(ctx context.Context, opts *types.DeployOptions, nodes map[string]*types.Node, candidates []*types.Container) ([]*types.Container, error) {
+	pod, err := c.store.GetPod(ctx, opts.Podname)
+	if err != nil {
+		return nil, err
+	}
+	// 部署状态不会因为驱逐而改变, 只取一次
+	nodesInfo, err := c.store.MakeDeployStatus(ctx, opts, getNodesInfo(pod, nodes, opts.CPUQuota, opts.Memory, opts.Storage))
+	if err != nil {
+		return nil, err
+	}
+	status := map[string]types.NodeInfo{}
+	for _, nodeInfo := range nodesInfo {
+		status[nodeInfo.Name] = nodeInfo
+	}
+	plan := func(victims []*types.Container) ([]types.NodeInfo, error) {
+		released := map[string]*types.Node{}
+		for nodename, node := range nodes {
+			released[nodename] = node
+		}
+		for _, container := range victims {
+			released[container.Nodename] = releaseResource(released[container.Nodename], container)
+		}
+		nodesInfo := getNodesInfo(pod, released, opts.CPUQuota, opts.Memory, opts.Storage)
+		for i := range nodesInfo {
+			nodesInfo[i].Count = status[nodesInfo[i].Name].Count
+			nodesInfo[i].Colocated = status[nodesInfo[i].Name].Colocated
+		}
+		nodesInfo, _, err := c.doScheduleNodes(opts, nodesInfo)
+		return nodesInfo, err
+	}
+
+	for i := range candidates {
+		nodesInfo, err := plan(candidates[:i+1])
+		if err != nil {
+			continue
+		}
+		deployed := map[string]bool{}
+		for _, nodeInfo := range nodesInfo {
+			deployed[nodeInfo.Name] = nodeInfo.Deploy > 0
+		}
+		victims := []*types.Container{}
+		for _, container := range candidates[:i+1] {
+			if deployed[container.Nodename] {
+				victims = append(victims, container)
+			}
+		}
+		if _, err := plan(victims); err != nil {
+			victims = candidates[:i+1]
+		}
+		return victims, nil
+	}
+	log.Warnf("[doSelectVictims] Evict %d containers still not enough", len(candidates))
+	return nil, nil
+}
+
+// releaseResource returns a copy of node with resource of container returned
+func releaseResource(node *types.Node, container *types.Container) *types.Node {
+	n := *node
+	n.CPU = types.CPUMap{}
+	n.CPU.Add(node.CPU)
+	n.CPU.Add(container.CPU)
+	n.SetCPUUsed(container.Quota, types.DecrUsage)
+	volume := container.VolumePlan.IntoVolumeMap()
+	n.Volume = types.VolumeMap{}
+	n.Volume.Add(node.Volume)
+	n.Volume.Add(volume)
+	n.SetVolumeUsed(volume.Total(), types.DecrUsage)
+	n.Devices = node.Devices.Copy()
+	n.Devices.Add(container.Devices)
+	n.MemCap += container.Memory
+	n.StorageCap += container.Storage
+	n.Bandwidth += container.Bandwidth
+	n.NUMAMemory = types.NUMAMemory{}
+	for nodeID, memory := range node.NUMAMemory {
+		n.NUMAMemory[nodeID] = memory
+	}
+	if nodeID := n.GetNUMANode(container.CPU); nodeID != "" {
+		n.IncrNUMANodeMemory(nodeID, container.Memory)
+	}
+	return &n
+}
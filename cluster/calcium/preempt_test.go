@@ -0,0 +1,173 @@
+package calcium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/projecteru2/core/cluster"
+	enginemocks "github.com/projecteru2/core/engine/mocks"
+	lockmocks "github.com/projecteru2/core/lock/mocks"
+	complexscheduler "github.com/projecteru2/core/scheduler/complex"
+	"github.com/projecteru2/core/store"
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+)
+
+func TestPreempt(t *testing.T) {
+	c := NewTestCluster()
+	c.scheduler, _ = complexscheduler.New(types.Config{Scheduler: types.SchedConfig{MaxShare: -1, ShareBase: 100}})
+	ctx := context.Background()
+	st := c.store.(*storemocks.Store)
+	engine := &enginemocks.API{}
+	node := &types.Node{
+		Name:       "n1",
+		Podname:    "p1",
+		Available:  true,
+		CPU:        types.CPUMap{"0": 100},
+		InitCPU:    types.CPUMap{"0": 100},
+		MemCap:     20,
+		InitMemCap: 100,
+		Engine:     engine,
+	}
+	opts := &types.DeployOptions{
+		Name:         "app",
+		Entrypoint:   &types.Entrypoint{Name: "web"},
+		Podname:      "p1",
+		Nodename:     "n1",
+		Count:        1,
+		Memory:       50,
+		CPUQuota:     0.5,
+		DeployMethod: cluster.DeployAuto,
+	}
+	containers := []*types.Container{
+		// same entrypoint is never evicted
+		{ID: "c1", Name: "app_web_aaaaaa", Podname: "p1", Nodename: "n1", Memory: 40, Engine: engine},
+		{ID: "c2", Name: "batch_job_bbbbbb", Podname: "p1", Nodename: "n1", Memory: 20, Priority: 1, Engine: engine},
+		{ID: "c3", Name: "lambda_run_cccccc", Podname: "p1", Nodename: "n1", Memory: 20, Engine: engine},
+	}
+	st.On("GetNode", mock.Anything, "n1").Return(node, nil)
+	st.On("GetPod", mock.Anything, "p1").Return(&types.Pod{Name: "p1"}, nil)
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	locked := []string{}
+	st.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil).Run(func(args mock.Arguments) {
+		locked = append(locked, args.String(0))
+	})
+	st.On("MakeDeployStatus", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ *types.DeployOptions, nodesInfo []types.NodeInfo) []types.NodeInfo {
+			return nodesInfo
+		}, nil)
+	st.On("ListNodeContainers", mock.Anything, "n1", mock.Anything).Return(containers, nil)
+	st.On("ListQuotas", mock.Anything, "app").Return(nil, nil)
+
+	// no priority, no preemption
+	_, _, err := c.doAllocResource(ctx, opts)
+	assert.True(t, errors.Is(err, types.ErrInsufficientMEM))
+	st.AssertNotCalled(t, "ListNodeContainers", mock.Anything, mock.Anything, mock.Anything)
+
+	// only c3 has lower priority, not enough
+	opts.Priority = 1
+	_, _, err = c.doAllocResource(ctx, opts)
+	assert.True(t, errors.Is(err, types.ErrInsufficientMEM))
+	st.AssertNotCalled(t, "RemoveContainer", mock.Anything, mock.Anything)
+
+	// evict c3 then c2
+	stolen := false
+	engine.On("VirtualizationRemove", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	st.On("RemoveContainer", mock.Anything, mock.Anything).Return(nil)
+	for _, container := range containers {
+		st.On("GetContainers", mock.Anything, []string{container.ID}).Return([]*types.Container{container}, nil)
+	}
+	st.On("UpdateNodeResource",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+	).Run(func(args mock.Arguments) {
+		n := args.Get(1).(*types.Node)
+		if args.String(9) == store.ActionIncr {
+			n.MemCap += args.Get(4).(int64)
+			// 释放出来的马上又被别人占了
+			if stolen {
+				n.MemCap = 0
+			}
+		} else {
+			n.MemCap -= args.Get(4).(int64)
+		}
+	}).Return(nil)
+	st.On("SaveProcessing", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	opts.Priority = 2
+	locked = locked[:0]
+	nodesInfo, evicted, err := c.doAllocResource(ctx, opts)
+	assert.NoError(t, err)
+	assert.Len(t, evicted, 2)
+	assert.Equal(t, "c3", evicted[0].Evicted.ContainerID)
+	assert.Equal(t, "c2", evicted[1].Evicted.ContainerID)
+	assert.True(t, evicted[1].Evicted.Success)
+	assert.Equal(t, 1, nodesInfo[0].Deploy)
+	assert.Equal(t, int64(10), node.MemCap)
+	st.AssertNotCalled(t, "RemoveContainer", mock.Anything, containers[0])
+	// victims picked with node locked, then evicted container first like RemoveContainer, then planned again
+	nodeLock := fmt.Sprintf(cluster.NodeLock, "p1", "n1")
+	assert.Equal(t, []string{
+		nodeLock,
+		fmt.Sprintf(cluster.ContainerLock, "c3"), nodeLock,
+		fmt.Sprintf(cluster.ContainerLock, "c2"), nodeLock,
+		nodeLock,
+	}, locked)
+
+	// evicted containers returned even if plan still fails
+	node.MemCap = 20
+	stolen = true
+	_, evicted, err = c.doAllocResource(ctx, opts)
+	assert.True(t, errors.Is(err, types.ErrInsufficientMEM))
+	assert.Len(t, evicted, 2)
+	// and sent with the error by create
+	node.MemCap = 20
+	ch, err := c.doCreateContainer(ctx, opts, &types.Pod{Name: "p1"})
+	assert.NoError(t, err)
+	messages := []*types.CreateContainerMessage{}
+	for m := range ch {
+		messages = append(messages, m)
+	}
+	assert.Len(t, messages, 3)
+	assert.Equal(t, "c3", messages[0].Evicted.ContainerID)
+	assert.True(t, errors.Is(messages[2].Error, types.ErrInsufficientMEM))
+	assert.True(t, evicted[0].Evicted.Success)
+}
+
+func TestReleaseResource(t *testing.T) {
+	node := &types.Node{
+		CPU:        types.CPUMap{"0": 50},
+		CPUUsed:    0.5,
+		Volume:     types.VolumeMap{"/data": 100},
+		VolumeUsed: 100,
+		MemCap:     10,
+		StorageCap: 10,
+		NUMA:       types.NUMA{"0": "node0"},
+		NUMAMemory: types.NUMAMemory{"node0": 5},
+	}
+	container := &types.Container{
+		CPU:        types.CPUMap{"0": 50},
+		Quota:      0.5,
+		Memory:     10,
+		Storage:    10,
+		VolumePlan: types.VolumePlan{types.MustToVolumeBinding("AUTO:/data:rw:100"): types.VolumeMap{"/data": 100}},
+	}
+	n := releaseResource(node, container)
+	assert.Equal(t, int64(100), n.CPU["0"])
+	assert.Equal(t, 0.0, n.CPUUsed)
+	assert.Equal(t, int64(200), n.Volume["/data"])
+	assert.Equal(t, int64(0), n.VolumeUsed)
+	assert.Equal(t, int64(20), n.MemCap)
+	assert.Equal(t, int64(20), n.StorageCap)
+	assert.Equal(t, int64(15), n.NUMAMemory["node0"])
+	// origin node not changed
+	assert.Equal(t, int64(50), node.CPU["0"])
+	assert.Equal(t, int64(10), node.MemCap)
+	assert.Equal(t, int64(5), node.NUMAMemory["node0"])
+}
@@ -0,0 +1,113 @@
+package calcium
+
+import (
+	"context"
+
+	"github.com/projecteru2/core/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// SetQuota set resource quota of an app, optionally scoped to a pod
+func (c *Calcium) SetQuota(ctx context.Context, quota *types.Quota) error {
+	if quota.Appname == "" {
+		return types.NewDetailedErr(types.ErrBadQuota, "appname must be set")
+	}
+	if quota.CPU < 0 || quota.Memory < 0 || quota.Storage < 0 || quota.Count < 0 {
+		return types.NewDetailedErr(types.ErrBadQuota, "limits can't be negative")
+	}
+	if quota.Podname != "" {
+		if _, err := c.store.GetPod(ctx, quota.Podname); err != nil {
+			log.Errorf("[SetQuota] Error during GetPod for %s: %v", quota.Podname, err)
+			return err
+		}
+	}
+	return c.store.SetQuota(ctx, quota)
+}
+
+// RemoveQuota remove quota of an app
+func (c *Calcium) RemoveQuota(ctx context.Context, appname, podname string) error {
+	return c.store.RemoveQuota(ctx, appname, podname)
+}
+
+// ListQuotaUsages list quotas of an app with current usage, or all quotas if appname is empty
+func (c *Calcium) ListQuotaUsages(ctx context.Context, appname string) ([]*types.QuotaUsage, error) {
+	quotas, err := c.store.ListQuotas(ctx, appname)
+	if err != nil {
+		return nil, err
+	}
+	appQuotas := map[string][]*types.Quota{}
+	for _, quota := range quotas {
+		appQuotas[quota.Appname] = append(appQuotas[quota.Appname], quota)
+	}
+	usages := []*types.QuotaUsage{}
+	for appname, quotas := range appQuotas {
+		u, err := c.doGetQuotaUsages(ctx, appname, quotas)
+		if err != nil {
+			return nil, err
+		}
+		usages = append(usages, u...)
+	}
+	return usages, nil
+}
+
+// doGetQuotaUsages count resource used by containers of the app under each quota
+// containers still being created are counted by their processing status
+func (c *Calcium) doGetQuotaUsages(ctx context.Context, appname string, quotas []*types.Quota) ([]*types.QuotaUsage, error) {
+	containers, err := c.store.ListContainers(ctx, appname, "", "", 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	processing, err := c.store.ListProcessing(ctx, appname)
+	if err != nil {
+		return nil, err
+	}
+	usages := []*types.QuotaUsage{}
+	for _, quota := range quotas {
+		usage := &types.QuotaUsage{Quota: quota}
+		for _, container := range containers {
+			if quota.Covers(container.Podname) {
+				usage.Used.Add(types.QuotaResource{CPU: container.Quota, Memory: container.Memory, Storage: container.Storage, Count: 1})
+			}
+		}
+		for _, p := range processing {
+			if quota.Covers(p.Podname) {
+				usage.Used.Add(p.QuotaResource())
+			}
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
+
+// doCheckQuota check whether the app exceeds its quotas after costs are added
+// costs are keyed by podname
+func (c *Calcium) doCheckQuota(ctx context.Context, appname string, costs map[string]types.QuotaResource) error {
+	quotas, err := c.store.ListQuotas(ctx, appname)
+	if err != nil || len(quotas) == 0 {
+		return err
+	}
+	usages, err := c.doGetQuotaUsages(ctx, appname, quotas)
+	if err != nil {
+		return err
+	}
+	for _, usage := range usages {
+		for podname, cost := range costs {
+			if usage.Quota.Covers(podname) {
+				usage.Used.Add(cost)
+			}
+		}
+		if err := usage.Quota.Check(usage.Used); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func deployCost(opts *types.DeployOptions, count int) map[string]types.QuotaResource {
+	return map[string]types.QuotaResource{opts.Podname: {
+		CPU:     opts.CPUQuota * float64(count),
+		Memory:  opts.Memory * int64(count),
+		Storage: opts.Storage * int64(count),
+		Count:   count,
+	}}
+}
@@ -0,0 +1,153 @@
+package calcium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/projecteru2/core/cluster"
+	lockmocks "github.com/projecteru2/core/lock/mocks"
+	complexscheduler "github.com/projecteru2/core/scheduler/complex"
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSetQuota(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+
+	// failed by appname
+	assert.True(t, errors.Is(c.SetQuota(ctx, &types.Quota{}), types.ErrBadQuota))
+	// failed by negative limit
+	quota := &types.Quota{Appname: "app", Podname: "p1", QuotaResource: types.QuotaResource{Memory: -1}}
+	assert.True(t, errors.Is(c.SetQuota(ctx, quota), types.ErrBadQuota))
+	quota.Memory = 100
+	// failed by pod
+	store.On("GetPod", mock.Anything, "p1").Return(nil, types.ErrKeyNotExists).Once()
+	assert.Error(t, c.SetQuota(ctx, quota))
+
+	store.On("GetPod", mock.Anything, "p1").Return(&types.Pod{Name: "p1"}, nil)
+	store.On("SetQuota", mock.Anything, quota).Return(nil)
+	assert.NoError(t, c.SetQuota(ctx, quota))
+}
+
+func TestQuotaUsage(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	quotas := []*types.Quota{
+		{Appname: "app", QuotaResource: types.QuotaResource{Memory: 100, Count: 4}},
+		{Appname: "app", Podname: "p1", QuotaResource: types.QuotaResource{CPU: 1}},
+	}
+	containers := []*types.Container{
+		{Podname: "p1", Quota: 0.5, Memory: 30},
+		{Podname: "p2", Quota: 1, Memory: 30, Storage: 10},
+	}
+	store.On("ListQuotas", mock.Anything, "app").Return(quotas, nil)
+	store.On("ListContainers", mock.Anything, "app", "", "", int64(0), mock.Anything).Return(containers, nil)
+	processing := []*types.Processing{}
+	store.On("ListProcessing", mock.Anything, "app").Return(func(context.Context, string) []*types.Processing { return processing }, nil)
+
+	usages, err := c.ListQuotaUsages(ctx, "app")
+	assert.NoError(t, err)
+	assert.Len(t, usages, 2)
+	assert.Equal(t, types.QuotaResource{CPU: 1.5, Memory: 60, Storage: 10, Count: 2}, usages[0].Used)
+	assert.Equal(t, types.QuotaResource{CPU: 0.5, Memory: 30, Count: 1}, usages[1].Used)
+
+	// p2 not limited by cpu
+	assert.NoError(t, c.doCheckQuota(ctx, "app", map[string]types.QuotaResource{"p2": {CPU: 2, Memory: 40, Count: 2}}))
+	err = c.doCheckQuota(ctx, "app", map[string]types.QuotaResource{"p1": {CPU: 1, Count: 1}})
+	assert.True(t, errors.Is(err, types.ErrQuotaExceeded))
+	assert.Contains(t, err.Error(), "cpu 1.5/1")
+	err = c.doCheckQuota(ctx, "app", map[string]types.QuotaResource{"p2": {Memory: 50}})
+	assert.True(t, errors.Is(err, types.ErrQuotaExceeded))
+
+	// realloc counts every growing container
+	reallocated := map[string]*types.Container{
+		"c1": {ID: "c1", Name: "app_web_aaaaaa", Podname: "p1"},
+		"c2": {ID: "c2", Name: "app_web_bbbbbb", Podname: "p1"},
+	}
+	assert.NoError(t, c.doCheckReallocQuota(ctx, reallocated, 0.2, 10))
+	assert.True(t, errors.Is(c.doCheckReallocQuota(ctx, reallocated, 0.3, 10), types.ErrQuotaExceeded))
+	// shrinking is never limited
+	assert.NoError(t, c.doCheckReallocQuota(ctx, reallocated, -0.3, -10))
+
+	// containers still being created are counted
+	processing = []*types.Processing{{Appname: "app", Podname: "p1", Count: 2, CPU: 0.2, Memory: 10}}
+	usages, err = c.ListQuotaUsages(ctx, "app")
+	assert.NoError(t, err)
+	assert.Equal(t, types.QuotaResource{CPU: 1.9, Memory: 80, Storage: 10, Count: 4}, usages[0].Used)
+	err = c.doCheckQuota(ctx, "app", map[string]types.QuotaResource{"p2": {Memory: 10, Count: 1}})
+	assert.True(t, errors.Is(err, types.ErrQuotaExceeded))
+}
+
+func TestAllocResourceQuota(t *testing.T) {
+	c := NewTestCluster()
+	c.scheduler, _ = complexscheduler.New(types.Config{Scheduler: types.SchedConfig{MaxShare: -1, ShareBase: 100}})
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	node := &types.Node{Name: "n1", Available: true, CPU: types.CPUMap{"0": 100}, MemCap: 100}
+	opts := &types.DeployOptions{
+		Name:         "app",
+		Entrypoint:   &types.Entrypoint{Name: "web"},
+		Podname:      "p1",
+		Nodename:     "n1",
+		Count:        2,
+		Memory:       30,
+		DeployMethod: cluster.DeployAuto,
+	}
+	store.On("GetNode", mock.Anything, "n1").Return(node, nil)
+	store.On("GetPod", mock.Anything, "p1").Return(&types.Pod{Name: "p1"}, nil)
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
+	store.On("MakeDeployStatus", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ *types.DeployOptions, nodesInfo []types.NodeInfo) []types.NodeInfo {
+			return nodesInfo
+		}, nil)
+	store.On("ListQuotas", mock.Anything, "app").Return([]*types.Quota{{Appname: "app", QuotaResource: types.QuotaResource{Memory: 80}}}, nil)
+	store.On("ListContainers", mock.Anything, "app", "", "", int64(0), mock.Anything).Return([]*types.Container{{Podname: "p2", Memory: 30}}, nil)
+
+	store.On("ListProcessing", mock.Anything, "app").Return(nil, nil)
+
+	_, _, err := c.doAllocResource(ctx, opts)
+	assert.True(t, errors.Is(err, types.ErrQuotaExceeded))
+	// app with quota is locked
+	store.AssertCalled(t, "CreateLock", fmt.Sprintf(cluster.AppLock, "app"), mock.Anything)
+	store.AssertNotCalled(t, "UpdateNodeResource", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestReallocQuotaLocked(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
+	// 记录加锁顺序
+	locked := []string{}
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil).Run(func(args mock.Arguments) {
+		locked = append(locked, args.String(0))
+	})
+	container := &types.Container{ID: "c1", Name: "app_web_aaaaaa", Podname: "p1", Nodename: "n1"}
+	store.On("GetContainers", mock.Anything, []string{"c1"}).Return([]*types.Container{container}, nil)
+	store.On("GetPod", mock.Anything, "p1").Return(&types.Pod{Name: "p1"}, nil)
+	store.On("ListQuotas", mock.Anything, "app").Return([]*types.Quota{{Appname: "app", QuotaResource: types.QuotaResource{Memory: 80}}}, nil)
+	store.On("ListContainers", mock.Anything, "app", "", "", int64(0), mock.Anything).Return([]*types.Container{container}, nil)
+	store.On("ListProcessing", mock.Anything, "app").Return(nil, nil)
+
+	ch, err := c.ReallocResource(ctx, []string{"c1"}, 0, 100, nil)
+	assert.NoError(t, err)
+	for m := range ch {
+		assert.False(t, m.Success)
+	}
+	// app 锁在容器锁外面, 配额不够不会动节点
+	assert.Equal(t, []string{fmt.Sprintf(cluster.AppLock, "app"), fmt.Sprintf(cluster.ContainerLock, "c1")}, locked)
+	store.AssertNotCalled(t, "GetNode", mock.Anything, "n1")
+}
@@ -0,0 +1,12 @@
+package calcium
+
+import (
+	"context"
+
+	"github.com/projecteru2/core/types"
+)
+
+// GetRBACPolicy get rbac policy in store
+func (c *Calcium) GetRBACPolicy(ctx context.Context) (*types.RBACPolicy, error) {
+	return c.store.GetRBACPolicy(ctx)
+}
@@ -2,11 +2,13 @@ package calcium
 
 import (
 	"context"
+	"sort"
 	"strings"
 	"sync"
 
 	"github.com/sanity-io/litter"
 
+	"github.com/projecteru2/core/cluster"
 	enginetypes "github.com/projecteru2/core/engine/types"
 	"github.com/projecteru2/core/types"
 	"github.com/projecteru2/core/utils"
@@ -22,42 +24,67 @@ type volCPUMemNodeContainers map[string]map[float64]map[int64]nodeContainers
 // ReallocResource allow realloc container resource
 func (c *Calcium) ReallocResource(ctx context.Context, IDs []string, cpu float64, memory int64, volumes types.VolumeBindings) (chan *types.ReallocResourceMessage, error) {
 	ch := make(chan *types.ReallocResourceMessage)
+	out := make(chan *types.ReallocResourceMessage)
+	ident := utils.RandomString(16)
+	reallocated := map[string]*types.Container{}
+	// 消息里没有容器信息, 转发时补上记录事件
 	go func() {
-		defer close(ch)
-		if err := c.withContainersLocked(ctx, IDs, func(containers map[string]*types.Container) error {
-			// Pod-Node-Containers
-			containersInfo := map[*types.Pod]nodeContainers{}
-			// Pod cache
-			podCache := map[string]*types.Pod{}
+		defer close(out)
+		for m := range ch {
 			var err error
-			for _, container := range containers {
-				pod, ok := podCache[container.Podname]
-				if !ok {
-					pod, err = c.store.GetPod(ctx, container.Podname)
-					if err != nil {
-						ch <- &types.ReallocResourceMessage{ContainerID: container.ID, Success: false}
-						continue
+			if !m.Success {
+				err = types.ErrReallocFailed
+			}
+			c.doRecordEvent(ctx, newContainerEvent(cluster.EventRealloc, ident, m.ContainerID, reallocated[m.ContainerID]), err)
+			out <- m
+		}
+	}()
+	go func() {
+		defer close(ch)
+		// 和部署一样, app 的锁在最外层, 检查完配额到资源更新完之间不让别的部署插进来
+		if err := c.withReallocQuotaLocked(ctx, IDs, cpu, memory, func() error {
+			return c.withContainersLocked(ctx, IDs, func(containers map[string]*types.Container) error {
+				for ID, container := range containers {
+					reallocated[ID] = container
+				}
+				// Pod-Node-Containers
+				containersInfo := map[*types.Pod]nodeContainers{}
+				// Pod cache
+				podCache := map[string]*types.Pod{}
+				var err error
+				for _, container := range containers {
+					pod, ok := podCache[container.Podname]
+					if !ok {
+						pod, err = c.store.GetPod(ctx, container.Podname)
+						if err != nil {
+							ch <- &types.ReallocResourceMessage{ContainerID: container.ID, Success: false}
+							continue
+						}
+						podCache[container.Podname] = pod
+						containersInfo[pod] = nodeContainers{}
+					}
+					if _, ok = containersInfo[pod][container.Nodename]; !ok {
+						containersInfo[pod][container.Nodename] = []*types.Container{}
 					}
-					podCache[container.Podname] = pod
-					containersInfo[pod] = nodeContainers{}
+					containersInfo[pod][container.Nodename] = append(containersInfo[pod][container.Nodename], container)
 				}
-				if _, ok = containersInfo[pod][container.Nodename]; !ok {
-					containersInfo[pod][container.Nodename] = []*types.Container{}
+
+				if err = c.doCheckReallocQuota(ctx, containers, cpu, memory); err != nil {
+					return err
 				}
-				containersInfo[pod][container.Nodename] = append(containersInfo[pod][container.Nodename], container)
-			}
 
-			wg := sync.WaitGroup{}
-			wg.Add(len(containersInfo))
-			// deal with normal container
-			for pod, nodeContainersInfo := range containersInfo {
-				go func(pod *types.Pod, nodeContainersInfo nodeContainers) {
-					defer wg.Done()
-					c.doReallocContainer(ctx, ch, pod, nodeContainersInfo, cpu, memory, volumes)
-				}(pod, nodeContainersInfo)
-			}
-			wg.Wait()
-			return nil
+				wg := sync.WaitGroup{}
+				wg.Add(len(containersInfo))
+				// deal with normal container
+				for pod, nodeContainersInfo := range containersInfo {
+					go func(pod *types.Pod, nodeContainersInfo nodeContainers) {
+						defer wg.Done()
+						c.doReallocContainer(ctx, ch, pod, nodeContainersInfo, cpu, memory, volumes)
+					}(pod, nodeContainersInfo)
+				}
+				wg.Wait()
+				return nil
+			})
 		}); err != nil {
 			log.Errorf("[ReallocResource] Realloc failed %v", err)
 			for _, ID := range IDs {
@@ -65,7 +92,60 @@ func (c *Calcium) ReallocResource(ctx context.Context, IDs []string, cpu float64
 			}
 		}
 	}()
-	return ch, nil
+	return out, nil
+}
+
+// withReallocQuotaLocked lock apps whose containers are growing
+func (c *Calcium) withReallocQuotaLocked(ctx context.Context, IDs []string, cpu float64, memory int64, f func() error) error {
+	if cpu <= 0 && memory <= 0 {
+		return f()
+	}
+	containers, err := c.GetContainers(ctx, IDs)
+	if err != nil {
+		return err
+	}
+	apps := map[string]struct{}{}
+	for _, container := range containers {
+		// 没有 app 的容器不检查配额
+		if appname, _, _, err := utils.ParseContainerName(container.Name); err == nil {
+			apps[appname] = struct{}{}
+		}
+	}
+	appnames := []string{}
+	for appname := range apps {
+		appnames = append(appnames, appname)
+	}
+	// 按顺序加锁, 避免并发的 realloc 互相等待
+	sort.Strings(appnames)
+	return c.withQuotasLocked(ctx, appnames, f)
+}
+
+// doCheckReallocQuota check quotas of apps whose containers are growing
+func (c *Calcium) doCheckReallocQuota(ctx context.Context, containers map[string]*types.Container, cpu float64, memory int64) error {
+	if cpu <= 0 && memory <= 0 {
+		return nil
+	}
+	// appname -> podname -> cost
+	appCosts := map[string]map[string]types.QuotaResource{}
+	for _, container := range containers {
+		appname, _, _, err := utils.ParseContainerName(container.Name)
+		if err != nil {
+			log.Warnf("[doCheckReallocQuota] Container %s has no app, skip quota %v", container.ID, err)
+			continue
+		}
+		if _, ok := appCosts[appname]; !ok {
+			appCosts[appname] = map[string]types.QuotaResource{}
+		}
+		cost := appCosts[appname][container.Podname]
+		cost.Add(types.QuotaResource{CPU: cpu, Memory: memory})
+		appCosts[appname][container.Podname] = cost
+	}
+	for appname, costs := range appCosts {
+		if err := c.doCheckQuota(ctx, appname, costs); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (c *Calcium) doReallocContainer(
@@ -135,15 +215,19 @@ func (c *Calcium) doReallocContainer(
 							}
 						}
 						// 检查内存
-						if cap := int(node.MemCap / newMemory); cap < len(containers) {
+						if cap := int(pod.MemCap(node) / newMemory); cap < len(containers) {
 							return types.NewDetailedErr(types.ErrInsufficientRes, node.Name)
 						}
+						// 检查非绑核的 quota
+						if cap, ok := pod.CPUQuotaCap(node); ok && cap < utils.Round(newCPU*float64(len(containers)-containerWithCPUBind)) {
+							return types.NewDetailedErr(types.ErrInsufficientCPU, node.Name)
+						}
 						var cpusets []types.CPUMap
 						// 按照 Node one by one 重新计算可以部署多少容器
 						if containerWithCPUBind > 0 {
 							nodesInfo := []types.NodeInfo{{Name: node.Name, CPUMap: node.CPU, MemCap: node.MemCap}}
 							// 重新计算需求
-							_, nodeCPUPlans, total, err := c.scheduler.SelectCPUNodes(nodesInfo, newCPU, newMemory)
+							_, nodeCPUPlans, total, err := c.getScheduler(pod.Name).SelectCPUNodes(nodesInfo, newCPU, newMemory)
 							if err != nil {
 								return err
 							}
@@ -160,7 +244,7 @@ func (c *Calcium) doReallocContainer(
 						if newAutoVol != "" {
 							nodesInfo := []types.NodeInfo{{Name: node.Name, VolumeMap: node.Volume, InitVolumeMap: node.InitVolume}}
 							autoVbs, _ = types.MakeVolumeBindings(strings.Split(newAutoVol, ","))
-							_, nodeVolumePlans, total, err := c.scheduler.SelectVolumeNodes(nodesInfo, autoVbs)
+							_, nodeVolumePlans, total, err := c.getScheduler(pod.Name).SelectVolumeNodes(nodesInfo, autoVbs)
 							if err != nil {
 								return err
 							}
@@ -10,6 +10,7 @@ import (
 	enginemocks "github.com/projecteru2/core/engine/mocks"
 	enginetypes "github.com/projecteru2/core/engine/types"
 	lockmocks "github.com/projecteru2/core/lock/mocks"
+	"github.com/projecteru2/core/scheduler"
 	schedulermocks "github.com/projecteru2/core/scheduler/mocks"
 	storemocks "github.com/projecteru2/core/store/mocks"
 	"github.com/projecteru2/core/types"
@@ -20,6 +21,7 @@ func TestRealloc(t *testing.T) {
 	c := NewTestCluster()
 	ctx := context.Background()
 	store := &storemocks.Store{}
+	store.On("AddEvent", mock.Anything, mock.Anything).Return(nil)
 	c.store = store
 
 	lock := &lockmocks.DistributedLock{}
@@ -105,8 +107,9 @@ func TestRealloc(t *testing.T) {
 		assert.False(t, r.Success)
 	}
 	// failed by no new CPU Plan
+	// pod has its own scheduler, default one not used
 	simpleMockScheduler := &schedulermocks.Scheduler{}
-	c.scheduler = simpleMockScheduler
+	c.podSchedulers = map[string]scheduler.Scheduler{"p1": simpleMockScheduler}
 	simpleMockScheduler.On("SelectCPUNodes", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, 0, types.ErrInsufficientMEM).Once()
 	nodeVolumePlans := map[string][]types.VolumePlan{
 		"c1": {{types.MustToVolumeBinding("AUTO:/data:rw:50"): types.VolumeMap{"/dir0": 50}}},
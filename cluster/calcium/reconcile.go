@@ -0,0 +1,103 @@
+package calcium
+
+import (
+	"context"
+	"time"
+
+	"github.com/projecteru2/core/cluster"
+	"github.com/projecteru2/core/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// Reconcile converge containers to desired deployments until ctx done
+// only the core holding the reconcile lock acts
+func (c *Calcium) Reconcile(ctx context.Context) {
+	c.withLeader(ctx, cluster.ReconcileLock, c.config.Reconcile.Interval, c.doReconcileLoop)
+}
+
+func (c *Calcium) doReconcileLoop(ctx context.Context) {
+	ticker := time.NewTicker(c.config.Reconcile.Interval)
+	defer ticker.Stop()
+	for {
+		// 先按指标调整期望数量, 再收敛
+		c.doAutoscaleAll(ctx)
+		c.doReconcileAll(ctx)
+		select {
+		case <-ctx.Done():
+			log.Info("[Reconcile] Reconcile stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (c *Calcium) doReconcileAll(ctx context.Context) {
+	deployments, err := c.store.ListDeployments(ctx)
+	if err != nil {
+		log.Errorf("[doReconcileAll] List deployments failed %v", err)
+		return
+	}
+	for _, deployment := range deployments {
+		if err := c.doReconcileDeployment(ctx, deployment); err != nil {
+			log.Errorf("[doReconcileAll] Reconcile %s %s failed %v", deployment.Appname, deployment.Entrypoint, err)
+		}
+	}
+}
+
+func (c *Calcium) doListAliveContainers(ctx context.Context, deployment *types.Deployment) ([]*types.Container, error) {
+	containers, err := c.store.ListContainers(ctx, deployment.Appname, deployment.Entrypoint, "", 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	alive := []*types.Container{}
+	for _, container := range containers {
+		if container.Podname != deployment.Podname {
+			continue
+		}
+		// 没有状态的容器无法判断, 当作存活
+		if container.StatusMeta != nil && !container.StatusMeta.Running {
+			continue
+		}
+		alive = append(alive, container)
+	}
+	return alive, nil
+}
+
+func (c *Calcium) doReconcileDeployment(ctx context.Context, deployment *types.Deployment) error {
+	alive, err := c.doListAliveContainers(ctx, deployment)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(alive) < deployment.Count:
+		opts := *deployment.Options
+		opts.Count = deployment.Count - len(alive)
+		log.Infof("[doReconcileDeployment] %s %s has %d alive, create %d", deployment.Appname, deployment.Entrypoint, len(alive), opts.Count)
+		ch, err := c.CreateContainer(ctx, &opts)
+		if err != nil {
+			return err
+		}
+		for m := range ch {
+			if m.Error != nil {
+				log.Errorf("[doReconcileDeployment] Create container failed %v", m.Error)
+			}
+		}
+	case len(alive) > deployment.Count:
+		IDs := []string{}
+		for _, container := range alive[deployment.Count:] {
+			IDs = append(IDs, container.ID)
+		}
+		log.Infof("[doReconcileDeployment] %s %s has %d alive, remove %d", deployment.Appname, deployment.Entrypoint, len(alive), len(IDs))
+		ch, err := c.RemoveContainer(ctx, IDs, true, 1)
+		if err != nil {
+			return err
+		}
+		for m := range ch {
+			if !m.Success {
+				log.Errorf("[doReconcileDeployment] Remove container %s failed", m.ContainerID)
+			}
+		}
+	}
+	return nil
+}
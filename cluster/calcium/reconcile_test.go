@@ -0,0 +1,136 @@
+package calcium
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	enginemocks "github.com/projecteru2/core/engine/mocks"
+	lockmocks "github.com/projecteru2/core/lock/mocks"
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestReconcileDeployment(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	deployment := &types.Deployment{
+		Appname:    "app",
+		Entrypoint: "entry",
+		Podname:    "pod",
+		Count:      1,
+		Options: &types.DeployOptions{
+			Name:       "app",
+			Entrypoint: &types.Entrypoint{Name: "entry"},
+			Podname:    "pod",
+			Count:      1,
+			Memory:     1,
+			CPUQuota:   1,
+		},
+	}
+
+	// failed by ListContainers
+	store.On("ListContainers", mock.Anything, "app", "entry", "", int64(0), mock.Anything).Return(nil, types.ErrNoETCD).Once()
+	assert.Error(t, c.doReconcileDeployment(ctx, deployment))
+
+	// converged, dead and other pod containers are not counted
+	containers := []*types.Container{
+		{ID: "c1", Podname: "pod"},
+		{ID: "c2", Podname: "pod", StatusMeta: &types.StatusMeta{Running: false}},
+		{ID: "c3", Podname: "other"},
+	}
+	store.On("ListContainers", mock.Anything, "app", "entry", "", int64(0), mock.Anything).Return(containers, nil).Once()
+	assert.NoError(t, c.doReconcileDeployment(ctx, deployment))
+	store.AssertNotCalled(t, "GetPod", mock.Anything, mock.Anything)
+
+	// less than desired, create
+	deployment.Count = 2
+	store.On("ListContainers", mock.Anything, "app", "entry", "", int64(0), mock.Anything).Return(containers, nil).Once()
+	store.On("GetPod", mock.Anything, "pod").Return(nil, types.ErrNoETCD).Once()
+	assert.Error(t, c.doReconcileDeployment(ctx, deployment))
+	// desired options not changed
+	assert.Equal(t, 1, deployment.Options.Count)
+
+	// more than desired, remove
+	deployment.Count = 0
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
+	engine := &enginemocks.API{}
+	engine.On("VirtualizationRemove", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	container := &types.Container{ID: "c1", Name: "app_entry_abcdef", Nodename: "n1", Engine: engine}
+	store.On("ListContainers", mock.Anything, "app", "entry", "", int64(0), mock.Anything).Return(containers, nil).Once()
+	store.On("GetContainers", mock.Anything, []string{"c1"}).Return([]*types.Container{container}, nil)
+	store.On("GetNodesByPod", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*types.Node{{Name: "n1"}}, nil)
+	store.On("GetNode", mock.Anything, "n1").Return(&types.Node{Name: "n1"}, nil)
+	store.On("RemoveContainer", mock.Anything, mock.Anything).Return(nil)
+	store.On("UpdateNodeResource", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	assert.NoError(t, c.doReconcileDeployment(ctx, deployment))
+	store.AssertCalled(t, "RemoveContainer", mock.Anything, container)
+}
+
+func TestReconcile(t *testing.T) {
+	c := NewTestCluster()
+	c.config.Reconcile.Interval = 10 * time.Millisecond
+	store := c.store.(*storemocks.Store)
+
+	// not leader
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(types.ErrKeyExists)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Millisecond)
+	defer cancel()
+	c.Reconcile(ctx)
+	store.AssertNotCalled(t, "ListDeployments", mock.Anything)
+	// session of every failed campaign released
+	lock.AssertNumberOfCalls(t, "Unlock", len(store.Calls))
+
+	// leader
+	c = NewTestCluster()
+	c.config.Reconcile.Interval = 10 * time.Millisecond
+	store = c.store.(*storemocks.Store)
+	lock = &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	lock.On("Done").Return(nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
+	store.On("ListAutoscalePolicies", mock.Anything).Return(nil, types.ErrNoETCD)
+	store.On("ListDeployments", mock.Anything).Return(nil, types.ErrNoETCD)
+	ctx, cancel = context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	c.Reconcile(ctx)
+	store.AssertCalled(t, "ListAutoscalePolicies", mock.Anything)
+	store.AssertCalled(t, "ListDeployments", mock.Anything)
+	lock.AssertCalled(t, "Unlock", mock.Anything)
+}
+
+func TestWithLeader(t *testing.T) {
+	c := NewTestCluster()
+	store := c.store.(*storemocks.Store)
+	lost := make(chan struct{})
+	close(lost)
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	lock.On("Done").Return((<-chan struct{})(lost))
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
+
+	// session expired, loop canceled and leadership campaigned again
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	campaigns := 0
+	c.withLeader(ctx, "leader", time.Millisecond, func(ctx context.Context) {
+		<-ctx.Done()
+		campaigns++
+		if campaigns == 2 {
+			cancel()
+		}
+	})
+	assert.Equal(t, 2, campaigns)
+	lock.AssertNumberOfCalls(t, "Unlock", 2)
+}
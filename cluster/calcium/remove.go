@@ -5,9 +5,10 @@ import (
 	"context"
 	"sync"
 
+	"github.com/projecteru2/core/cluster"
 	"github.com/projecteru2/core/store"
-
 	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -15,6 +16,7 @@ import (
 // returns a channel that contains removing responses
 func (c *Calcium) RemoveContainer(ctx context.Context, IDs []string, force bool, step int) (chan *types.RemoveContainerMessage, error) {
 	ch := make(chan *types.RemoveContainerMessage)
+	ident := utils.RandomString(16)
 	if step < 1 {
 		step = 1
 	}
@@ -29,21 +31,21 @@ func (c *Calcium) RemoveContainer(ctx context.Context, IDs []string, force bool,
 				defer wg.Done()
 				output := []*bytes.Buffer{}
 				success := false
-				if err := c.withContainerLocked(ctx, ID, func(container *types.Container) error {
+				var removed *types.Container
+				err := c.withContainerLocked(ctx, ID, func(container *types.Container) error {
+					removed = container
 					return c.withNodeLocked(ctx, container.Nodename, func(node *types.Node) (err error) {
-						if err = c.doRemoveContainer(ctx, container, force); err != nil {
-							return err
-						}
-						log.Infof("[RemoveContainer] Container %s removed", container.ID)
-						if err = c.store.UpdateNodeResource(ctx, node, container.CPU, container.Quota, container.Memory, container.Storage, container.VolumePlan.IntoVolumeMap(), store.ActionIncr); err == nil {
+						if err = c.doRemoveContainerAndReleaseResource(ctx, container, node, force); err == nil {
 							success = true
 						}
 						return err
 					})
-				}); err != nil {
+				})
+				if err != nil {
 					log.Errorf("[RemoveContainer] Remove container %s failed, err: %v", ID, err)
 					output = append(output, bytes.NewBufferString(err.Error()))
 				}
+				c.doRecordEvent(ctx, newContainerEvent(cluster.EventRemove, ident, ID, removed), err)
 				ch <- &types.RemoveContainerMessage{ContainerID: ID, Success: success, Hook: output}
 			}(ID)
 			if (i+1)%step == 0 {
@@ -63,6 +65,15 @@ func (c *Calcium) doRemoveContainer(ctx context.Context, container *types.Contai
 	return c.store.RemoveContainer(ctx, container)
 }
 
+// doRemoveContainerAndReleaseResource must be called with container and its node locked
+func (c *Calcium) doRemoveContainerAndReleaseResource(ctx context.Context, container *types.Container, node *types.Node, force bool) error {
+	if err := c.doRemoveContainer(ctx, container, force); err != nil {
+		return err
+	}
+	log.Infof("[doRemoveContainerAndReleaseResource] Container %s removed", container.ID)
+	return c.store.UpdateNodeResource(ctx, node, container.CPU, container.Quota, container.Memory, container.Storage, container.Bandwidth, container.VolumePlan.IntoVolumeMap(), container.Devices, store.ActionIncr)
+}
+
 // 同步地删除容器, 在某些需要等待的场合异常有用!
 func (c *Calcium) doRemoveContainerSync(ctx context.Context, IDs []string) error {
 	ch, err := c.RemoveContainer(ctx, IDs, true, 1)
@@ -6,17 +6,26 @@ import (
 	"errors"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/projecteru2/core/cluster"
 	"github.com/projecteru2/core/types"
 	"github.com/projecteru2/core/utils"
 	log "github.com/sirupsen/logrus"
 )
 
+var healthCheckPollInterval = time.Second
+
 // ReplaceContainer replace containers with same resource
 func (c *Calcium) ReplaceContainer(ctx context.Context, opts *types.ReplaceOptions) (chan *types.ReplaceContainerMessage, error) {
 	if opts.Count == 0 {
 		opts.Count = 1
 	}
+	// 没有健康检查无从判断是否回滚
+	if opts.Rollback && opts.HealthCheckTimeout <= 0 {
+		return nil, types.NewDetailedErr(types.ErrBadHealthCheck, "rollback needs health check timeout")
+	}
+	opts.ProcessIdent = utils.RandomString(16)
 	if len(opts.IDs) == 0 {
 		oldContainers, err := c.ListContainers(ctx, &types.ListContainersOptions{
 			Appname: opts.Name, Entrypoint: opts.Entrypoint.Name, Nodename: opts.Nodename,
@@ -28,65 +37,42 @@ func (c *Calcium) ReplaceContainer(ctx context.Context, opts *types.ReplaceOptio
 			opts.IDs = append(opts.IDs, container.ID)
 		}
 	}
+	batchSize := opts.Count
+	if opts.BatchSize > 0 {
+		batchSize = opts.BatchSize
+	}
 	ch := make(chan *types.ReplaceContainerMessage)
 	go func() {
 		defer close(ch)
-		// 并发控制
-		wg := sync.WaitGroup{}
-		defer wg.Wait()
-		for index, ID := range opts.IDs {
-			wg.Add(1)
-			go func(replaceOpts types.ReplaceOptions, index int, ID string) {
-				defer wg.Done()
-				var createMessage *types.CreateContainerMessage
-				removeMessage := &types.RemoveContainerMessage{ContainerID: ID}
-				var err error
-				if err = c.withContainerLocked(ctx, ID, func(container *types.Container) error {
-					if opts.Podname != "" && container.Podname != opts.Podname {
-						log.Warnf("[ReplaceContainer] Skip not in pod container %s", container.ID)
-						return types.NewDetailedErr(types.ErrIgnoreContainer,
-							fmt.Sprintf("container %s not in pod %s", container.ID, opts.Podname),
-						)
-					}
-					// 使用复制之后的配置
-					// 停老的，起新的
-					replaceOpts.Memory = container.Memory
-					replaceOpts.Storage = container.Storage
-					replaceOpts.CPUQuota = container.Quota
-					replaceOpts.SoftLimit = container.SoftLimit
-					// 覆盖 podname 如果做全量更新的话
-					replaceOpts.Podname = container.Podname
-					// 覆盖 Volumes
-					replaceOpts.Volumes = container.Volumes
-					// 继承网络配置
-					if replaceOpts.NetworkInherit {
-						info, err := container.Inspect(ctx)
-						if err != nil {
-							return err
-						} else if !info.Running {
-							return types.NewDetailedErr(types.ErrNotSupport,
-								fmt.Sprintf("container %s is not running, can not inherit", container.ID),
-							)
-						}
-						replaceOpts.NetworkMode = ""
-						replaceOpts.Networks = info.Networks
-						log.Infof("[ReplaceContainer] Inherit old container network configuration mode %v", replaceOpts.Networks)
-					}
-					createMessage, removeMessage, err = c.doReplaceContainer(ctx, container, &replaceOpts, index)
-					return err
-				}); err != nil {
-					if errors.Is(err, types.ErrIgnoreContainer) {
-						return
-					}
-					log.Errorf("[ReplaceContainer] Replace and remove failed %v, old container restarted", err)
-				} else {
-					log.Infof("[ReplaceContainer] Replace and remove success %s", ID)
-					log.Infof("[ReplaceContainer] New container %s", createMessage.ContainerID)
+		total := (len(opts.IDs) + batchSize - 1) / batchSize
+		for batch := 0; batch < total; batch++ {
+			begin := batch * batchSize
+			IDs := opts.IDs[begin:utils.Min(begin+batchSize, len(opts.IDs))]
+			if opts.Rolling() {
+				ch <- &types.ReplaceContainerMessage{Batch: &types.ReplaceBatchMessage{Index: batch, Total: total}}
+			}
+			created, err := c.doReplaceContainersInBatch(ctx, opts, IDs, begin, ch)
+			if err == nil && opts.HealthCheckTimeout > 0 {
+				err = c.doWaitContainersHealthy(ctx, created, time.Duration(opts.HealthCheckTimeout)*time.Second)
+			}
+			if opts.Rolling() {
+				ch <- &types.ReplaceContainerMessage{
+					Batch: &types.ReplaceBatchMessage{Index: batch, Total: total, Done: true, IDs: created},
+					Error: err,
+				}
+			}
+			// 不分批的替换保持原来的行为, 失败了也接着替换其他容器
+			if err != nil && opts.Rolling() {
+				log.Errorf("[ReplaceContainer] Batch %d failed %v, rollout stopped", batch, err)
+				return
+			}
+			if opts.BatchInterval > 0 && batch < total-1 {
+				select {
+				case <-time.After(time.Duration(opts.BatchInterval) * time.Second):
+				case <-ctx.Done():
+					log.Warnf("[ReplaceContainer] Rollout aborted %v", ctx.Err())
+					return
 				}
-				ch <- &types.ReplaceContainerMessage{Create: createMessage, Remove: removeMessage, Error: err}
-			}(*opts, index, ID) // 传 opts 的值，产生一次复制
-			if (index+1)%opts.Count == 0 {
-				wg.Wait()
 			}
 		}
 	}()
@@ -94,6 +80,111 @@ func (c *Calcium) ReplaceContainer(ctx context.Context, opts *types.ReplaceOptio
 	return ch, nil
 }
 
+// doReplaceContainersInBatch replace containers concurrently, limited by MaxUnavailable
+// returns IDs of new containers, and error of the first container failed to be replaced
+func (c *Calcium) doReplaceContainersInBatch(ctx context.Context, opts *types.ReplaceOptions, IDs []string, begin int, ch chan *types.ReplaceContainerMessage) ([]string, error) {
+	// 并发控制
+	wg := sync.WaitGroup{}
+	mutex := sync.Mutex{}
+	created := []string{}
+	var failed error
+	concurrency := len(IDs)
+	if opts.MaxUnavailable > 0 {
+		concurrency = utils.Min(concurrency, opts.MaxUnavailable)
+	}
+	sem := make(chan struct{}, concurrency)
+	for i, ID := range IDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(replaceOpts types.ReplaceOptions, index int, ID string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			var createMessage *types.CreateContainerMessage
+			removeMessage := &types.RemoveContainerMessage{ContainerID: ID}
+			var replaced *types.Container
+			var err error
+			if err = c.withContainerLocked(ctx, ID, func(container *types.Container) error {
+				replaced = container
+				if opts.Podname != "" && container.Podname != opts.Podname {
+					log.Warnf("[ReplaceContainer] Skip not in pod container %s", container.ID)
+					return types.NewDetailedErr(types.ErrIgnoreContainer,
+						fmt.Sprintf("container %s not in pod %s", container.ID, opts.Podname),
+					)
+				}
+				// 使用复制之后的配置
+				// 停老的，起新的
+				replaceOpts.Memory = container.Memory
+				replaceOpts.Storage = container.Storage
+				replaceOpts.CPUQuota = container.Quota
+				replaceOpts.SoftLimit = container.SoftLimit
+				replaceOpts.Bandwidth = container.Bandwidth
+				// 覆盖 podname 如果做全量更新的话
+				replaceOpts.Podname = container.Podname
+				// 覆盖 Volumes
+				replaceOpts.Volumes = container.Volumes
+				// 继承网络配置
+				if replaceOpts.NetworkInherit {
+					info, err := container.Inspect(ctx)
+					if err != nil {
+						return err
+					} else if !info.Running {
+						return types.NewDetailedErr(types.ErrNotSupport,
+							fmt.Sprintf("container %s is not running, can not inherit", container.ID),
+						)
+					}
+					replaceOpts.NetworkMode = ""
+					replaceOpts.Networks = info.Networks
+					log.Infof("[ReplaceContainer] Inherit old container network configuration mode %v", replaceOpts.Networks)
+				}
+				createMessage, removeMessage, err = c.doReplaceContainer(ctx, container, &replaceOpts, index)
+				return err
+			}); err != nil {
+				if errors.Is(err, types.ErrIgnoreContainer) {
+					return
+				}
+				log.Errorf("[ReplaceContainer] Replace and remove failed %v, old container restarted", err)
+			} else {
+				log.Infof("[ReplaceContainer] Replace and remove success %s", ID)
+				log.Infof("[ReplaceContainer] New container %s", createMessage.ContainerID)
+			}
+			c.doRecordEvent(ctx, newContainerEvent(cluster.EventReplace, opts.ProcessIdent, ID, replaced), err)
+			mutex.Lock()
+			if createMessage != nil && createMessage.Success {
+				created = append(created, createMessage.ContainerID)
+			}
+			// 标签不符的只是跳过, 不算失败
+			if err != nil && failed == nil && !errors.Is(err, types.ErrNotFitLabels) {
+				failed = err
+			}
+			mutex.Unlock()
+			ch <- &types.ReplaceContainerMessage{Create: createMessage, Remove: removeMessage, Error: err}
+		}(*opts, begin+i, ID) // 传 opts 的值，产生一次复制
+	}
+	wg.Wait()
+	return created, failed
+}
+
+// doWaitContainersHealthy wait until all containers are marked healthy in store
+func (c *Calcium) doWaitContainersHealthy(ctx context.Context, IDs []string, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	for _, ID := range IDs {
+		for {
+			// 状态可能还没上报
+			status, err := c.store.GetContainerStatus(ctx, ID)
+			if err == nil && status.Healthy {
+				break
+			}
+			select {
+			case <-ctx.Done():
+				return types.NewDetailedErr(types.ErrHealthCheckTimeout, ID)
+			case <-time.After(healthCheckPollInterval):
+			}
+		}
+	}
+	return nil
+}
+
 func (c *Calcium) doReplaceContainer(
 	ctx context.Context,
 	container *types.Container,
@@ -106,7 +197,7 @@ func (c *Calcium) doReplaceContainer(
 		Hook:        []*bytes.Buffer{},
 	}
 	// label filter
-	if !utils.FilterContainer(container.Labels, opts.FilterLabels) {
+	if !opts.FilterLabels.Matches(container.Labels) {
 		return nil, removeMessage, types.ErrNotFitLabels
 	}
 	// get node
@@ -137,17 +228,28 @@ func (c *Calcium) doReplaceContainer(
 	}
 	// 不涉及资源消耗，创建容器失败会被回收容器而不回收资源
 	// 创建成功容器会干掉之前的老容器也不会动资源，实际上实现了动态捆绑
-	createMessage := c.doCreateAndStartContainer(ctx, index, node, &opts.DeployOptions, container.CPU, container.VolumePlan)
+	createMessage := c.doCreateAndStartContainer(ctx, index, node, &opts.DeployOptions, container.CPU, container.VolumePlan, container.Devices)
 	if createMessage.Error != nil {
-		// 重启老容器
-		message, err := c.doStartContainer(ctx, container, opts.IgnoreHook)
-		removeMessage.Hook = append(removeMessage.Hook, message...)
-		if err != nil {
-			log.Errorf("[replaceAndRemove] Old container %s restart failed %v", container.ID, err)
-			removeMessage.Hook = append(removeMessage.Hook, bytes.NewBufferString(err.Error()))
-		}
+		c.doRestartOldContainer(ctx, container, opts, removeMessage)
 		return nil, removeMessage, createMessage.Error
 	}
+	// 老容器只停不删, 等新容器健康了再删
+	if opts.Rollback {
+		if err = c.doWaitContainersHealthy(ctx, []string{createMessage.ContainerID}, time.Duration(opts.HealthCheckTimeout)*time.Second); err != nil {
+			log.Errorf("[replaceAndRemove] New container %s not healthy %v, rollback", createMessage.ContainerID, err)
+			// 资源是和老容器绑定的, 删掉新容器即归还
+			rerr := c.doRemoveCreatedContainer(ctx, createMessage)
+			// 新容器删不掉也要把老容器拉起来, 不能让服务空着
+			c.doRestartOldContainer(ctx, container, opts, removeMessage)
+			if rerr != nil {
+				log.Errorf("[replaceAndRemove] New container %s remove failed %v", createMessage.ContainerID, rerr)
+				createMessage.Success = false
+				createMessage.Error = types.NewDetailedErr(types.ErrRollbackFailed, createMessage.ContainerID)
+				return createMessage, removeMessage, err
+			}
+			return nil, removeMessage, err
+		}
+	}
 	// 干掉老的
 	if err = c.doRemoveContainer(ctx, container, true); err != nil {
 		log.Errorf("[replaceAndRemove] Old container %s remove failed %v", container.ID, err)
@@ -156,3 +258,13 @@ func (c *Calcium) doReplaceContainer(
 	removeMessage.Success = true
 	return createMessage, removeMessage, nil
 }
+
+func (c *Calcium) doRestartOldContainer(ctx context.Context, container *types.Container, opts *types.ReplaceOptions, removeMessage *types.RemoveContainerMessage) {
+	// 重启老容器
+	message, err := c.doStartContainer(ctx, container, opts.IgnoreHook)
+	removeMessage.Hook = append(removeMessage.Hook, message...)
+	if err != nil {
+		log.Errorf("[replaceAndRemove] Old container %s restart failed %v", container.ID, err)
+		removeMessage.Hook = append(removeMessage.Hook, bytes.NewBufferString(err.Error()))
+	}
+}
@@ -3,8 +3,10 @@ package calcium
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io/ioutil"
 	"testing"
+	"time"
 
 	enginemocks "github.com/projecteru2/core/engine/mocks"
 	enginetypes "github.com/projecteru2/core/engine/types"
@@ -73,7 +75,7 @@ func TestReplaceContainer(t *testing.T) {
 	}
 	engine.On("VirtualizationInspect", mock.Anything, mock.Anything).Return(&enginetypes.VirtualizationInfo{Running: true}, nil)
 	// failed by not fit
-	opts.FilterLabels = map[string]string{"x": "y"}
+	opts.FilterLabels = types.MakeLabelSelector(map[string]string{"x": "y"})
 	ch, err = c.ReplaceContainer(ctx, opts)
 	assert.NoError(t, err)
 	for r := range ch {
@@ -82,7 +84,7 @@ func TestReplaceContainer(t *testing.T) {
 		assert.False(t, r.Remove.Success)
 	}
 	// failed by get node
-	opts.FilterLabels = map[string]string{}
+	opts.FilterLabels = types.LabelSelector{}
 	store.On("GetNode", mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
 	ch, err = c.ReplaceContainer(ctx, opts)
 	assert.NoError(t, err)
@@ -158,7 +160,8 @@ func TestReplaceContainer(t *testing.T) {
 		assert.True(t, r.Create.Success)
 	}
 	store.On("RemoveContainer", mock.Anything, mock.Anything).Return(nil)
-	// succ
+	// succ, bandwidth of old container kept
+	container.Bandwidth = 100
 	ch, err = c.ReplaceContainer(ctx, opts)
 	assert.NoError(t, err)
 	for r := range ch {
@@ -168,4 +171,149 @@ func TestReplaceContainer(t *testing.T) {
 		assert.True(t, r.Remove.Success)
 		assert.True(t, r.Create.Success)
 	}
+	var added *types.Container
+	for _, call := range store.Calls {
+		if call.Method == "AddContainer" {
+			added = call.Arguments.Get(1).(*types.Container)
+		}
+	}
+	assert.Equal(t, int64(100), added.Bandwidth)
+
+	// rolling, failed by health check
+	healthCheckPollInterval = 10 * time.Millisecond
+	opts.BatchSize = 1
+	opts.HealthCheckTimeout = 1
+	healthy := false
+	store.On("GetContainerStatus", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, ID string) *types.StatusMeta {
+			return &types.StatusMeta{ID: ID, Healthy: healthy}
+		}, nil,
+	)
+	ch, err = c.ReplaceContainer(ctx, opts)
+	assert.NoError(t, err)
+	batches := []*types.ReplaceBatchMessage{}
+	for r := range ch {
+		if r.Batch == nil {
+			continue
+		}
+		batches = append(batches, r.Batch)
+		if r.Batch.Done {
+			assert.True(t, errors.Is(r.Error, types.ErrHealthCheckTimeout))
+		}
+	}
+	assert.Len(t, batches, 2)
+	assert.False(t, batches[0].Done)
+	assert.True(t, batches[1].Done)
+	assert.Equal(t, []string{"new"}, batches[1].IDs)
+	// rolling succ
+	healthy = true
+	opts.IDs = []string{"xx", "xx"}
+	opts.BatchInterval = 1
+	ch, err = c.ReplaceContainer(ctx, opts)
+	assert.NoError(t, err)
+	batches = batches[:0]
+	for r := range ch {
+		assert.NoError(t, r.Error)
+		if r.Batch != nil {
+			batches = append(batches, r.Batch)
+		}
+	}
+	assert.Len(t, batches, 4)
+	assert.Equal(t, 2, batches[3].Total)
+
+	// rollback as new container not healthy
+	healthy = false
+	opts.Rollback = true
+	opts.BatchInterval = 0
+	ch, err = c.ReplaceContainer(ctx, opts)
+	assert.NoError(t, err)
+	replaced := 0
+	for r := range ch {
+		if r.Batch != nil {
+			continue
+		}
+		replaced++
+		assert.True(t, errors.Is(r.Error, types.ErrHealthCheckTimeout))
+		assert.Nil(t, r.Create)
+		assert.False(t, r.Remove.Success)
+	}
+	// rollout stopped after first batch
+	assert.Equal(t, 1, replaced)
+
+	// rollback needs health check
+	opts.HealthCheckTimeout = 0
+	_, err = c.ReplaceContainer(ctx, opts)
+	assert.True(t, errors.Is(err, types.ErrBadHealthCheck))
+}
+
+func TestReplaceRollbackRestartOldContainer(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	healthCheckPollInterval = 10 * time.Millisecond
+
+	engine := &enginemocks.API{}
+	node := &types.Node{Name: "n1", Engine: engine}
+	container := &types.Container{ID: "old", Nodename: "n1", Engine: engine}
+	store.On("GetNode", mock.Anything, "n1").Return(node, nil)
+	engine.On("ImageLocalDigests", mock.Anything, mock.Anything).Return([]string{"id"}, nil)
+	engine.On("ImageRemoteDigest", mock.Anything, mock.Anything).Return("id", nil)
+	engine.On("VirtualizationStop", mock.Anything, "old").Return(nil)
+	engine.On("VirtualizationCreate", mock.Anything, mock.Anything).Return(&enginetypes.VirtualizationCreated{ID: "new"}, nil)
+	engine.On("VirtualizationStart", mock.Anything, mock.Anything).Return(nil)
+	engine.On("VirtualizationInspect", mock.Anything, "new").Return(&enginetypes.VirtualizationInfo{}, nil)
+	store.On("AddContainer", mock.Anything, mock.Anything).Return(nil)
+	store.On("GetContainerStatus", mock.Anything, "new").Return(&types.StatusMeta{ID: "new"}, nil)
+	// unhealthy new container can't be removed
+	engine.On("VirtualizationRemove", mock.Anything, "new", mock.Anything, mock.Anything).Return(types.ErrNilEngine)
+
+	opts := &types.ReplaceOptions{
+		DeployOptions:      types.DeployOptions{Image: "image", Entrypoint: &types.Entrypoint{}},
+		HealthCheckTimeout: 1,
+		Rollback:           true,
+	}
+	createMessage, removeMessage, err := c.doReplaceContainer(ctx, container, opts, 0)
+	assert.True(t, errors.Is(err, types.ErrHealthCheckTimeout))
+	assert.True(t, errors.Is(createMessage.Error, types.ErrRollbackFailed))
+	assert.False(t, removeMessage.Success)
+	// old container restarted anyway
+	engine.AssertCalled(t, "VirtualizationStart", mock.Anything, "old")
+}
+
+func TestReplaceContainerStopOnFailure(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	store.On("GetContainers", mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD)
+
+	opts := &types.ReplaceOptions{
+		DeployOptions: types.DeployOptions{Entrypoint: &types.Entrypoint{}},
+		IDs:           []string{"c1", "c2"},
+		BatchSize:     1,
+	}
+	ch, err := c.ReplaceContainer(ctx, opts)
+	assert.NoError(t, err)
+	replaced := 0
+	for r := range ch {
+		if r.Batch != nil {
+			assert.Equal(t, 0, r.Batch.Index)
+			if r.Batch.Done {
+				assert.Equal(t, types.ErrNoETCD, r.Error)
+			}
+			continue
+		}
+		replaced++
+	}
+	// rollout stopped after first batch failed
+	assert.Equal(t, 1, replaced)
+
+	// not rolling, all containers tried
+	opts.BatchSize = 0
+	ch, err = c.ReplaceContainer(ctx, opts)
+	assert.NoError(t, err)
+	replaced = 0
+	for range ch {
+		replaced++
+	}
+	assert.Equal(t, 2, replaced)
 }
@@ -2,6 +2,7 @@ package calcium
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -16,26 +17,34 @@ import (
 
 // PodResource show pod resource usage
 func (c *Calcium) PodResource(ctx context.Context, podname string) (*types.PodResource, error) {
+	pod, err := c.store.GetPod(ctx, podname)
+	if err != nil {
+		return nil, err
+	}
 	nodes, err := c.ListPodNodes(ctx, podname, nil, true)
 	if err != nil {
 		return nil, err
 	}
 	r := &types.PodResource{
-		Name:            podname,
-		CPUPercents:     map[string]float64{},
-		MemoryPercents:  map[string]float64{},
-		StoragePercents: map[string]float64{},
-		Verifications:   map[string]bool{},
-		Details:         map[string]string{},
+		Name:                     podname,
+		CPUPercents:              map[string]float64{},
+		MemoryPercents:           map[string]float64{},
+		StoragePercents:          map[string]float64{},
+		CPUOvercommitPercents:    map[string]float64{},
+		MemoryOvercommitPercents: map[string]float64{},
+		Verifications:            map[string]bool{},
+		Details:                  map[string]string{},
 	}
 	for _, node := range nodes {
-		nodeDetail, err := c.doGetNodeResource(ctx, node)
+		nodeDetail, err := c.doGetNodeResource(ctx, pod, node)
 		if err != nil {
 			return nil, err
 		}
 		r.CPUPercents[node.Name] = nodeDetail.CPUPercent
 		r.MemoryPercents[node.Name] = nodeDetail.MemoryPercent
 		r.StoragePercents[node.Name] = nodeDetail.StoragePercent
+		r.CPUOvercommitPercents[node.Name] = nodeDetail.CPUOvercommitPercent
+		r.MemoryOvercommitPercents[node.Name] = nodeDetail.MemoryOvercommitPercent
 		r.Verifications[node.Name] = nodeDetail.Verification
 		r.Details[node.Name] = strings.Join(nodeDetail.Details, "\n")
 	}
@@ -48,7 +57,11 @@ func (c *Calcium) NodeResource(ctx context.Context, nodename string) (*types.Nod
 	if err != nil {
 		return nil, err
 	}
-	nr, err := c.doGetNodeResource(ctx, node)
+	pod, err := c.store.GetPod(ctx, node.Podname)
+	if err != nil {
+		return nil, err
+	}
+	nr, err := c.doGetNodeResource(ctx, pod, node)
 	if err != nil {
 		return nil, err
 	}
@@ -63,7 +76,66 @@ func (c *Calcium) NodeResource(ctx context.Context, nodename string) (*types.Nod
 	return nr, err
 }
 
-func (c *Calcium) doGetNodeResource(ctx context.Context, node *types.Node) (*types.NodeResource, error) {
+// PlanDeploy run scheduler without allocating resource, show where containers will be deployed
+func (c *Calcium) PlanDeploy(ctx context.Context, opts *types.DeployOptions) (*types.DeployPlan, error) {
+	if _, err := c.store.GetPod(ctx, opts.Podname); err != nil {
+		log.Errorf("[PlanDeploy] Error during GetPod for %s: %v", opts.Podname, err)
+		return nil, err
+	}
+	if err := validateDeployOptions(opts); err != nil {
+		return nil, err
+	}
+	ns, err := c.GetNodes(ctx, opts.Podname, opts.Nodename, opts.NodeLabels, false)
+	if err != nil {
+		return nil, err
+	}
+	nodes := map[string]*types.Node{}
+	for _, node := range ns {
+		nodes[node.Name] = node
+	}
+
+	plan := &types.DeployPlan{NodeErrors: map[string]error{}}
+	if plan.NodesInfo, plan.Total, plan.Error = c.doPlanResource(ctx, opts, nodes); plan.Error != nil {
+		log.Warnf("[PlanDeploy] Plan failed %v", plan.Error)
+		se := &types.ScheduleError{}
+		if errors.As(plan.Error, &se) {
+			for nodename, reason := range se.Reasons {
+				plan.NodeErrors[nodename] = errors.New(reason)
+			}
+		}
+	}
+	return plan, nil
+}
+
+// doExplainPlan find out why each node is rejected when plan failed
+// reasons given by the failed step are kept, other nodes are selected one by one
+func (c *Calcium) doExplainPlan(opts *types.DeployOptions, nodesInfo []types.NodeInfo, err error) error {
+	reasons := map[string]string{}
+	se := &types.ScheduleError{}
+	if errors.As(err, &se) {
+		for nodename, reason := range se.Reasons {
+			reasons[nodename] = reason
+		}
+		err = se.Err
+	}
+	for _, nodeInfo := range nodesInfo {
+		if _, ok := reasons[nodeInfo.Name]; ok {
+			continue
+		}
+		_, _, _, _, total, nodeErr := c.doSelectNodes(opts, []types.NodeInfo{nodeInfo})
+		if nodeErr == nil {
+			reasons[nodeInfo.Name] = fmt.Sprintf("capacity %d", total)
+			continue
+		}
+		reasons[nodeInfo.Name] = nodeErr.Error()
+		if errors.As(nodeErr, &se) && se.Reasons[nodeInfo.Name] != "" {
+			reasons[nodeInfo.Name] = se.Reasons[nodeInfo.Name]
+		}
+	}
+	return types.NewScheduleError(err, reasons)
+}
+
+func (c *Calcium) doGetNodeResource(ctx context.Context, pod *types.Pod, node *types.Node) (*types.NodeResource, error) {
 	containers, err := c.ListNodeContainers(ctx, node.Name, nil)
 	if err != nil {
 		return nil, err
@@ -75,15 +147,21 @@ func (c *Calcium) doGetNodeResource(ctx context.Context, node *types.Node) (*typ
 	cpus := 0.0
 	memory := int64(0)
 	storage := int64(0)
+	bandwidth := int64(0)
 	cpumap := types.CPUMap{}
+	devices := types.DeviceMap{}
 	for _, container := range containers {
 		cpus = utils.Round(cpus + container.Quota)
 		memory += container.Memory
 		storage += container.Storage
+		bandwidth += container.Bandwidth
 		cpumap.Add(container.CPU)
+		devices.Add(container.Devices)
 	}
 	nr.CPUPercent = cpus / float64(len(node.InitCPU))
 	nr.MemoryPercent = float64(memory) / float64(node.InitMemCap)
+	nr.CPUOvercommitPercent = nr.CPUPercent / pod.CPURatio()
+	nr.MemoryOvercommitPercent = nr.MemoryPercent / pod.MemoryRatio()
 	nr.NUMAMemoryPercent = map[string]float64{}
 	nr.VolumePercent = float64(node.VolumeUsed) / float64(node.InitVolume.Total())
 	for nodeID, nmemory := range node.NUMAMemory {
@@ -108,6 +186,12 @@ func (c *Calcium) doGetNodeResource(ctx context.Context, node *types.Node) (*typ
 		nr.Details = append(nr.Details, fmt.Sprintf("memory now %d", node.InitMemCap-(memory+node.MemCap)))
 	}
 
+	devices.Add(node.Devices)
+	if !devices.Contains(node.InitDevices) || !node.InitDevices.Contains(devices) {
+		nr.Verification = false
+		nr.Details = append(nr.Details, fmt.Sprintf("devices record: %v but now: %v", node.InitDevices, devices))
+	}
+
 	nr.StoragePercent = 0
 	if node.InitStorageCap != 0 {
 		nr.StoragePercent = float64(storage) / float64(node.InitStorageCap)
@@ -117,6 +201,15 @@ func (c *Calcium) doGetNodeResource(ctx context.Context, node *types.Node) (*typ
 		}
 	}
 
+	nr.BandwidthPercent = 0
+	if node.InitBandwidth != 0 {
+		nr.BandwidthPercent = float64(bandwidth) / float64(node.InitBandwidth)
+		if bandwidth+node.Bandwidth != node.InitBandwidth {
+			nr.Verification = false
+			nr.Details = append(nr.Details, fmt.Sprintf("bandwidth now %d", node.InitBandwidth-(bandwidth+node.Bandwidth)))
+		}
+	}
+
 	if err := node.Engine.ResourceValidate(ctx, cpus, cpumap, memory, storage); err != nil {
 		nr.Details = append(nr.Details, err.Error())
 	}
@@ -124,106 +217,272 @@ func (c *Calcium) doGetNodeResource(ctx context.Context, node *types.Node) (*typ
 	return nr, nil
 }
 
-func (c *Calcium) doAllocResource(ctx context.Context, opts *types.DeployOptions) ([]types.NodeInfo, error) {
-	var err error
-	var total int
+// doAllocResource plan and take resource on nodes
+// returns the plan and containers evicted for it
+// deploys of app with quotas are serialized until processing saved, then they are counted as usage
+func (c *Calcium) doAllocResource(ctx context.Context, opts *types.DeployOptions) ([]types.NodeInfo, []*types.CreateContainerMessage, error) {
 	var nodesInfo []types.NodeInfo
-	var nodeCPUPlans map[string][]types.CPUMap
-	var nodeVolumePlans map[string][]types.VolumePlan
-	if err = c.withNodesLocked(ctx, opts.Podname, opts.Nodename, opts.NodeLabels, false, func(nodes map[string]*types.Node) error {
-		if len(nodes) == 0 {
-			return types.ErrInsufficientNodes
+	var evicted []*types.CreateContainerMessage
+	err := c.withQuotaLocked(ctx, opts.Name, func() (err error) {
+		nodesInfo, evicted, err = c.doAllocResourceOnNodes(ctx, opts)
+		return err
+	})
+	return nodesInfo, evicted, err
+}
+
+// doAllocResourceOnNodes plan and take resource on nodes
+// if resource is not enough, victims are picked with nodes locked, then evicted after nodes unlocked
+// and the plan is made again, so that locks are always taken container first like RemoveContainer
+// containers evicted are returned even if the plan still fails
+func (c *Calcium) doAllocResourceOnNodes(ctx context.Context, opts *types.DeployOptions) ([]types.NodeInfo, []*types.CreateContainerMessage, error) {
+	var nodesInfo []types.NodeInfo
+	var victims []*types.Container
+	err := c.withNodesLocked(ctx, opts.Podname, opts.Nodename, opts.NodeLabels, false, func(nodes map[string]*types.Node) (err error) {
+		if nodesInfo, _, err = c.doPlanResource(ctx, opts, nodes); err == nil {
+			return c.doTakeResource(ctx, opts, nodes, nodesInfo)
 		}
-		nodesInfo = getNodesInfo(nodes, opts.CPUQuota, opts.Memory, opts.Storage)
-		// 载入之前部署的情况
-		nodesInfo, err = c.store.MakeDeployStatus(ctx, opts, nodesInfo)
-		if err != nil {
+		// 资源不够时, 驱逐低优先级的容器再试一次
+		se := &types.ScheduleError{}
+		if opts.Priority <= 0 || !errors.As(err, &se) {
 			return err
 		}
-
-		if !opts.CPUBind {
-			nodesInfo, total, err = c.scheduler.SelectMemoryNodes(nodesInfo, opts.CPUQuota, opts.Memory) // 还是以 Bytes 作单位， 不转换了
-		} else {
-			log.Info("[doAllocResource] CPU Bind, selecting CPU plan")
-			nodesInfo, nodeCPUPlans, total, err = c.scheduler.SelectCPUNodes(nodesInfo, opts.CPUQuota, opts.Memory)
+		// 数量确定的部署先检查配额, 免得白白驱逐
+		if opts.DeployMethod == cluster.DeployAuto {
+			if qerr := c.doCheckQuota(ctx, opts.Name, deployCost(opts, opts.Count)); qerr != nil {
+				return qerr
+			}
 		}
-		if err != nil {
+		log.Infof("[allocResource] Plan failed, try to preempt for priority %d", opts.Priority)
+		var perr error
+		if victims, perr = c.doPickVictims(ctx, opts, nodes); perr != nil {
+			return perr
+		}
+		if len(victims) == 0 {
 			return err
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(victims) == 0 {
+		return nodesInfo, nil, nil
+	}
 
-		var storTotal int
-		if nodesInfo, storTotal, err = c.scheduler.SelectStorageNodes(nodesInfo, opts.Storage); err != nil {
-			return err
+	// 放掉节点锁再驱逐, 和 RemoveContainer 一样先锁容器再锁节点
+	evicted, err := c.doPreempt(ctx, victims)
+	if err == nil {
+		err = c.withNodesLocked(ctx, opts.Podname, opts.Nodename, opts.NodeLabels, false, func(nodes map[string]*types.Node) (err error) {
+			if nodesInfo, _, err = c.doPlanResource(ctx, opts, nodes); err != nil {
+				return err
+			}
+			return c.doTakeResource(ctx, opts, nodes, nodesInfo)
+		})
+	}
+	if err != nil {
+		for _, m := range evicted {
+			log.Warnf("[allocResource] Container %s evicted, success: %v", m.Evicted.ContainerID, m.Evicted.Success)
 		}
+		return nil, evicted, err
+	}
+	return nodesInfo, evicted, nil
+}
 
-		var volumeTotal int
-		if nodesInfo, nodeVolumePlans, volumeTotal, err = c.scheduler.SelectVolumeNodes(nodesInfo, opts.Volumes); err != nil {
+// doTakeResource check quota and take resource of plan on nodes, processing is recorded
+// must be called with nodes locked
+func (c *Calcium) doTakeResource(ctx context.Context, opts *types.DeployOptions, nodes map[string]*types.Node, nodesInfo []types.NodeInfo) error {
+	deployed := 0
+	for _, nodeInfo := range nodesInfo {
+		deployed += nodeInfo.Deploy
+	}
+	if err := c.doCheckQuota(ctx, opts.Name, deployCost(opts, deployed)); err != nil {
+		return err
+	}
+	pod, err := c.store.GetPod(ctx, opts.Podname)
+	if err != nil {
+		return err
+	}
+	// 资源处理
+	for _, nodeInfo := range nodesInfo {
+		cpuCost := types.CPUMap{}
+		memoryCost := opts.Memory * int64(nodeInfo.Deploy)
+		storageCost := opts.Storage * int64(nodeInfo.Deploy)
+		bandwidthCost := opts.Bandwidth * int64(nodeInfo.Deploy)
+		quotaCost := opts.CPUQuota * float64(nodeInfo.Deploy)
+		volumeCost := types.VolumeMap{}
+		deviceCost := types.DeviceMap{}
+
+		for _, cpu := range nodeInfo.CPUPlan {
+			cpuCost.Add(cpu)
+		}
+		for _, volumePlan := range nodeInfo.VolumePlans {
+			volumeCost.Add(volumePlan.IntoVolumeMap())
+		}
+		for _, devicePlan := range nodeInfo.DevicePlans {
+			deviceCost.Add(devicePlan)
+		}
+
+		if err = checkOvercommit(pod, nodes[nodeInfo.Name], nodeInfo.Deploy, nodeInfo.CPUPlan, opts.CPUQuota, opts.Memory); err != nil {
+			return err
+		}
+		if err = c.store.UpdateNodeResource(ctx, nodes[nodeInfo.Name], cpuCost, quotaCost, memoryCost, storageCost, bandwidthCost, volumeCost, deviceCost, store.ActionDecr); err != nil {
 			return err
 		}
+	}
+	go func() {
+		for _, nodeInfo := range nodesInfo {
+			log.Infof("[allocResource] deploy %d to %s", nodeInfo.Deploy, nodeInfo.Name)
+		}
+	}()
+	// 节点锁内记录 processing, 修复节点资源时才能看到正在创建的容器
+	return c.doBindProcessStatus(ctx, opts, nodesInfo)
+}
 
-		total = utils.Min(volumeTotal, storTotal, total)
+// doPlanResource run scheduler on nodes and return nodes which will be deployed with their plans
+// nothing will be written into store, so it's safe for dry run
+func (c *Calcium) doPlanResource(ctx context.Context, opts *types.DeployOptions, nodes map[string]*types.Node) ([]types.NodeInfo, int, error) {
+	if len(nodes) == 0 {
+		return nil, 0, types.ErrInsufficientNodes
+	}
+	pod, err := c.store.GetPod(ctx, opts.Podname)
+	if err != nil {
+		return nil, 0, err
+	}
+	nodesInfo := getNodesInfo(pod, nodes, opts.CPUQuota, opts.Memory, opts.Storage)
+	// 载入之前部署的情况
+	nodesInfo, err = c.store.MakeDeployStatus(ctx, opts, nodesInfo)
+	if err != nil {
+		return nil, 0, err
+	}
 
-		switch opts.DeployMethod {
-		case cluster.DeployAuto:
-			nodesInfo, err = c.scheduler.CommonDivision(nodesInfo, opts.Count, total)
-		case cluster.DeployEach:
-			nodesInfo, err = c.scheduler.EachDivision(nodesInfo, opts.Count, opts.NodesLimit)
-		case cluster.DeployFill:
-			nodesInfo, err = c.scheduler.FillDivision(nodesInfo, opts.Count, opts.NodesLimit)
-		case cluster.DeployGlobal:
-			nodesInfo, err = c.scheduler.GlobalDivision(nodesInfo, opts.Count, total)
-		default:
-			return types.ErrBadDeployMethod
+	// 调度会修改 nodesInfo, 失败时用原来的解释每个节点的原因
+	origin := append([]types.NodeInfo{}, nodesInfo...)
+	result, total, err := c.doScheduleNodes(opts, nodesInfo)
+	if err != nil {
+		return nil, total, c.doExplainPlan(opts, origin, err)
+	}
+	return result, total, nil
+}
+
+// doScheduleNodes select nodes and divide containers on them
+func (c *Calcium) doScheduleNodes(opts *types.DeployOptions, nodesInfo []types.NodeInfo) ([]types.NodeInfo, int, error) {
+	nodesInfo, nodeCPUPlans, nodeVolumePlans, nodeDevicePlans, total, err := c.doSelectNodes(opts, nodesInfo)
+	if err != nil {
+		return nil, total, err
+	}
+
+	sched := c.getScheduler(opts.Podname)
+	switch {
+	case opts.Affinity != nil && opts.Affinity.SpreadLabel != "":
+		nodesInfo, err = sched.SpreadDivision(nodesInfo, opts.Count, opts.Affinity.SpreadLabel)
+	case opts.DeployMethod == cluster.DeployAuto:
+		nodesInfo, err = sched.CommonDivision(nodesInfo, opts.Count, total)
+	case opts.DeployMethod == cluster.DeployEach:
+		nodesInfo, err = sched.EachDivision(nodesInfo, opts.Count, opts.NodesLimit)
+	case opts.DeployMethod == cluster.DeployFill:
+		nodesInfo, err = sched.FillDivision(nodesInfo, opts.Count, opts.NodesLimit)
+	case opts.DeployMethod == cluster.DeployGlobal:
+		nodesInfo, err = sched.GlobalDivision(nodesInfo, opts.Count, total)
+	default:
+		return nil, total, types.ErrBadDeployMethod
+	}
+	if err != nil {
+		return nil, total, err
+	}
+
+	sort.Slice(nodesInfo, func(i, j int) bool { return nodesInfo[i].Deploy < nodesInfo[j].Deploy })
+	p := sort.Search(len(nodesInfo), func(i int) bool { return nodesInfo[i].Deploy > 0 })
+	// p 最大也就是 len(nodesInfo) - 1
+	if p == len(nodesInfo) {
+		return nil, total, types.ErrInsufficientRes
+	}
+	nodesInfo = nodesInfo[p:]
+	for i, nodeInfo := range nodesInfo {
+		if _, ok := nodeCPUPlans[nodeInfo.Name]; ok {
+			nodesInfo[i].CPUPlan = nodeCPUPlans[nodeInfo.Name][:nodeInfo.Deploy]
 		}
-		if err != nil {
-			return err
+		if _, ok := nodeVolumePlans[nodeInfo.Name]; ok {
+			nodesInfo[i].VolumePlans = nodeVolumePlans[nodeInfo.Name][:nodeInfo.Deploy]
+		}
+		if _, ok := nodeDevicePlans[nodeInfo.Name]; ok {
+			nodesInfo[i].DevicePlans = nodeDevicePlans[nodeInfo.Name][:nodeInfo.Deploy]
 		}
+	}
+	return nodesInfo, total, nil
+}
 
-		// 资源处理
-		sort.Slice(nodesInfo, func(i, j int) bool { return nodesInfo[i].Deploy < nodesInfo[j].Deploy })
-		p := sort.Search(len(nodesInfo), func(i int) bool { return nodesInfo[i].Deploy > 0 })
-		// p 最大也就是 len(nodesInfo) - 1
-		if p == len(nodesInfo) {
-			return types.ErrInsufficientRes
-		}
-		nodesInfo = nodesInfo[p:]
-		for i, nodeInfo := range nodesInfo {
-			cpuCost := types.CPUMap{}
-			memoryCost := opts.Memory * int64(nodeInfo.Deploy)
-			storageCost := opts.Storage * int64(nodeInfo.Deploy)
-			quotaCost := opts.CPUQuota * float64(nodeInfo.Deploy)
-			volumeCost := types.VolumeMap{}
-
-			if _, ok := nodeCPUPlans[nodeInfo.Name]; ok {
-				cpuList := nodeCPUPlans[nodeInfo.Name][:nodeInfo.Deploy]
-				nodesInfo[i].CPUPlan = cpuList
-				for _, cpu := range cpuList {
-					cpuCost.Add(cpu)
-				}
-			}
+// doSelectNodes filter nodes by cpu, memory, storage, volume, bandwidth and device
+// returns the nodes can hold containers, the plans on them and the capacity total
+func (c *Calcium) doSelectNodes(opts *types.DeployOptions, nodesInfo []types.NodeInfo) ([]types.NodeInfo, map[string][]types.CPUMap, map[string][]types.VolumePlan, map[string][]types.DeviceMap, int, error) {
+	var err error
+	var total int
+	var nodeCPUPlans map[string][]types.CPUMap
+	var nodeVolumePlans map[string][]types.VolumePlan
+	var nodeDevicePlans map[string][]types.DeviceMap
+	sched := c.getScheduler(opts.Podname)
 
-			if _, ok := nodeVolumePlans[nodeInfo.Name]; ok {
-				nodesInfo[i].VolumePlans = nodeVolumePlans[nodeInfo.Name][:nodeInfo.Deploy]
-				for _, volumePlan := range nodesInfo[i].VolumePlans {
-					volumeCost.Add(volumePlan.IntoVolumeMap())
-				}
-			}
+	if !opts.CPUBind {
+		nodesInfo, total, err = sched.SelectMemoryNodes(nodesInfo, opts.CPUQuota, opts.Memory) // 还是以 Bytes 作单位， 不转换了
+	} else {
+		log.Info("[doSelectNodes] CPU Bind, selecting CPU plan")
+		nodesInfo, nodeCPUPlans, total, err = sched.SelectCPUNodes(nodesInfo, opts.CPUQuota, opts.Memory)
+	}
+	if err != nil {
+		return nil, nil, nil, nil, 0, err
+	}
 
-			if err = c.store.UpdateNodeResource(ctx, nodes[nodeInfo.Name], cpuCost, quotaCost, memoryCost, storageCost, volumeCost, store.ActionDecr); err != nil {
-				return err
-			}
+	var storTotal int
+	if nodesInfo, storTotal, err = sched.SelectStorageNodes(nodesInfo, opts.Storage); err != nil {
+		return nil, nil, nil, nil, 0, err
+	}
+
+	var volumeTotal int
+	if nodesInfo, nodeVolumePlans, volumeTotal, err = sched.SelectVolumeNodes(nodesInfo, opts.Volumes); err != nil {
+		return nil, nil, nil, nil, 0, err
+	}
+
+	total = utils.Min(volumeTotal, storTotal, total)
+	if opts.Bandwidth > 0 {
+		var bwTotal int
+		if nodesInfo, bwTotal, err = sched.SelectBandwidthNodes(nodesInfo, opts.Bandwidth); err != nil {
+			return nil, nil, nil, nil, 0, err
 		}
-		go func() {
-			for _, nodeInfo := range nodesInfo {
-				log.Infof("[allocResource] deploy %d to %s", nodeInfo.Deploy, nodeInfo.Name)
-			}
-		}()
-		return nil
-	}); err != nil {
-		return nil, err
+		total = utils.Min(bwTotal, total)
+	}
+	if len(opts.Devices) > 0 {
+		var deviceTotal int
+		if nodesInfo, nodeDevicePlans, deviceTotal, err = sched.SelectDeviceNodes(nodesInfo, opts.Devices); err != nil {
+			return nil, nil, nil, nil, 0, err
+		}
+		total = utils.Min(deviceTotal, total)
 	}
+	if opts.Affinity != nil {
+		if nodesInfo, total, err = sched.SelectAffinityNodes(nodesInfo, opts.Affinity); err != nil {
+			return nil, nil, nil, nil, 0, err
+		}
+	}
+
+	return nodesInfo, nodeCPUPlans, nodeVolumePlans, nodeDevicePlans, total, nil
+}
 
-	return nodesInfo, c.doBindProcessStatus(ctx, opts, nodesInfo)
+// doCheckNodeProcessing refuse if containers are being created on node, must be called with node locked
+// processing not updated in global timeout is left by a crashed deploy, it's ignored
+func (c *Calcium) doCheckNodeProcessing(ctx context.Context, nodename string) error {
+	processing, err := c.store.ListProcessing(ctx, "")
+	if err != nil {
+		return err
+	}
+	for _, p := range processing {
+		if p.Nodename != nodename || p.Count <= 0 {
+			continue
+		}
+		if p.Stale(c.config.GlobalTimeout) {
+			log.Warnf("[doCheckNodeProcessing] Stale processing %s of %s %s on %s ignored", p.Ident, p.Appname, p.Entrypoint, nodename)
+			continue
+		}
+		return types.NewDetailedErr(types.ErrNodeInProcessing, fmt.Sprintf("%d of %s %s on %s", p.Count, p.Appname, p.Entrypoint, nodename))
+	}
+	return nil
 }
 
 func (c *Calcium) doBindProcessStatus(ctx context.Context, opts *types.DeployOptions, nodesInfo []types.NodeInfo) error {
@@ -2,6 +2,7 @@ package calcium
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"testing"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/projecteru2/core/cluster"
 	enginemocks "github.com/projecteru2/core/engine/mocks"
+	complexscheduler "github.com/projecteru2/core/scheduler/complex"
 	schedulermocks "github.com/projecteru2/core/scheduler/mocks"
 	storemocks "github.com/projecteru2/core/store/mocks"
 	"github.com/projecteru2/core/types"
@@ -26,9 +28,14 @@ func TestPodResource(t *testing.T) {
 	nodename := "testnode"
 	store := &storemocks.Store{}
 	c.store = store
+	// failed by GetPod
+	store.On("GetPod", mock.Anything, podname).Return(nil, types.ErrNoETCD).Once()
+	_, err := c.PodResource(ctx, podname)
+	assert.Error(t, err)
+	store.On("GetPod", mock.Anything, podname).Return(&types.Pod{Name: podname, CPUOvercommit: 2, MemoryOvercommit: 1.5}, nil)
 	// failed by GetNodesByPod
 	store.On("GetNodesByPod", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
-	_, err := c.PodResource(ctx, podname)
+	_, err = c.PodResource(ctx, podname)
 	assert.Error(t, err)
 	node := &types.Node{
 		Name:           nodename,
@@ -68,6 +75,10 @@ func TestPodResource(t *testing.T) {
 	assert.Len(t, r.CPUPercents, 1)
 	assert.Len(t, r.MemoryPercents, 1)
 	assert.Len(t, r.StoragePercents, 1)
+	assert.InDelta(t, 0.9, r.CPUPercents[nodename], 1e-9)
+	assert.InDelta(t, 0.45, r.CPUOvercommitPercents[nodename], 1e-9)
+	assert.InDelta(t, 0.5, r.MemoryPercents[nodename], 1e-9)
+	assert.InDelta(t, 1.0/3, r.MemoryOvercommitPercents[nodename], 1e-9)
 	assert.False(t, r.Verifications[nodename])
 	assert.NotEmpty(t, r.Details[nodename])
 }
@@ -86,6 +97,8 @@ func TestNodeResource(t *testing.T) {
 		InitMemCap:     6,
 		NUMAMemory:     types.NUMAMemory{"0": 1, "1": 1},
 		InitNUMAMemory: types.NUMAMemory{"0": 3, "1": 3},
+		Bandwidth:      10,
+		InitBandwidth:  100,
 	}
 	engine := &enginemocks.API{}
 	engine.On("ResourceValidate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(
@@ -97,20 +110,23 @@ func TestNodeResource(t *testing.T) {
 	_, err := c.NodeResource(ctx, nodename)
 	assert.Error(t, err)
 	store.On("GetNode", ctx, nodename).Return(node, nil)
+	store.On("GetPod", mock.Anything, mock.Anything).Return(&types.Pod{}, nil)
 	// failed by list node containers
 	store.On("ListNodeContainers", mock.Anything, mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
 	_, err = c.NodeResource(ctx, nodename)
 	assert.Error(t, err)
 	containers := []*types.Container{
 		{
-			Memory: 1,
-			CPU:    types.CPUMap{"0": 100, "1": 30},
-			Quota:  1.3,
+			Memory:    1,
+			CPU:       types.CPUMap{"0": 100, "1": 30},
+			Quota:     1.3,
+			Bandwidth: 30,
 		},
 		{
-			Memory: 2,
-			CPU:    types.CPUMap{"1": 50},
-			Quota:  0.5,
+			Memory:    2,
+			CPU:       types.CPUMap{"1": 50},
+			Quota:     0.5,
+			Bandwidth: 50,
 		},
 	}
 	store.On("ListNodeContainers", mock.Anything, mock.Anything, mock.Anything).Return(containers, nil)
@@ -118,10 +134,14 @@ func TestNodeResource(t *testing.T) {
 	nr, err := c.NodeResource(ctx, nodename)
 	assert.NoError(t, err)
 	assert.Equal(t, nr.Name, nodename)
+	assert.Equal(t, nr.CPUPercent, nr.CPUOvercommitPercent)
+	assert.Equal(t, nr.MemoryPercent, nr.MemoryOvercommitPercent)
 	assert.NotEmpty(t, nr.Details)
 	assert.False(t, nr.Verification)
 	details := strings.Join(nr.Details, ",")
 	assert.Contains(t, details, "inspect failed")
+	assert.Contains(t, details, "bandwidth now 10")
+	assert.Equal(t, 0.8, nr.BandwidthPercent)
 }
 
 func TestAllocResource(t *testing.T) {
@@ -155,6 +175,7 @@ func TestAllocResource(t *testing.T) {
 
 	store := c.store.(*storemocks.Store)
 	defer store.AssertExpectations(t)
+	store.On("ListQuotas", mock.Anything, mock.Anything).Return(nil, nil)
 
 	testAllocFailedAsGetNodesByPodError(t, c, opts)
 	store.On("GetNodesByPod", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nodes, nil)
@@ -201,6 +222,9 @@ func TestAllocResource(t *testing.T) {
 		},
 	}
 
+	testAllocFailedAsGetPodError(t, c, opts)
+	store.On("GetPod", mock.Anything, mock.Anything).Return(&types.Pod{}, nil)
+
 	testAllocFailedAsMakeDeployStatusError(t, c, opts)
 	store.On("MakeDeployStatus", mock.Anything, mock.Anything, mock.Anything).Return(nodesInfo, nil)
 
@@ -234,8 +258,8 @@ func TestAllocResource(t *testing.T) {
 
 	testAllocFailedAsUpdateNodeResourceError(t, c, opts)
 	store.On("UpdateNodeResource",
-		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 	).Return(nil)
 
 	testAllocFailedAsSaveProcessingError(t, c, opts)
@@ -245,7 +269,7 @@ func TestAllocResource(t *testing.T) {
 
 	// success
 	opts.CPUBind = true
-	nsi, err := c.doAllocResource(ctx, opts)
+	nsi, _, err := c.doAllocResource(ctx, opts)
 	assert.NoError(t, err)
 	assert.Len(t, nsi, 1)
 	assert.Equal(t, nsi[0].Name, n2)
@@ -255,14 +279,14 @@ func TestAllocResource(t *testing.T) {
 func testAllocFailedAsGetNodesByPodError(t *testing.T, c *Calcium, opts *types.DeployOptions) {
 	store := c.store.(*storemocks.Store)
 	store.On("GetNodesByPod", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
 func testAllocFailedAsCreateLockError(t *testing.T, c *Calcium, opts *types.DeployOptions) {
 	store := c.store.(*storemocks.Store)
 	store.On("CreateLock", mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
@@ -274,36 +298,45 @@ func testAllocFailedAsNoLabels(t *testing.T, c *Calcium, opts *types.DeployOptio
 
 	store := c.store.(*storemocks.Store)
 	store.On("GetNode", mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
-	opts.NodeLabels = map[string]string{"test": "1"}
-	_, err := c.doAllocResource(context.Background(), opts)
+	opts.NodeLabels = types.MakeLabelSelector(map[string]string{"test": "1"})
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
 func testAllocFailedAsGetNodeError(t *testing.T, c *Calcium, opts *types.DeployOptions) {
 	store := c.store.(*storemocks.Store)
 	store.On("GetNode", mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
+	_, _, err := c.doAllocResource(context.Background(), opts)
+	assert.Error(t, err)
+}
+
+func testAllocFailedAsGetPodError(t *testing.T, c *Calcium, opts *types.DeployOptions) {
+	store := c.store.(*storemocks.Store)
+	store.On("GetPod", mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
 func testAllocFailedAsMakeDeployStatusError(t *testing.T, c *Calcium, opts *types.DeployOptions) {
 	store := c.store.(*storemocks.Store)
 	store.On("MakeDeployStatus", mock.Anything, mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
 func testAllocFailedAsInsufficientMemory(t *testing.T, c *Calcium, opts *types.DeployOptions) {
 	sched := c.scheduler.(*schedulermocks.Scheduler)
-	sched.On("SelectMemoryNodes", mock.Anything, mock.Anything, mock.Anything).Return(nil, 0, types.ErrInsufficientMEM).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
-	assert.Error(t, err)
+	// selected again to explain the node
+	sched.On("SelectMemoryNodes", mock.Anything, mock.Anything, mock.Anything).Return(nil, 0, types.ErrInsufficientMEM).Twice()
+	_, _, err := c.doAllocResource(context.Background(), opts)
+	assert.True(t, errors.Is(err, types.ErrInsufficientMEM))
+	assert.Equal(t, types.ErrInsufficientMEM.Error(), err.(*types.ScheduleError).Reasons[opts.Nodename])
 }
 
 func testAllocFailedAsInsufficientStorage(t *testing.T, c *Calcium, opts *types.DeployOptions) {
 	sched := c.scheduler.(*schedulermocks.Scheduler)
-	sched.On("SelectStorageNodes", mock.Anything, mock.Anything).Return(nil, 0, types.ErrInsufficientStorage).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
+	sched.On("SelectStorageNodes", mock.Anything, mock.Anything).Return(nil, 0, types.ErrInsufficientStorage).Twice()
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
@@ -315,8 +348,8 @@ func testAllocFailedAsInsufficientCPU(t *testing.T, c *Calcium, opts *types.Depl
 
 	opts.CPUBind = true
 	sched := c.scheduler.(*schedulermocks.Scheduler)
-	sched.On("SelectCPUNodes", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, 0, types.ErrInsufficientCPU).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
+	sched.On("SelectCPUNodes", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil, 0, types.ErrInsufficientCPU).Twice()
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
@@ -327,7 +360,7 @@ func testAllocFailedAsWrongDeployMethod(t *testing.T, c *Calcium, opts *types.De
 	}()
 
 	opts.DeployMethod = "invalid"
-	_, err := c.doAllocResource(context.Background(), opts)
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
@@ -340,8 +373,9 @@ func testAllocFailedAsCommonDivisionError(t *testing.T, c *Calcium, opts *types.
 	opts.DeployMethod = cluster.DeployAuto
 	sched := c.scheduler.(*schedulermocks.Scheduler)
 	sched.On("CommonDivision", mock.Anything, mock.Anything, mock.Anything).Return(nil, types.ErrInsufficientRes).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
-	assert.Error(t, err)
+	_, _, err := c.doAllocResource(context.Background(), opts)
+	assert.True(t, errors.Is(err, types.ErrInsufficientRes))
+	assert.Equal(t, "capacity 3", err.(*types.ScheduleError).Reasons[opts.Nodename])
 }
 
 func testAllocFailedAsGlobalDivisionError(t *testing.T, c *Calcium, opts *types.DeployOptions) {
@@ -353,7 +387,7 @@ func testAllocFailedAsGlobalDivisionError(t *testing.T, c *Calcium, opts *types.
 	opts.DeployMethod = cluster.DeployGlobal
 	sched := c.scheduler.(*schedulermocks.Scheduler)
 	sched.On("GlobalDivision", mock.Anything, mock.Anything, mock.Anything).Return(nil, types.ErrInsufficientRes)
-	_, err := c.doAllocResource(context.Background(), opts)
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
@@ -366,7 +400,7 @@ func testAllocFailedAsEachDivisionError(t *testing.T, c *Calcium, opts *types.De
 	opts.DeployMethod = cluster.DeployEach
 	sched := c.scheduler.(*schedulermocks.Scheduler)
 	sched.On("EachDivision", mock.Anything, mock.Anything, mock.Anything).Return(nil, types.ErrInsufficientRes)
-	_, err := c.doAllocResource(context.Background(), opts)
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
@@ -379,30 +413,227 @@ func testAllocFailedAsFillDivisionError(t *testing.T, c *Calcium, opts *types.De
 	opts.DeployMethod = cluster.DeployFill
 	sched := c.scheduler.(*schedulermocks.Scheduler)
 	sched.On("FillDivision", mock.Anything, mock.Anything, mock.Anything).Return([]types.NodeInfo{}, nil).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
 func testAllocFailedAsUpdateNodeResourceError(t *testing.T, c *Calcium, opts *types.DeployOptions) {
 	store := c.store.(*storemocks.Store)
 	store.On("UpdateNodeResource",
-		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
-		mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
 	).Return(types.ErrNoETCD).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
 func testAllocFailedAsSaveProcessingError(t *testing.T, c *Calcium, opts *types.DeployOptions) {
 	store := c.store.(*storemocks.Store)
 	store.On("SaveProcessing", mock.Anything, mock.Anything, mock.Anything).Return(types.ErrNoETCD).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
+	_, _, err := c.doAllocResource(context.Background(), opts)
 	assert.Error(t, err)
 }
 
 func testAllocFailedAsInsufficientVolume(t *testing.T, c *Calcium, opts *types.DeployOptions) {
 	sched := c.scheduler.(*schedulermocks.Scheduler)
-	sched.On("SelectVolumeNodes", mock.Anything, mock.Anything).Return(nil, nil, 0, types.ErrInsufficientVolume).Once()
-	_, err := c.doAllocResource(context.Background(), opts)
+	sched.On("SelectVolumeNodes", mock.Anything, mock.Anything).Return(nil, nil, 0, types.ErrInsufficientVolume).Twice()
+	_, _, err := c.doAllocResource(context.Background(), opts)
+	assert.Error(t, err)
+}
+
+func TestAllocResourceDevices(t *testing.T) {
+	c := NewTestCluster()
+	c.scheduler, _ = complexscheduler.New(types.Config{Scheduler: types.SchedConfig{MaxShare: -1, ShareBase: 100}})
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	node := &types.Node{
+		Name:      "n1",
+		Available: true,
+		CPU:       types.CPUMap{"0": 100},
+		MemCap:    100,
+		Devices:   types.DeviceMap{"gpu": {"0", "1", "2"}},
+	}
+	opts := &types.DeployOptions{
+		Name:         "app",
+		Entrypoint:   &types.Entrypoint{Name: "web"},
+		Podname:      "p1",
+		Nodename:     "n1",
+		Count:        1,
+		Memory:       10,
+		Devices:      map[string]int{"gpu": 2},
+		DeployMethod: cluster.DeployAuto,
+	}
+	store.On("GetNode", mock.Anything, "n1").Return(node, nil)
+	store.On("GetPod", mock.Anything, "p1").Return(&types.Pod{Name: "p1"}, nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(&dummyLock{}, nil)
+	store.On("MakeDeployStatus", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ *types.DeployOptions, nodesInfo []types.NodeInfo) []types.NodeInfo {
+			return nodesInfo
+		}, nil)
+	store.On("ListQuotas", mock.Anything, "app").Return([]*types.Quota{}, nil)
+	store.On("SaveProcessing", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	store.On("UpdateNodeResource",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, mock.Anything, mock.Anything, types.DeviceMap{"gpu": {"0", "1"}}, mock.Anything,
+	).Return(nil).Once()
+
+	nodesInfo, _, err := c.doAllocResource(ctx, opts)
+	assert.NoError(t, err)
+	assert.Len(t, nodesInfo, 1)
+	assert.Equal(t, []types.DeviceMap{{"gpu": {"0", "1"}}}, nodesInfo[0].DevicePlans)
+	store.AssertExpectations(t)
+
+	// devices are passed to engine
+	config := c.doMakeContainerOptions(0, nil, nil, nodesInfo[0].DevicePlans[0], opts, node)
+	assert.Equal(t, []string{"0", "1"}, config.Devices["gpu"])
+
+	// not enough devices
+	opts.Count = 2
+	_, _, err = c.doAllocResource(ctx, opts)
+	assert.True(t, errors.Is(err, types.ErrInsufficientRes))
+}
+
+func TestAllocResourceBandwidth(t *testing.T) {
+	c := NewTestCluster()
+	c.scheduler, _ = complexscheduler.New(types.Config{Scheduler: types.SchedConfig{MaxShare: -1, ShareBase: 100}})
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	node := &types.Node{
+		Name:          "n1",
+		Available:     true,
+		CPU:           types.CPUMap{"0": 100},
+		MemCap:        100,
+		Bandwidth:     100,
+		InitBandwidth: 1000,
+	}
+	opts := &types.DeployOptions{
+		Name:         "app",
+		Entrypoint:   &types.Entrypoint{Name: "web"},
+		Podname:      "p1",
+		Nodename:     "n1",
+		Count:        2,
+		Memory:       10,
+		Bandwidth:    50,
+		DeployMethod: cluster.DeployAuto,
+	}
+	store.On("GetNode", mock.Anything, "n1").Return(node, nil)
+	store.On("GetPod", mock.Anything, "p1").Return(&types.Pod{Name: "p1"}, nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(&dummyLock{}, nil)
+	store.On("MakeDeployStatus", mock.Anything, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, _ *types.DeployOptions, nodesInfo []types.NodeInfo) []types.NodeInfo {
+			return nodesInfo
+		}, nil)
+	store.On("ListQuotas", mock.Anything, "app").Return([]*types.Quota{}, nil)
+	store.On("SaveProcessing", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	store.On("UpdateNodeResource",
+		mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything,
+		mock.Anything, int64(100), mock.Anything, mock.Anything, mock.Anything,
+	).Return(nil).Once()
+
+	nodesInfo, _, err := c.doAllocResource(ctx, opts)
+	assert.NoError(t, err)
+	assert.Len(t, nodesInfo, 1)
+	assert.Equal(t, 2, nodesInfo[0].Deploy)
+	store.AssertExpectations(t)
+
+	// bandwidth is given to engine by label and env
+	config := c.doMakeContainerOptions(0, nil, nil, nil, opts, node)
+	assert.Equal(t, "50", config.Labels[cluster.LabelBandwidth])
+	assert.Contains(t, config.Env, "ERU_BANDWIDTH=50")
+
+	opts.Bandwidth = 150
+	_, _, err = c.doAllocResource(ctx, opts)
+	assert.True(t, errors.Is(err, types.ErrInsufficientBandwidth))
+}
+
+func TestPlanDeploy(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	opts := &types.DeployOptions{
+		Podname:      "testpod",
+		Count:        2,
+		Memory:       10,
+		CPUQuota:     1,
+		DeployMethod: cluster.DeployAuto,
+	}
+	store := c.store.(*storemocks.Store)
+	sched := c.scheduler.(*schedulermocks.Scheduler)
+	defer store.AssertExpectations(t)
+	defer sched.AssertExpectations(t)
+
+	// failed by GetPod
+	store.On("GetPod", mock.Anything, mock.Anything).Return(nil, types.ErrNoETCD).Once()
+	_, err := c.PlanDeploy(ctx, opts)
 	assert.Error(t, err)
+	store.On("GetPod", mock.Anything, mock.Anything).Return(&types.Pod{Name: opts.Podname}, nil)
+
+	// failed by bad options
+	opts.Count = 0
+	_, err = c.PlanDeploy(ctx, opts)
+	assert.Error(t, err)
+	opts.Count = 2
+
+	node := &types.Node{
+		Name:       "n1",
+		Available:  true,
+		CPU:        types.CPUMap{"0": 100},
+		InitCPU:    types.CPUMap{"0": 100},
+		MemCap:     100,
+		InitMemCap: 100,
+	}
+	store.On("GetNodesByPod", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return([]*types.Node{node}, nil)
+	nodesInfo := []types.NodeInfo{{Name: node.Name, MemCap: 100, Capacity: 10}}
+	store.On("MakeDeployStatus", mock.Anything, mock.Anything, mock.Anything).Return(nodesInfo, nil)
+	sched.On("SelectStorageNodes", mock.Anything, mock.Anything).Return(nodesInfo, 10, nil)
+	sched.On("SelectVolumeNodes", mock.Anything, mock.Anything).Return(nodesInfo, nil, 10, nil)
+
+	// plan failed, explain every node
+	sched.On("SelectMemoryNodes", mock.Anything, mock.Anything, mock.Anything).Return(nil, 0, types.ErrInsufficientMEM).Twice()
+	plan, err := c.PlanDeploy(ctx, opts)
+	assert.NoError(t, err)
+	assert.True(t, errors.Is(plan.Error, types.ErrInsufficientMEM))
+	assert.EqualError(t, plan.NodeErrors[node.Name], types.ErrInsufficientMEM.Error())
+	assert.Empty(t, plan.NodesInfo)
+
+	// success, nothing allocated
+	sched.On("SelectMemoryNodes", mock.Anything, mock.Anything, mock.Anything).Return(nodesInfo, 10, nil)
+	deployed := []types.NodeInfo{{Name: node.Name, MemCap: 100, Capacity: 10, Deploy: 2}}
+	sched.On("CommonDivision", mock.Anything, mock.Anything, mock.Anything).Return(deployed, nil)
+	plan, err = c.PlanDeploy(ctx, opts)
+	assert.NoError(t, err)
+	assert.NoError(t, plan.Error)
+	assert.Equal(t, 10, plan.Total)
+	assert.Len(t, plan.NodesInfo, 1)
+	assert.Equal(t, 2, plan.NodesInfo[0].Deploy)
+	store.AssertNotCalled(t, "UpdateNodeResource", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	store.AssertNotCalled(t, "SaveProcessing", mock.Anything, mock.Anything, mock.Anything)
+
+	// spread by label
+	opts.Affinity = &types.Affinity{SpreadLabel: "rack"}
+	opts.DeployMethod = cluster.DeployEach
+	_, err = c.PlanDeploy(ctx, opts)
+	assert.Error(t, err)
+	opts.DeployMethod = cluster.DeployAuto
+	sched.On("SelectAffinityNodes", mock.Anything, opts.Affinity).Return(nodesInfo, 5, nil)
+	sched.On("SpreadDivision", mock.Anything, 2, "rack").Return(deployed, nil)
+	plan, err = c.PlanDeploy(ctx, opts)
+	assert.NoError(t, err)
+	assert.NoError(t, plan.Error)
+	assert.Equal(t, 5, plan.Total)
+	sched.AssertNumberOfCalls(t, "CommonDivision", 1)
+}
+
+func TestExplainPlan(t *testing.T) {
+	c := NewTestCluster()
+	opts := &types.DeployOptions{Podname: "testpod", Memory: 10, CPUQuota: 1}
+	sched := c.scheduler.(*schedulermocks.Scheduler)
+	nodesInfo := []types.NodeInfo{{Name: "n1"}, {Name: "n2"}}
+
+	// n1 explained by the failed step, n2 selected again
+	sched.On("SelectMemoryNodes", mock.Anything, mock.Anything, mock.Anything).Return(nil, 0, types.NewScheduleError(types.ErrInsufficientMEM, map[string]string{"n2": "memory short by 1 bytes"})).Once()
+	err := c.doExplainPlan(opts, nodesInfo, types.NewScheduleError(types.ErrInsufficientRes, map[string]string{"n1": "capacity 1"}))
+	assert.True(t, errors.Is(err, types.ErrInsufficientRes))
+	se := err.(*types.ScheduleError)
+	assert.Equal(t, map[string]string{"n1": "capacity 1", "n2": "memory short by 1 bytes"}, se.Reasons)
+	sched.AssertExpectations(t)
 }
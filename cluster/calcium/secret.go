@@ -0,0 +1,248 @@
+package calcium
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/projecteru2/core/cluster"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// SetSecret create or update a secret, value is encrypted by master key before stored
+func (c *Calcium) SetSecret(ctx context.Context, opts *types.SetSecretOptions) (*types.Secret, error) {
+	if c.config.Secret.Key == "" {
+		return nil, types.ErrSecretDisabled
+	}
+	if opts.Name == "" {
+		return nil, types.NewDetailedErr(types.ErrBadSecret, "no name")
+	}
+	event := newEvent(cluster.EventSecret, cluster.EventSet, opts.Name)
+	secret, err := c.doSetSecret(ctx, opts.Name, opts.Value, false, func(secret *types.Secret) {
+		secret.Apps = opts.Apps
+	})
+	c.doRecordEvent(ctx, event, err)
+	return secret, err
+}
+
+// ListSecrets list secrets without value
+func (c *Calcium) ListSecrets(ctx context.Context) ([]*types.Secret, error) {
+	secrets, err := c.store.ListSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, secret := range secrets {
+		secret.Value = nil
+	}
+	return secrets, nil
+}
+
+// RemoveSecret remove secret not referenced by any container or deployment
+func (c *Calcium) RemoveSecret(ctx context.Context, name string) error {
+	event := newEvent(cluster.EventSecret, cluster.EventRemove, name)
+	err := c.doRemoveSecret(ctx, name)
+	c.doRecordEvent(ctx, event, err)
+	return err
+}
+
+// RotateSecret update value of an existing secret
+// containers referencing it will be replaced by their desired deployments if asked
+// or by their own records if there is no deployment
+func (c *Calcium) RotateSecret(ctx context.Context, opts *types.RotateSecretOptions) (chan *types.ReplaceContainerMessage, error) {
+	if c.config.Secret.Key == "" {
+		return nil, types.ErrSecretDisabled
+	}
+	event := newEvent(cluster.EventSecret, cluster.EventRotate, opts.Name)
+	_, err := c.doSetSecret(ctx, opts.Name, opts.Value, true, func(*types.Secret) {})
+	c.doRecordEvent(ctx, event, err)
+	if err != nil {
+		return nil, err
+	}
+
+	ch := make(chan *types.ReplaceContainerMessage)
+	go func() {
+		defer close(ch)
+		if !opts.Replace {
+			return
+		}
+		containers, err := c.doListSecretConsumers(ctx, opts.Name)
+		if err != nil {
+			log.Errorf("[RotateSecret] List containers referencing %s failed %v", opts.Name, err)
+			ch <- &types.ReplaceContainerMessage{Error: err}
+			return
+		}
+		// 按 app entrypoint 分组, 用期望部署的参数替换
+		groups := map[[2]string][]*types.Container{}
+		keys := [][2]string{}
+		for _, container := range containers {
+			appname, entrypoint, _, err := utils.ParseContainerName(container.Name)
+			if err != nil {
+				ch <- &types.ReplaceContainerMessage{Remove: &types.RemoveContainerMessage{ContainerID: container.ID}, Error: err}
+				continue
+			}
+			key := [2]string{appname, entrypoint}
+			if _, ok := groups[key]; !ok {
+				keys = append(keys, key)
+			}
+			groups[key] = append(groups[key], container)
+		}
+		for _, key := range keys {
+			c.doReplaceSecretConsumers(ctx, key[0], key[1], groups[key], ch)
+		}
+	}()
+	return ch, nil
+}
+
+func (c *Calcium) doReplaceSecretConsumers(ctx context.Context, appname, entrypoint string, containers []*types.Container, ch chan *types.ReplaceContainerMessage) {
+	deployment, err := c.store.GetDeployment(ctx, appname, entrypoint)
+	if err != nil {
+		// 没有期望部署的, 按容器自己的记录一个个替换
+		log.Warnf("[RotateSecret] No deployment of %s %s %v, replace containers by their own records", appname, entrypoint, err)
+		for _, container := range containers {
+			opts, err := c.doMakeEvacuateOptions(ctx, container)
+			if err != nil {
+				log.Errorf("[RotateSecret] Container %s can't be replaced %v", container.ID, err)
+				ch <- &types.ReplaceContainerMessage{Remove: &types.RemoveContainerMessage{ContainerID: container.ID}, Error: err}
+				continue
+			}
+			c.doReplaceSecretConsumersWith(ctx, &types.ReplaceOptions{DeployOptions: *opts}, []*types.Container{container}, ch)
+		}
+		return
+	}
+	c.doReplaceSecretConsumersWith(ctx, &types.ReplaceOptions{DeployOptions: *deployment.Options}, containers, ch)
+}
+
+func (c *Calcium) doReplaceSecretConsumersWith(ctx context.Context, opts *types.ReplaceOptions, containers []*types.Container, ch chan *types.ReplaceContainerMessage) {
+	for _, container := range containers {
+		opts.IDs = append(opts.IDs, container.ID)
+	}
+	// 容器留在原来的 pod 里
+	opts.Podname = ""
+	rch, err := c.ReplaceContainer(ctx, opts)
+	if err != nil {
+		log.Errorf("[RotateSecret] Replace containers of %s failed %v", opts.Name, err)
+		for _, ID := range opts.IDs {
+			ch <- &types.ReplaceContainerMessage{Remove: &types.RemoveContainerMessage{ContainerID: ID}, Error: err}
+		}
+		return
+	}
+	for m := range rch {
+		ch <- m
+	}
+}
+
+// doSetSecret encrypt value and save secret under lock, update can change other fields of secret
+func (c *Calcium) doSetSecret(ctx context.Context, name string, value []byte, mustExist bool, update func(*types.Secret)) (*types.Secret, error) {
+	var secret *types.Secret
+	err := c.withSecretLocked(ctx, name, func() error {
+		s, err := c.store.GetSecret(ctx, name)
+		if err != nil {
+			if mustExist || !errors.Is(err, types.ErrBadCount) {
+				return err
+			}
+			s = &types.Secret{Name: name}
+		}
+		// name 作为附加数据, 密文不能挪给别的 secret 用
+		if s.Value, err = utils.Encrypt(c.config.Secret.Key, value, []byte(name)); err != nil {
+			return err
+		}
+		update(s)
+		s.Version++
+		s.UpdatedAt = time.Now().UnixNano()
+		if err := c.store.SetSecret(ctx, s); err != nil {
+			return err
+		}
+		s.Value = nil
+		secret = s
+		return nil
+	})
+	return secret, err
+}
+
+func (c *Calcium) doRemoveSecret(ctx context.Context, name string) error {
+	return c.withSecretLocked(ctx, name, func() error {
+		containers, err := c.doListSecretConsumers(ctx, name)
+		if err != nil {
+			return err
+		}
+		if len(containers) > 0 {
+			return types.NewDetailedErr(types.ErrSecretInUse, fmt.Sprintf("referenced by container %s", containers[0].ID))
+		}
+		deployments, err := c.store.ListDeployments(ctx)
+		if err != nil {
+			return err
+		}
+		for _, deployment := range deployments {
+			if referenceSecret(deployment.Options.SecretEnv, deployment.Options.SecretData, name) {
+				return types.NewDetailedErr(types.ErrSecretInUse, fmt.Sprintf("referenced by deployment %s %s", deployment.Appname, deployment.Entrypoint))
+			}
+		}
+		return c.store.RemoveSecret(ctx, name)
+	})
+}
+
+// doListSecretConsumers list containers referencing secret
+func (c *Calcium) doListSecretConsumers(ctx context.Context, name string) ([]*types.Container, error) {
+	containers, err := c.store.ListContainers(ctx, "", "", "", 0, nil)
+	if err != nil {
+		return nil, err
+	}
+	consumers := []*types.Container{}
+	for _, container := range containers {
+		if referenceSecret(container.SecretEnv, container.SecretData, name) {
+			consumers = append(consumers, container)
+		}
+	}
+	return consumers, nil
+}
+
+// doResolveSecret decrypt value of secret for app
+func (c *Calcium) doResolveSecret(ctx context.Context, name, appname string) ([]byte, error) {
+	if c.config.Secret.Key == "" {
+		return nil, types.ErrSecretDisabled
+	}
+	secret, err := c.store.GetSecret(ctx, name)
+	if err != nil {
+		return nil, types.NewDetailedErr(err, fmt.Sprintf("secret %s", name))
+	}
+	if !secret.AllowApp(appname) {
+		return nil, types.NewDetailedErr(types.ErrSecretNotAllowed, fmt.Sprintf("%s for %s", name, appname))
+	}
+	return utils.Decrypt(c.config.Secret.Key, secret.Value, []byte(name))
+}
+
+// doResolveSecretEnv make env like KEY=value from secrets referenced
+func (c *Calcium) doResolveSecretEnv(ctx context.Context, opts *types.DeployOptions) ([]string, error) {
+	keys := []string{}
+	for key := range opts.SecretEnv {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	env := []string{}
+	for _, key := range keys {
+		value, err := c.doResolveSecret(ctx, opts.SecretEnv[key], opts.Name)
+		if err != nil {
+			return nil, err
+		}
+		env = append(env, fmt.Sprintf("%s=%s", key, value))
+	}
+	return env, nil
+}
+
+func referenceSecret(secretEnv, secretData map[string]string, name string) bool {
+	for _, n := range secretEnv {
+		if n == name {
+			return true
+		}
+	}
+	for _, n := range secretData {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
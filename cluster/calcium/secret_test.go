@@ -0,0 +1,196 @@
+package calcium
+
+import (
+	"archive/tar"
+	"context"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	enginemocks "github.com/projecteru2/core/engine/mocks"
+	lockmocks "github.com/projecteru2/core/lock/mocks"
+	storemocks "github.com/projecteru2/core/store/mocks"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestSecret(t *testing.T, c *Calcium, name, value string, apps ...string) *types.Secret {
+	encrypted, err := utils.Encrypt(c.config.Secret.Key, []byte(value), []byte(name))
+	assert.NoError(t, err)
+	return &types.Secret{Name: name, Value: encrypted, Apps: apps, Version: 1}
+}
+
+func mockSecretLock(store *storemocks.Store) {
+	lock := &lockmocks.DistributedLock{}
+	lock.On("Lock", mock.Anything).Return(nil)
+	lock.On("Unlock", mock.Anything).Return(nil)
+	store.On("CreateLock", mock.Anything, mock.Anything).Return(lock, nil)
+}
+
+func TestSetSecret(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+
+	// no master key
+	_, err := c.SetSecret(ctx, &types.SetSecretOptions{Name: "db", Value: []byte("pass")})
+	assert.Equal(t, types.ErrSecretDisabled, err)
+	c.config.Secret.Key = "master"
+	_, err = c.SetSecret(ctx, &types.SetSecretOptions{Value: []byte("pass")})
+	assert.Error(t, err)
+
+	mockSecretLock(store)
+	var saved *types.Secret
+	store.On("SetSecret", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		s := *args.Get(1).(*types.Secret)
+		saved = &s
+	})
+
+	// create
+	store.On("GetSecret", mock.Anything, "db").Return(nil, types.NewDetailedErr(types.ErrBadCount, "key: /secrets/db")).Once()
+	secret, err := c.SetSecret(ctx, &types.SetSecretOptions{Name: "db", Value: []byte("pass"), Apps: []string{"app"}})
+	assert.NoError(t, err)
+	assert.Nil(t, secret.Value)
+	assert.Equal(t, int64(1), secret.Version)
+	assert.Equal(t, []string{"app"}, secret.Apps)
+	assert.NotEqual(t, []byte("pass"), saved.Value)
+	value, err := utils.Decrypt("master", saved.Value, []byte("db"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("pass"), value)
+
+	// update
+	store.On("GetSecret", mock.Anything, "db").Return(newTestSecret(t, c, "db", "pass", "app"), nil).Once()
+	secret, err = c.SetSecret(ctx, &types.SetSecretOptions{Name: "db", Value: []byte("pass2")})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), secret.Version)
+	assert.Empty(t, secret.Apps)
+
+	// failed by store
+	store.On("GetSecret", mock.Anything, "db").Return(nil, types.ErrNoETCD).Once()
+	_, err = c.SetSecret(ctx, &types.SetSecretOptions{Name: "db", Value: []byte("pass")})
+	assert.Equal(t, types.ErrNoETCD, err)
+
+	// values never listed
+	store.On("ListSecrets", mock.Anything).Return([]*types.Secret{newTestSecret(t, c, "db", "pass")}, nil)
+	secrets, err := c.ListSecrets(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 1)
+	assert.Nil(t, secrets[0].Value)
+}
+
+func TestRemoveSecret(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	mockSecretLock(store)
+
+	containers := []*types.Container{{ID: "c1"}, {ID: "c2", SecretData: map[string]string{"/etc/db": "db"}}}
+	store.On("ListContainers", mock.Anything, "", "", "", int64(0), mock.Anything).Return(containers, nil).Once()
+	err := c.RemoveSecret(ctx, "db")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), types.ErrSecretInUse.Error())
+
+	store.On("ListContainers", mock.Anything, "", "", "", int64(0), mock.Anything).Return(containers[:1], nil)
+	deployments := []*types.Deployment{{Appname: "app", Entrypoint: "web", Options: &types.DeployOptions{SecretEnv: map[string]string{"DB": "db"}}}}
+	store.On("ListDeployments", mock.Anything).Return(deployments, nil).Once()
+	err = c.RemoveSecret(ctx, "db")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), types.ErrSecretInUse.Error())
+
+	store.On("ListDeployments", mock.Anything).Return([]*types.Deployment{}, nil)
+	store.On("RemoveSecret", mock.Anything, "db").Return(nil)
+	assert.NoError(t, c.RemoveSecret(ctx, "db"))
+	store.AssertCalled(t, "RemoveSecret", mock.Anything, "db")
+}
+
+func TestRotateSecret(t *testing.T) {
+	c := NewTestCluster()
+	c.config.Secret.Key = "master"
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+	mockSecretLock(store)
+	store.On("SetSecret", mock.Anything, mock.Anything).Return(nil)
+
+	// must exist
+	store.On("GetSecret", mock.Anything, "db").Return(nil, types.NewDetailedErr(types.ErrBadCount, "key: /secrets/db")).Once()
+	_, err := c.RotateSecret(ctx, &types.RotateSecretOptions{Name: "db", Value: []byte("pass2")})
+	assert.Error(t, err)
+
+	// value only
+	store.On("GetSecret", mock.Anything, "db").Return(newTestSecret(t, c, "db", "pass"), nil)
+	ch, err := c.RotateSecret(ctx, &types.RotateSecretOptions{Name: "db", Value: []byte("pass2")})
+	assert.NoError(t, err)
+	for range ch {
+		assert.Fail(t, "no container should be replaced")
+	}
+	store.AssertNotCalled(t, "ListContainers", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+
+	// consumers without desired deployment are replaced by their own records
+	containers := []*types.Container{
+		{ID: "c1", Name: "app_web_aaaaaa", Nodename: "node1", Entrypoint: &types.Entrypoint{Name: "web"}, SecretEnv: map[string]string{"DB": "db"}},
+		{ID: "c2", Name: "app_web_bbbbbb", SecretData: map[string]string{"/etc/db": "db"}},
+		{ID: "c3", Name: "app_web_cccccc"},
+	}
+	store.On("ListContainers", mock.Anything, "", "", "", int64(0), mock.Anything).Return(containers, nil)
+	store.On("GetDeployment", mock.Anything, "app", "web").Return(nil, types.ErrNoETCD)
+	store.On("GetContainers", mock.Anything, []string{"c1"}).Return(containers[:1], nil)
+	store.On("GetNode", mock.Anything, "node1").Return(nil, types.ErrNoETCD)
+	ch, err = c.RotateSecret(ctx, &types.RotateSecretOptions{Name: "db", Value: []byte("pass2"), Replace: true})
+	assert.NoError(t, err)
+	errs := map[string]error{}
+	for m := range ch {
+		errs[m.Remove.ContainerID] = m.Error
+	}
+	assert.Len(t, errs, 2)
+	// c1 went through replace
+	assert.Equal(t, types.ErrNoETCD, errs["c1"])
+	store.AssertCalled(t, "GetNode", mock.Anything, "node1")
+	// c2 has no entrypoint recorded
+	assert.Error(t, errs["c2"])
+	assert.Contains(t, errs["c2"].Error(), types.ErrNoEntryInSpec.Error())
+}
+
+func TestResolveSecret(t *testing.T) {
+	c := NewTestCluster()
+	ctx := context.Background()
+	store := c.store.(*storemocks.Store)
+
+	opts := &types.DeployOptions{Name: "app", SecretEnv: map[string]string{"PASSWORD": "db", "API_KEY": "api"}}
+	_, err := c.doResolveSecretEnv(ctx, opts)
+	assert.Equal(t, types.ErrSecretDisabled, err)
+
+	c.config.Secret.Key = "master"
+	store.On("GetSecret", mock.Anything, "db").Return(newTestSecret(t, c, "db", "pass", "app"), nil)
+	store.On("GetSecret", mock.Anything, "api").Return(newTestSecret(t, c, "api", "key"), nil)
+	env, err := c.doResolveSecretEnv(ctx, opts)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"API_KEY=key", "PASSWORD=pass"}, env)
+
+	// app not allowed
+	opts.Name = "other"
+	_, err = c.doResolveSecretEnv(ctx, opts)
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), types.ErrSecretNotAllowed.Error())
+
+	// ciphertext moved to another name can't be decrypted
+	store.On("GetSecret", mock.Anything, "moved").Return(&types.Secret{Name: "moved", Value: newTestSecret(t, c, "db", "pass").Value}, nil)
+	_, err = c.doResolveSecret(ctx, "moved", "app")
+	assert.Error(t, err)
+
+	// secret file sent in tar
+	engine := &enginemocks.API{}
+	var content []byte
+	engine.On("VirtualizationCopyTo", mock.Anything, "c1", "/etc", mock.Anything, true, true).Return(nil).Run(func(args mock.Arguments) {
+		tr := tar.NewReader(args.Get(3).(io.Reader))
+		hdr, err := tr.Next()
+		assert.NoError(t, err)
+		assert.Equal(t, "db", hdr.Name)
+		assert.Equal(t, int64(0600), hdr.Mode)
+		content, err = ioutil.ReadAll(tr)
+		assert.NoError(t, err)
+	})
+	assert.NoError(t, c.doSendSecretToContainer(ctx, engine, "c1", "/etc/db", "db", "app"))
+	assert.Equal(t, []byte("pass"), content)
+}
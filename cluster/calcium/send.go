@@ -8,6 +8,7 @@ import (
 
 	"github.com/projecteru2/core/engine"
 	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -52,3 +53,17 @@ func (c *Calcium) doSendFileToContainer(ctx context.Context, engine engine.API,
 	defer f.Close()
 	return engine.VirtualizationCopyTo(ctx, ID, path, f, AllowOverwriteDirWithFile, CopyUIDGID)
 }
+
+// doSendSecretToContainer tar secret in memory, never written to local disk
+func (c *Calcium) doSendSecretToContainer(ctx context.Context, engine engine.API, ID, dst, name, appname string) error {
+	log.Infof("[doSendSecretToContainer] Send secret %s to %s:%s", name, ID, dst)
+	value, err := c.doResolveSecret(ctx, name, appname)
+	if err != nil {
+		return err
+	}
+	content, err := utils.TarBytes(dst, value, 0600)
+	if err != nil {
+		return err
+	}
+	return engine.VirtualizationCopyTo(ctx, ID, filepath.Dir(dst), content, true, true)
+}
@@ -41,6 +41,6 @@ func (c *Calcium) SetContainersStatus(ctx context.Context, status []*types.Statu
 }
 
 // ContainerStatusStream stream container status
-func (c *Calcium) ContainerStatusStream(ctx context.Context, appname, entrypoint, nodename string, labels map[string]string) chan *types.ContainerStatus {
-	return c.store.ContainerStatusStream(ctx, appname, entrypoint, nodename, labels)
+func (c *Calcium) ContainerStatusStream(ctx context.Context, appname, entrypoint, nodename string, selector types.LabelSelector) chan *types.ContainerStatus {
+	return c.store.ContainerStatusStream(ctx, appname, entrypoint, nodename, selector)
 }
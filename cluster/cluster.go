@@ -26,10 +26,16 @@ const (
 	DeployFill = "fill"
 	// DeployGlobal for global node resource plan
 	DeployGlobal = "global"
+	// SchedulerPotassium for potassium scheduler
+	SchedulerPotassium = "potassium"
+	// SchedulerScoring for scoring scheduler
+	SchedulerScoring = "scoring"
 	// ERUMark mark container controlled by eru
 	ERUMark = "ERU"
 	// LabelMeta store publish and health things
 	LabelMeta = "ERU_META"
+	// LabelBandwidth store bandwidth of container in bps, for traffic shaping
+	LabelBandwidth = "ERU_BANDWIDTH"
 	// ContainerStop for stop container
 	ContainerStop = "stop"
 	// ContainerStart for start container
@@ -40,6 +46,52 @@ const (
 	ContainerLock = "clock_%s"
 	// NodeLock for lock node
 	NodeLock = "cnode_%s_%s"
+	// ReconcileLock for reconciler leader
+	ReconcileLock = "creconcile"
+	// HealthCheckLock for health checker leader
+	HealthCheckLock = "chealthcheck"
+	// NodeMonitorLock for node monitor leader
+	NodeMonitorLock = "cnodemonitor"
+	// AppLock for lock app when checking its quota
+	AppLock = "capp_%s"
+	// SecretLock for lock secret
+	SecretLock = "csecret_%s"
+	// AuditAdopt for adopt orphan containers into store
+	AuditAdopt = "adopt"
+	// AuditRemove for remove orphan containers from engine
+	AuditRemove = "remove"
+	// AuditDissociate for dissociate ghost containers from store
+	AuditDissociate = "dissociate"
+	// EventContainer for container events
+	EventContainer = "container"
+	// EventNode for node events
+	EventNode = "node"
+	// EventPod for pod events
+	EventPod = "pod"
+	// EventImage for image events
+	EventImage = "image"
+	// EventSecret for secret events
+	EventSecret = "secret"
+	// EventCreate for create action
+	EventCreate = "create"
+	// EventRemove for remove action
+	EventRemove = "remove"
+	// EventReplace for replace action
+	EventReplace = "replace"
+	// EventRealloc for realloc action
+	EventRealloc = "realloc"
+	// EventDissociate for dissociate action
+	EventDissociate = "dissociate"
+	// EventAdd for add action
+	EventAdd = "add"
+	// EventSet for set action
+	EventSet = "set"
+	// EventRotate for rotate action
+	EventRotate = "rotate"
+	// EventBuild for build action
+	EventBuild = "build"
+	// EventCache for cache action
+	EventCache = "cache"
 	// NodeUp for node up
 	NodeUp = 1
 	// NodeDown for node down
@@ -57,22 +109,51 @@ type Cluster interface {
 	AddPod(ctx context.Context, podname, desc string) (*types.Pod, error)
 	RemovePod(ctx context.Context, podname string) error
 	GetPod(ctx context.Context, podname string) (*types.Pod, error)
+	SetPodOvercommit(ctx context.Context, podname string, cpu, memory float64) (*types.Pod, error)
 	PodResource(ctx context.Context, podname string) (*types.PodResource, error)
-	ListPodNodes(ctx context.Context, podname string, labels map[string]string, all bool) ([]*types.Node, error)
+	ListPodNodes(ctx context.Context, podname string, selector types.LabelSelector, all bool) ([]*types.Node, error)
 	// meta node
 	AddNode(context.Context, *types.AddNodeOptions) (*types.Node, error)
 	RemoveNode(ctx context.Context, nodename string) error
 	SetNode(ctx context.Context, opts *types.SetNodeOptions) (*types.Node, error)
 	GetNode(ctx context.Context, nodename string) (*types.Node, error)
 	NodeResource(ctx context.Context, nodename string) (*types.NodeResource, error)
+	FixNodeResource(ctx context.Context, nodename string, dryRun bool) (*types.NodeResourceFix, error)
+	DrainNode(ctx context.Context, opts *types.DrainNodeOptions) (chan *types.DrainNodeMessage, error)
+	AuditContainers(ctx context.Context, opts *types.AuditOptions) (chan *types.AuditNodeMessage, error)
 	// meta containers
 	GetContainer(ctx context.Context, ID string) (*types.Container, error)
 	GetContainers(ctx context.Context, IDs []string) ([]*types.Container, error)
 	ListContainers(ctx context.Context, opts *types.ListContainersOptions) ([]*types.Container, error)
-	ListNodeContainers(ctx context.Context, nodename string, labels map[string]string) ([]*types.Container, error)
+	ListNodeContainers(ctx context.Context, nodename string, selector types.LabelSelector) ([]*types.Container, error)
 	GetContainersStatus(ctx context.Context, IDs []string) ([]*types.StatusMeta, error)
 	SetContainersStatus(ctx context.Context, status []*types.StatusMeta, ttls map[string]int64) ([]*types.StatusMeta, error)
-	ContainerStatusStream(ctx context.Context, appname, entrypoint, nodename string, labels map[string]string) chan *types.ContainerStatus
+	ContainerStatusStream(ctx context.Context, appname, entrypoint, nodename string, selector types.LabelSelector) chan *types.ContainerStatus
+	// events
+	WatchEvents(ctx context.Context, opts *types.WatchEventsOptions) chan *types.Event
+	// audit logs
+	AddAuditLog(ctx context.Context, log *types.AuditLog) error
+	ListAuditLogs(ctx context.Context, query *types.AuditLogQuery) ([]*types.AuditLog, error)
+	// rbac
+	GetRBACPolicy(ctx context.Context) (*types.RBACPolicy, error)
+	// secrets
+	SetSecret(ctx context.Context, opts *types.SetSecretOptions) (*types.Secret, error)
+	ListSecrets(ctx context.Context) ([]*types.Secret, error)
+	RemoveSecret(ctx context.Context, name string) error
+	RotateSecret(ctx context.Context, opts *types.RotateSecretOptions) (chan *types.ReplaceContainerMessage, error)
+	// desired deployment methods
+	SetDeployment(ctx context.Context, opts *types.DeployOptions) (*types.Deployment, error)
+	RemoveDeployment(ctx context.Context, appname, entrypoint string) error
+	ListDeployments(ctx context.Context) ([]*types.Deployment, error)
+	// autoscale methods
+	SetAutoscalePolicy(ctx context.Context, policy *types.AutoscalePolicy) error
+	RemoveAutoscalePolicy(ctx context.Context, appname, entrypoint string) error
+	ListAutoscalePolicies(ctx context.Context) ([]*types.AutoscalePolicy, error)
+	ListAutoscaleDecisions(ctx context.Context, appname, entrypoint string, limit int64) ([]*types.AutoscaleDecision, error)
+	// quota methods
+	SetQuota(ctx context.Context, quota *types.Quota) error
+	RemoveQuota(ctx context.Context, appname, podname string) error
+	ListQuotaUsages(ctx context.Context, appname string) ([]*types.QuotaUsage, error)
 	// cluster methods
 	Copy(ctx context.Context, opts *types.CopyOptions) (chan *types.CopyMessage, error)
 	Send(ctx context.Context, opts *types.SendOptions) (chan *types.SendMessage, error)
@@ -81,6 +162,7 @@ type Cluster interface {
 	CacheImage(ctx context.Context, podname, nodenmae string, images []string, step int) (chan *types.CacheImageMessage, error)
 	RemoveImage(ctx context.Context, podname, nodename string, images []string, step int, prune bool) (chan *types.RemoveImageMessage, error)
 	// container methods
+	PlanDeploy(ctx context.Context, opts *types.DeployOptions) (*types.DeployPlan, error)
 	CreateContainer(ctx context.Context, opts *types.DeployOptions) (chan *types.CreateContainerMessage, error)
 	ReplaceContainer(ctx context.Context, opts *types.ReplaceOptions) (chan *types.ReplaceContainerMessage, error)
 	RemoveContainer(ctx context.Context, IDs []string, force bool, step int) (chan *types.RemoveContainerMessage, error)
@@ -12,6 +12,20 @@ type Cluster struct {
 	mock.Mock
 }
 
+// AddAuditLog provides a mock function with given fields: ctx, log
+func (_m *Cluster) AddAuditLog(ctx context.Context, log *types.AuditLog) error {
+	ret := _m.Called(ctx, log)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AuditLog) error); ok {
+		r0 = rf(ctx, log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddNode provides a mock function with given fields: _a0, _a1
 func (_m *Cluster) AddNode(_a0 context.Context, _a1 *types.AddNodeOptions) (*types.Node, error) {
 	ret := _m.Called(_a0, _a1)
@@ -58,6 +72,29 @@ func (_m *Cluster) AddPod(ctx context.Context, podname string, desc string) (*ty
 	return r0, r1
 }
 
+// AuditContainers provides a mock function with given fields: ctx, opts
+func (_m *Cluster) AuditContainers(ctx context.Context, opts *types.AuditOptions) (chan *types.AuditNodeMessage, error) {
+	ret := _m.Called(ctx, opts)
+
+	var r0 chan *types.AuditNodeMessage
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AuditOptions) chan *types.AuditNodeMessage); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(chan *types.AuditNodeMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.AuditOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BuildImage provides a mock function with given fields: ctx, opts
 func (_m *Cluster) BuildImage(ctx context.Context, opts *enginetypes.BuildOptions) (chan *types.BuildImageMessage, error) {
 	ret := _m.Called(ctx, opts)
@@ -104,13 +141,13 @@ func (_m *Cluster) CacheImage(ctx context.Context, podname string, nodenmae stri
 	return r0, r1
 }
 
-// ContainerStatusStream provides a mock function with given fields: ctx, appname, entrypoint, nodename, labels
-func (_m *Cluster) ContainerStatusStream(ctx context.Context, appname string, entrypoint string, nodename string, labels map[string]string) chan *types.ContainerStatus {
-	ret := _m.Called(ctx, appname, entrypoint, nodename, labels)
+// ContainerStatusStream provides a mock function with given fields: ctx, appname, entrypoint, nodename, selector
+func (_m *Cluster) ContainerStatusStream(ctx context.Context, appname string, entrypoint string, nodename string, selector types.LabelSelector) chan *types.ContainerStatus {
+	ret := _m.Called(ctx, appname, entrypoint, nodename, selector)
 
 	var r0 chan *types.ContainerStatus
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string) chan *types.ContainerStatus); ok {
-		r0 = rf(ctx, appname, entrypoint, nodename, labels)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.LabelSelector) chan *types.ContainerStatus); ok {
+		r0 = rf(ctx, appname, entrypoint, nodename, selector)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(chan *types.ContainerStatus)
@@ -212,6 +249,29 @@ func (_m *Cluster) DissociateContainer(ctx context.Context, IDs []string) (chan
 	return r0, r1
 }
 
+// DrainNode provides a mock function with given fields: ctx, opts
+func (_m *Cluster) DrainNode(ctx context.Context, opts *types.DrainNodeOptions) (chan *types.DrainNodeMessage, error) {
+	ret := _m.Called(ctx, opts)
+
+	var r0 chan *types.DrainNodeMessage
+	if rf, ok := ret.Get(0).(func(context.Context, *types.DrainNodeOptions) chan *types.DrainNodeMessage); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(chan *types.DrainNodeMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.DrainNodeOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ExecuteContainer provides a mock function with given fields: ctx, opts, inCh
 func (_m *Cluster) ExecuteContainer(ctx context.Context, opts *types.ExecuteContainerOptions, inCh <-chan []byte) chan *types.AttachContainerMessage {
 	ret := _m.Called(ctx, opts, inCh)
@@ -233,6 +293,29 @@ func (_m *Cluster) Finalizer() {
 	_m.Called()
 }
 
+// FixNodeResource provides a mock function with given fields: ctx, nodename, dryRun
+func (_m *Cluster) FixNodeResource(ctx context.Context, nodename string, dryRun bool) (*types.NodeResourceFix, error) {
+	ret := _m.Called(ctx, nodename, dryRun)
+
+	var r0 *types.NodeResourceFix
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *types.NodeResourceFix); ok {
+		r0 = rf(ctx, nodename, dryRun)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.NodeResourceFix)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, nodename, dryRun)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetContainer provides a mock function with given fields: ctx, ID
 func (_m *Cluster) GetContainer(ctx context.Context, ID string) (*types.Container, error) {
 	ret := _m.Called(ctx, ID)
@@ -348,6 +431,98 @@ func (_m *Cluster) GetPod(ctx context.Context, podname string) (*types.Pod, erro
 	return r0, r1
 }
 
+// GetRBACPolicy provides a mock function with given fields: ctx
+func (_m *Cluster) GetRBACPolicy(ctx context.Context) (*types.RBACPolicy, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *types.RBACPolicy
+	if rf, ok := ret.Get(0).(func(context.Context) *types.RBACPolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RBACPolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuditLogs provides a mock function with given fields: ctx, query
+func (_m *Cluster) ListAuditLogs(ctx context.Context, query *types.AuditLogQuery) ([]*types.AuditLog, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []*types.AuditLog
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AuditLogQuery) []*types.AuditLog); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.AuditLog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.AuditLogQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAutoscaleDecisions provides a mock function with given fields: ctx, appname, entrypoint, limit
+func (_m *Cluster) ListAutoscaleDecisions(ctx context.Context, appname string, entrypoint string, limit int64) ([]*types.AutoscaleDecision, error) {
+	ret := _m.Called(ctx, appname, entrypoint, limit)
+
+	var r0 []*types.AutoscaleDecision
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) []*types.AutoscaleDecision); ok {
+		r0 = rf(ctx, appname, entrypoint, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.AutoscaleDecision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, appname, entrypoint, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAutoscalePolicies provides a mock function with given fields: ctx
+func (_m *Cluster) ListAutoscalePolicies(ctx context.Context) ([]*types.AutoscalePolicy, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*types.AutoscalePolicy
+	if rf, ok := ret.Get(0).(func(context.Context) []*types.AutoscalePolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.AutoscalePolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListContainers provides a mock function with given fields: ctx, opts
 func (_m *Cluster) ListContainers(ctx context.Context, opts *types.ListContainersOptions) ([]*types.Container, error) {
 	ret := _m.Called(ctx, opts)
@@ -371,6 +546,29 @@ func (_m *Cluster) ListContainers(ctx context.Context, opts *types.ListContainer
 	return r0, r1
 }
 
+// ListDeployments provides a mock function with given fields: ctx
+func (_m *Cluster) ListDeployments(ctx context.Context) ([]*types.Deployment, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*types.Deployment
+	if rf, ok := ret.Get(0).(func(context.Context) []*types.Deployment); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Deployment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListNetworks provides a mock function with given fields: ctx, podname, driver
 func (_m *Cluster) ListNetworks(ctx context.Context, podname string, driver string) ([]*enginetypes.Network, error) {
 	ret := _m.Called(ctx, podname, driver)
@@ -394,13 +592,13 @@ func (_m *Cluster) ListNetworks(ctx context.Context, podname string, driver stri
 	return r0, r1
 }
 
-// ListNodeContainers provides a mock function with given fields: ctx, nodename, labels
-func (_m *Cluster) ListNodeContainers(ctx context.Context, nodename string, labels map[string]string) ([]*types.Container, error) {
-	ret := _m.Called(ctx, nodename, labels)
+// ListNodeContainers provides a mock function with given fields: ctx, nodename, selector
+func (_m *Cluster) ListNodeContainers(ctx context.Context, nodename string, selector types.LabelSelector) ([]*types.Container, error) {
+	ret := _m.Called(ctx, nodename, selector)
 
 	var r0 []*types.Container
-	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]string) []*types.Container); ok {
-		r0 = rf(ctx, nodename, labels)
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.LabelSelector) []*types.Container); ok {
+		r0 = rf(ctx, nodename, selector)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*types.Container)
@@ -408,8 +606,8 @@ func (_m *Cluster) ListNodeContainers(ctx context.Context, nodename string, labe
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]string) error); ok {
-		r1 = rf(ctx, nodename, labels)
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.LabelSelector) error); ok {
+		r1 = rf(ctx, nodename, selector)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -417,13 +615,13 @@ func (_m *Cluster) ListNodeContainers(ctx context.Context, nodename string, labe
 	return r0, r1
 }
 
-// ListPodNodes provides a mock function with given fields: ctx, podname, labels, all
-func (_m *Cluster) ListPodNodes(ctx context.Context, podname string, labels map[string]string, all bool) ([]*types.Node, error) {
-	ret := _m.Called(ctx, podname, labels, all)
+// ListPodNodes provides a mock function with given fields: ctx, podname, selector, all
+func (_m *Cluster) ListPodNodes(ctx context.Context, podname string, selector types.LabelSelector, all bool) ([]*types.Node, error) {
+	ret := _m.Called(ctx, podname, selector, all)
 
 	var r0 []*types.Node
-	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]string, bool) []*types.Node); ok {
-		r0 = rf(ctx, podname, labels, all)
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.LabelSelector, bool) []*types.Node); ok {
+		r0 = rf(ctx, podname, selector, all)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*types.Node)
@@ -431,8 +629,8 @@ func (_m *Cluster) ListPodNodes(ctx context.Context, podname string, labels map[
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]string, bool) error); ok {
-		r1 = rf(ctx, podname, labels, all)
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.LabelSelector, bool) error); ok {
+		r1 = rf(ctx, podname, selector, all)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -463,6 +661,52 @@ func (_m *Cluster) ListPods(ctx context.Context) ([]*types.Pod, error) {
 	return r0, r1
 }
 
+// ListQuotaUsages provides a mock function with given fields: ctx, appname
+func (_m *Cluster) ListQuotaUsages(ctx context.Context, appname string) ([]*types.QuotaUsage, error) {
+	ret := _m.Called(ctx, appname)
+
+	var r0 []*types.QuotaUsage
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*types.QuotaUsage); ok {
+		r0 = rf(ctx, appname)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.QuotaUsage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, appname)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSecrets provides a mock function with given fields: ctx
+func (_m *Cluster) ListSecrets(ctx context.Context) ([]*types.Secret, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*types.Secret
+	if rf, ok := ret.Get(0).(func(context.Context) []*types.Secret); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Secret)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LogStream provides a mock function with given fields: ctx, ID
 func (_m *Cluster) LogStream(ctx context.Context, ID string) (chan *types.LogStreamMessage, error) {
 	ret := _m.Called(ctx, ID)
@@ -509,6 +753,29 @@ func (_m *Cluster) NodeResource(ctx context.Context, nodename string) (*types.No
 	return r0, r1
 }
 
+// PlanDeploy provides a mock function with given fields: ctx, opts
+func (_m *Cluster) PlanDeploy(ctx context.Context, opts *types.DeployOptions) (*types.DeployPlan, error) {
+	ret := _m.Called(ctx, opts)
+
+	var r0 *types.DeployPlan
+	if rf, ok := ret.Get(0).(func(context.Context, *types.DeployOptions) *types.DeployPlan); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.DeployPlan)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.DeployOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PodResource provides a mock function with given fields: ctx, podname
 func (_m *Cluster) PodResource(ctx context.Context, podname string) (*types.PodResource, error) {
 	ret := _m.Called(ctx, podname)
@@ -555,6 +822,20 @@ func (_m *Cluster) ReallocResource(ctx context.Context, IDs []string, cpu float6
 	return r0, r1
 }
 
+// RemoveAutoscalePolicy provides a mock function with given fields: ctx, appname, entrypoint
+func (_m *Cluster) RemoveAutoscalePolicy(ctx context.Context, appname string, entrypoint string) error {
+	ret := _m.Called(ctx, appname, entrypoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, appname, entrypoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveContainer provides a mock function with given fields: ctx, IDs, force, step
 func (_m *Cluster) RemoveContainer(ctx context.Context, IDs []string, force bool, step int) (chan *types.RemoveContainerMessage, error) {
 	ret := _m.Called(ctx, IDs, force, step)
@@ -578,6 +859,20 @@ func (_m *Cluster) RemoveContainer(ctx context.Context, IDs []string, force bool
 	return r0, r1
 }
 
+// RemoveDeployment provides a mock function with given fields: ctx, appname, entrypoint
+func (_m *Cluster) RemoveDeployment(ctx context.Context, appname string, entrypoint string) error {
+	ret := _m.Called(ctx, appname, entrypoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, appname, entrypoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveImage provides a mock function with given fields: ctx, podname, nodename, images, step, prune
 func (_m *Cluster) RemoveImage(ctx context.Context, podname string, nodename string, images []string, step int, prune bool) (chan *types.RemoveImageMessage, error) {
 	ret := _m.Called(ctx, podname, nodename, images, step, prune)
@@ -629,6 +924,34 @@ func (_m *Cluster) RemovePod(ctx context.Context, podname string) error {
 	return r0
 }
 
+// RemoveQuota provides a mock function with given fields: ctx, appname, podname
+func (_m *Cluster) RemoveQuota(ctx context.Context, appname string, podname string) error {
+	ret := _m.Called(ctx, appname, podname)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, appname, podname)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveSecret provides a mock function with given fields: ctx, name
+func (_m *Cluster) RemoveSecret(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ReplaceContainer provides a mock function with given fields: ctx, opts
 func (_m *Cluster) ReplaceContainer(ctx context.Context, opts *types.ReplaceOptions) (chan *types.ReplaceContainerMessage, error) {
 	ret := _m.Called(ctx, opts)
@@ -652,6 +975,29 @@ func (_m *Cluster) ReplaceContainer(ctx context.Context, opts *types.ReplaceOpti
 	return r0, r1
 }
 
+// RotateSecret provides a mock function with given fields: ctx, opts
+func (_m *Cluster) RotateSecret(ctx context.Context, opts *types.RotateSecretOptions) (chan *types.ReplaceContainerMessage, error) {
+	ret := _m.Called(ctx, opts)
+
+	var r0 chan *types.ReplaceContainerMessage
+	if rf, ok := ret.Get(0).(func(context.Context, *types.RotateSecretOptions) chan *types.ReplaceContainerMessage); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(chan *types.ReplaceContainerMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.RotateSecretOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RunAndWait provides a mock function with given fields: ctx, opts, inCh
 func (_m *Cluster) RunAndWait(ctx context.Context, opts *types.DeployOptions, inCh <-chan []byte) (<-chan *types.AttachContainerMessage, error) {
 	ret := _m.Called(ctx, opts, inCh)
@@ -698,6 +1044,20 @@ func (_m *Cluster) Send(ctx context.Context, opts *types.SendOptions) (chan *typ
 	return r0, r1
 }
 
+// SetAutoscalePolicy provides a mock function with given fields: ctx, policy
+func (_m *Cluster) SetAutoscalePolicy(ctx context.Context, policy *types.AutoscalePolicy) error {
+	ret := _m.Called(ctx, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AutoscalePolicy) error); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetContainersStatus provides a mock function with given fields: ctx, status, ttls
 func (_m *Cluster) SetContainersStatus(ctx context.Context, status []*types.StatusMeta, ttls map[string]int64) ([]*types.StatusMeta, error) {
 	ret := _m.Called(ctx, status, ttls)
@@ -721,6 +1081,29 @@ func (_m *Cluster) SetContainersStatus(ctx context.Context, status []*types.Stat
 	return r0, r1
 }
 
+// SetDeployment provides a mock function with given fields: ctx, opts
+func (_m *Cluster) SetDeployment(ctx context.Context, opts *types.DeployOptions) (*types.Deployment, error) {
+	ret := _m.Called(ctx, opts)
+
+	var r0 *types.Deployment
+	if rf, ok := ret.Get(0).(func(context.Context, *types.DeployOptions) *types.Deployment); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Deployment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.DeployOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetNode provides a mock function with given fields: ctx, opts
 func (_m *Cluster) SetNode(ctx context.Context, opts *types.SetNodeOptions) (*types.Node, error) {
 	ret := _m.Called(ctx, opts)
@@ -743,3 +1126,79 @@ func (_m *Cluster) SetNode(ctx context.Context, opts *types.SetNodeOptions) (*ty
 
 	return r0, r1
 }
+
+// SetPodOvercommit provides a mock function with given fields: ctx, podname, cpu, memory
+func (_m *Cluster) SetPodOvercommit(ctx context.Context, podname string, cpu float64, memory float64) (*types.Pod, error) {
+	ret := _m.Called(ctx, podname, cpu, memory)
+
+	var r0 *types.Pod
+	if rf, ok := ret.Get(0).(func(context.Context, string, float64, float64) *types.Pod); ok {
+		r0 = rf(ctx, podname, cpu, memory)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Pod)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, float64, float64) error); ok {
+		r1 = rf(ctx, podname, cpu, memory)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetQuota provides a mock function with given fields: ctx, quota
+func (_m *Cluster) SetQuota(ctx context.Context, quota *types.Quota) error {
+	ret := _m.Called(ctx, quota)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Quota) error); ok {
+		r0 = rf(ctx, quota)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetSecret provides a mock function with given fields: ctx, opts
+func (_m *Cluster) SetSecret(ctx context.Context, opts *types.SetSecretOptions) (*types.Secret, error) {
+	ret := _m.Called(ctx, opts)
+
+	var r0 *types.Secret
+	if rf, ok := ret.Get(0).(func(context.Context, *types.SetSecretOptions) *types.Secret); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Secret)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.SetSecretOptions) error); ok {
+		r1 = rf(ctx, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// WatchEvents provides a mock function with given fields: ctx, opts
+func (_m *Cluster) WatchEvents(ctx context.Context, opts *types.WatchEventsOptions) chan *types.Event {
+	ret := _m.Called(ctx, opts)
+
+	var r0 chan *types.Event
+	if rf, ok := ret.Get(0).(func(context.Context, *types.WatchEventsOptions) chan *types.Event); ok {
+		r0 = rf(ctx, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(chan *types.Event)
+		}
+	}
+
+	return r0
+}
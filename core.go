@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"fmt"
 	"net"
 	"net/http"
@@ -8,8 +9,11 @@ import (
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
+	"github.com/projecteru2/core/audit"
 	"github.com/projecteru2/core/auth"
+	"github.com/projecteru2/core/auth/token"
 	"github.com/projecteru2/core/cluster/calcium"
 	"github.com/projecteru2/core/metrics"
 	"github.com/projecteru2/core/rpc"
@@ -20,6 +24,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	cli "github.com/urfave/cli/v2"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 var (
@@ -62,8 +67,25 @@ func serve() {
 	}
 	defer cluster.Finalizer()
 
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if config.HealthCheck.Enable {
+		go cluster.HealthCheck(ctx)
+	}
+	if config.Reconcile.Enable {
+		go cluster.Reconcile(ctx)
+	}
+	if config.NodeMonitor.Enable {
+		go cluster.NodeMonitor(ctx)
+	}
+
+	auditSink, err := audit.NewSink(config.Audit, cluster)
+	if err != nil {
+		log.Fatalf("[main] %v", err)
+	}
+
 	rpcch := make(chan struct{}, 1)
-	vibranium := rpc.New(cluster, config, rpcch)
+	vibranium := rpc.New(cluster, config, auditSink, rpcch)
 	s, err := net.Listen("tcp", config.Bind)
 	if err != nil {
 		log.Fatalf("[main] %v", err)
@@ -74,12 +96,38 @@ func serve() {
 		grpc.MaxRecvMsgSize(config.GRPCConfig.MaxRecvMsgSize),
 	}
 
-	if config.Auth.Username != "" {
-		log.Info("[main] Cluster auth enable.")
-		auth := auth.NewAuth(config.Auth)
-		opts = append(opts, grpc.StreamInterceptor(auth.StreamInterceptor))
-		opts = append(opts, grpc.UnaryInterceptor(auth.UnaryInterceptor))
-		log.Infof("[main] Username %s Password %s", config.Auth.Username, config.Auth.Password)
+	// audit 在最外层, 被 auth 拒绝的请求也要记下, 用户名由 auth 留在 ctx 的 identity 里
+	streamInterceptors := []grpc.StreamServerInterceptor{}
+	unaryInterceptors := []grpc.UnaryServerInterceptor{}
+	if auditSink != nil {
+		log.Infof("[main] Audit log enable, sink %s.", config.Audit.Sink)
+		auditor := audit.NewAuditor(auditSink)
+		streamInterceptors = append(streamInterceptors, auditor.StreamInterceptor)
+		unaryInterceptors = append(unaryInterceptors, auditor.UnaryInterceptor)
+	}
+	auths, err := auth.NewAuths(config, cluster)
+	if err != nil {
+		log.Fatalf("[main] %v", err)
+	}
+	for _, a := range auths {
+		streamInterceptors = append(streamInterceptors, a.StreamInterceptor)
+		unaryInterceptors = append(unaryInterceptors, a.UnaryInterceptor)
+	}
+	if len(auths) > 0 {
+		log.Infof("[main] Cluster auth enable, rbac %v, token %v, client cert %v.", config.RBAC.Enable, config.Token.Secret != "", config.TLS.CA != "")
+	}
+	if len(streamInterceptors) > 0 {
+		opts = append(opts, grpc.StreamInterceptor(rpc.ChainStreamInterceptors(streamInterceptors...)))
+		opts = append(opts, grpc.UnaryInterceptor(rpc.ChainUnaryInterceptors(unaryInterceptors...)))
+	}
+
+	if config.TLS.Cert != "" {
+		tlsConfig, err := utils.NewServerTLSConfig(config.TLS)
+		if err != nil {
+			log.Fatalf("[main] %v", err)
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		log.Info("[main] TLS enable.")
 	}
 
 	grpcServer := grpc.NewServer(opts...)
@@ -106,6 +154,22 @@ func serve() {
 	log.Info("[main] cluster gracefully stopped.")
 }
 
+func issueToken(username string, ttl time.Duration) error {
+	config, err := utils.LoadConfig(configPath)
+	if err != nil {
+		return err
+	}
+	if config.Token.Secret == "" {
+		return fmt.Errorf("token secret not set in %s", configPath)
+	}
+	t, err := token.Issue(config.Token.Secret, username, ttl)
+	if err != nil {
+		return err
+	}
+	fmt.Println(t)
+	return nil
+}
+
 func main() {
 	cli.VersionPrinter = func(c *cli.Context) {
 		fmt.Print(versioninfo.VersionString())
@@ -133,6 +197,29 @@ func main() {
 		serve()
 		return nil
 	}
+	app.Commands = []*cli.Command{
+		{
+			Name:  "token",
+			Usage: "issue bearer token signed by token secret in config",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     "username",
+					Usage:    "username in token",
+					Required: true,
+				},
+				&cli.DurationFlag{
+					Name:  "ttl",
+					Value: 24 * time.Hour,
+					Usage: "token expired after ttl",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return issueToken(c.String("username"), c.Duration("ttl"))
+			},
+		},
+	}
 
-	app.Run(os.Args)
+	if err := app.Run(os.Args); err != nil {
+		log.Fatalf("[main] %v", err)
+	}
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"strings"
 
 	"github.com/docker/go-connections/nat"
 	"github.com/docker/go-units"
@@ -13,6 +14,7 @@ import (
 
 	dockertypes "github.com/docker/docker/api/types"
 	dockercontainer "github.com/docker/docker/api/types/container"
+	dockerfilters "github.com/docker/docker/api/types/filters"
 	dockernetwork "github.com/docker/docker/api/types/network"
 	dockerslice "github.com/docker/docker/api/types/strslice"
 
@@ -26,6 +28,7 @@ const (
 	minMemory     = units.MiB * 4
 	restartAlways = "always"
 	root          = "root"
+	nvidiaGPU     = "nvidia.com/gpu"
 )
 
 type rawArgs struct {
@@ -78,6 +81,9 @@ func (e *Engine) VirtualizationCreate(ctx context.Context, opts *enginetypes.Vir
 	// add node IP
 	hostIP := GetIP(e.client.DaemonHost())
 	opts.Env = append(opts.Env, fmt.Sprintf("ERU_NODE_IP=%s", hostIP))
+	// devices
+	deviceMappings, deviceEnv := makeDeviceSetting(opts.Devices)
+	opts.Env = append(opts.Env, deviceEnv...)
 	// 如果有给dns就优先用给定的dns.
 	// 没有给出dns的时候, 如果设定是用宿主机IP作为dns, 就会把宿主机IP设置过去.
 	// 其他情况就是默认值.
@@ -107,6 +113,7 @@ func (e *Engine) VirtualizationCreate(ctx context.Context, opts *enginetypes.Vir
 	resource.Ulimits = []*units.Ulimit{
 		&units.Ulimit{Name: "nofile", Soft: 65535, Hard: 65535},
 	}
+	resource.Devices = deviceMappings
 	if networkMode.IsHost() {
 		opts.DNS = []string{}
 		opts.Sysctl = map[string]string{}
@@ -219,6 +226,7 @@ func (e *Engine) VirtualizationInspect(ctx context.Context, ID string) (*enginet
 		return r, err
 	}
 	r.ID = containerJSON.ID
+	r.Name = strings.TrimPrefix(containerJSON.Name, "/")
 	r.User = containerJSON.Config.User
 	r.Image = containerJSON.Config.Image
 	r.Env = containerJSON.Config.Env
@@ -235,6 +243,38 @@ func (e *Engine) VirtualizationInspect(ctx context.Context, ID string) (*enginet
 	return r, nil
 }
 
+// VirtualizationList list virtualizations with all labels matched
+func (e *Engine) VirtualizationList(ctx context.Context, labels map[string]string) ([]*enginetypes.VirtualizationInfo, error) {
+	if e.client == nil {
+		return nil, coretypes.ErrNilEngine
+	}
+
+	filters := dockerfilters.NewArgs()
+	for k, v := range labels {
+		filters.Add("label", fmt.Sprintf("%s=%s", k, v))
+	}
+	containers, err := e.client.ContainerList(ctx, dockertypes.ContainerListOptions{All: true, Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+
+	r := []*enginetypes.VirtualizationInfo{}
+	for _, container := range containers {
+		name := ""
+		if len(container.Names) > 0 {
+			name = strings.TrimPrefix(container.Names[0], "/")
+		}
+		r = append(r, &enginetypes.VirtualizationInfo{
+			ID:      container.ID,
+			Name:    name,
+			Image:   container.Image,
+			Labels:  container.Labels,
+			Running: container.State == "running",
+		})
+	}
+	return r, nil
+}
+
 // VirtualizationLogs show virtualization logs
 func (e *Engine) VirtualizationLogs(ctx context.Context, ID string, follow, stdout, stderr bool) (io.ReadCloser, error) {
 	logsOpts := dockertypes.ContainerLogsOptions{Follow: follow, ShowStdout: stdout, ShowStderr: stderr}
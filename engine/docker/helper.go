@@ -13,6 +13,8 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"regexp"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -127,6 +129,38 @@ func makeResourceSetting(cpu float64, memory int64, cpuMap map[string]int64, num
 	return resource
 }
 
+var deviceEnvReplacer = regexp.MustCompile("[^A-Z0-9]+")
+
+// makeDeviceSetting turns device IDs into docker device mappings and env
+// IDs under /dev are mapped into container, nvidia gpus are exposed by nvidia runtime env
+// every type is also exposed as ERU_DEVICES_{TYPE}
+func makeDeviceSetting(devices map[string][]string) ([]dockercontainer.DeviceMapping, []string) {
+	mappings := []dockercontainer.DeviceMapping{}
+	env := []string{}
+	kinds := []string{}
+	for kind := range devices {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+	for _, kind := range kinds {
+		IDs := devices[kind]
+		if len(IDs) == 0 {
+			continue
+		}
+		for _, ID := range IDs {
+			if strings.HasPrefix(ID, "/dev/") {
+				mappings = append(mappings, dockercontainer.DeviceMapping{PathOnHost: ID, PathInContainer: ID, CgroupPermissions: "rwm"})
+			}
+		}
+		if kind == nvidiaGPU {
+			env = append(env, fmt.Sprintf("NVIDIA_VISIBLE_DEVICES=%s", strings.Join(IDs, ",")))
+		}
+		name := strings.Trim(deviceEnvReplacer.ReplaceAllString(strings.ToUpper(kind), "_"), "_")
+		env = append(env, fmt.Sprintf("ERU_DEVICES_%s=%s", name, strings.Join(IDs, ",")))
+	}
+	return mappings, env
+}
+
 // 只要一个image的前面, tag不要
 func normalizeImage(image string) string {
 	if strings.Contains(image, ":") {
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"testing"
 
+	dockercontainer "github.com/docker/docker/api/types/container"
 	coreutils "github.com/projecteru2/core/utils"
 	"github.com/stretchr/testify/assert"
 )
@@ -17,3 +18,17 @@ func TestCreateTarStream(t *testing.T) {
 	_, err = CreateTarStream(fname)
 	assert.NoError(t, err)
 }
+
+func TestMakeDeviceSetting(t *testing.T) {
+	mappings, env := makeDeviceSetting(nil)
+	assert.Empty(t, mappings)
+	assert.Empty(t, env)
+
+	mappings, env = makeDeviceSetting(map[string][]string{
+		"nvidia.com/gpu": {"0", "1"},
+		"fpga":           {"/dev/fpga0"},
+		"empty":          {},
+	})
+	assert.Equal(t, []dockercontainer.DeviceMapping{{PathOnHost: "/dev/fpga0", PathInContainer: "/dev/fpga0", CgroupPermissions: "rwm"}}, mappings)
+	assert.Equal(t, []string{"ERU_DEVICES_FPGA=/dev/fpga0", "NVIDIA_VISIBLE_DEVICES=0,1", "ERU_DEVICES_NVIDIA_COM_GPU=0,1"}, env)
+}
@@ -591,6 +591,29 @@ func (_m *API) VirtualizationInspect(ctx context.Context, ID string) (*types.Vir
 	return r0, r1
 }
 
+// VirtualizationList provides a mock function with given fields: ctx, labels
+func (_m *API) VirtualizationList(ctx context.Context, labels map[string]string) ([]*types.VirtualizationInfo, error) {
+	ret := _m.Called(ctx, labels)
+
+	var r0 []*types.VirtualizationInfo
+	if rf, ok := ret.Get(0).(func(context.Context, map[string]string) []*types.VirtualizationInfo); ok {
+		r0 = rf(ctx, labels)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.VirtualizationInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, map[string]string) error); ok {
+		r1 = rf(ctx, labels)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // VirtualizationLogs provides a mock function with given fields: ctx, ID, follow, stdout, stderr
 func (_m *API) VirtualizationLogs(ctx context.Context, ID string, follow bool, stdout bool, stderr bool) (io.ReadCloser, error) {
 	ret := _m.Called(ctx, ID, follow, stdout, stderr)
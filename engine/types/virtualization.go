@@ -11,6 +11,7 @@ type VirtualizationResource struct {
 	Volumes       []string
 	VolumePlan    map[string]map[string]int64 // literal VolumePlan
 	VolumeChanged bool                        // indicate whether new volumes contained in realloc request
+	Devices       map[string][]string         // device IDs of each type
 }
 
 // VirtualizationCreateOptions use for create virtualization target
@@ -56,6 +57,7 @@ type VirtualizationCreated struct {
 // VirtualizationInfo store virtualization info
 type VirtualizationInfo struct {
 	ID       string
+	Name     string
 	User     string
 	Image    string
 	Running  bool
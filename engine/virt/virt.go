@@ -215,6 +215,12 @@ func (v *Virt) VirtualizationInspect(ctx context.Context, ID string) (*enginetyp
 	}, nil
 }
 
+// VirtualizationList lists guests by labels.
+// yavirtd has no api to list guests yet, guests can only be inspected one by one.
+func (v *Virt) VirtualizationList(ctx context.Context, labels map[string]string) ([]*enginetypes.VirtualizationInfo, error) {
+	return nil, coretypes.NewDetailedErr(coretypes.ErrNotSupport, "VirtualizationList")
+}
+
 // VirtualizationLogs streams a specific guest's log.
 func (v *Virt) VirtualizationLogs(ctx context.Context, ID string, follow, stdout, stderr bool) (io.ReadCloser, error) {
 	return nil, fmt.Errorf("VirtualizationLogs does not implement")
@@ -51,3 +51,8 @@ func (m *Mutex) Unlock(ctx context.Context) error {
 	// 一定要释放
 	return m.mutex.Unlock(ctx)
 }
+
+// Done closed when session expired, lock is lost then
+func (m *Mutex) Done() <-chan struct{} {
+	return m.session.Done()
+}
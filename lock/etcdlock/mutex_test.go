@@ -23,6 +23,13 @@ func TestMutex(t *testing.T) {
 	ctx := context.Background()
 	err = mutex.Lock(ctx)
 	assert.NoError(t, err)
+	select {
+	case <-mutex.Done():
+		assert.Fail(t, "lock should be held")
+	default:
+	}
 	err = mutex.Unlock(ctx)
 	assert.NoError(t, err)
+	// session closed after unlock
+	<-mutex.Done()
 }
@@ -6,4 +6,6 @@ import "context"
 type DistributedLock interface {
 	Lock(ctx context.Context) error
 	Unlock(ctx context.Context) error
+	// Done closed when lock is lost, e.g. session behind it expired
+	Done() <-chan struct{}
 }
@@ -11,6 +11,22 @@ type DistributedLock struct {
 	mock.Mock
 }
 
+// Done provides a mock function with given fields:
+func (_m *DistributedLock) Done() <-chan struct{} {
+	ret := _m.Called()
+
+	var r0 <-chan struct{}
+	if rf, ok := ret.Get(0).(func() <-chan struct{}); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan struct{})
+		}
+	}
+
+	return r0
+}
+
 // Lock provides a mock function with given fields: ctx
 func (_m *DistributedLock) Lock(ctx context.Context) error {
 	ret := _m.Called(ctx)
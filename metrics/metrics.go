@@ -16,6 +16,7 @@ const (
 	memStats     = "core.node.%s.memory"
 	storageStats = "core.node.%s.storage"
 	deployCount  = "core.%s.deploy.count"
+	nodeStatus   = "core.node.%s.%s.count"
 )
 
 // Metrics define metrics
@@ -28,6 +29,8 @@ type Metrics struct {
 	StorageCapacity *prometheus.GaugeVec
 	CPUMap          *prometheus.GaugeVec
 	DeployCount     *prometheus.CounterVec
+	NodeAvailable   *prometheus.GaugeVec
+	NodeTransition  *prometheus.CounterVec
 }
 
 // Lazy connect
@@ -124,6 +127,33 @@ func (m *Metrics) SendDeployCount(n int) {
 	}
 }
 
+// SendNodeTransition record node marked up or down by monitor
+func (m *Metrics) SendNodeTransition(node *types.Node, status string) {
+	log.Debugf("[Metrics] Update %s transition to %s", node.Name, status)
+	nodename := utils.CleanStatsdMetrics(node.Name)
+	podname := utils.CleanStatsdMetrics(node.Podname)
+	available := 0.0
+	if node.Available {
+		available = 1
+	}
+
+	if m.NodeAvailable != nil {
+		m.NodeAvailable.WithLabelValues(podname, nodename).Set(available)
+	}
+
+	if m.NodeTransition != nil {
+		m.NodeTransition.WithLabelValues(podname, nodename, status).Inc()
+	}
+
+	if m.StatsdAddr == "" {
+		return
+	}
+	key := fmt.Sprintf(nodeStatus, nodename, status)
+	if err := m.count(key, 1, 1.0); err != nil {
+		log.Errorf("[SendNodeTransition] Error occurred while counting: %v", err)
+	}
+}
+
 // Client is a metrics obj
 var Client = Metrics{}
 
@@ -155,9 +185,20 @@ func InitMetrics(statsd string) error {
 		Help: "core deploy counter",
 	}, []string{"hostname"})
 
+	Client.NodeAvailable = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "node_available",
+		Help: "node available or not, judged by node monitor.",
+	}, []string{"podname", "nodename"})
+
+	Client.NodeTransition = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "node_transition",
+		Help: "node up and down counter of node monitor.",
+	}, []string{"podname", "nodename", "status"})
+
 	prometheus.MustRegister(
 		Client.DeployCount, Client.MemoryCapacity,
 		Client.StorageCapacity, Client.CPUMap,
+		Client.NodeAvailable, Client.NodeTransition,
 	)
 	return nil
 }
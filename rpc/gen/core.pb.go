@@ -61,6 +61,7 @@ type ListContainersOptions struct {
 	Nodename             string            `protobuf:"bytes,3,opt,name=nodename,proto3" json:"nodename,omitempty"`
 	Labels               map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	Limit                int64             `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	Selector             string            `protobuf:"bytes,6,opt,name=selector,proto3" json:"selector,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -126,10 +127,19 @@ func (m *ListContainersOptions) GetLimit() int64 {
 	return 0
 }
 
+func (m *ListContainersOptions) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
 // 对的, protobuf 就是这样...
 type Pod struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	Desc                 string   `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	CpuOvercommit        float64  `protobuf:"fixed64,3,opt,name=cpu_overcommit,json=cpuOvercommit,proto3" json:"cpu_overcommit,omitempty"`
+	MemoryOvercommit     float64  `protobuf:"fixed64,4,opt,name=memory_overcommit,json=memoryOvercommit,proto3" json:"memory_overcommit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -174,6 +184,20 @@ func (m *Pod) GetDesc() string {
 	return ""
 }
 
+func (m *Pod) GetCpuOvercommit() float64 {
+	if m != nil {
+		return m.CpuOvercommit
+	}
+	return 0
+}
+
+func (m *Pod) GetMemoryOvercommit() float64 {
+	if m != nil {
+		return m.MemoryOvercommit
+	}
+	return 0
+}
+
 type Pods struct {
 	Pods                 []*Pod   `protobuf:"bytes,1,rep,name=pods,proto3" json:"pods,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -214,15 +238,17 @@ func (m *Pods) GetPods() []*Pod {
 }
 
 type PodResource struct {
-	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CpuPercents          map[string]float64 `protobuf:"bytes,2,rep,name=cpu_percents,json=cpuPercents,proto3" json:"cpu_percents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	MemoryPercents       map[string]float64 `protobuf:"bytes,3,rep,name=memory_percents,json=memoryPercents,proto3" json:"memory_percents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	Verifications        map[string]bool    `protobuf:"bytes,4,rep,name=verifications,proto3" json:"verifications,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Details              map[string]string  `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	StoragePercents      map[string]float64 `protobuf:"bytes,6,rep,name=storage_percents,json=storagePercents,proto3" json:"storage_percents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Name                     string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CpuPercents              map[string]float64 `protobuf:"bytes,2,rep,name=cpu_percents,json=cpuPercents,proto3" json:"cpu_percents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	MemoryPercents           map[string]float64 `protobuf:"bytes,3,rep,name=memory_percents,json=memoryPercents,proto3" json:"memory_percents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Verifications            map[string]bool    `protobuf:"bytes,4,rep,name=verifications,proto3" json:"verifications,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Details                  map[string]string  `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StoragePercents          map[string]float64 `protobuf:"bytes,6,rep,name=storage_percents,json=storagePercents,proto3" json:"storage_percents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	CpuOvercommitPercents    map[string]float64 `protobuf:"bytes,7,rep,name=cpu_overcommit_percents,json=cpuOvercommitPercents,proto3" json:"cpu_overcommit_percents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	MemoryOvercommitPercents map[string]float64 `protobuf:"bytes,8,rep,name=memory_overcommit_percents,json=memoryOvercommitPercents,proto3" json:"memory_overcommit_percents,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral     struct{}           `json:"-"`
+	XXX_unrecognized         []byte             `json:"-"`
+	XXX_sizecache            int32              `json:"-"`
 }
 
 func (m *PodResource) Reset()         { *m = PodResource{} }
@@ -292,17 +318,34 @@ func (m *PodResource) GetStoragePercents() map[string]float64 {
 	return nil
 }
 
+func (m *PodResource) GetCpuOvercommitPercents() map[string]float64 {
+	if m != nil {
+		return m.CpuOvercommitPercents
+	}
+	return nil
+}
+
+func (m *PodResource) GetMemoryOvercommitPercents() map[string]float64 {
+	if m != nil {
+		return m.MemoryOvercommitPercents
+	}
+	return nil
+}
+
 type NodeResource struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	CpuPercent           float64  `protobuf:"fixed64,2,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
-	MemoryPercent        float64  `protobuf:"fixed64,3,opt,name=memory_percent,json=memoryPercent,proto3" json:"memory_percent,omitempty"`
-	Verification         bool     `protobuf:"varint,4,opt,name=verification,proto3" json:"verification,omitempty"`
-	Details              []string `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty"`
-	StoragePercent       float64  `protobuf:"fixed64,6,opt,name=storage_percent,json=storagePercent,proto3" json:"storage_percent,omitempty"`
-	VolumePercent        float64  `protobuf:"fixed64,7,opt,name=volume_percent,json=volumePercent,proto3" json:"volume_percent,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Name                    string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CpuPercent              float64  `protobuf:"fixed64,2,opt,name=cpu_percent,json=cpuPercent,proto3" json:"cpu_percent,omitempty"`
+	MemoryPercent           float64  `protobuf:"fixed64,3,opt,name=memory_percent,json=memoryPercent,proto3" json:"memory_percent,omitempty"`
+	Verification            bool     `protobuf:"varint,4,opt,name=verification,proto3" json:"verification,omitempty"`
+	Details                 []string `protobuf:"bytes,5,rep,name=details,proto3" json:"details,omitempty"`
+	StoragePercent          float64  `protobuf:"fixed64,6,opt,name=storage_percent,json=storagePercent,proto3" json:"storage_percent,omitempty"`
+	VolumePercent           float64  `protobuf:"fixed64,7,opt,name=volume_percent,json=volumePercent,proto3" json:"volume_percent,omitempty"`
+	CpuOvercommitPercent    float64  `protobuf:"fixed64,8,opt,name=cpu_overcommit_percent,json=cpuOvercommitPercent,proto3" json:"cpu_overcommit_percent,omitempty"`
+	MemoryOvercommitPercent float64  `protobuf:"fixed64,9,opt,name=memory_overcommit_percent,json=memoryOvercommitPercent,proto3" json:"memory_overcommit_percent,omitempty"`
+	BandwidthPercent        float64  `protobuf:"fixed64,10,opt,name=bandwidth_percent,json=bandwidthPercent,proto3" json:"bandwidth_percent,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
 }
 
 func (m *NodeResource) Reset()         { *m = NodeResource{} }
@@ -379,6 +422,137 @@ func (m *NodeResource) GetVolumePercent() float64 {
 	return 0
 }
 
+func (m *NodeResource) GetCpuOvercommitPercent() float64 {
+	if m != nil {
+		return m.CpuOvercommitPercent
+	}
+	return 0
+}
+
+func (m *NodeResource) GetMemoryOvercommitPercent() float64 {
+	if m != nil {
+		return m.MemoryOvercommitPercent
+	}
+	return 0
+}
+
+func (m *NodeResource) GetBandwidthPercent() float64 {
+	if m != nil {
+		return m.BandwidthPercent
+	}
+	return 0
+}
+
+type FixNodeResourceOptions struct {
+	Nodename             string   `protobuf:"bytes,1,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	DryRun               bool     `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FixNodeResourceOptions) Reset()         { *m = FixNodeResourceOptions{} }
+func (m *FixNodeResourceOptions) String() string { return proto.CompactTextString(m) }
+func (*FixNodeResourceOptions) ProtoMessage()    {}
+func (*FixNodeResourceOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{6}
+}
+
+func (m *FixNodeResourceOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FixNodeResourceOptions.Unmarshal(m, b)
+}
+func (m *FixNodeResourceOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FixNodeResourceOptions.Marshal(b, m, deterministic)
+}
+func (m *FixNodeResourceOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FixNodeResourceOptions.Merge(m, src)
+}
+func (m *FixNodeResourceOptions) XXX_Size() int {
+	return xxx_messageInfo_FixNodeResourceOptions.Size(m)
+}
+func (m *FixNodeResourceOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_FixNodeResourceOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FixNodeResourceOptions proto.InternalMessageInfo
+
+func (m *FixNodeResourceOptions) GetNodename() string {
+	if m != nil {
+		return m.Nodename
+	}
+	return ""
+}
+
+func (m *FixNodeResourceOptions) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type NodeResourceFix struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Diffs                []string `protobuf:"bytes,2,rep,name=diffs,proto3" json:"diffs,omitempty"`
+	MissingContainers    []string `protobuf:"bytes,3,rep,name=missing_containers,json=missingContainers,proto3" json:"missing_containers,omitempty"`
+	Fixed                bool     `protobuf:"varint,4,opt,name=fixed,proto3" json:"fixed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeResourceFix) Reset()         { *m = NodeResourceFix{} }
+func (m *NodeResourceFix) String() string { return proto.CompactTextString(m) }
+func (*NodeResourceFix) ProtoMessage()    {}
+func (*NodeResourceFix) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{7}
+}
+
+func (m *NodeResourceFix) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeResourceFix.Unmarshal(m, b)
+}
+func (m *NodeResourceFix) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeResourceFix.Marshal(b, m, deterministic)
+}
+func (m *NodeResourceFix) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeResourceFix.Merge(m, src)
+}
+func (m *NodeResourceFix) XXX_Size() int {
+	return xxx_messageInfo_NodeResourceFix.Size(m)
+}
+func (m *NodeResourceFix) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeResourceFix.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeResourceFix proto.InternalMessageInfo
+
+func (m *NodeResourceFix) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NodeResourceFix) GetDiffs() []string {
+	if m != nil {
+		return m.Diffs
+	}
+	return nil
+}
+
+func (m *NodeResourceFix) GetMissingContainers() []string {
+	if m != nil {
+		return m.MissingContainers
+	}
+	return nil
+}
+
+func (m *NodeResourceFix) GetFixed() bool {
+	if m != nil {
+		return m.Fixed
+	}
+	return false
+}
+
 type ListNetworkOptions struct {
 	Podname              string   `protobuf:"bytes,1,opt,name=podname,proto3" json:"podname,omitempty"`
 	Driver               string   `protobuf:"bytes,2,opt,name=driver,proto3" json:"driver,omitempty"`
@@ -391,7 +565,7 @@ func (m *ListNetworkOptions) Reset()         { *m = ListNetworkOptions{} }
 func (m *ListNetworkOptions) String() string { return proto.CompactTextString(m) }
 func (*ListNetworkOptions) ProtoMessage()    {}
 func (*ListNetworkOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{6}
+	return fileDescriptor_f7e43720d1edc0fe, []int{8}
 }
 
 func (m *ListNetworkOptions) XXX_Unmarshal(b []byte) error {
@@ -438,7 +612,7 @@ func (m *Network) Reset()         { *m = Network{} }
 func (m *Network) String() string { return proto.CompactTextString(m) }
 func (*Network) ProtoMessage()    {}
 func (*Network) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{7}
+	return fileDescriptor_f7e43720d1edc0fe, []int{9}
 }
 
 func (m *Network) XXX_Unmarshal(b []byte) error {
@@ -484,7 +658,7 @@ func (m *Networks) Reset()         { *m = Networks{} }
 func (m *Networks) String() string { return proto.CompactTextString(m) }
 func (*Networks) ProtoMessage()    {}
 func (*Networks) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{8}
+	return fileDescriptor_f7e43720d1edc0fe, []int{10}
 }
 
 func (m *Networks) XXX_Unmarshal(b []byte) error {
@@ -513,36 +687,41 @@ func (m *Networks) GetNetworks() []*Network {
 }
 
 type Node struct {
-	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Endpoint             string            `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Podname              string            `protobuf:"bytes,3,opt,name=podname,proto3" json:"podname,omitempty"`
-	Cpu                  map[string]int32  `protobuf:"bytes,4,rep,name=cpu,proto3" json:"cpu,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	CpuUsed              float64           `protobuf:"fixed64,5,opt,name=cpu_used,json=cpuUsed,proto3" json:"cpu_used,omitempty"`
-	Memory               int64             `protobuf:"varint,6,opt,name=memory,proto3" json:"memory,omitempty"`
-	MemoryUsed           int64             `protobuf:"varint,7,opt,name=memory_used,json=memoryUsed,proto3" json:"memory_used,omitempty"`
-	Available            bool              `protobuf:"varint,8,opt,name=available,proto3" json:"available,omitempty"`
-	Labels               map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	InitMemory           int64             `protobuf:"varint,10,opt,name=init_memory,json=initMemory,proto3" json:"init_memory,omitempty"`
-	InitCpu              map[string]int32  `protobuf:"bytes,11,rep,name=init_cpu,json=initCpu,proto3" json:"init_cpu,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Info                 string            `protobuf:"bytes,12,opt,name=info,proto3" json:"info,omitempty"`
-	Numa                 map[string]string `protobuf:"bytes,13,rep,name=numa,proto3" json:"numa,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	NumaMemory           map[string]int64  `protobuf:"bytes,14,rep,name=numa_memory,json=numaMemory,proto3" json:"numa_memory,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Storage              int64             `protobuf:"varint,15,opt,name=storage,proto3" json:"storage,omitempty"`
-	StorageUsed          int64             `protobuf:"varint,16,opt,name=storage_used,json=storageUsed,proto3" json:"storage_used,omitempty"`
-	InitStorage          int64             `protobuf:"varint,17,opt,name=init_storage,json=initStorage,proto3" json:"init_storage,omitempty"`
-	InitVolume           map[string]int64  `protobuf:"bytes,18,rep,name=init_volume,json=initVolume,proto3" json:"init_volume,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Volume               map[string]int64  `protobuf:"bytes,19,rep,name=volume,proto3" json:"volume,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	VolumeUsed           int64             `protobuf:"varint,20,opt,name=volume_used,json=volumeUsed,proto3" json:"volume_used,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Name                 string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Endpoint             string              `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Podname              string              `protobuf:"bytes,3,opt,name=podname,proto3" json:"podname,omitempty"`
+	Cpu                  map[string]int32    `protobuf:"bytes,4,rep,name=cpu,proto3" json:"cpu,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	CpuUsed              float64             `protobuf:"fixed64,5,opt,name=cpu_used,json=cpuUsed,proto3" json:"cpu_used,omitempty"`
+	Memory               int64               `protobuf:"varint,6,opt,name=memory,proto3" json:"memory,omitempty"`
+	MemoryUsed           int64               `protobuf:"varint,7,opt,name=memory_used,json=memoryUsed,proto3" json:"memory_used,omitempty"`
+	Available            bool                `protobuf:"varint,8,opt,name=available,proto3" json:"available,omitempty"`
+	Labels               map[string]string   `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	InitMemory           int64               `protobuf:"varint,10,opt,name=init_memory,json=initMemory,proto3" json:"init_memory,omitempty"`
+	InitCpu              map[string]int32    `protobuf:"bytes,11,rep,name=init_cpu,json=initCpu,proto3" json:"init_cpu,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Info                 string              `protobuf:"bytes,12,opt,name=info,proto3" json:"info,omitempty"`
+	Numa                 map[string]string   `protobuf:"bytes,13,rep,name=numa,proto3" json:"numa,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NumaMemory           map[string]int64    `protobuf:"bytes,14,rep,name=numa_memory,json=numaMemory,proto3" json:"numa_memory,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Storage              int64               `protobuf:"varint,15,opt,name=storage,proto3" json:"storage,omitempty"`
+	StorageUsed          int64               `protobuf:"varint,16,opt,name=storage_used,json=storageUsed,proto3" json:"storage_used,omitempty"`
+	InitStorage          int64               `protobuf:"varint,17,opt,name=init_storage,json=initStorage,proto3" json:"init_storage,omitempty"`
+	InitVolume           map[string]int64    `protobuf:"bytes,18,rep,name=init_volume,json=initVolume,proto3" json:"init_volume,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Volume               map[string]int64    `protobuf:"bytes,19,rep,name=volume,proto3" json:"volume,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	VolumeUsed           int64               `protobuf:"varint,20,opt,name=volume_used,json=volumeUsed,proto3" json:"volume_used,omitempty"`
+	InitDevices          map[string]*Devices `protobuf:"bytes,21,rep,name=init_devices,json=initDevices,proto3" json:"init_devices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Devices              map[string]*Devices `protobuf:"bytes,22,rep,name=devices,proto3" json:"devices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Bandwidth            int64               `protobuf:"varint,23,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	InitBandwidth        int64               `protobuf:"varint,24,opt,name=init_bandwidth,json=initBandwidth,proto3" json:"init_bandwidth,omitempty"`
+	Unreachable          bool                `protobuf:"varint,25,opt,name=unreachable,proto3" json:"unreachable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{9}
+	return fileDescriptor_f7e43720d1edc0fe, []int{11}
 }
 
 func (m *Node) XXX_Unmarshal(b []byte) error {
@@ -703,6 +882,41 @@ func (m *Node) GetVolumeUsed() int64 {
 	return 0
 }
 
+func (m *Node) GetInitDevices() map[string]*Devices {
+	if m != nil {
+		return m.InitDevices
+	}
+	return nil
+}
+
+func (m *Node) GetDevices() map[string]*Devices {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *Node) GetBandwidth() int64 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+func (m *Node) GetInitBandwidth() int64 {
+	if m != nil {
+		return m.InitBandwidth
+	}
+	return 0
+}
+
+func (m *Node) GetUnreachable() bool {
+	if m != nil {
+		return m.Unreachable
+	}
+	return false
+}
+
 type Nodes struct {
 	Nodes                []*Node  `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -714,7 +928,7 @@ func (m *Nodes) Reset()         { *m = Nodes{} }
 func (m *Nodes) String() string { return proto.CompactTextString(m) }
 func (*Nodes) ProtoMessage()    {}
 func (*Nodes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{10}
+	return fileDescriptor_f7e43720d1edc0fe, []int{12}
 }
 
 func (m *Nodes) XXX_Unmarshal(b []byte) error {
@@ -754,7 +968,7 @@ func (m *NodeAvailable) Reset()         { *m = NodeAvailable{} }
 func (m *NodeAvailable) String() string { return proto.CompactTextString(m) }
 func (*NodeAvailable) ProtoMessage()    {}
 func (*NodeAvailable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{11}
+	return fileDescriptor_f7e43720d1edc0fe, []int{13}
 }
 
 func (m *NodeAvailable) XXX_Unmarshal(b []byte) error {
@@ -790,25 +1004,27 @@ func (m *NodeAvailable) GetPodname() string {
 }
 
 type SetNodeOptions struct {
-	Nodename             string            `protobuf:"bytes,1,opt,name=nodename,proto3" json:"nodename,omitempty"`
-	Status               int32             `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	DeltaCpu             map[string]int32  `protobuf:"bytes,3,rep,name=delta_cpu,json=deltaCpu,proto3" json:"delta_cpu,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	DeltaMemory          int64             `protobuf:"varint,4,opt,name=delta_memory,json=deltaMemory,proto3" json:"delta_memory,omitempty"`
-	DeltaStorage         int64             `protobuf:"varint,5,opt,name=delta_storage,json=deltaStorage,proto3" json:"delta_storage,omitempty"`
-	DeltaNumaMemory      map[string]int64  `protobuf:"bytes,6,rep,name=delta_numa_memory,json=deltaNumaMemory,proto3" json:"delta_numa_memory,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Numa                 map[string]string `protobuf:"bytes,7,rep,name=numa,proto3" json:"numa,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Labels               map[string]string `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	DeltaVolume          map[string]int64  `protobuf:"bytes,9,rep,name=delta_volume,json=deltaVolume,proto3" json:"delta_volume,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Nodename             string              `protobuf:"bytes,1,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	Status               int32               `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	DeltaCpu             map[string]int32    `protobuf:"bytes,3,rep,name=delta_cpu,json=deltaCpu,proto3" json:"delta_cpu,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	DeltaMemory          int64               `protobuf:"varint,4,opt,name=delta_memory,json=deltaMemory,proto3" json:"delta_memory,omitempty"`
+	DeltaStorage         int64               `protobuf:"varint,5,opt,name=delta_storage,json=deltaStorage,proto3" json:"delta_storage,omitempty"`
+	DeltaNumaMemory      map[string]int64    `protobuf:"bytes,6,rep,name=delta_numa_memory,json=deltaNumaMemory,proto3" json:"delta_numa_memory,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Numa                 map[string]string   `protobuf:"bytes,7,rep,name=numa,proto3" json:"numa,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Labels               map[string]string   `protobuf:"bytes,8,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DeltaVolume          map[string]int64    `protobuf:"bytes,9,rep,name=delta_volume,json=deltaVolume,proto3" json:"delta_volume,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Devices              map[string]*Devices `protobuf:"bytes,10,rep,name=devices,proto3" json:"devices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DeltaBandwidth       int64               `protobuf:"varint,11,opt,name=delta_bandwidth,json=deltaBandwidth,proto3" json:"delta_bandwidth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *SetNodeOptions) Reset()         { *m = SetNodeOptions{} }
 func (m *SetNodeOptions) String() string { return proto.CompactTextString(m) }
 func (*SetNodeOptions) ProtoMessage()    {}
 func (*SetNodeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{12}
+	return fileDescriptor_f7e43720d1edc0fe, []int{14}
 }
 
 func (m *SetNodeOptions) XXX_Unmarshal(b []byte) error {
@@ -892,32 +1108,161 @@ func (m *SetNodeOptions) GetDeltaVolume() map[string]int64 {
 	return nil
 }
 
+func (m *SetNodeOptions) GetDevices() map[string]*Devices {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *SetNodeOptions) GetDeltaBandwidth() int64 {
+	if m != nil {
+		return m.DeltaBandwidth
+	}
+	return 0
+}
+
+type DrainNodeOptions struct {
+	Nodename             string   `protobuf:"bytes,1,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	CordonOnly           bool     `protobuf:"varint,2,opt,name=cordon_only,json=cordonOnly,proto3" json:"cordon_only,omitempty"`
+	DryRun               bool     `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainNodeOptions) Reset()         { *m = DrainNodeOptions{} }
+func (m *DrainNodeOptions) String() string { return proto.CompactTextString(m) }
+func (*DrainNodeOptions) ProtoMessage()    {}
+func (*DrainNodeOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{15}
+}
+
+func (m *DrainNodeOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainNodeOptions.Unmarshal(m, b)
+}
+func (m *DrainNodeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainNodeOptions.Marshal(b, m, deterministic)
+}
+func (m *DrainNodeOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainNodeOptions.Merge(m, src)
+}
+func (m *DrainNodeOptions) XXX_Size() int {
+	return xxx_messageInfo_DrainNodeOptions.Size(m)
+}
+func (m *DrainNodeOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainNodeOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainNodeOptions proto.InternalMessageInfo
+
+func (m *DrainNodeOptions) GetNodename() string {
+	if m != nil {
+		return m.Nodename
+	}
+	return ""
+}
+
+func (m *DrainNodeOptions) GetCordonOnly() bool {
+	if m != nil {
+		return m.CordonOnly
+	}
+	return false
+}
+
+func (m *DrainNodeOptions) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type AuditOptions struct {
+	Podname              string   `protobuf:"bytes,1,opt,name=podname,proto3" json:"podname,omitempty"`
+	OrphanAction         string   `protobuf:"bytes,2,opt,name=orphan_action,json=orphanAction,proto3" json:"orphan_action,omitempty"`
+	GhostAction          string   `protobuf:"bytes,3,opt,name=ghost_action,json=ghostAction,proto3" json:"ghost_action,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditOptions) Reset()         { *m = AuditOptions{} }
+func (m *AuditOptions) String() string { return proto.CompactTextString(m) }
+func (*AuditOptions) ProtoMessage()    {}
+func (*AuditOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{16}
+}
+
+func (m *AuditOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditOptions.Unmarshal(m, b)
+}
+func (m *AuditOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditOptions.Marshal(b, m, deterministic)
+}
+func (m *AuditOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditOptions.Merge(m, src)
+}
+func (m *AuditOptions) XXX_Size() int {
+	return xxx_messageInfo_AuditOptions.Size(m)
+}
+func (m *AuditOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditOptions proto.InternalMessageInfo
+
+func (m *AuditOptions) GetPodname() string {
+	if m != nil {
+		return m.Podname
+	}
+	return ""
+}
+
+func (m *AuditOptions) GetOrphanAction() string {
+	if m != nil {
+		return m.OrphanAction
+	}
+	return ""
+}
+
+func (m *AuditOptions) GetGhostAction() string {
+	if m != nil {
+		return m.GhostAction
+	}
+	return ""
+}
+
 type Container struct {
-	Id                   string             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Podname              string             `protobuf:"bytes,2,opt,name=podname,proto3" json:"podname,omitempty"`
-	Nodename             string             `protobuf:"bytes,3,opt,name=nodename,proto3" json:"nodename,omitempty"`
-	Name                 string             `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Cpu                  map[string]int32   `protobuf:"bytes,5,rep,name=cpu,proto3" json:"cpu,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Quota                float64            `protobuf:"fixed64,6,opt,name=quota,proto3" json:"quota,omitempty"`
-	Memory               int64              `protobuf:"varint,7,opt,name=memory,proto3" json:"memory,omitempty"`
-	Privileged           bool               `protobuf:"varint,8,opt,name=privileged,proto3" json:"privileged,omitempty"`
-	Labels               map[string]string  `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Publish              map[string]string  `protobuf:"bytes,10,rep,name=publish,proto3" json:"publish,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Image                string             `protobuf:"bytes,11,opt,name=image,proto3" json:"image,omitempty"`
-	Storage              int64              `protobuf:"varint,12,opt,name=storage,proto3" json:"storage,omitempty"`
-	Status               *ContainerStatus   `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
-	Volumes              []string           `protobuf:"bytes,14,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	VolumePlan           map[string]*Volume `protobuf:"bytes,15,rep,name=volume_plan,json=volumePlan,proto3" json:"volume_plan,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Id                   string              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Podname              string              `protobuf:"bytes,2,opt,name=podname,proto3" json:"podname,omitempty"`
+	Nodename             string              `protobuf:"bytes,3,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	Name                 string              `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Cpu                  map[string]int32    `protobuf:"bytes,5,rep,name=cpu,proto3" json:"cpu,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Quota                float64             `protobuf:"fixed64,6,opt,name=quota,proto3" json:"quota,omitempty"`
+	Memory               int64               `protobuf:"varint,7,opt,name=memory,proto3" json:"memory,omitempty"`
+	Privileged           bool                `protobuf:"varint,8,opt,name=privileged,proto3" json:"privileged,omitempty"`
+	Labels               map[string]string   `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Publish              map[string]string   `protobuf:"bytes,10,rep,name=publish,proto3" json:"publish,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Image                string              `protobuf:"bytes,11,opt,name=image,proto3" json:"image,omitempty"`
+	Storage              int64               `protobuf:"varint,12,opt,name=storage,proto3" json:"storage,omitempty"`
+	Status               *ContainerStatus    `protobuf:"bytes,13,opt,name=status,proto3" json:"status,omitempty"`
+	Volumes              []string            `protobuf:"bytes,14,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	VolumePlan           map[string]*Volume  `protobuf:"bytes,15,rep,name=volume_plan,json=volumePlan,proto3" json:"volume_plan,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Priority             int32               `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`
+	Devices              map[string]*Devices `protobuf:"bytes,17,rep,name=devices,proto3" json:"devices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Bandwidth            int64               `protobuf:"varint,18,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	SecretEnv            map[string]string   `protobuf:"bytes,19,rep,name=secret_env,json=secretEnv,proto3" json:"secret_env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SecretData           map[string]string   `protobuf:"bytes,20,rep,name=secret_data,json=secretData,proto3" json:"secret_data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
 func (m *Container) Reset()         { *m = Container{} }
 func (m *Container) String() string { return proto.CompactTextString(m) }
 func (*Container) ProtoMessage()    {}
 func (*Container) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{13}
+	return fileDescriptor_f7e43720d1edc0fe, []int{17}
 }
 
 func (m *Container) XXX_Unmarshal(b []byte) error {
@@ -1043,14 +1388,49 @@ func (m *Container) GetVolumePlan() map[string]*Volume {
 	return nil
 }
 
-type ContainerStatus struct {
-	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Running              bool              `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
-	Healthy              bool              `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
-	Networks             map[string]string `protobuf:"bytes,4,rep,name=networks,proto3" json:"networks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Extension            []byte            `protobuf:"bytes,5,opt,name=extension,proto3" json:"extension,omitempty"`
-	Ttl                  int64             `protobuf:"varint,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+func (m *Container) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *Container) GetDevices() map[string]*Devices {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *Container) GetBandwidth() int64 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+func (m *Container) GetSecretEnv() map[string]string {
+	if m != nil {
+		return m.SecretEnv
+	}
+	return nil
+}
+
+func (m *Container) GetSecretData() map[string]string {
+	if m != nil {
+		return m.SecretData
+	}
+	return nil
+}
+
+type ContainerStatus struct {
+	Id                   string            `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Running              bool              `protobuf:"varint,2,opt,name=running,proto3" json:"running,omitempty"`
+	Healthy              bool              `protobuf:"varint,3,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	Networks             map[string]string `protobuf:"bytes,4,rep,name=networks,proto3" json:"networks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Extension            []byte            `protobuf:"bytes,5,opt,name=extension,proto3" json:"extension,omitempty"`
+	Ttl                  int64             `protobuf:"varint,6,opt,name=ttl,proto3" json:"ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
@@ -1059,7 +1439,7 @@ func (m *ContainerStatus) Reset()         { *m = ContainerStatus{} }
 func (m *ContainerStatus) String() string { return proto.CompactTextString(m) }
 func (*ContainerStatus) ProtoMessage()    {}
 func (*ContainerStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{14}
+	return fileDescriptor_f7e43720d1edc0fe, []int{18}
 }
 
 func (m *ContainerStatus) XXX_Unmarshal(b []byte) error {
@@ -1133,7 +1513,7 @@ func (m *ContainersStatus) Reset()         { *m = ContainersStatus{} }
 func (m *ContainersStatus) String() string { return proto.CompactTextString(m) }
 func (*ContainersStatus) ProtoMessage()    {}
 func (*ContainersStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{15}
+	return fileDescriptor_f7e43720d1edc0fe, []int{19}
 }
 
 func (m *ContainersStatus) XXX_Unmarshal(b []byte) error {
@@ -1176,7 +1556,7 @@ func (m *ContainerStatusStreamMessage) Reset()         { *m = ContainerStatusStr
 func (m *ContainerStatusStreamMessage) String() string { return proto.CompactTextString(m) }
 func (*ContainerStatusStreamMessage) ProtoMessage()    {}
 func (*ContainerStatusStreamMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{16}
+	return fileDescriptor_f7e43720d1edc0fe, []int{20}
 }
 
 func (m *ContainerStatusStreamMessage) XXX_Unmarshal(b []byte) error {
@@ -1243,7 +1623,7 @@ func (m *SetContainersStatusOptions) Reset()         { *m = SetContainersStatusO
 func (m *SetContainersStatusOptions) String() string { return proto.CompactTextString(m) }
 func (*SetContainersStatusOptions) ProtoMessage()    {}
 func (*SetContainersStatusOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{17}
+	return fileDescriptor_f7e43720d1edc0fe, []int{21}
 }
 
 func (m *SetContainersStatusOptions) XXX_Unmarshal(b []byte) error {
@@ -1276,6 +1656,7 @@ type ContainerStatusStreamOptions struct {
 	Entrypoint           string            `protobuf:"bytes,2,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
 	Nodename             string            `protobuf:"bytes,3,opt,name=nodename,proto3" json:"nodename,omitempty"`
 	Labels               map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Selector             string            `protobuf:"bytes,5,opt,name=selector,proto3" json:"selector,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1285,7 +1666,7 @@ func (m *ContainerStatusStreamOptions) Reset()         { *m = ContainerStatusStr
 func (m *ContainerStatusStreamOptions) String() string { return proto.CompactTextString(m) }
 func (*ContainerStatusStreamOptions) ProtoMessage()    {}
 func (*ContainerStatusStreamOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{18}
+	return fileDescriptor_f7e43720d1edc0fe, []int{22}
 }
 
 func (m *ContainerStatusStreamOptions) XXX_Unmarshal(b []byte) error {
@@ -1334,583 +1715,1295 @@ func (m *ContainerStatusStreamOptions) GetLabels() map[string]string {
 	return nil
 }
 
-type Containers struct {
-	Containers           []*Container `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *ContainerStatusStreamOptions) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
 }
 
-func (m *Containers) Reset()         { *m = Containers{} }
-func (m *Containers) String() string { return proto.CompactTextString(m) }
-func (*Containers) ProtoMessage()    {}
-func (*Containers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{19}
+type WatchEventsOptions struct {
+	Kinds                []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	Appname              string   `protobuf:"bytes,2,opt,name=appname,proto3" json:"appname,omitempty"`
+	Podname              string   `protobuf:"bytes,3,opt,name=podname,proto3" json:"podname,omitempty"`
+	Nodename             string   `protobuf:"bytes,4,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	Since                int64    `protobuf:"varint,5,opt,name=since,proto3" json:"since,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Containers) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Containers.Unmarshal(m, b)
+func (m *WatchEventsOptions) Reset()         { *m = WatchEventsOptions{} }
+func (m *WatchEventsOptions) String() string { return proto.CompactTextString(m) }
+func (*WatchEventsOptions) ProtoMessage()    {}
+func (*WatchEventsOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{23}
 }
-func (m *Containers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Containers.Marshal(b, m, deterministic)
+
+func (m *WatchEventsOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEventsOptions.Unmarshal(m, b)
 }
-func (m *Containers) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Containers.Merge(m, src)
+func (m *WatchEventsOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEventsOptions.Marshal(b, m, deterministic)
 }
-func (m *Containers) XXX_Size() int {
-	return xxx_messageInfo_Containers.Size(m)
+func (m *WatchEventsOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEventsOptions.Merge(m, src)
 }
-func (m *Containers) XXX_DiscardUnknown() {
-	xxx_messageInfo_Containers.DiscardUnknown(m)
+func (m *WatchEventsOptions) XXX_Size() int {
+	return xxx_messageInfo_WatchEventsOptions.Size(m)
+}
+func (m *WatchEventsOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEventsOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Containers proto.InternalMessageInfo
+var xxx_messageInfo_WatchEventsOptions proto.InternalMessageInfo
 
-func (m *Containers) GetContainers() []*Container {
+func (m *WatchEventsOptions) GetKinds() []string {
 	if m != nil {
-		return m.Containers
+		return m.Kinds
 	}
 	return nil
 }
 
-type ContainerID struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+func (m *WatchEventsOptions) GetAppname() string {
+	if m != nil {
+		return m.Appname
+	}
+	return ""
+}
+
+func (m *WatchEventsOptions) GetPodname() string {
+	if m != nil {
+		return m.Podname
+	}
+	return ""
+}
+
+func (m *WatchEventsOptions) GetNodename() string {
+	if m != nil {
+		return m.Nodename
+	}
+	return ""
+}
+
+func (m *WatchEventsOptions) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+type Event struct {
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Action               string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	Target               string   `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	ProcessIdent         string   `protobuf:"bytes,4,opt,name=process_ident,json=processIdent,proto3" json:"process_ident,omitempty"`
+	Appname              string   `protobuf:"bytes,5,opt,name=appname,proto3" json:"appname,omitempty"`
+	Podname              string   `protobuf:"bytes,6,opt,name=podname,proto3" json:"podname,omitempty"`
+	Nodename             string   `protobuf:"bytes,7,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	Success              bool     `protobuf:"varint,8,opt,name=success,proto3" json:"success,omitempty"`
+	Error                string   `protobuf:"bytes,9,opt,name=error,proto3" json:"error,omitempty"`
+	Time                 int64    `protobuf:"varint,10,opt,name=time,proto3" json:"time,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ContainerID) Reset()         { *m = ContainerID{} }
-func (m *ContainerID) String() string { return proto.CompactTextString(m) }
-func (*ContainerID) ProtoMessage()    {}
-func (*ContainerID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{20}
+func (m *Event) Reset()         { *m = Event{} }
+func (m *Event) String() string { return proto.CompactTextString(m) }
+func (*Event) ProtoMessage()    {}
+func (*Event) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{24}
 }
 
-func (m *ContainerID) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ContainerID.Unmarshal(m, b)
+func (m *Event) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Event.Unmarshal(m, b)
 }
-func (m *ContainerID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ContainerID.Marshal(b, m, deterministic)
+func (m *Event) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Event.Marshal(b, m, deterministic)
 }
-func (m *ContainerID) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContainerID.Merge(m, src)
+func (m *Event) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Event.Merge(m, src)
 }
-func (m *ContainerID) XXX_Size() int {
-	return xxx_messageInfo_ContainerID.Size(m)
+func (m *Event) XXX_Size() int {
+	return xxx_messageInfo_Event.Size(m)
 }
-func (m *ContainerID) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContainerID.DiscardUnknown(m)
+func (m *Event) XXX_DiscardUnknown() {
+	xxx_messageInfo_Event.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ContainerID proto.InternalMessageInfo
+var xxx_messageInfo_Event proto.InternalMessageInfo
 
-func (m *ContainerID) GetId() string {
+func (m *Event) GetKind() string {
 	if m != nil {
-		return m.Id
+		return m.Kind
 	}
 	return ""
 }
 
-type ContainerIDs struct {
-	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Event) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
 }
 
-func (m *ContainerIDs) Reset()         { *m = ContainerIDs{} }
-func (m *ContainerIDs) String() string { return proto.CompactTextString(m) }
-func (*ContainerIDs) ProtoMessage()    {}
-func (*ContainerIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{21}
+func (m *Event) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
 }
 
-func (m *ContainerIDs) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ContainerIDs.Unmarshal(m, b)
+func (m *Event) GetProcessIdent() string {
+	if m != nil {
+		return m.ProcessIdent
+	}
+	return ""
 }
-func (m *ContainerIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ContainerIDs.Marshal(b, m, deterministic)
+
+func (m *Event) GetAppname() string {
+	if m != nil {
+		return m.Appname
+	}
+	return ""
 }
-func (m *ContainerIDs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ContainerIDs.Merge(m, src)
+
+func (m *Event) GetPodname() string {
+	if m != nil {
+		return m.Podname
+	}
+	return ""
 }
-func (m *ContainerIDs) XXX_Size() int {
-	return xxx_messageInfo_ContainerIDs.Size(m)
+
+func (m *Event) GetNodename() string {
+	if m != nil {
+		return m.Nodename
+	}
+	return ""
 }
-func (m *ContainerIDs) XXX_DiscardUnknown() {
-	xxx_messageInfo_ContainerIDs.DiscardUnknown(m)
+
+func (m *Event) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
 }
 
-var xxx_messageInfo_ContainerIDs proto.InternalMessageInfo
+func (m *Event) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
 
-func (m *ContainerIDs) GetIds() []string {
+func (m *Event) GetTime() int64 {
 	if m != nil {
-		return m.Ids
+		return m.Time
 	}
-	return nil
+	return 0
 }
 
-type RemoveContainerOptions struct {
-	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
-	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
-	Step                 int32    `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+type ListAuditLogsOptions struct {
+	// unix nanoseconds, inclusive, 0 for no lower bound
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	// unix nanoseconds, exclusive, 0 for no upper bound
+	End                  int64    `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
+	Username             string   `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Limit                int32    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveContainerOptions) Reset()         { *m = RemoveContainerOptions{} }
-func (m *RemoveContainerOptions) String() string { return proto.CompactTextString(m) }
-func (*RemoveContainerOptions) ProtoMessage()    {}
-func (*RemoveContainerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{22}
+func (m *ListAuditLogsOptions) Reset()         { *m = ListAuditLogsOptions{} }
+func (m *ListAuditLogsOptions) String() string { return proto.CompactTextString(m) }
+func (*ListAuditLogsOptions) ProtoMessage()    {}
+func (*ListAuditLogsOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{25}
 }
 
-func (m *RemoveContainerOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveContainerOptions.Unmarshal(m, b)
+func (m *ListAuditLogsOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAuditLogsOptions.Unmarshal(m, b)
 }
-func (m *RemoveContainerOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveContainerOptions.Marshal(b, m, deterministic)
+func (m *ListAuditLogsOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAuditLogsOptions.Marshal(b, m, deterministic)
 }
-func (m *RemoveContainerOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveContainerOptions.Merge(m, src)
+func (m *ListAuditLogsOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAuditLogsOptions.Merge(m, src)
 }
-func (m *RemoveContainerOptions) XXX_Size() int {
-	return xxx_messageInfo_RemoveContainerOptions.Size(m)
+func (m *ListAuditLogsOptions) XXX_Size() int {
+	return xxx_messageInfo_ListAuditLogsOptions.Size(m)
 }
-func (m *RemoveContainerOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveContainerOptions.DiscardUnknown(m)
+func (m *ListAuditLogsOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAuditLogsOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveContainerOptions proto.InternalMessageInfo
+var xxx_messageInfo_ListAuditLogsOptions proto.InternalMessageInfo
 
-func (m *RemoveContainerOptions) GetIds() []string {
+func (m *ListAuditLogsOptions) GetStart() int64 {
 	if m != nil {
-		return m.Ids
+		return m.Start
 	}
-	return nil
+	return 0
 }
 
-func (m *RemoveContainerOptions) GetForce() bool {
+func (m *ListAuditLogsOptions) GetEnd() int64 {
 	if m != nil {
-		return m.Force
+		return m.End
 	}
-	return false
+	return 0
 }
 
-func (m *RemoveContainerOptions) GetStep() int32 {
+func (m *ListAuditLogsOptions) GetUsername() string {
 	if m != nil {
-		return m.Step
+		return m.Username
+	}
+	return ""
+}
+
+func (m *ListAuditLogsOptions) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
 	}
 	return 0
 }
 
-type DissociateContainerOptions struct {
-	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+type AuditLog struct {
+	// unix nanoseconds
+	Time     int64  `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Method   string `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Peer     string `protobuf:"bytes,4,opt,name=peer,proto3" json:"peer,omitempty"`
+	Request  string `protobuf:"bytes,5,opt,name=request,proto3" json:"request,omitempty"`
+	// nanoseconds
+	Duration             int64    `protobuf:"varint,6,opt,name=duration,proto3" json:"duration,omitempty"`
+	Success              bool     `protobuf:"varint,7,opt,name=success,proto3" json:"success,omitempty"`
+	Error                string   `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DissociateContainerOptions) Reset()         { *m = DissociateContainerOptions{} }
-func (m *DissociateContainerOptions) String() string { return proto.CompactTextString(m) }
-func (*DissociateContainerOptions) ProtoMessage()    {}
-func (*DissociateContainerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{23}
+func (m *AuditLog) Reset()         { *m = AuditLog{} }
+func (m *AuditLog) String() string { return proto.CompactTextString(m) }
+func (*AuditLog) ProtoMessage()    {}
+func (*AuditLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{26}
 }
 
-func (m *DissociateContainerOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DissociateContainerOptions.Unmarshal(m, b)
+func (m *AuditLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLog.Unmarshal(m, b)
 }
-func (m *DissociateContainerOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DissociateContainerOptions.Marshal(b, m, deterministic)
+func (m *AuditLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLog.Marshal(b, m, deterministic)
 }
-func (m *DissociateContainerOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DissociateContainerOptions.Merge(m, src)
+func (m *AuditLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLog.Merge(m, src)
 }
-func (m *DissociateContainerOptions) XXX_Size() int {
-	return xxx_messageInfo_DissociateContainerOptions.Size(m)
+func (m *AuditLog) XXX_Size() int {
+	return xxx_messageInfo_AuditLog.Size(m)
 }
-func (m *DissociateContainerOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_DissociateContainerOptions.DiscardUnknown(m)
+func (m *AuditLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLog.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DissociateContainerOptions proto.InternalMessageInfo
+var xxx_messageInfo_AuditLog proto.InternalMessageInfo
 
-func (m *DissociateContainerOptions) GetIds() []string {
+func (m *AuditLog) GetTime() int64 {
 	if m != nil {
-		return m.Ids
+		return m.Time
 	}
-	return nil
-}
-
-type ReallocOptions struct {
-	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
-	Cpu                  float64  `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Memory               int64    `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
-	Volumes              []string `protobuf:"bytes,4,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	return 0
 }
 
-func (m *ReallocOptions) Reset()         { *m = ReallocOptions{} }
-func (m *ReallocOptions) String() string { return proto.CompactTextString(m) }
-func (*ReallocOptions) ProtoMessage()    {}
-func (*ReallocOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{24}
+func (m *AuditLog) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
 }
 
-func (m *ReallocOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReallocOptions.Unmarshal(m, b)
-}
-func (m *ReallocOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReallocOptions.Marshal(b, m, deterministic)
-}
-func (m *ReallocOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReallocOptions.Merge(m, src)
-}
-func (m *ReallocOptions) XXX_Size() int {
-	return xxx_messageInfo_ReallocOptions.Size(m)
-}
-func (m *ReallocOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReallocOptions.DiscardUnknown(m)
+func (m *AuditLog) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
 }
 
-var xxx_messageInfo_ReallocOptions proto.InternalMessageInfo
+func (m *AuditLog) GetPeer() string {
+	if m != nil {
+		return m.Peer
+	}
+	return ""
+}
 
-func (m *ReallocOptions) GetIds() []string {
+func (m *AuditLog) GetRequest() string {
 	if m != nil {
-		return m.Ids
+		return m.Request
 	}
-	return nil
+	return ""
 }
 
-func (m *ReallocOptions) GetCpu() float64 {
+func (m *AuditLog) GetDuration() int64 {
 	if m != nil {
-		return m.Cpu
+		return m.Duration
 	}
 	return 0
 }
 
-func (m *ReallocOptions) GetMemory() int64 {
+func (m *AuditLog) GetSuccess() bool {
 	if m != nil {
-		return m.Memory
+		return m.Success
 	}
-	return 0
+	return false
 }
 
-func (m *ReallocOptions) GetVolumes() []string {
+func (m *AuditLog) GetError() string {
 	if m != nil {
-		return m.Volumes
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-type AddPodOptions struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Desc                 string   `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type AuditLogs struct {
+	Logs                 []*AuditLog `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *AddPodOptions) Reset()         { *m = AddPodOptions{} }
-func (m *AddPodOptions) String() string { return proto.CompactTextString(m) }
-func (*AddPodOptions) ProtoMessage()    {}
-func (*AddPodOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{25}
+func (m *AuditLogs) Reset()         { *m = AuditLogs{} }
+func (m *AuditLogs) String() string { return proto.CompactTextString(m) }
+func (*AuditLogs) ProtoMessage()    {}
+func (*AuditLogs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{27}
 }
 
-func (m *AddPodOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddPodOptions.Unmarshal(m, b)
+func (m *AuditLogs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditLogs.Unmarshal(m, b)
 }
-func (m *AddPodOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddPodOptions.Marshal(b, m, deterministic)
+func (m *AuditLogs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditLogs.Marshal(b, m, deterministic)
 }
-func (m *AddPodOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddPodOptions.Merge(m, src)
+func (m *AuditLogs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditLogs.Merge(m, src)
 }
-func (m *AddPodOptions) XXX_Size() int {
-	return xxx_messageInfo_AddPodOptions.Size(m)
+func (m *AuditLogs) XXX_Size() int {
+	return xxx_messageInfo_AuditLogs.Size(m)
 }
-func (m *AddPodOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddPodOptions.DiscardUnknown(m)
+func (m *AuditLogs) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditLogs.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddPodOptions proto.InternalMessageInfo
-
-func (m *AddPodOptions) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
+var xxx_messageInfo_AuditLogs proto.InternalMessageInfo
 
-func (m *AddPodOptions) GetDesc() string {
+func (m *AuditLogs) GetLogs() []*AuditLog {
 	if m != nil {
-		return m.Desc
+		return m.Logs
 	}
-	return ""
+	return nil
 }
 
-type RemovePodOptions struct {
+type SetSecretOptions struct {
 	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Apps                 []string `protobuf:"bytes,3,rep,name=apps,proto3" json:"apps,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemovePodOptions) Reset()         { *m = RemovePodOptions{} }
-func (m *RemovePodOptions) String() string { return proto.CompactTextString(m) }
-func (*RemovePodOptions) ProtoMessage()    {}
-func (*RemovePodOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{26}
+func (m *SetSecretOptions) Reset()         { *m = SetSecretOptions{} }
+func (m *SetSecretOptions) String() string { return proto.CompactTextString(m) }
+func (*SetSecretOptions) ProtoMessage()    {}
+func (*SetSecretOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{28}
 }
 
-func (m *RemovePodOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemovePodOptions.Unmarshal(m, b)
+func (m *SetSecretOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetSecretOptions.Unmarshal(m, b)
 }
-func (m *RemovePodOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemovePodOptions.Marshal(b, m, deterministic)
+func (m *SetSecretOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetSecretOptions.Marshal(b, m, deterministic)
 }
-func (m *RemovePodOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemovePodOptions.Merge(m, src)
+func (m *SetSecretOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSecretOptions.Merge(m, src)
 }
-func (m *RemovePodOptions) XXX_Size() int {
-	return xxx_messageInfo_RemovePodOptions.Size(m)
+func (m *SetSecretOptions) XXX_Size() int {
+	return xxx_messageInfo_SetSecretOptions.Size(m)
 }
-func (m *RemovePodOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemovePodOptions.DiscardUnknown(m)
+func (m *SetSecretOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSecretOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemovePodOptions proto.InternalMessageInfo
+var xxx_messageInfo_SetSecretOptions proto.InternalMessageInfo
 
-func (m *RemovePodOptions) GetName() string {
+func (m *SetSecretOptions) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-type GetPodOptions struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GetPodOptions) Reset()         { *m = GetPodOptions{} }
-func (m *GetPodOptions) String() string { return proto.CompactTextString(m) }
-func (*GetPodOptions) ProtoMessage()    {}
-func (*GetPodOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{27}
-}
-
-func (m *GetPodOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPodOptions.Unmarshal(m, b)
-}
-func (m *GetPodOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPodOptions.Marshal(b, m, deterministic)
-}
-func (m *GetPodOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPodOptions.Merge(m, src)
-}
-func (m *GetPodOptions) XXX_Size() int {
-	return xxx_messageInfo_GetPodOptions.Size(m)
-}
-func (m *GetPodOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPodOptions.DiscardUnknown(m)
+func (m *SetSecretOptions) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetPodOptions proto.InternalMessageInfo
-
-func (m *GetPodOptions) GetName() string {
+func (m *SetSecretOptions) GetApps() []string {
 	if m != nil {
-		return m.Name
+		return m.Apps
 	}
-	return ""
+	return nil
 }
 
-type AddNodeOptions struct {
-	Nodename             string            `protobuf:"bytes,1,opt,name=nodename,proto3" json:"nodename,omitempty"`
-	Endpoint             string            `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
-	Podname              string            `protobuf:"bytes,3,opt,name=podname,proto3" json:"podname,omitempty"`
-	Ca                   string            `protobuf:"bytes,4,opt,name=ca,proto3" json:"ca,omitempty"`
-	Cert                 string            `protobuf:"bytes,5,opt,name=cert,proto3" json:"cert,omitempty"`
-	Key                  string            `protobuf:"bytes,6,opt,name=key,proto3" json:"key,omitempty"`
-	Cpu                  int32             `protobuf:"varint,7,opt,name=cpu,proto3" json:"cpu,omitempty"`
-	Share                int32             `protobuf:"varint,8,opt,name=share,proto3" json:"share,omitempty"`
-	Memory               int64             `protobuf:"varint,9,opt,name=memory,proto3" json:"memory,omitempty"`
-	Labels               map[string]string `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Numa                 map[string]string `protobuf:"bytes,11,rep,name=numa,proto3" json:"numa,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	NumaMemory           map[string]int64  `protobuf:"bytes,12,rep,name=numa_memory,json=numaMemory,proto3" json:"numa_memory,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Storage              int64             `protobuf:"varint,13,opt,name=storage,proto3" json:"storage,omitempty"`
-	VolumeMap            map[string]int64  `protobuf:"bytes,14,rep,name=volume_map,json=volumeMap,proto3" json:"volume_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type Secret struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Apps                 []string `protobuf:"bytes,2,rep,name=apps,proto3" json:"apps,omitempty"`
+	Version              int64    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	UpdatedAt            int64    `protobuf:"varint,4,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddNodeOptions) Reset()         { *m = AddNodeOptions{} }
-func (m *AddNodeOptions) String() string { return proto.CompactTextString(m) }
-func (*AddNodeOptions) ProtoMessage()    {}
-func (*AddNodeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{28}
+func (m *Secret) Reset()         { *m = Secret{} }
+func (m *Secret) String() string { return proto.CompactTextString(m) }
+func (*Secret) ProtoMessage()    {}
+func (*Secret) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{29}
 }
 
-func (m *AddNodeOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AddNodeOptions.Unmarshal(m, b)
+func (m *Secret) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Secret.Unmarshal(m, b)
 }
-func (m *AddNodeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AddNodeOptions.Marshal(b, m, deterministic)
+func (m *Secret) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Secret.Marshal(b, m, deterministic)
 }
-func (m *AddNodeOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AddNodeOptions.Merge(m, src)
+func (m *Secret) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Secret.Merge(m, src)
 }
-func (m *AddNodeOptions) XXX_Size() int {
-	return xxx_messageInfo_AddNodeOptions.Size(m)
+func (m *Secret) XXX_Size() int {
+	return xxx_messageInfo_Secret.Size(m)
 }
-func (m *AddNodeOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_AddNodeOptions.DiscardUnknown(m)
+func (m *Secret) XXX_DiscardUnknown() {
+	xxx_messageInfo_Secret.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AddNodeOptions proto.InternalMessageInfo
+var xxx_messageInfo_Secret proto.InternalMessageInfo
 
-func (m *AddNodeOptions) GetNodename() string {
+func (m *Secret) GetName() string {
 	if m != nil {
-		return m.Nodename
+		return m.Name
 	}
 	return ""
 }
 
-func (m *AddNodeOptions) GetEndpoint() string {
+func (m *Secret) GetApps() []string {
 	if m != nil {
-		return m.Endpoint
+		return m.Apps
 	}
-	return ""
+	return nil
 }
 
-func (m *AddNodeOptions) GetPodname() string {
+func (m *Secret) GetVersion() int64 {
 	if m != nil {
-		return m.Podname
+		return m.Version
 	}
-	return ""
+	return 0
 }
 
-func (m *AddNodeOptions) GetCa() string {
+func (m *Secret) GetUpdatedAt() int64 {
 	if m != nil {
-		return m.Ca
+		return m.UpdatedAt
 	}
-	return ""
+	return 0
 }
 
-func (m *AddNodeOptions) GetCert() string {
-	if m != nil {
-		return m.Cert
-	}
-	return ""
+type Secrets struct {
+	Secrets              []*Secret `protobuf:"bytes,1,rep,name=secrets,proto3" json:"secrets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *AddNodeOptions) GetKey() string {
-	if m != nil {
-		return m.Key
-	}
-	return ""
+func (m *Secrets) Reset()         { *m = Secrets{} }
+func (m *Secrets) String() string { return proto.CompactTextString(m) }
+func (*Secrets) ProtoMessage()    {}
+func (*Secrets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{30}
 }
 
-func (m *AddNodeOptions) GetCpu() int32 {
-	if m != nil {
-		return m.Cpu
-	}
-	return 0
+func (m *Secrets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Secrets.Unmarshal(m, b)
 }
-
-func (m *AddNodeOptions) GetShare() int32 {
-	if m != nil {
-		return m.Share
-	}
-	return 0
+func (m *Secrets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Secrets.Marshal(b, m, deterministic)
 }
-
-func (m *AddNodeOptions) GetMemory() int64 {
-	if m != nil {
-		return m.Memory
-	}
-	return 0
+func (m *Secrets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Secrets.Merge(m, src)
+}
+func (m *Secrets) XXX_Size() int {
+	return xxx_messageInfo_Secrets.Size(m)
+}
+func (m *Secrets) XXX_DiscardUnknown() {
+	xxx_messageInfo_Secrets.DiscardUnknown(m)
 }
 
-func (m *AddNodeOptions) GetLabels() map[string]string {
+var xxx_messageInfo_Secrets proto.InternalMessageInfo
+
+func (m *Secrets) GetSecrets() []*Secret {
 	if m != nil {
-		return m.Labels
+		return m.Secrets
 	}
 	return nil
 }
 
-func (m *AddNodeOptions) GetNuma() map[string]string {
-	if m != nil {
-		return m.Numa
-	}
-	return nil
+type RemoveSecretOptions struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AddNodeOptions) GetNumaMemory() map[string]int64 {
-	if m != nil {
-		return m.NumaMemory
-	}
-	return nil
+func (m *RemoveSecretOptions) Reset()         { *m = RemoveSecretOptions{} }
+func (m *RemoveSecretOptions) String() string { return proto.CompactTextString(m) }
+func (*RemoveSecretOptions) ProtoMessage()    {}
+func (*RemoveSecretOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{31}
 }
 
-func (m *AddNodeOptions) GetStorage() int64 {
-	if m != nil {
-		return m.Storage
-	}
-	return 0
+func (m *RemoveSecretOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveSecretOptions.Unmarshal(m, b)
+}
+func (m *RemoveSecretOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveSecretOptions.Marshal(b, m, deterministic)
+}
+func (m *RemoveSecretOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveSecretOptions.Merge(m, src)
+}
+func (m *RemoveSecretOptions) XXX_Size() int {
+	return xxx_messageInfo_RemoveSecretOptions.Size(m)
+}
+func (m *RemoveSecretOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveSecretOptions.DiscardUnknown(m)
 }
 
-func (m *AddNodeOptions) GetVolumeMap() map[string]int64 {
+var xxx_messageInfo_RemoveSecretOptions proto.InternalMessageInfo
+
+func (m *RemoveSecretOptions) GetName() string {
 	if m != nil {
-		return m.VolumeMap
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type RemoveNodeOptions struct {
-	Nodename             string   `protobuf:"bytes,1,opt,name=nodename,proto3" json:"nodename,omitempty"`
+type RotateSecretOptions struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                []byte   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	Replace              bool     `protobuf:"varint,3,opt,name=replace,proto3" json:"replace,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *RemoveNodeOptions) Reset()         { *m = RemoveNodeOptions{} }
-func (m *RemoveNodeOptions) String() string { return proto.CompactTextString(m) }
-func (*RemoveNodeOptions) ProtoMessage()    {}
-func (*RemoveNodeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{29}
+func (m *RotateSecretOptions) Reset()         { *m = RotateSecretOptions{} }
+func (m *RotateSecretOptions) String() string { return proto.CompactTextString(m) }
+func (*RotateSecretOptions) ProtoMessage()    {}
+func (*RotateSecretOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{32}
 }
 
-func (m *RemoveNodeOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RemoveNodeOptions.Unmarshal(m, b)
+func (m *RotateSecretOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RotateSecretOptions.Unmarshal(m, b)
 }
-func (m *RemoveNodeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RemoveNodeOptions.Marshal(b, m, deterministic)
+func (m *RotateSecretOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RotateSecretOptions.Marshal(b, m, deterministic)
 }
-func (m *RemoveNodeOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RemoveNodeOptions.Merge(m, src)
+func (m *RotateSecretOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RotateSecretOptions.Merge(m, src)
 }
-func (m *RemoveNodeOptions) XXX_Size() int {
-	return xxx_messageInfo_RemoveNodeOptions.Size(m)
+func (m *RotateSecretOptions) XXX_Size() int {
+	return xxx_messageInfo_RotateSecretOptions.Size(m)
 }
-func (m *RemoveNodeOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_RemoveNodeOptions.DiscardUnknown(m)
+func (m *RotateSecretOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RotateSecretOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RemoveNodeOptions proto.InternalMessageInfo
+var xxx_messageInfo_RotateSecretOptions proto.InternalMessageInfo
 
-func (m *RemoveNodeOptions) GetNodename() string {
+func (m *RotateSecretOptions) GetName() string {
 	if m != nil {
-		return m.Nodename
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RotateSecretOptions) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *RotateSecretOptions) GetReplace() bool {
+	if m != nil {
+		return m.Replace
+	}
+	return false
+}
+
+type Containers struct {
+	Containers           []*Container `protobuf:"bytes,1,rep,name=containers,proto3" json:"containers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *Containers) Reset()         { *m = Containers{} }
+func (m *Containers) String() string { return proto.CompactTextString(m) }
+func (*Containers) ProtoMessage()    {}
+func (*Containers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{33}
+}
+
+func (m *Containers) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Containers.Unmarshal(m, b)
+}
+func (m *Containers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Containers.Marshal(b, m, deterministic)
+}
+func (m *Containers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Containers.Merge(m, src)
+}
+func (m *Containers) XXX_Size() int {
+	return xxx_messageInfo_Containers.Size(m)
+}
+func (m *Containers) XXX_DiscardUnknown() {
+	xxx_messageInfo_Containers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Containers proto.InternalMessageInfo
+
+func (m *Containers) GetContainers() []*Container {
+	if m != nil {
+		return m.Containers
+	}
+	return nil
+}
+
+type ContainerID struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContainerID) Reset()         { *m = ContainerID{} }
+func (m *ContainerID) String() string { return proto.CompactTextString(m) }
+func (*ContainerID) ProtoMessage()    {}
+func (*ContainerID) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{34}
+}
+
+func (m *ContainerID) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerID.Unmarshal(m, b)
+}
+func (m *ContainerID) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerID.Marshal(b, m, deterministic)
+}
+func (m *ContainerID) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerID.Merge(m, src)
+}
+func (m *ContainerID) XXX_Size() int {
+	return xxx_messageInfo_ContainerID.Size(m)
+}
+func (m *ContainerID) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerID.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerID proto.InternalMessageInfo
+
+func (m *ContainerID) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type ContainerIDs struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ContainerIDs) Reset()         { *m = ContainerIDs{} }
+func (m *ContainerIDs) String() string { return proto.CompactTextString(m) }
+func (*ContainerIDs) ProtoMessage()    {}
+func (*ContainerIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{35}
+}
+
+func (m *ContainerIDs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ContainerIDs.Unmarshal(m, b)
+}
+func (m *ContainerIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ContainerIDs.Marshal(b, m, deterministic)
+}
+func (m *ContainerIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContainerIDs.Merge(m, src)
+}
+func (m *ContainerIDs) XXX_Size() int {
+	return xxx_messageInfo_ContainerIDs.Size(m)
+}
+func (m *ContainerIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContainerIDs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContainerIDs proto.InternalMessageInfo
+
+func (m *ContainerIDs) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type RemoveContainerOptions struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Force                bool     `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	Step                 int32    `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveContainerOptions) Reset()         { *m = RemoveContainerOptions{} }
+func (m *RemoveContainerOptions) String() string { return proto.CompactTextString(m) }
+func (*RemoveContainerOptions) ProtoMessage()    {}
+func (*RemoveContainerOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{36}
+}
+
+func (m *RemoveContainerOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveContainerOptions.Unmarshal(m, b)
+}
+func (m *RemoveContainerOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveContainerOptions.Marshal(b, m, deterministic)
+}
+func (m *RemoveContainerOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveContainerOptions.Merge(m, src)
+}
+func (m *RemoveContainerOptions) XXX_Size() int {
+	return xxx_messageInfo_RemoveContainerOptions.Size(m)
+}
+func (m *RemoveContainerOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveContainerOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveContainerOptions proto.InternalMessageInfo
+
+func (m *RemoveContainerOptions) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *RemoveContainerOptions) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+func (m *RemoveContainerOptions) GetStep() int32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+type DissociateContainerOptions struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DissociateContainerOptions) Reset()         { *m = DissociateContainerOptions{} }
+func (m *DissociateContainerOptions) String() string { return proto.CompactTextString(m) }
+func (*DissociateContainerOptions) ProtoMessage()    {}
+func (*DissociateContainerOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{37}
+}
+
+func (m *DissociateContainerOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DissociateContainerOptions.Unmarshal(m, b)
+}
+func (m *DissociateContainerOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DissociateContainerOptions.Marshal(b, m, deterministic)
+}
+func (m *DissociateContainerOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DissociateContainerOptions.Merge(m, src)
+}
+func (m *DissociateContainerOptions) XXX_Size() int {
+	return xxx_messageInfo_DissociateContainerOptions.Size(m)
+}
+func (m *DissociateContainerOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DissociateContainerOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DissociateContainerOptions proto.InternalMessageInfo
+
+func (m *DissociateContainerOptions) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type ReallocOptions struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	Cpu                  float64  `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory               int64    `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Volumes              []string `protobuf:"bytes,4,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReallocOptions) Reset()         { *m = ReallocOptions{} }
+func (m *ReallocOptions) String() string { return proto.CompactTextString(m) }
+func (*ReallocOptions) ProtoMessage()    {}
+func (*ReallocOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{38}
+}
+
+func (m *ReallocOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReallocOptions.Unmarshal(m, b)
+}
+func (m *ReallocOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReallocOptions.Marshal(b, m, deterministic)
+}
+func (m *ReallocOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReallocOptions.Merge(m, src)
+}
+func (m *ReallocOptions) XXX_Size() int {
+	return xxx_messageInfo_ReallocOptions.Size(m)
+}
+func (m *ReallocOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReallocOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReallocOptions proto.InternalMessageInfo
+
+func (m *ReallocOptions) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+func (m *ReallocOptions) GetCpu() float64 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+func (m *ReallocOptions) GetMemory() int64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *ReallocOptions) GetVolumes() []string {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
+
+type AddPodOptions struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Desc                 string   `protobuf:"bytes,2,opt,name=desc,proto3" json:"desc,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddPodOptions) Reset()         { *m = AddPodOptions{} }
+func (m *AddPodOptions) String() string { return proto.CompactTextString(m) }
+func (*AddPodOptions) ProtoMessage()    {}
+func (*AddPodOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{39}
+}
+
+func (m *AddPodOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddPodOptions.Unmarshal(m, b)
+}
+func (m *AddPodOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddPodOptions.Marshal(b, m, deterministic)
+}
+func (m *AddPodOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddPodOptions.Merge(m, src)
+}
+func (m *AddPodOptions) XXX_Size() int {
+	return xxx_messageInfo_AddPodOptions.Size(m)
+}
+func (m *AddPodOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddPodOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddPodOptions proto.InternalMessageInfo
+
+func (m *AddPodOptions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddPodOptions) GetDesc() string {
+	if m != nil {
+		return m.Desc
+	}
+	return ""
+}
+
+type RemovePodOptions struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePodOptions) Reset()         { *m = RemovePodOptions{} }
+func (m *RemovePodOptions) String() string { return proto.CompactTextString(m) }
+func (*RemovePodOptions) ProtoMessage()    {}
+func (*RemovePodOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{40}
+}
+
+func (m *RemovePodOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePodOptions.Unmarshal(m, b)
+}
+func (m *RemovePodOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePodOptions.Marshal(b, m, deterministic)
+}
+func (m *RemovePodOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePodOptions.Merge(m, src)
+}
+func (m *RemovePodOptions) XXX_Size() int {
+	return xxx_messageInfo_RemovePodOptions.Size(m)
+}
+func (m *RemovePodOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePodOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePodOptions proto.InternalMessageInfo
+
+func (m *RemovePodOptions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type GetPodOptions struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPodOptions) Reset()         { *m = GetPodOptions{} }
+func (m *GetPodOptions) String() string { return proto.CompactTextString(m) }
+func (*GetPodOptions) ProtoMessage()    {}
+func (*GetPodOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{41}
+}
+
+func (m *GetPodOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPodOptions.Unmarshal(m, b)
+}
+func (m *GetPodOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPodOptions.Marshal(b, m, deterministic)
+}
+func (m *GetPodOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPodOptions.Merge(m, src)
+}
+func (m *GetPodOptions) XXX_Size() int {
+	return xxx_messageInfo_GetPodOptions.Size(m)
+}
+func (m *GetPodOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPodOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPodOptions proto.InternalMessageInfo
+
+func (m *GetPodOptions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type SetPodOvercommitOptions struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CpuOvercommit        float64  `protobuf:"fixed64,2,opt,name=cpu_overcommit,json=cpuOvercommit,proto3" json:"cpu_overcommit,omitempty"`
+	MemoryOvercommit     float64  `protobuf:"fixed64,3,opt,name=memory_overcommit,json=memoryOvercommit,proto3" json:"memory_overcommit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetPodOvercommitOptions) Reset()         { *m = SetPodOvercommitOptions{} }
+func (m *SetPodOvercommitOptions) String() string { return proto.CompactTextString(m) }
+func (*SetPodOvercommitOptions) ProtoMessage()    {}
+func (*SetPodOvercommitOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{42}
+}
+
+func (m *SetPodOvercommitOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetPodOvercommitOptions.Unmarshal(m, b)
+}
+func (m *SetPodOvercommitOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetPodOvercommitOptions.Marshal(b, m, deterministic)
+}
+func (m *SetPodOvercommitOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetPodOvercommitOptions.Merge(m, src)
+}
+func (m *SetPodOvercommitOptions) XXX_Size() int {
+	return xxx_messageInfo_SetPodOvercommitOptions.Size(m)
+}
+func (m *SetPodOvercommitOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetPodOvercommitOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetPodOvercommitOptions proto.InternalMessageInfo
+
+func (m *SetPodOvercommitOptions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SetPodOvercommitOptions) GetCpuOvercommit() float64 {
+	if m != nil {
+		return m.CpuOvercommit
+	}
+	return 0
+}
+
+func (m *SetPodOvercommitOptions) GetMemoryOvercommit() float64 {
+	if m != nil {
+		return m.MemoryOvercommit
+	}
+	return 0
+}
+
+type AddNodeOptions struct {
+	Nodename             string              `protobuf:"bytes,1,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	Endpoint             string              `protobuf:"bytes,2,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Podname              string              `protobuf:"bytes,3,opt,name=podname,proto3" json:"podname,omitempty"`
+	Ca                   string              `protobuf:"bytes,4,opt,name=ca,proto3" json:"ca,omitempty"`
+	Cert                 string              `protobuf:"bytes,5,opt,name=cert,proto3" json:"cert,omitempty"`
+	Key                  string              `protobuf:"bytes,6,opt,name=key,proto3" json:"key,omitempty"`
+	Cpu                  int32               `protobuf:"varint,7,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Share                int32               `protobuf:"varint,8,opt,name=share,proto3" json:"share,omitempty"`
+	Memory               int64               `protobuf:"varint,9,opt,name=memory,proto3" json:"memory,omitempty"`
+	Labels               map[string]string   `protobuf:"bytes,10,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Numa                 map[string]string   `protobuf:"bytes,11,rep,name=numa,proto3" json:"numa,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	NumaMemory           map[string]int64    `protobuf:"bytes,12,rep,name=numa_memory,json=numaMemory,proto3" json:"numa_memory,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Storage              int64               `protobuf:"varint,13,opt,name=storage,proto3" json:"storage,omitempty"`
+	VolumeMap            map[string]int64    `protobuf:"bytes,14,rep,name=volume_map,json=volumeMap,proto3" json:"volume_map,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Devices              map[string]*Devices `protobuf:"bytes,15,rep,name=devices,proto3" json:"devices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Bandwidth            int64               `protobuf:"varint,16,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *AddNodeOptions) Reset()         { *m = AddNodeOptions{} }
+func (m *AddNodeOptions) String() string { return proto.CompactTextString(m) }
+func (*AddNodeOptions) ProtoMessage()    {}
+func (*AddNodeOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{43}
+}
+
+func (m *AddNodeOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddNodeOptions.Unmarshal(m, b)
+}
+func (m *AddNodeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddNodeOptions.Marshal(b, m, deterministic)
+}
+func (m *AddNodeOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddNodeOptions.Merge(m, src)
+}
+func (m *AddNodeOptions) XXX_Size() int {
+	return xxx_messageInfo_AddNodeOptions.Size(m)
+}
+func (m *AddNodeOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddNodeOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddNodeOptions proto.InternalMessageInfo
+
+func (m *AddNodeOptions) GetNodename() string {
+	if m != nil {
+		return m.Nodename
+	}
+	return ""
+}
+
+func (m *AddNodeOptions) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *AddNodeOptions) GetPodname() string {
+	if m != nil {
+		return m.Podname
+	}
+	return ""
+}
+
+func (m *AddNodeOptions) GetCa() string {
+	if m != nil {
+		return m.Ca
+	}
+	return ""
+}
+
+func (m *AddNodeOptions) GetCert() string {
+	if m != nil {
+		return m.Cert
+	}
+	return ""
+}
+
+func (m *AddNodeOptions) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *AddNodeOptions) GetCpu() int32 {
+	if m != nil {
+		return m.Cpu
+	}
+	return 0
+}
+
+func (m *AddNodeOptions) GetShare() int32 {
+	if m != nil {
+		return m.Share
+	}
+	return 0
+}
+
+func (m *AddNodeOptions) GetMemory() int64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
+}
+
+func (m *AddNodeOptions) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *AddNodeOptions) GetNuma() map[string]string {
+	if m != nil {
+		return m.Numa
+	}
+	return nil
+}
+
+func (m *AddNodeOptions) GetNumaMemory() map[string]int64 {
+	if m != nil {
+		return m.NumaMemory
+	}
+	return nil
+}
+
+func (m *AddNodeOptions) GetStorage() int64 {
+	if m != nil {
+		return m.Storage
+	}
+	return 0
+}
+
+func (m *AddNodeOptions) GetVolumeMap() map[string]int64 {
+	if m != nil {
+		return m.VolumeMap
+	}
+	return nil
+}
+
+func (m *AddNodeOptions) GetDevices() map[string]*Devices {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *AddNodeOptions) GetBandwidth() int64 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+type RemoveNodeOptions struct {
+	Nodename             string   `protobuf:"bytes,1,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveNodeOptions) Reset()         { *m = RemoveNodeOptions{} }
+func (m *RemoveNodeOptions) String() string { return proto.CompactTextString(m) }
+func (*RemoveNodeOptions) ProtoMessage()    {}
+func (*RemoveNodeOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{44}
+}
+
+func (m *RemoveNodeOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveNodeOptions.Unmarshal(m, b)
+}
+func (m *RemoveNodeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveNodeOptions.Marshal(b, m, deterministic)
+}
+func (m *RemoveNodeOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveNodeOptions.Merge(m, src)
+}
+func (m *RemoveNodeOptions) XXX_Size() int {
+	return xxx_messageInfo_RemoveNodeOptions.Size(m)
+}
+func (m *RemoveNodeOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveNodeOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveNodeOptions proto.InternalMessageInfo
+
+func (m *RemoveNodeOptions) GetNodename() string {
+	if m != nil {
+		return m.Nodename
 	}
 	return ""
 }
@@ -1918,972 +3011,1941 @@ func (m *RemoveNodeOptions) GetNodename() string {
 type GetNodeOptions struct {
 	Nodename             string            `protobuf:"bytes,1,opt,name=nodename,proto3" json:"nodename,omitempty"`
 	Labels               map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Selector             string            `protobuf:"bytes,3,opt,name=selector,proto3" json:"selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetNodeOptions) Reset()         { *m = GetNodeOptions{} }
+func (m *GetNodeOptions) String() string { return proto.CompactTextString(m) }
+func (*GetNodeOptions) ProtoMessage()    {}
+func (*GetNodeOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{45}
+}
+
+func (m *GetNodeOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNodeOptions.Unmarshal(m, b)
+}
+func (m *GetNodeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNodeOptions.Marshal(b, m, deterministic)
+}
+func (m *GetNodeOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNodeOptions.Merge(m, src)
+}
+func (m *GetNodeOptions) XXX_Size() int {
+	return xxx_messageInfo_GetNodeOptions.Size(m)
+}
+func (m *GetNodeOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNodeOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNodeOptions proto.InternalMessageInfo
+
+func (m *GetNodeOptions) GetNodename() string {
+	if m != nil {
+		return m.Nodename
+	}
+	return ""
+}
+
+func (m *GetNodeOptions) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *GetNodeOptions) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+type ListNodesOptions struct {
+	Podname              string            `protobuf:"bytes,1,opt,name=podname,proto3" json:"podname,omitempty"`
+	All                  bool              `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
+	Labels               map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Selector             string            `protobuf:"bytes,4,opt,name=selector,proto3" json:"selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListNodesOptions) Reset()         { *m = ListNodesOptions{} }
+func (m *ListNodesOptions) String() string { return proto.CompactTextString(m) }
+func (*ListNodesOptions) ProtoMessage()    {}
+func (*ListNodesOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{46}
+}
+
+func (m *ListNodesOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNodesOptions.Unmarshal(m, b)
+}
+func (m *ListNodesOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNodesOptions.Marshal(b, m, deterministic)
+}
+func (m *ListNodesOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNodesOptions.Merge(m, src)
+}
+func (m *ListNodesOptions) XXX_Size() int {
+	return xxx_messageInfo_ListNodesOptions.Size(m)
+}
+func (m *ListNodesOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNodesOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNodesOptions proto.InternalMessageInfo
+
+func (m *ListNodesOptions) GetPodname() string {
+	if m != nil {
+		return m.Podname
+	}
+	return ""
+}
+
+func (m *ListNodesOptions) GetAll() bool {
+	if m != nil {
+		return m.All
+	}
+	return false
+}
+
+func (m *ListNodesOptions) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ListNodesOptions) GetSelector() string {
+	if m != nil {
+		return m.Selector
+	}
+	return ""
+}
+
+type Build struct {
+	Base                 string            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Repo                 string            `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
+	Version              string            `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Dir                  string            `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
+	Submodule            bool              `protobuf:"varint,5,opt,name=submodule,proto3" json:"submodule,omitempty"`
+	Commands             []string          `protobuf:"bytes,6,rep,name=commands,proto3" json:"commands,omitempty"`
+	Envs                 map[string]string `protobuf:"bytes,7,rep,name=envs,proto3" json:"envs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Args                 map[string]string `protobuf:"bytes,8,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Labels               map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Artifacts            map[string]string `protobuf:"bytes,10,rep,name=artifacts,proto3" json:"artifacts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Cache                map[string]string `protobuf:"bytes,11,rep,name=cache,proto3" json:"cache,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	StopSignal           string            `protobuf:"bytes,12,opt,name=stop_signal,json=stopSignal,proto3" json:"stop_signal,omitempty"`
+	Security             bool              `protobuf:"varint,13,opt,name=security,proto3" json:"security,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Build) Reset()         { *m = Build{} }
+func (m *Build) String() string { return proto.CompactTextString(m) }
+func (*Build) ProtoMessage()    {}
+func (*Build) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{47}
+}
+
+func (m *Build) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Build.Unmarshal(m, b)
+}
+func (m *Build) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Build.Marshal(b, m, deterministic)
+}
+func (m *Build) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Build.Merge(m, src)
+}
+func (m *Build) XXX_Size() int {
+	return xxx_messageInfo_Build.Size(m)
+}
+func (m *Build) XXX_DiscardUnknown() {
+	xxx_messageInfo_Build.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Build proto.InternalMessageInfo
+
+func (m *Build) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *Build) GetRepo() string {
+	if m != nil {
+		return m.Repo
+	}
+	return ""
+}
+
+func (m *Build) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *Build) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
+}
+
+func (m *Build) GetSubmodule() bool {
+	if m != nil {
+		return m.Submodule
+	}
+	return false
+}
+
+func (m *Build) GetCommands() []string {
+	if m != nil {
+		return m.Commands
+	}
+	return nil
+}
+
+func (m *Build) GetEnvs() map[string]string {
+	if m != nil {
+		return m.Envs
+	}
+	return nil
+}
+
+func (m *Build) GetArgs() map[string]string {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (m *Build) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Build) GetArtifacts() map[string]string {
+	if m != nil {
+		return m.Artifacts
+	}
+	return nil
+}
+
+func (m *Build) GetCache() map[string]string {
+	if m != nil {
+		return m.Cache
+	}
+	return nil
+}
+
+func (m *Build) GetStopSignal() string {
+	if m != nil {
+		return m.StopSignal
+	}
+	return ""
+}
+
+func (m *Build) GetSecurity() bool {
+	if m != nil {
+		return m.Security
+	}
+	return false
+}
+
+type Builds struct {
+	Stages               []string          `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
+	Builds               map[string]*Build `protobuf:"bytes,2,rep,name=builds,proto3" json:"builds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Builds) Reset()         { *m = Builds{} }
+func (m *Builds) String() string { return proto.CompactTextString(m) }
+func (*Builds) ProtoMessage()    {}
+func (*Builds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{48}
+}
+
+func (m *Builds) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Builds.Unmarshal(m, b)
+}
+func (m *Builds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Builds.Marshal(b, m, deterministic)
+}
+func (m *Builds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Builds.Merge(m, src)
+}
+func (m *Builds) XXX_Size() int {
+	return xxx_messageInfo_Builds.Size(m)
+}
+func (m *Builds) XXX_DiscardUnknown() {
+	xxx_messageInfo_Builds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Builds proto.InternalMessageInfo
+
+func (m *Builds) GetStages() []string {
+	if m != nil {
+		return m.Stages
+	}
+	return nil
+}
+
+func (m *Builds) GetBuilds() map[string]*Build {
+	if m != nil {
+		return m.Builds
+	}
+	return nil
+}
+
+type BuildImageOptions struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	User                 string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	Uid                  int32    `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
+	Tags                 []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
+	Builds               *Builds  `protobuf:"bytes,5,opt,name=builds,proto3" json:"builds,omitempty"`
+	Tar                  []byte   `protobuf:"bytes,6,opt,name=tar,proto3" json:"tar,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BuildImageOptions) Reset()         { *m = BuildImageOptions{} }
+func (m *BuildImageOptions) String() string { return proto.CompactTextString(m) }
+func (*BuildImageOptions) ProtoMessage()    {}
+func (*BuildImageOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{49}
+}
+
+func (m *BuildImageOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BuildImageOptions.Unmarshal(m, b)
+}
+func (m *BuildImageOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BuildImageOptions.Marshal(b, m, deterministic)
+}
+func (m *BuildImageOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BuildImageOptions.Merge(m, src)
+}
+func (m *BuildImageOptions) XXX_Size() int {
+	return xxx_messageInfo_BuildImageOptions.Size(m)
+}
+func (m *BuildImageOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_BuildImageOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BuildImageOptions proto.InternalMessageInfo
+
+func (m *BuildImageOptions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *BuildImageOptions) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+func (m *BuildImageOptions) GetUid() int32 {
+	if m != nil {
+		return m.Uid
+	}
+	return 0
+}
+
+func (m *BuildImageOptions) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *BuildImageOptions) GetBuilds() *Builds {
+	if m != nil {
+		return m.Builds
+	}
+	return nil
+}
+
+func (m *BuildImageOptions) GetTar() []byte {
+	if m != nil {
+		return m.Tar
+	}
+	return nil
+}
+
+type HookOptions struct {
+	AfterStart           []string `protobuf:"bytes,1,rep,name=after_start,json=afterStart,proto3" json:"after_start,omitempty"`
+	BeforeStop           []string `protobuf:"bytes,2,rep,name=before_stop,json=beforeStop,proto3" json:"before_stop,omitempty"`
+	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HookOptions) Reset()         { *m = HookOptions{} }
+func (m *HookOptions) String() string { return proto.CompactTextString(m) }
+func (*HookOptions) ProtoMessage()    {}
+func (*HookOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{50}
+}
+
+func (m *HookOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HookOptions.Unmarshal(m, b)
+}
+func (m *HookOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HookOptions.Marshal(b, m, deterministic)
+}
+func (m *HookOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HookOptions.Merge(m, src)
+}
+func (m *HookOptions) XXX_Size() int {
+	return xxx_messageInfo_HookOptions.Size(m)
+}
+func (m *HookOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_HookOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HookOptions proto.InternalMessageInfo
+
+func (m *HookOptions) GetAfterStart() []string {
+	if m != nil {
+		return m.AfterStart
+	}
+	return nil
+}
+
+func (m *HookOptions) GetBeforeStop() []string {
+	if m != nil {
+		return m.BeforeStop
+	}
+	return nil
+}
+
+func (m *HookOptions) GetForce() bool {
+	if m != nil {
+		return m.Force
+	}
+	return false
+}
+
+type HealthCheckOptions struct {
+	TcpPorts             []string `protobuf:"bytes,1,rep,name=tcp_ports,json=tcpPorts,proto3" json:"tcp_ports,omitempty"`
+	HttpPort             string   `protobuf:"bytes,2,opt,name=http_port,json=httpPort,proto3" json:"http_port,omitempty"`
+	Url                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
+	Code                 int32    `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HealthCheckOptions) Reset()         { *m = HealthCheckOptions{} }
+func (m *HealthCheckOptions) String() string { return proto.CompactTextString(m) }
+func (*HealthCheckOptions) ProtoMessage()    {}
+func (*HealthCheckOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{51}
+}
+
+func (m *HealthCheckOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HealthCheckOptions.Unmarshal(m, b)
+}
+func (m *HealthCheckOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HealthCheckOptions.Marshal(b, m, deterministic)
+}
+func (m *HealthCheckOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthCheckOptions.Merge(m, src)
+}
+func (m *HealthCheckOptions) XXX_Size() int {
+	return xxx_messageInfo_HealthCheckOptions.Size(m)
+}
+func (m *HealthCheckOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthCheckOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthCheckOptions proto.InternalMessageInfo
+
+func (m *HealthCheckOptions) GetTcpPorts() []string {
+	if m != nil {
+		return m.TcpPorts
+	}
+	return nil
+}
+
+func (m *HealthCheckOptions) GetHttpPort() string {
+	if m != nil {
+		return m.HttpPort
+	}
+	return ""
+}
+
+func (m *HealthCheckOptions) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+func (m *HealthCheckOptions) GetCode() int32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+type LogOptions struct {
+	Type                 string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Config               map[string]string `protobuf:"bytes,2,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetNodeOptions) Reset()         { *m = GetNodeOptions{} }
-func (m *GetNodeOptions) String() string { return proto.CompactTextString(m) }
-func (*GetNodeOptions) ProtoMessage()    {}
-func (*GetNodeOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{30}
+func (m *LogOptions) Reset()         { *m = LogOptions{} }
+func (m *LogOptions) String() string { return proto.CompactTextString(m) }
+func (*LogOptions) ProtoMessage()    {}
+func (*LogOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{52}
+}
+
+func (m *LogOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogOptions.Unmarshal(m, b)
+}
+func (m *LogOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogOptions.Marshal(b, m, deterministic)
+}
+func (m *LogOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogOptions.Merge(m, src)
+}
+func (m *LogOptions) XXX_Size() int {
+	return xxx_messageInfo_LogOptions.Size(m)
+}
+func (m *LogOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LogOptions proto.InternalMessageInfo
+
+func (m *LogOptions) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *LogOptions) GetConfig() map[string]string {
+	if m != nil {
+		return m.Config
+	}
+	return nil
+}
+
+type EntrypointOptions struct {
+	Name                 string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Command              string              `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
+	Privileged           bool                `protobuf:"varint,3,opt,name=privileged,proto3" json:"privileged,omitempty"`
+	Dir                  string              `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
+	Log                  *LogOptions         `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
+	Publish              []string            `protobuf:"bytes,6,rep,name=publish,proto3" json:"publish,omitempty"`
+	Healthcheck          *HealthCheckOptions `protobuf:"bytes,7,opt,name=healthcheck,proto3" json:"healthcheck,omitempty"`
+	Hook                 *HookOptions        `protobuf:"bytes,8,opt,name=hook,proto3" json:"hook,omitempty"`
+	RestartPolicy        string              `protobuf:"bytes,9,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
+	Sysctls              map[string]string   `protobuf:"bytes,10,rep,name=sysctls,proto3" json:"sysctls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *EntrypointOptions) Reset()         { *m = EntrypointOptions{} }
+func (m *EntrypointOptions) String() string { return proto.CompactTextString(m) }
+func (*EntrypointOptions) ProtoMessage()    {}
+func (*EntrypointOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{53}
+}
+
+func (m *EntrypointOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EntrypointOptions.Unmarshal(m, b)
+}
+func (m *EntrypointOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EntrypointOptions.Marshal(b, m, deterministic)
+}
+func (m *EntrypointOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EntrypointOptions.Merge(m, src)
+}
+func (m *EntrypointOptions) XXX_Size() int {
+	return xxx_messageInfo_EntrypointOptions.Size(m)
+}
+func (m *EntrypointOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_EntrypointOptions.DiscardUnknown(m)
 }
 
-func (m *GetNodeOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetNodeOptions.Unmarshal(m, b)
+var xxx_messageInfo_EntrypointOptions proto.InternalMessageInfo
+
+func (m *EntrypointOptions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
-func (m *GetNodeOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetNodeOptions.Marshal(b, m, deterministic)
+
+func (m *EntrypointOptions) GetCommand() string {
+	if m != nil {
+		return m.Command
+	}
+	return ""
 }
-func (m *GetNodeOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetNodeOptions.Merge(m, src)
+
+func (m *EntrypointOptions) GetPrivileged() bool {
+	if m != nil {
+		return m.Privileged
+	}
+	return false
 }
-func (m *GetNodeOptions) XXX_Size() int {
-	return xxx_messageInfo_GetNodeOptions.Size(m)
+
+func (m *EntrypointOptions) GetDir() string {
+	if m != nil {
+		return m.Dir
+	}
+	return ""
 }
-func (m *GetNodeOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetNodeOptions.DiscardUnknown(m)
+
+func (m *EntrypointOptions) GetLog() *LogOptions {
+	if m != nil {
+		return m.Log
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetNodeOptions proto.InternalMessageInfo
+func (m *EntrypointOptions) GetPublish() []string {
+	if m != nil {
+		return m.Publish
+	}
+	return nil
+}
 
-func (m *GetNodeOptions) GetNodename() string {
+func (m *EntrypointOptions) GetHealthcheck() *HealthCheckOptions {
 	if m != nil {
-		return m.Nodename
+		return m.Healthcheck
+	}
+	return nil
+}
+
+func (m *EntrypointOptions) GetHook() *HookOptions {
+	if m != nil {
+		return m.Hook
+	}
+	return nil
+}
+
+func (m *EntrypointOptions) GetRestartPolicy() string {
+	if m != nil {
+		return m.RestartPolicy
 	}
 	return ""
 }
 
-func (m *GetNodeOptions) GetLabels() map[string]string {
+func (m *EntrypointOptions) GetSysctls() map[string]string {
 	if m != nil {
-		return m.Labels
+		return m.Sysctls
 	}
 	return nil
 }
 
-type ListNodesOptions struct {
-	Podname              string            `protobuf:"bytes,1,opt,name=podname,proto3" json:"podname,omitempty"`
-	All                  bool              `protobuf:"varint,2,opt,name=all,proto3" json:"all,omitempty"`
-	Labels               map[string]string `protobuf:"bytes,3,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type DeployOptions struct {
+	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Entrypoint           *EntrypointOptions `protobuf:"bytes,2,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	Podname              string             `protobuf:"bytes,3,opt,name=podname,proto3" json:"podname,omitempty"`
+	Nodename             string             `protobuf:"bytes,4,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	Image                string             `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
+	ExtraArgs            string             `protobuf:"bytes,6,opt,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`
+	CpuQuota             float64            `protobuf:"fixed64,7,opt,name=cpu_quota,json=cpuQuota,proto3" json:"cpu_quota,omitempty"`
+	Memory               int64              `protobuf:"varint,8,opt,name=memory,proto3" json:"memory,omitempty"`
+	Count                int32              `protobuf:"varint,9,opt,name=count,proto3" json:"count,omitempty"`
+	Env                  []string           `protobuf:"bytes,10,rep,name=env,proto3" json:"env,omitempty"`
+	Dns                  []string           `protobuf:"bytes,11,rep,name=dns,proto3" json:"dns,omitempty"`
+	ExtraHosts           []string           `protobuf:"bytes,12,rep,name=extra_hosts,json=extraHosts,proto3" json:"extra_hosts,omitempty"`
+	Volumes              []string           `protobuf:"bytes,13,rep,name=volumes,proto3" json:"volumes,omitempty"`
+	Networks             map[string]string  `protobuf:"bytes,14,rep,name=networks,proto3" json:"networks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Networkmode          string             `protobuf:"bytes,15,opt,name=networkmode,proto3" json:"networkmode,omitempty"`
+	User                 string             `protobuf:"bytes,16,opt,name=user,proto3" json:"user,omitempty"`
+	Debug                bool               `protobuf:"varint,17,opt,name=debug,proto3" json:"debug,omitempty"`
+	OpenStdin            bool               `protobuf:"varint,18,opt,name=openStdin,proto3" json:"openStdin,omitempty"`
+	Labels               map[string]string  `protobuf:"bytes,19,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Nodelabels           map[string]string  `protobuf:"bytes,20,rep,name=nodelabels,proto3" json:"nodelabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	DeployMethod         string             `protobuf:"bytes,21,opt,name=deploy_method,json=deployMethod,proto3" json:"deploy_method,omitempty"`
+	Data                 map[string][]byte  `protobuf:"bytes,22,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SoftLimit            bool               `protobuf:"varint,23,opt,name=soft_limit,json=softLimit,proto3" json:"soft_limit,omitempty"`
+	NodesLimit           int32              `protobuf:"varint,24,opt,name=nodes_limit,json=nodesLimit,proto3" json:"nodes_limit,omitempty"`
+	CpuBind              bool               `protobuf:"varint,25,opt,name=cpu_bind,json=cpuBind,proto3" json:"cpu_bind,omitempty"`
+	IgnoreHook           bool               `protobuf:"varint,26,opt,name=ignore_hook,json=ignoreHook,proto3" json:"ignore_hook,omitempty"`
+	AfterCreate          []string           `protobuf:"bytes,27,rep,name=after_create,json=afterCreate,proto3" json:"after_create,omitempty"`
+	RawArgs              []byte             `protobuf:"bytes,28,opt,name=raw_args,json=rawArgs,proto3" json:"raw_args,omitempty"`
+	Storage              int64              `protobuf:"varint,29,opt,name=storage,proto3" json:"storage,omitempty"`
+	AllOrNothing         bool               `protobuf:"varint,30,opt,name=all_or_nothing,json=allOrNothing,proto3" json:"all_or_nothing,omitempty"`
+	WaitHealthy          int32              `protobuf:"varint,31,opt,name=wait_healthy,json=waitHealthy,proto3" json:"wait_healthy,omitempty"`
+	Affinity             *Affinity          `protobuf:"bytes,32,opt,name=affinity,proto3" json:"affinity,omitempty"`
+	NodeSelector         string             `protobuf:"bytes,33,opt,name=node_selector,json=nodeSelector,proto3" json:"node_selector,omitempty"`
+	Priority             int32              `protobuf:"varint,34,opt,name=priority,proto3" json:"priority,omitempty"`
+	Devices              map[string]int32   `protobuf:"bytes,35,rep,name=devices,proto3" json:"devices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Bandwidth            int64              `protobuf:"varint,36,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	SecretEnv            map[string]string  `protobuf:"bytes,37,rep,name=secret_env,json=secretEnv,proto3" json:"secret_env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	SecretData           map[string]string  `protobuf:"bytes,38,rep,name=secret_data,json=secretData,proto3" json:"secret_data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *ListNodesOptions) Reset()         { *m = ListNodesOptions{} }
-func (m *ListNodesOptions) String() string { return proto.CompactTextString(m) }
-func (*ListNodesOptions) ProtoMessage()    {}
-func (*ListNodesOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{31}
+func (m *DeployOptions) Reset()         { *m = DeployOptions{} }
+func (m *DeployOptions) String() string { return proto.CompactTextString(m) }
+func (*DeployOptions) ProtoMessage()    {}
+func (*DeployOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{54}
 }
 
-func (m *ListNodesOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ListNodesOptions.Unmarshal(m, b)
+func (m *DeployOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployOptions.Unmarshal(m, b)
 }
-func (m *ListNodesOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ListNodesOptions.Marshal(b, m, deterministic)
+func (m *DeployOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployOptions.Marshal(b, m, deterministic)
 }
-func (m *ListNodesOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListNodesOptions.Merge(m, src)
+func (m *DeployOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployOptions.Merge(m, src)
 }
-func (m *ListNodesOptions) XXX_Size() int {
-	return xxx_messageInfo_ListNodesOptions.Size(m)
+func (m *DeployOptions) XXX_Size() int {
+	return xxx_messageInfo_DeployOptions.Size(m)
 }
-func (m *ListNodesOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListNodesOptions.DiscardUnknown(m)
+func (m *DeployOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListNodesOptions proto.InternalMessageInfo
+var xxx_messageInfo_DeployOptions proto.InternalMessageInfo
 
-func (m *ListNodesOptions) GetPodname() string {
+func (m *DeployOptions) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DeployOptions) GetEntrypoint() *EntrypointOptions {
+	if m != nil {
+		return m.Entrypoint
+	}
+	return nil
+}
+
+func (m *DeployOptions) GetPodname() string {
 	if m != nil {
 		return m.Podname
 	}
 	return ""
 }
 
-func (m *ListNodesOptions) GetAll() bool {
+func (m *DeployOptions) GetNodename() string {
 	if m != nil {
-		return m.All
+		return m.Nodename
 	}
-	return false
+	return ""
 }
 
-func (m *ListNodesOptions) GetLabels() map[string]string {
+func (m *DeployOptions) GetImage() string {
 	if m != nil {
-		return m.Labels
+		return m.Image
 	}
-	return nil
+	return ""
 }
 
-type Build struct {
-	Base                 string            `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Repo                 string            `protobuf:"bytes,2,opt,name=repo,proto3" json:"repo,omitempty"`
-	Version              string            `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	Dir                  string            `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
-	Submodule            bool              `protobuf:"varint,5,opt,name=submodule,proto3" json:"submodule,omitempty"`
-	Commands             []string          `protobuf:"bytes,6,rep,name=commands,proto3" json:"commands,omitempty"`
-	Envs                 map[string]string `protobuf:"bytes,7,rep,name=envs,proto3" json:"envs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Args                 map[string]string `protobuf:"bytes,8,rep,name=args,proto3" json:"args,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Labels               map[string]string `protobuf:"bytes,9,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Artifacts            map[string]string `protobuf:"bytes,10,rep,name=artifacts,proto3" json:"artifacts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Cache                map[string]string `protobuf:"bytes,11,rep,name=cache,proto3" json:"cache,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	StopSignal           string            `protobuf:"bytes,12,opt,name=stop_signal,json=stopSignal,proto3" json:"stop_signal,omitempty"`
-	Security             bool              `protobuf:"varint,13,opt,name=security,proto3" json:"security,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *DeployOptions) GetExtraArgs() string {
+	if m != nil {
+		return m.ExtraArgs
+	}
+	return ""
 }
 
-func (m *Build) Reset()         { *m = Build{} }
-func (m *Build) String() string { return proto.CompactTextString(m) }
-func (*Build) ProtoMessage()    {}
-func (*Build) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{32}
+func (m *DeployOptions) GetCpuQuota() float64 {
+	if m != nil {
+		return m.CpuQuota
+	}
+	return 0
 }
 
-func (m *Build) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Build.Unmarshal(m, b)
+func (m *DeployOptions) GetMemory() int64 {
+	if m != nil {
+		return m.Memory
+	}
+	return 0
 }
-func (m *Build) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Build.Marshal(b, m, deterministic)
+
+func (m *DeployOptions) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
-func (m *Build) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Build.Merge(m, src)
+
+func (m *DeployOptions) GetEnv() []string {
+	if m != nil {
+		return m.Env
+	}
+	return nil
 }
-func (m *Build) XXX_Size() int {
-	return xxx_messageInfo_Build.Size(m)
+
+func (m *DeployOptions) GetDns() []string {
+	if m != nil {
+		return m.Dns
+	}
+	return nil
 }
-func (m *Build) XXX_DiscardUnknown() {
-	xxx_messageInfo_Build.DiscardUnknown(m)
+
+func (m *DeployOptions) GetExtraHosts() []string {
+	if m != nil {
+		return m.ExtraHosts
+	}
+	return nil
 }
 
-var xxx_messageInfo_Build proto.InternalMessageInfo
+func (m *DeployOptions) GetVolumes() []string {
+	if m != nil {
+		return m.Volumes
+	}
+	return nil
+}
 
-func (m *Build) GetBase() string {
+func (m *DeployOptions) GetNetworks() map[string]string {
 	if m != nil {
-		return m.Base
+		return m.Networks
 	}
-	return ""
+	return nil
 }
 
-func (m *Build) GetRepo() string {
+func (m *DeployOptions) GetNetworkmode() string {
 	if m != nil {
-		return m.Repo
+		return m.Networkmode
 	}
 	return ""
 }
 
-func (m *Build) GetVersion() string {
+func (m *DeployOptions) GetUser() string {
 	if m != nil {
-		return m.Version
+		return m.User
 	}
 	return ""
 }
 
-func (m *Build) GetDir() string {
+func (m *DeployOptions) GetDebug() bool {
 	if m != nil {
-		return m.Dir
+		return m.Debug
 	}
-	return ""
+	return false
 }
 
-func (m *Build) GetSubmodule() bool {
+func (m *DeployOptions) GetOpenStdin() bool {
 	if m != nil {
-		return m.Submodule
+		return m.OpenStdin
 	}
 	return false
 }
 
-func (m *Build) GetCommands() []string {
+func (m *DeployOptions) GetLabels() map[string]string {
 	if m != nil {
-		return m.Commands
+		return m.Labels
 	}
 	return nil
 }
 
-func (m *Build) GetEnvs() map[string]string {
+func (m *DeployOptions) GetNodelabels() map[string]string {
 	if m != nil {
-		return m.Envs
+		return m.Nodelabels
 	}
 	return nil
 }
 
-func (m *Build) GetArgs() map[string]string {
+func (m *DeployOptions) GetDeployMethod() string {
 	if m != nil {
-		return m.Args
+		return m.DeployMethod
 	}
-	return nil
+	return ""
 }
 
-func (m *Build) GetLabels() map[string]string {
+func (m *DeployOptions) GetData() map[string][]byte {
 	if m != nil {
-		return m.Labels
+		return m.Data
 	}
 	return nil
 }
 
-func (m *Build) GetArtifacts() map[string]string {
+func (m *DeployOptions) GetSoftLimit() bool {
 	if m != nil {
-		return m.Artifacts
+		return m.SoftLimit
 	}
-	return nil
+	return false
 }
 
-func (m *Build) GetCache() map[string]string {
+func (m *DeployOptions) GetNodesLimit() int32 {
 	if m != nil {
-		return m.Cache
+		return m.NodesLimit
 	}
-	return nil
+	return 0
 }
 
-func (m *Build) GetStopSignal() string {
+func (m *DeployOptions) GetCpuBind() bool {
 	if m != nil {
-		return m.StopSignal
+		return m.CpuBind
 	}
-	return ""
+	return false
 }
 
-func (m *Build) GetSecurity() bool {
+func (m *DeployOptions) GetIgnoreHook() bool {
 	if m != nil {
-		return m.Security
+		return m.IgnoreHook
 	}
 	return false
 }
 
-type Builds struct {
-	Stages               []string          `protobuf:"bytes,1,rep,name=stages,proto3" json:"stages,omitempty"`
-	Builds               map[string]*Build `protobuf:"bytes,2,rep,name=builds,proto3" json:"builds,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *DeployOptions) GetAfterCreate() []string {
+	if m != nil {
+		return m.AfterCreate
+	}
+	return nil
 }
 
-func (m *Builds) Reset()         { *m = Builds{} }
-func (m *Builds) String() string { return proto.CompactTextString(m) }
-func (*Builds) ProtoMessage()    {}
-func (*Builds) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{33}
+func (m *DeployOptions) GetRawArgs() []byte {
+	if m != nil {
+		return m.RawArgs
+	}
+	return nil
 }
 
-func (m *Builds) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Builds.Unmarshal(m, b)
+func (m *DeployOptions) GetStorage() int64 {
+	if m != nil {
+		return m.Storage
+	}
+	return 0
 }
-func (m *Builds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Builds.Marshal(b, m, deterministic)
+
+func (m *DeployOptions) GetAllOrNothing() bool {
+	if m != nil {
+		return m.AllOrNothing
+	}
+	return false
 }
-func (m *Builds) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Builds.Merge(m, src)
+
+func (m *DeployOptions) GetWaitHealthy() int32 {
+	if m != nil {
+		return m.WaitHealthy
+	}
+	return 0
 }
-func (m *Builds) XXX_Size() int {
-	return xxx_messageInfo_Builds.Size(m)
+
+func (m *DeployOptions) GetAffinity() *Affinity {
+	if m != nil {
+		return m.Affinity
+	}
+	return nil
 }
-func (m *Builds) XXX_DiscardUnknown() {
-	xxx_messageInfo_Builds.DiscardUnknown(m)
+
+func (m *DeployOptions) GetNodeSelector() string {
+	if m != nil {
+		return m.NodeSelector
+	}
+	return ""
 }
 
-var xxx_messageInfo_Builds proto.InternalMessageInfo
+func (m *DeployOptions) GetPriority() int32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
 
-func (m *Builds) GetStages() []string {
+func (m *DeployOptions) GetDevices() map[string]int32 {
 	if m != nil {
-		return m.Stages
+		return m.Devices
 	}
 	return nil
 }
 
-func (m *Builds) GetBuilds() map[string]*Build {
+func (m *DeployOptions) GetBandwidth() int64 {
 	if m != nil {
-		return m.Builds
+		return m.Bandwidth
+	}
+	return 0
+}
+
+func (m *DeployOptions) GetSecretEnv() map[string]string {
+	if m != nil {
+		return m.SecretEnv
 	}
 	return nil
 }
 
-type BuildImageOptions struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	User                 string   `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
-	Uid                  int32    `protobuf:"varint,3,opt,name=uid,proto3" json:"uid,omitempty"`
-	Tags                 []string `protobuf:"bytes,4,rep,name=tags,proto3" json:"tags,omitempty"`
-	Builds               *Builds  `protobuf:"bytes,5,opt,name=builds,proto3" json:"builds,omitempty"`
-	Tar                  []byte   `protobuf:"bytes,6,opt,name=tar,proto3" json:"tar,omitempty"`
+func (m *DeployOptions) GetSecretData() map[string]string {
+	if m != nil {
+		return m.SecretData
+	}
+	return nil
+}
+
+type Affinity struct {
+	MaxPerNode           int32    `protobuf:"varint,1,opt,name=max_per_node,json=maxPerNode,proto3" json:"max_per_node,omitempty"`
+	SpreadLabel          string   `protobuf:"bytes,2,opt,name=spread_label,json=spreadLabel,proto3" json:"spread_label,omitempty"`
+	Colocate             []string `protobuf:"bytes,3,rep,name=colocate,proto3" json:"colocate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BuildImageOptions) Reset()         { *m = BuildImageOptions{} }
-func (m *BuildImageOptions) String() string { return proto.CompactTextString(m) }
-func (*BuildImageOptions) ProtoMessage()    {}
-func (*BuildImageOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{34}
+func (m *Affinity) Reset()         { *m = Affinity{} }
+func (m *Affinity) String() string { return proto.CompactTextString(m) }
+func (*Affinity) ProtoMessage()    {}
+func (*Affinity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{55}
 }
 
-func (m *BuildImageOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BuildImageOptions.Unmarshal(m, b)
+func (m *Affinity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Affinity.Unmarshal(m, b)
 }
-func (m *BuildImageOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BuildImageOptions.Marshal(b, m, deterministic)
+func (m *Affinity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Affinity.Marshal(b, m, deterministic)
 }
-func (m *BuildImageOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BuildImageOptions.Merge(m, src)
+func (m *Affinity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Affinity.Merge(m, src)
 }
-func (m *BuildImageOptions) XXX_Size() int {
-	return xxx_messageInfo_BuildImageOptions.Size(m)
+func (m *Affinity) XXX_Size() int {
+	return xxx_messageInfo_Affinity.Size(m)
 }
-func (m *BuildImageOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_BuildImageOptions.DiscardUnknown(m)
+func (m *Affinity) XXX_DiscardUnknown() {
+	xxx_messageInfo_Affinity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BuildImageOptions proto.InternalMessageInfo
+var xxx_messageInfo_Affinity proto.InternalMessageInfo
 
-func (m *BuildImageOptions) GetName() string {
+func (m *Affinity) GetMaxPerNode() int32 {
 	if m != nil {
-		return m.Name
+		return m.MaxPerNode
 	}
-	return ""
+	return 0
 }
 
-func (m *BuildImageOptions) GetUser() string {
+func (m *Affinity) GetSpreadLabel() string {
 	if m != nil {
-		return m.User
+		return m.SpreadLabel
 	}
 	return ""
 }
 
-func (m *BuildImageOptions) GetUid() int32 {
+func (m *Affinity) GetColocate() []string {
 	if m != nil {
-		return m.Uid
+		return m.Colocate
 	}
-	return 0
+	return nil
 }
 
-func (m *BuildImageOptions) GetTags() []string {
-	if m != nil {
-		return m.Tags
-	}
-	return nil
+type ReplaceOptions struct {
+	DeployOpt            *DeployOptions    `protobuf:"bytes,1,opt,name=deployOpt,proto3" json:"deployOpt,omitempty"`
+	Networkinherit       bool              `protobuf:"varint,2,opt,name=networkinherit,proto3" json:"networkinherit,omitempty"`
+	FilterLabels         map[string]string `protobuf:"bytes,3,rep,name=filter_labels,json=filterLabels,proto3" json:"filter_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Copy                 map[string]string `protobuf:"bytes,4,rep,name=copy,proto3" json:"copy,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Ids                  []string          `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
+	BatchSize            int32             `protobuf:"varint,6,opt,name=batch_size,json=batchSize,proto3" json:"batch_size,omitempty"`
+	MaxUnavailable       int32             `protobuf:"varint,7,opt,name=max_unavailable,json=maxUnavailable,proto3" json:"max_unavailable,omitempty"`
+	BatchInterval        int32             `protobuf:"varint,8,opt,name=batch_interval,json=batchInterval,proto3" json:"batch_interval,omitempty"`
+	HealthCheckTimeout   int32             `protobuf:"varint,9,opt,name=health_check_timeout,json=healthCheckTimeout,proto3" json:"health_check_timeout,omitempty"`
+	Rollback             bool              `protobuf:"varint,10,opt,name=rollback,proto3" json:"rollback,omitempty"`
+	FilterSelector       string            `protobuf:"bytes,11,opt,name=filter_selector,json=filterSelector,proto3" json:"filter_selector,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *BuildImageOptions) GetBuilds() *Builds {
-	if m != nil {
-		return m.Builds
-	}
-	return nil
+func (m *ReplaceOptions) Reset()         { *m = ReplaceOptions{} }
+func (m *ReplaceOptions) String() string { return proto.CompactTextString(m) }
+func (*ReplaceOptions) ProtoMessage()    {}
+func (*ReplaceOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{56}
 }
 
-func (m *BuildImageOptions) GetTar() []byte {
+func (m *ReplaceOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplaceOptions.Unmarshal(m, b)
+}
+func (m *ReplaceOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplaceOptions.Marshal(b, m, deterministic)
+}
+func (m *ReplaceOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplaceOptions.Merge(m, src)
+}
+func (m *ReplaceOptions) XXX_Size() int {
+	return xxx_messageInfo_ReplaceOptions.Size(m)
+}
+func (m *ReplaceOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplaceOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplaceOptions proto.InternalMessageInfo
+
+func (m *ReplaceOptions) GetDeployOpt() *DeployOptions {
 	if m != nil {
-		return m.Tar
+		return m.DeployOpt
 	}
 	return nil
 }
 
-type HookOptions struct {
-	AfterStart           []string `protobuf:"bytes,1,rep,name=after_start,json=afterStart,proto3" json:"after_start,omitempty"`
-	BeforeStop           []string `protobuf:"bytes,2,rep,name=before_stop,json=beforeStop,proto3" json:"before_stop,omitempty"`
-	Force                bool     `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *HookOptions) Reset()         { *m = HookOptions{} }
-func (m *HookOptions) String() string { return proto.CompactTextString(m) }
-func (*HookOptions) ProtoMessage()    {}
-func (*HookOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{35}
+func (m *ReplaceOptions) GetNetworkinherit() bool {
+	if m != nil {
+		return m.Networkinherit
+	}
+	return false
 }
 
-func (m *HookOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HookOptions.Unmarshal(m, b)
-}
-func (m *HookOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HookOptions.Marshal(b, m, deterministic)
+func (m *ReplaceOptions) GetFilterLabels() map[string]string {
+	if m != nil {
+		return m.FilterLabels
+	}
+	return nil
 }
-func (m *HookOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HookOptions.Merge(m, src)
+
+func (m *ReplaceOptions) GetCopy() map[string]string {
+	if m != nil {
+		return m.Copy
+	}
+	return nil
 }
-func (m *HookOptions) XXX_Size() int {
-	return xxx_messageInfo_HookOptions.Size(m)
+
+func (m *ReplaceOptions) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
 }
-func (m *HookOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_HookOptions.DiscardUnknown(m)
+
+func (m *ReplaceOptions) GetBatchSize() int32 {
+	if m != nil {
+		return m.BatchSize
+	}
+	return 0
 }
 
-var xxx_messageInfo_HookOptions proto.InternalMessageInfo
+func (m *ReplaceOptions) GetMaxUnavailable() int32 {
+	if m != nil {
+		return m.MaxUnavailable
+	}
+	return 0
+}
 
-func (m *HookOptions) GetAfterStart() []string {
+func (m *ReplaceOptions) GetBatchInterval() int32 {
 	if m != nil {
-		return m.AfterStart
+		return m.BatchInterval
 	}
-	return nil
+	return 0
 }
 
-func (m *HookOptions) GetBeforeStop() []string {
+func (m *ReplaceOptions) GetHealthCheckTimeout() int32 {
 	if m != nil {
-		return m.BeforeStop
+		return m.HealthCheckTimeout
 	}
-	return nil
+	return 0
 }
 
-func (m *HookOptions) GetForce() bool {
+func (m *ReplaceOptions) GetRollback() bool {
 	if m != nil {
-		return m.Force
+		return m.Rollback
 	}
 	return false
 }
 
-type HealthCheckOptions struct {
-	TcpPorts             []string `protobuf:"bytes,1,rep,name=tcp_ports,json=tcpPorts,proto3" json:"tcp_ports,omitempty"`
-	HttpPort             string   `protobuf:"bytes,2,opt,name=http_port,json=httpPort,proto3" json:"http_port,omitempty"`
-	Url                  string   `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`
-	Code                 int32    `protobuf:"varint,4,opt,name=code,proto3" json:"code,omitempty"`
+func (m *ReplaceOptions) GetFilterSelector() string {
+	if m != nil {
+		return m.FilterSelector
+	}
+	return ""
+}
+
+type Deployment struct {
+	Appname              string   `protobuf:"bytes,1,opt,name=appname,proto3" json:"appname,omitempty"`
+	Entrypoint           string   `protobuf:"bytes,2,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	Podname              string   `protobuf:"bytes,3,opt,name=podname,proto3" json:"podname,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HealthCheckOptions) Reset()         { *m = HealthCheckOptions{} }
-func (m *HealthCheckOptions) String() string { return proto.CompactTextString(m) }
-func (*HealthCheckOptions) ProtoMessage()    {}
-func (*HealthCheckOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{36}
+func (m *Deployment) Reset()         { *m = Deployment{} }
+func (m *Deployment) String() string { return proto.CompactTextString(m) }
+func (*Deployment) ProtoMessage()    {}
+func (*Deployment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{57}
 }
 
-func (m *HealthCheckOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HealthCheckOptions.Unmarshal(m, b)
+func (m *Deployment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Deployment.Unmarshal(m, b)
 }
-func (m *HealthCheckOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HealthCheckOptions.Marshal(b, m, deterministic)
+func (m *Deployment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Deployment.Marshal(b, m, deterministic)
 }
-func (m *HealthCheckOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HealthCheckOptions.Merge(m, src)
+func (m *Deployment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deployment.Merge(m, src)
 }
-func (m *HealthCheckOptions) XXX_Size() int {
-	return xxx_messageInfo_HealthCheckOptions.Size(m)
+func (m *Deployment) XXX_Size() int {
+	return xxx_messageInfo_Deployment.Size(m)
 }
-func (m *HealthCheckOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_HealthCheckOptions.DiscardUnknown(m)
+func (m *Deployment) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deployment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HealthCheckOptions proto.InternalMessageInfo
+var xxx_messageInfo_Deployment proto.InternalMessageInfo
 
-func (m *HealthCheckOptions) GetTcpPorts() []string {
+func (m *Deployment) GetAppname() string {
 	if m != nil {
-		return m.TcpPorts
+		return m.Appname
 	}
-	return nil
+	return ""
 }
 
-func (m *HealthCheckOptions) GetHttpPort() string {
+func (m *Deployment) GetEntrypoint() string {
 	if m != nil {
-		return m.HttpPort
+		return m.Entrypoint
 	}
 	return ""
 }
 
-func (m *HealthCheckOptions) GetUrl() string {
+func (m *Deployment) GetPodname() string {
 	if m != nil {
-		return m.Url
+		return m.Podname
 	}
 	return ""
 }
 
-func (m *HealthCheckOptions) GetCode() int32 {
+func (m *Deployment) GetCount() int32 {
 	if m != nil {
-		return m.Code
+		return m.Count
 	}
 	return 0
 }
 
-type LogOptions struct {
-	Type                 string            `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Config               map[string]string `protobuf:"bytes,2,rep,name=config,proto3" json:"config,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type Deployments struct {
+	Deployments          []*Deployment `protobuf:"bytes,1,rep,name=deployments,proto3" json:"deployments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *LogOptions) Reset()         { *m = LogOptions{} }
-func (m *LogOptions) String() string { return proto.CompactTextString(m) }
-func (*LogOptions) ProtoMessage()    {}
-func (*LogOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{37}
+func (m *Deployments) Reset()         { *m = Deployments{} }
+func (m *Deployments) String() string { return proto.CompactTextString(m) }
+func (*Deployments) ProtoMessage()    {}
+func (*Deployments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{58}
 }
 
-func (m *LogOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LogOptions.Unmarshal(m, b)
+func (m *Deployments) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Deployments.Unmarshal(m, b)
 }
-func (m *LogOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LogOptions.Marshal(b, m, deterministic)
+func (m *Deployments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Deployments.Marshal(b, m, deterministic)
 }
-func (m *LogOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LogOptions.Merge(m, src)
+func (m *Deployments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deployments.Merge(m, src)
 }
-func (m *LogOptions) XXX_Size() int {
-	return xxx_messageInfo_LogOptions.Size(m)
+func (m *Deployments) XXX_Size() int {
+	return xxx_messageInfo_Deployments.Size(m)
 }
-func (m *LogOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_LogOptions.DiscardUnknown(m)
+func (m *Deployments) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deployments.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LogOptions proto.InternalMessageInfo
-
-func (m *LogOptions) GetType() string {
-	if m != nil {
-		return m.Type
-	}
-	return ""
-}
+var xxx_messageInfo_Deployments proto.InternalMessageInfo
 
-func (m *LogOptions) GetConfig() map[string]string {
+func (m *Deployments) GetDeployments() []*Deployment {
 	if m != nil {
-		return m.Config
+		return m.Deployments
 	}
 	return nil
 }
 
-type EntrypointOptions struct {
-	Name                 string              `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Command              string              `protobuf:"bytes,2,opt,name=command,proto3" json:"command,omitempty"`
-	Privileged           bool                `protobuf:"varint,3,opt,name=privileged,proto3" json:"privileged,omitempty"`
-	Dir                  string              `protobuf:"bytes,4,opt,name=dir,proto3" json:"dir,omitempty"`
-	Log                  *LogOptions         `protobuf:"bytes,5,opt,name=log,proto3" json:"log,omitempty"`
-	Publish              []string            `protobuf:"bytes,6,rep,name=publish,proto3" json:"publish,omitempty"`
-	Healthcheck          *HealthCheckOptions `protobuf:"bytes,7,opt,name=healthcheck,proto3" json:"healthcheck,omitempty"`
-	Hook                 *HookOptions        `protobuf:"bytes,8,opt,name=hook,proto3" json:"hook,omitempty"`
-	RestartPolicy        string              `protobuf:"bytes,9,opt,name=restart_policy,json=restartPolicy,proto3" json:"restart_policy,omitempty"`
-	Sysctls              map[string]string   `protobuf:"bytes,10,rep,name=sysctls,proto3" json:"sysctls,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type RemoveDeploymentOptions struct {
+	Appname              string   `protobuf:"bytes,1,opt,name=appname,proto3" json:"appname,omitempty"`
+	Entrypoint           string   `protobuf:"bytes,2,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EntrypointOptions) Reset()         { *m = EntrypointOptions{} }
-func (m *EntrypointOptions) String() string { return proto.CompactTextString(m) }
-func (*EntrypointOptions) ProtoMessage()    {}
-func (*EntrypointOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{38}
+func (m *RemoveDeploymentOptions) Reset()         { *m = RemoveDeploymentOptions{} }
+func (m *RemoveDeploymentOptions) String() string { return proto.CompactTextString(m) }
+func (*RemoveDeploymentOptions) ProtoMessage()    {}
+func (*RemoveDeploymentOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{59}
 }
 
-func (m *EntrypointOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_EntrypointOptions.Unmarshal(m, b)
+func (m *RemoveDeploymentOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveDeploymentOptions.Unmarshal(m, b)
 }
-func (m *EntrypointOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_EntrypointOptions.Marshal(b, m, deterministic)
+func (m *RemoveDeploymentOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveDeploymentOptions.Marshal(b, m, deterministic)
 }
-func (m *EntrypointOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_EntrypointOptions.Merge(m, src)
+func (m *RemoveDeploymentOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveDeploymentOptions.Merge(m, src)
 }
-func (m *EntrypointOptions) XXX_Size() int {
-	return xxx_messageInfo_EntrypointOptions.Size(m)
+func (m *RemoveDeploymentOptions) XXX_Size() int {
+	return xxx_messageInfo_RemoveDeploymentOptions.Size(m)
 }
-func (m *EntrypointOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_EntrypointOptions.DiscardUnknown(m)
+func (m *RemoveDeploymentOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveDeploymentOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_EntrypointOptions proto.InternalMessageInfo
+var xxx_messageInfo_RemoveDeploymentOptions proto.InternalMessageInfo
 
-func (m *EntrypointOptions) GetName() string {
+func (m *RemoveDeploymentOptions) GetAppname() string {
 	if m != nil {
-		return m.Name
+		return m.Appname
 	}
 	return ""
 }
 
-func (m *EntrypointOptions) GetCommand() string {
+func (m *RemoveDeploymentOptions) GetEntrypoint() string {
 	if m != nil {
-		return m.Command
+		return m.Entrypoint
 	}
 	return ""
 }
 
-func (m *EntrypointOptions) GetPrivileged() bool {
-	if m != nil {
-		return m.Privileged
-	}
-	return false
+type AutoscalePolicy struct {
+	Appname              string   `protobuf:"bytes,1,opt,name=appname,proto3" json:"appname,omitempty"`
+	Entrypoint           string   `protobuf:"bytes,2,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	Min                  int32    `protobuf:"varint,3,opt,name=min,proto3" json:"min,omitempty"`
+	Max                  int32    `protobuf:"varint,4,opt,name=max,proto3" json:"max,omitempty"`
+	Metric               string   `protobuf:"bytes,5,opt,name=metric,proto3" json:"metric,omitempty"`
+	Threshold            float64  `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Cooldown             int64    `protobuf:"varint,7,opt,name=cooldown,proto3" json:"cooldown,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *EntrypointOptions) GetDir() string {
+func (m *AutoscalePolicy) Reset()         { *m = AutoscalePolicy{} }
+func (m *AutoscalePolicy) String() string { return proto.CompactTextString(m) }
+func (*AutoscalePolicy) ProtoMessage()    {}
+func (*AutoscalePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{60}
+}
+
+func (m *AutoscalePolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutoscalePolicy.Unmarshal(m, b)
+}
+func (m *AutoscalePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutoscalePolicy.Marshal(b, m, deterministic)
+}
+func (m *AutoscalePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoscalePolicy.Merge(m, src)
+}
+func (m *AutoscalePolicy) XXX_Size() int {
+	return xxx_messageInfo_AutoscalePolicy.Size(m)
+}
+func (m *AutoscalePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoscalePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoscalePolicy proto.InternalMessageInfo
+
+func (m *AutoscalePolicy) GetAppname() string {
 	if m != nil {
-		return m.Dir
+		return m.Appname
 	}
 	return ""
 }
 
-func (m *EntrypointOptions) GetLog() *LogOptions {
+func (m *AutoscalePolicy) GetEntrypoint() string {
 	if m != nil {
-		return m.Log
+		return m.Entrypoint
 	}
-	return nil
+	return ""
 }
 
-func (m *EntrypointOptions) GetPublish() []string {
+func (m *AutoscalePolicy) GetMin() int32 {
 	if m != nil {
-		return m.Publish
+		return m.Min
 	}
-	return nil
+	return 0
 }
 
-func (m *EntrypointOptions) GetHealthcheck() *HealthCheckOptions {
+func (m *AutoscalePolicy) GetMax() int32 {
 	if m != nil {
-		return m.Healthcheck
+		return m.Max
 	}
-	return nil
+	return 0
 }
 
-func (m *EntrypointOptions) GetHook() *HookOptions {
+func (m *AutoscalePolicy) GetMetric() string {
 	if m != nil {
-		return m.Hook
+		return m.Metric
 	}
-	return nil
+	return ""
 }
 
-func (m *EntrypointOptions) GetRestartPolicy() string {
+func (m *AutoscalePolicy) GetThreshold() float64 {
 	if m != nil {
-		return m.RestartPolicy
+		return m.Threshold
 	}
-	return ""
+	return 0
 }
 
-func (m *EntrypointOptions) GetSysctls() map[string]string {
+func (m *AutoscalePolicy) GetCooldown() int64 {
 	if m != nil {
-		return m.Sysctls
+		return m.Cooldown
 	}
-	return nil
+	return 0
 }
 
-type DeployOptions struct {
-	Name                 string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Entrypoint           *EntrypointOptions `protobuf:"bytes,2,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
-	Podname              string             `protobuf:"bytes,3,opt,name=podname,proto3" json:"podname,omitempty"`
-	Nodename             string             `protobuf:"bytes,4,opt,name=nodename,proto3" json:"nodename,omitempty"`
-	Image                string             `protobuf:"bytes,5,opt,name=image,proto3" json:"image,omitempty"`
-	ExtraArgs            string             `protobuf:"bytes,6,opt,name=extra_args,json=extraArgs,proto3" json:"extra_args,omitempty"`
-	CpuQuota             float64            `protobuf:"fixed64,7,opt,name=cpu_quota,json=cpuQuota,proto3" json:"cpu_quota,omitempty"`
-	Memory               int64              `protobuf:"varint,8,opt,name=memory,proto3" json:"memory,omitempty"`
-	Count                int32              `protobuf:"varint,9,opt,name=count,proto3" json:"count,omitempty"`
-	Env                  []string           `protobuf:"bytes,10,rep,name=env,proto3" json:"env,omitempty"`
-	Dns                  []string           `protobuf:"bytes,11,rep,name=dns,proto3" json:"dns,omitempty"`
-	ExtraHosts           []string           `protobuf:"bytes,12,rep,name=extra_hosts,json=extraHosts,proto3" json:"extra_hosts,omitempty"`
-	Volumes              []string           `protobuf:"bytes,13,rep,name=volumes,proto3" json:"volumes,omitempty"`
-	Networks             map[string]string  `protobuf:"bytes,14,rep,name=networks,proto3" json:"networks,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Networkmode          string             `protobuf:"bytes,15,opt,name=networkmode,proto3" json:"networkmode,omitempty"`
-	User                 string             `protobuf:"bytes,16,opt,name=user,proto3" json:"user,omitempty"`
-	Debug                bool               `protobuf:"varint,17,opt,name=debug,proto3" json:"debug,omitempty"`
-	OpenStdin            bool               `protobuf:"varint,18,opt,name=openStdin,proto3" json:"openStdin,omitempty"`
-	Labels               map[string]string  `protobuf:"bytes,19,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Nodelabels           map[string]string  `protobuf:"bytes,20,rep,name=nodelabels,proto3" json:"nodelabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	DeployMethod         string             `protobuf:"bytes,21,opt,name=deploy_method,json=deployMethod,proto3" json:"deploy_method,omitempty"`
-	Data                 map[string][]byte  `protobuf:"bytes,22,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	SoftLimit            bool               `protobuf:"varint,23,opt,name=soft_limit,json=softLimit,proto3" json:"soft_limit,omitempty"`
-	NodesLimit           int32              `protobuf:"varint,24,opt,name=nodes_limit,json=nodesLimit,proto3" json:"nodes_limit,omitempty"`
-	CpuBind              bool               `protobuf:"varint,25,opt,name=cpu_bind,json=cpuBind,proto3" json:"cpu_bind,omitempty"`
-	IgnoreHook           bool               `protobuf:"varint,26,opt,name=ignore_hook,json=ignoreHook,proto3" json:"ignore_hook,omitempty"`
-	AfterCreate          []string           `protobuf:"bytes,27,rep,name=after_create,json=afterCreate,proto3" json:"after_create,omitempty"`
-	RawArgs              []byte             `protobuf:"bytes,28,opt,name=raw_args,json=rawArgs,proto3" json:"raw_args,omitempty"`
-	Storage              int64              `protobuf:"varint,29,opt,name=storage,proto3" json:"storage,omitempty"`
+type AutoscalePolicies struct {
+	Policies             []*AutoscalePolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
 	XXX_sizecache        int32              `json:"-"`
 }
 
-func (m *DeployOptions) Reset()         { *m = DeployOptions{} }
-func (m *DeployOptions) String() string { return proto.CompactTextString(m) }
-func (*DeployOptions) ProtoMessage()    {}
-func (*DeployOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{39}
+func (m *AutoscalePolicies) Reset()         { *m = AutoscalePolicies{} }
+func (m *AutoscalePolicies) String() string { return proto.CompactTextString(m) }
+func (*AutoscalePolicies) ProtoMessage()    {}
+func (*AutoscalePolicies) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{61}
 }
 
-func (m *DeployOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeployOptions.Unmarshal(m, b)
+func (m *AutoscalePolicies) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutoscalePolicies.Unmarshal(m, b)
 }
-func (m *DeployOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeployOptions.Marshal(b, m, deterministic)
+func (m *AutoscalePolicies) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutoscalePolicies.Marshal(b, m, deterministic)
+}
+func (m *AutoscalePolicies) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoscalePolicies.Merge(m, src)
+}
+func (m *AutoscalePolicies) XXX_Size() int {
+	return xxx_messageInfo_AutoscalePolicies.Size(m)
+}
+func (m *AutoscalePolicies) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoscalePolicies.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoscalePolicies proto.InternalMessageInfo
+
+func (m *AutoscalePolicies) GetPolicies() []*AutoscalePolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+type RemoveAutoscalePolicyOptions struct {
+	Appname              string   `protobuf:"bytes,1,opt,name=appname,proto3" json:"appname,omitempty"`
+	Entrypoint           string   `protobuf:"bytes,2,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveAutoscalePolicyOptions) Reset()         { *m = RemoveAutoscalePolicyOptions{} }
+func (m *RemoveAutoscalePolicyOptions) String() string { return proto.CompactTextString(m) }
+func (*RemoveAutoscalePolicyOptions) ProtoMessage()    {}
+func (*RemoveAutoscalePolicyOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{62}
+}
+
+func (m *RemoveAutoscalePolicyOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveAutoscalePolicyOptions.Unmarshal(m, b)
 }
-func (m *DeployOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeployOptions.Merge(m, src)
+func (m *RemoveAutoscalePolicyOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveAutoscalePolicyOptions.Marshal(b, m, deterministic)
 }
-func (m *DeployOptions) XXX_Size() int {
-	return xxx_messageInfo_DeployOptions.Size(m)
+func (m *RemoveAutoscalePolicyOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveAutoscalePolicyOptions.Merge(m, src)
 }
-func (m *DeployOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeployOptions.DiscardUnknown(m)
+func (m *RemoveAutoscalePolicyOptions) XXX_Size() int {
+	return xxx_messageInfo_RemoveAutoscalePolicyOptions.Size(m)
+}
+func (m *RemoveAutoscalePolicyOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveAutoscalePolicyOptions.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeployOptions proto.InternalMessageInfo
+var xxx_messageInfo_RemoveAutoscalePolicyOptions proto.InternalMessageInfo
 
-func (m *DeployOptions) GetName() string {
+func (m *RemoveAutoscalePolicyOptions) GetAppname() string {
 	if m != nil {
-		return m.Name
+		return m.Appname
 	}
 	return ""
 }
 
-func (m *DeployOptions) GetEntrypoint() *EntrypointOptions {
+func (m *RemoveAutoscalePolicyOptions) GetEntrypoint() string {
 	if m != nil {
 		return m.Entrypoint
 	}
-	return nil
+	return ""
 }
 
-func (m *DeployOptions) GetPodname() string {
-	if m != nil {
-		return m.Podname
-	}
-	return ""
+type AutoscaleDecision struct {
+	Appname              string   `protobuf:"bytes,1,opt,name=appname,proto3" json:"appname,omitempty"`
+	Entrypoint           string   `protobuf:"bytes,2,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	Time                 int64    `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	From                 int32    `protobuf:"varint,4,opt,name=from,proto3" json:"from,omitempty"`
+	To                   int32    `protobuf:"varint,5,opt,name=to,proto3" json:"to,omitempty"`
+	Value                float64  `protobuf:"fixed64,6,opt,name=value,proto3" json:"value,omitempty"`
+	Error                string   `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeployOptions) GetNodename() string {
-	if m != nil {
-		return m.Nodename
-	}
-	return ""
+func (m *AutoscaleDecision) Reset()         { *m = AutoscaleDecision{} }
+func (m *AutoscaleDecision) String() string { return proto.CompactTextString(m) }
+func (*AutoscaleDecision) ProtoMessage()    {}
+func (*AutoscaleDecision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{63}
 }
 
-func (m *DeployOptions) GetImage() string {
+func (m *AutoscaleDecision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutoscaleDecision.Unmarshal(m, b)
+}
+func (m *AutoscaleDecision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutoscaleDecision.Marshal(b, m, deterministic)
+}
+func (m *AutoscaleDecision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoscaleDecision.Merge(m, src)
+}
+func (m *AutoscaleDecision) XXX_Size() int {
+	return xxx_messageInfo_AutoscaleDecision.Size(m)
+}
+func (m *AutoscaleDecision) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoscaleDecision.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoscaleDecision proto.InternalMessageInfo
+
+func (m *AutoscaleDecision) GetAppname() string {
 	if m != nil {
-		return m.Image
+		return m.Appname
 	}
 	return ""
 }
 
-func (m *DeployOptions) GetExtraArgs() string {
+func (m *AutoscaleDecision) GetEntrypoint() string {
 	if m != nil {
-		return m.ExtraArgs
+		return m.Entrypoint
 	}
 	return ""
 }
 
-func (m *DeployOptions) GetCpuQuota() float64 {
+func (m *AutoscaleDecision) GetTime() int64 {
 	if m != nil {
-		return m.CpuQuota
+		return m.Time
 	}
 	return 0
 }
 
-func (m *DeployOptions) GetMemory() int64 {
+func (m *AutoscaleDecision) GetFrom() int32 {
 	if m != nil {
-		return m.Memory
+		return m.From
 	}
 	return 0
 }
 
-func (m *DeployOptions) GetCount() int32 {
+func (m *AutoscaleDecision) GetTo() int32 {
 	if m != nil {
-		return m.Count
+		return m.To
 	}
 	return 0
 }
 
-func (m *DeployOptions) GetEnv() []string {
+func (m *AutoscaleDecision) GetValue() float64 {
 	if m != nil {
-		return m.Env
+		return m.Value
 	}
-	return nil
+	return 0
 }
 
-func (m *DeployOptions) GetDns() []string {
+func (m *AutoscaleDecision) GetError() string {
 	if m != nil {
-		return m.Dns
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-func (m *DeployOptions) GetExtraHosts() []string {
-	if m != nil {
-		return m.ExtraHosts
-	}
-	return nil
+type AutoscaleDecisions struct {
+	Decisions            []*AutoscaleDecision `protobuf:"bytes,1,rep,name=decisions,proto3" json:"decisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *DeployOptions) GetVolumes() []string {
-	if m != nil {
-		return m.Volumes
-	}
-	return nil
+func (m *AutoscaleDecisions) Reset()         { *m = AutoscaleDecisions{} }
+func (m *AutoscaleDecisions) String() string { return proto.CompactTextString(m) }
+func (*AutoscaleDecisions) ProtoMessage()    {}
+func (*AutoscaleDecisions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{64}
 }
 
-func (m *DeployOptions) GetNetworks() map[string]string {
+func (m *AutoscaleDecisions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AutoscaleDecisions.Unmarshal(m, b)
+}
+func (m *AutoscaleDecisions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AutoscaleDecisions.Marshal(b, m, deterministic)
+}
+func (m *AutoscaleDecisions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoscaleDecisions.Merge(m, src)
+}
+func (m *AutoscaleDecisions) XXX_Size() int {
+	return xxx_messageInfo_AutoscaleDecisions.Size(m)
+}
+func (m *AutoscaleDecisions) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoscaleDecisions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AutoscaleDecisions proto.InternalMessageInfo
+
+func (m *AutoscaleDecisions) GetDecisions() []*AutoscaleDecision {
 	if m != nil {
-		return m.Networks
+		return m.Decisions
 	}
 	return nil
 }
 
-func (m *DeployOptions) GetNetworkmode() string {
+type ListAutoscaleDecisionsOptions struct {
+	Appname              string   `protobuf:"bytes,1,opt,name=appname,proto3" json:"appname,omitempty"`
+	Entrypoint           string   `protobuf:"bytes,2,opt,name=entrypoint,proto3" json:"entrypoint,omitempty"`
+	Limit                int64    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListAutoscaleDecisionsOptions) Reset()         { *m = ListAutoscaleDecisionsOptions{} }
+func (m *ListAutoscaleDecisionsOptions) String() string { return proto.CompactTextString(m) }
+func (*ListAutoscaleDecisionsOptions) ProtoMessage()    {}
+func (*ListAutoscaleDecisionsOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{65}
+}
+
+func (m *ListAutoscaleDecisionsOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListAutoscaleDecisionsOptions.Unmarshal(m, b)
+}
+func (m *ListAutoscaleDecisionsOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListAutoscaleDecisionsOptions.Marshal(b, m, deterministic)
+}
+func (m *ListAutoscaleDecisionsOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListAutoscaleDecisionsOptions.Merge(m, src)
+}
+func (m *ListAutoscaleDecisionsOptions) XXX_Size() int {
+	return xxx_messageInfo_ListAutoscaleDecisionsOptions.Size(m)
+}
+func (m *ListAutoscaleDecisionsOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListAutoscaleDecisionsOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListAutoscaleDecisionsOptions proto.InternalMessageInfo
+
+func (m *ListAutoscaleDecisionsOptions) GetAppname() string {
 	if m != nil {
-		return m.Networkmode
+		return m.Appname
 	}
 	return ""
 }
 
-func (m *DeployOptions) GetUser() string {
+func (m *ListAutoscaleDecisionsOptions) GetEntrypoint() string {
 	if m != nil {
-		return m.User
+		return m.Entrypoint
 	}
 	return ""
 }
 
-func (m *DeployOptions) GetDebug() bool {
+func (m *ListAutoscaleDecisionsOptions) GetLimit() int64 {
 	if m != nil {
-		return m.Debug
+		return m.Limit
 	}
-	return false
+	return 0
 }
 
-func (m *DeployOptions) GetOpenStdin() bool {
-	if m != nil {
-		return m.OpenStdin
-	}
-	return false
+type Quota struct {
+	Appname              string   `protobuf:"bytes,1,opt,name=appname,proto3" json:"appname,omitempty"`
+	Podname              string   `protobuf:"bytes,2,opt,name=podname,proto3" json:"podname,omitempty"`
+	Cpu                  float64  `protobuf:"fixed64,3,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory               int64    `protobuf:"varint,4,opt,name=memory,proto3" json:"memory,omitempty"`
+	Storage              int64    `protobuf:"varint,5,opt,name=storage,proto3" json:"storage,omitempty"`
+	Count                int32    `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeployOptions) GetLabels() map[string]string {
-	if m != nil {
-		return m.Labels
-	}
-	return nil
+func (m *Quota) Reset()         { *m = Quota{} }
+func (m *Quota) String() string { return proto.CompactTextString(m) }
+func (*Quota) ProtoMessage()    {}
+func (*Quota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{66}
 }
 
-func (m *DeployOptions) GetNodelabels() map[string]string {
-	if m != nil {
-		return m.Nodelabels
-	}
-	return nil
+func (m *Quota) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Quota.Unmarshal(m, b)
+}
+func (m *Quota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Quota.Marshal(b, m, deterministic)
+}
+func (m *Quota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Quota.Merge(m, src)
+}
+func (m *Quota) XXX_Size() int {
+	return xxx_messageInfo_Quota.Size(m)
+}
+func (m *Quota) XXX_DiscardUnknown() {
+	xxx_messageInfo_Quota.DiscardUnknown(m)
 }
 
-func (m *DeployOptions) GetDeployMethod() string {
+var xxx_messageInfo_Quota proto.InternalMessageInfo
+
+func (m *Quota) GetAppname() string {
 	if m != nil {
-		return m.DeployMethod
+		return m.Appname
 	}
 	return ""
 }
 
-func (m *DeployOptions) GetData() map[string][]byte {
+func (m *Quota) GetPodname() string {
 	if m != nil {
-		return m.Data
+		return m.Podname
 	}
-	return nil
+	return ""
 }
 
-func (m *DeployOptions) GetSoftLimit() bool {
+func (m *Quota) GetCpu() float64 {
 	if m != nil {
-		return m.SoftLimit
+		return m.Cpu
 	}
-	return false
+	return 0
 }
 
-func (m *DeployOptions) GetNodesLimit() int32 {
+func (m *Quota) GetMemory() int64 {
 	if m != nil {
-		return m.NodesLimit
+		return m.Memory
 	}
 	return 0
 }
 
-func (m *DeployOptions) GetCpuBind() bool {
+func (m *Quota) GetStorage() int64 {
 	if m != nil {
-		return m.CpuBind
+		return m.Storage
 	}
-	return false
+	return 0
 }
 
-func (m *DeployOptions) GetIgnoreHook() bool {
+func (m *Quota) GetCount() int32 {
 	if m != nil {
-		return m.IgnoreHook
+		return m.Count
 	}
-	return false
+	return 0
 }
 
-func (m *DeployOptions) GetAfterCreate() []string {
+type RemoveQuotaOptions struct {
+	Appname              string   `protobuf:"bytes,1,opt,name=appname,proto3" json:"appname,omitempty"`
+	Podname              string   `protobuf:"bytes,2,opt,name=podname,proto3" json:"podname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveQuotaOptions) Reset()         { *m = RemoveQuotaOptions{} }
+func (m *RemoveQuotaOptions) String() string { return proto.CompactTextString(m) }
+func (*RemoveQuotaOptions) ProtoMessage()    {}
+func (*RemoveQuotaOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{67}
+}
+
+func (m *RemoveQuotaOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveQuotaOptions.Unmarshal(m, b)
+}
+func (m *RemoveQuotaOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveQuotaOptions.Marshal(b, m, deterministic)
+}
+func (m *RemoveQuotaOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveQuotaOptions.Merge(m, src)
+}
+func (m *RemoveQuotaOptions) XXX_Size() int {
+	return xxx_messageInfo_RemoveQuotaOptions.Size(m)
+}
+func (m *RemoveQuotaOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveQuotaOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveQuotaOptions proto.InternalMessageInfo
+
+func (m *RemoveQuotaOptions) GetAppname() string {
 	if m != nil {
-		return m.AfterCreate
+		return m.Appname
 	}
-	return nil
+	return ""
 }
 
-func (m *DeployOptions) GetRawArgs() []byte {
+func (m *RemoveQuotaOptions) GetPodname() string {
 	if m != nil {
-		return m.RawArgs
+		return m.Podname
 	}
-	return nil
+	return ""
 }
 
-func (m *DeployOptions) GetStorage() int64 {
+type ListQuotaUsagesOptions struct {
+	Appname              string   `protobuf:"bytes,1,opt,name=appname,proto3" json:"appname,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListQuotaUsagesOptions) Reset()         { *m = ListQuotaUsagesOptions{} }
+func (m *ListQuotaUsagesOptions) String() string { return proto.CompactTextString(m) }
+func (*ListQuotaUsagesOptions) ProtoMessage()    {}
+func (*ListQuotaUsagesOptions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{68}
+}
+
+func (m *ListQuotaUsagesOptions) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListQuotaUsagesOptions.Unmarshal(m, b)
+}
+func (m *ListQuotaUsagesOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListQuotaUsagesOptions.Marshal(b, m, deterministic)
+}
+func (m *ListQuotaUsagesOptions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListQuotaUsagesOptions.Merge(m, src)
+}
+func (m *ListQuotaUsagesOptions) XXX_Size() int {
+	return xxx_messageInfo_ListQuotaUsagesOptions.Size(m)
+}
+func (m *ListQuotaUsagesOptions) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListQuotaUsagesOptions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListQuotaUsagesOptions proto.InternalMessageInfo
+
+func (m *ListQuotaUsagesOptions) GetAppname() string {
 	if m != nil {
-		return m.Storage
+		return m.Appname
 	}
-	return 0
+	return ""
 }
 
-type ReplaceOptions struct {
-	DeployOpt            *DeployOptions    `protobuf:"bytes,1,opt,name=deployOpt,proto3" json:"deployOpt,omitempty"`
-	Networkinherit       bool              `protobuf:"varint,2,opt,name=networkinherit,proto3" json:"networkinherit,omitempty"`
-	FilterLabels         map[string]string `protobuf:"bytes,3,rep,name=filter_labels,json=filterLabels,proto3" json:"filter_labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Copy                 map[string]string `protobuf:"bytes,4,rep,name=copy,proto3" json:"copy,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Ids                  []string          `protobuf:"bytes,5,rep,name=ids,proto3" json:"ids,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type QuotaUsage struct {
+	Quota                *Quota   `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	Cpu                  float64  `protobuf:"fixed64,2,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	Memory               int64    `protobuf:"varint,3,opt,name=memory,proto3" json:"memory,omitempty"`
+	Storage              int64    `protobuf:"varint,4,opt,name=storage,proto3" json:"storage,omitempty"`
+	Count                int32    `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReplaceOptions) Reset()         { *m = ReplaceOptions{} }
-func (m *ReplaceOptions) String() string { return proto.CompactTextString(m) }
-func (*ReplaceOptions) ProtoMessage()    {}
-func (*ReplaceOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{40}
+func (m *QuotaUsage) Reset()         { *m = QuotaUsage{} }
+func (m *QuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*QuotaUsage) ProtoMessage()    {}
+func (*QuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{69}
 }
 
-func (m *ReplaceOptions) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReplaceOptions.Unmarshal(m, b)
+func (m *QuotaUsage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaUsage.Unmarshal(m, b)
 }
-func (m *ReplaceOptions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReplaceOptions.Marshal(b, m, deterministic)
+func (m *QuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaUsage.Marshal(b, m, deterministic)
 }
-func (m *ReplaceOptions) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReplaceOptions.Merge(m, src)
+func (m *QuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsage.Merge(m, src)
 }
-func (m *ReplaceOptions) XXX_Size() int {
-	return xxx_messageInfo_ReplaceOptions.Size(m)
+func (m *QuotaUsage) XXX_Size() int {
+	return xxx_messageInfo_QuotaUsage.Size(m)
 }
-func (m *ReplaceOptions) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReplaceOptions.DiscardUnknown(m)
+func (m *QuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReplaceOptions proto.InternalMessageInfo
+var xxx_messageInfo_QuotaUsage proto.InternalMessageInfo
 
-func (m *ReplaceOptions) GetDeployOpt() *DeployOptions {
+func (m *QuotaUsage) GetQuota() *Quota {
 	if m != nil {
-		return m.DeployOpt
+		return m.Quota
 	}
 	return nil
 }
 
-func (m *ReplaceOptions) GetNetworkinherit() bool {
+func (m *QuotaUsage) GetCpu() float64 {
 	if m != nil {
-		return m.Networkinherit
+		return m.Cpu
 	}
-	return false
+	return 0
 }
 
-func (m *ReplaceOptions) GetFilterLabels() map[string]string {
+func (m *QuotaUsage) GetMemory() int64 {
 	if m != nil {
-		return m.FilterLabels
+		return m.Memory
 	}
-	return nil
+	return 0
+}
+
+func (m *QuotaUsage) GetStorage() int64 {
+	if m != nil {
+		return m.Storage
+	}
+	return 0
+}
+
+func (m *QuotaUsage) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type QuotaUsages struct {
+	Usages               []*QuotaUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *QuotaUsages) Reset()         { *m = QuotaUsages{} }
+func (m *QuotaUsages) String() string { return proto.CompactTextString(m) }
+func (*QuotaUsages) ProtoMessage()    {}
+func (*QuotaUsages) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{70}
+}
+
+func (m *QuotaUsages) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuotaUsages.Unmarshal(m, b)
+}
+func (m *QuotaUsages) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuotaUsages.Marshal(b, m, deterministic)
 }
-
-func (m *ReplaceOptions) GetCopy() map[string]string {
-	if m != nil {
-		return m.Copy
-	}
-	return nil
+func (m *QuotaUsages) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuotaUsages.Merge(m, src)
+}
+func (m *QuotaUsages) XXX_Size() int {
+	return xxx_messageInfo_QuotaUsages.Size(m)
+}
+func (m *QuotaUsages) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuotaUsages.DiscardUnknown(m)
 }
 
-func (m *ReplaceOptions) GetIds() []string {
+var xxx_messageInfo_QuotaUsages proto.InternalMessageInfo
+
+func (m *QuotaUsages) GetUsages() []*QuotaUsage {
 	if m != nil {
-		return m.Ids
+		return m.Usages
 	}
 	return nil
 }
@@ -2902,7 +4964,7 @@ func (m *CacheImageOptions) Reset()         { *m = CacheImageOptions{} }
 func (m *CacheImageOptions) String() string { return proto.CompactTextString(m) }
 func (*CacheImageOptions) ProtoMessage()    {}
 func (*CacheImageOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{41}
+	return fileDescriptor_f7e43720d1edc0fe, []int{71}
 }
 
 func (m *CacheImageOptions) XXX_Unmarshal(b []byte) error {
@@ -2966,7 +5028,7 @@ func (m *RemoveImageOptions) Reset()         { *m = RemoveImageOptions{} }
 func (m *RemoveImageOptions) String() string { return proto.CompactTextString(m) }
 func (*RemoveImageOptions) ProtoMessage()    {}
 func (*RemoveImageOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{42}
+	return fileDescriptor_f7e43720d1edc0fe, []int{72}
 }
 
 func (m *RemoveImageOptions) XXX_Unmarshal(b []byte) error {
@@ -3033,7 +5095,7 @@ func (m *CopyPaths) Reset()         { *m = CopyPaths{} }
 func (m *CopyPaths) String() string { return proto.CompactTextString(m) }
 func (*CopyPaths) ProtoMessage()    {}
 func (*CopyPaths) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{43}
+	return fileDescriptor_f7e43720d1edc0fe, []int{73}
 }
 
 func (m *CopyPaths) XXX_Unmarshal(b []byte) error {
@@ -3072,7 +5134,7 @@ func (m *CopyOptions) Reset()         { *m = CopyOptions{} }
 func (m *CopyOptions) String() string { return proto.CompactTextString(m) }
 func (*CopyOptions) ProtoMessage()    {}
 func (*CopyOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{44}
+	return fileDescriptor_f7e43720d1edc0fe, []int{74}
 }
 
 func (m *CopyOptions) XXX_Unmarshal(b []byte) error {
@@ -3112,7 +5174,7 @@ func (m *SendOptions) Reset()         { *m = SendOptions{} }
 func (m *SendOptions) String() string { return proto.CompactTextString(m) }
 func (*SendOptions) ProtoMessage()    {}
 func (*SendOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{45}
+	return fileDescriptor_f7e43720d1edc0fe, []int{75}
 }
 
 func (m *SendOptions) XXX_Unmarshal(b []byte) error {
@@ -3159,7 +5221,7 @@ func (m *ErrorDetail) Reset()         { *m = ErrorDetail{} }
 func (m *ErrorDetail) String() string { return proto.CompactTextString(m) }
 func (*ErrorDetail) ProtoMessage()    {}
 func (*ErrorDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{46}
+	return fileDescriptor_f7e43720d1edc0fe, []int{76}
 }
 
 func (m *ErrorDetail) XXX_Unmarshal(b []byte) error {
@@ -3210,7 +5272,7 @@ func (m *BuildImageMessage) Reset()         { *m = BuildImageMessage{} }
 func (m *BuildImageMessage) String() string { return proto.CompactTextString(m) }
 func (*BuildImageMessage) ProtoMessage()    {}
 func (*BuildImageMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{47}
+	return fileDescriptor_f7e43720d1edc0fe, []int{77}
 }
 
 func (m *BuildImageMessage) XXX_Unmarshal(b []byte) error {
@@ -3284,7 +5346,7 @@ func (m *Volume) Reset()         { *m = Volume{} }
 func (m *Volume) String() string { return proto.CompactTextString(m) }
 func (*Volume) ProtoMessage()    {}
 func (*Volume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{48}
+	return fileDescriptor_f7e43720d1edc0fe, []int{78}
 }
 
 func (m *Volume) XXX_Unmarshal(b []byte) error {
@@ -3313,29 +5375,33 @@ func (m *Volume) GetVolume() map[string]int64 {
 }
 
 type CreateContainerMessage struct {
-	Podname              string             `protobuf:"bytes,1,opt,name=podname,proto3" json:"podname,omitempty"`
-	Nodename             string             `protobuf:"bytes,2,opt,name=nodename,proto3" json:"nodename,omitempty"`
-	Id                   string             `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string             `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
-	Error                string             `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
-	Success              bool               `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
-	Cpu                  map[string]int32   `protobuf:"bytes,7,rep,name=cpu,proto3" json:"cpu,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	Quota                float64            `protobuf:"fixed64,8,opt,name=quota,proto3" json:"quota,omitempty"`
-	Memory               int64              `protobuf:"varint,9,opt,name=memory,proto3" json:"memory,omitempty"`
-	Publish              map[string]string  `protobuf:"bytes,10,rep,name=publish,proto3" json:"publish,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	Hook                 []byte             `protobuf:"bytes,11,opt,name=hook,proto3" json:"hook,omitempty"`
-	Storage              int64              `protobuf:"varint,12,opt,name=storage,proto3" json:"storage,omitempty"`
-	VolumePlan           map[string]*Volume `protobuf:"bytes,13,rep,name=volume_plan,json=volumePlan,proto3" json:"volume_plan,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
-	XXX_unrecognized     []byte             `json:"-"`
-	XXX_sizecache        int32              `json:"-"`
+	Podname              string                  `protobuf:"bytes,1,opt,name=podname,proto3" json:"podname,omitempty"`
+	Nodename             string                  `protobuf:"bytes,2,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	Id                   string                  `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string                  `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	Error                string                  `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	Success              bool                    `protobuf:"varint,6,opt,name=success,proto3" json:"success,omitempty"`
+	Cpu                  map[string]int32        `protobuf:"bytes,7,rep,name=cpu,proto3" json:"cpu,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Quota                float64                 `protobuf:"fixed64,8,opt,name=quota,proto3" json:"quota,omitempty"`
+	Memory               int64                   `protobuf:"varint,9,opt,name=memory,proto3" json:"memory,omitempty"`
+	Publish              map[string]string       `protobuf:"bytes,10,rep,name=publish,proto3" json:"publish,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Hook                 []byte                  `protobuf:"bytes,11,opt,name=hook,proto3" json:"hook,omitempty"`
+	Storage              int64                   `protobuf:"varint,12,opt,name=storage,proto3" json:"storage,omitempty"`
+	VolumePlan           map[string]*Volume      `protobuf:"bytes,13,rep,name=volume_plan,json=volumePlan,proto3" json:"volume_plan,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RolledBack           bool                    `protobuf:"varint,14,opt,name=rolled_back,json=rolledBack,proto3" json:"rolled_back,omitempty"`
+	Evicted              *RemoveContainerMessage `protobuf:"bytes,15,opt,name=evicted,proto3" json:"evicted,omitempty"`
+	Devices              map[string]*Devices     `protobuf:"bytes,16,rep,name=devices,proto3" json:"devices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Bandwidth            int64                   `protobuf:"varint,17,opt,name=bandwidth,proto3" json:"bandwidth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
 }
 
 func (m *CreateContainerMessage) Reset()         { *m = CreateContainerMessage{} }
 func (m *CreateContainerMessage) String() string { return proto.CompactTextString(m) }
 func (*CreateContainerMessage) ProtoMessage()    {}
 func (*CreateContainerMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{49}
+	return fileDescriptor_f7e43720d1edc0fe, []int{79}
 }
 
 func (m *CreateContainerMessage) XXX_Unmarshal(b []byte) error {
@@ -3447,10 +5513,416 @@ func (m *CreateContainerMessage) GetVolumePlan() map[string]*Volume {
 	return nil
 }
 
+func (m *CreateContainerMessage) GetRolledBack() bool {
+	if m != nil {
+		return m.RolledBack
+	}
+	return false
+}
+
+func (m *CreateContainerMessage) GetEvicted() *RemoveContainerMessage {
+	if m != nil {
+		return m.Evicted
+	}
+	return nil
+}
+
+func (m *CreateContainerMessage) GetDevices() map[string]*Devices {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+func (m *CreateContainerMessage) GetBandwidth() int64 {
+	if m != nil {
+		return m.Bandwidth
+	}
+	return 0
+}
+
+type CPUPlan struct {
+	Cpu                  map[string]int32 `protobuf:"bytes,1,rep,name=cpu,proto3" json:"cpu,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CPUPlan) Reset()         { *m = CPUPlan{} }
+func (m *CPUPlan) String() string { return proto.CompactTextString(m) }
+func (*CPUPlan) ProtoMessage()    {}
+func (*CPUPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{80}
+}
+
+func (m *CPUPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CPUPlan.Unmarshal(m, b)
+}
+func (m *CPUPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CPUPlan.Marshal(b, m, deterministic)
+}
+func (m *CPUPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CPUPlan.Merge(m, src)
+}
+func (m *CPUPlan) XXX_Size() int {
+	return xxx_messageInfo_CPUPlan.Size(m)
+}
+func (m *CPUPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_CPUPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CPUPlan proto.InternalMessageInfo
+
+func (m *CPUPlan) GetCpu() map[string]int32 {
+	if m != nil {
+		return m.Cpu
+	}
+	return nil
+}
+
+type VolumePlan struct {
+	VolumePlan           map[string]*Volume `protobuf:"bytes,1,rep,name=volume_plan,json=volumePlan,proto3" json:"volume_plan,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *VolumePlan) Reset()         { *m = VolumePlan{} }
+func (m *VolumePlan) String() string { return proto.CompactTextString(m) }
+func (*VolumePlan) ProtoMessage()    {}
+func (*VolumePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{81}
+}
+
+func (m *VolumePlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VolumePlan.Unmarshal(m, b)
+}
+func (m *VolumePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VolumePlan.Marshal(b, m, deterministic)
+}
+func (m *VolumePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VolumePlan.Merge(m, src)
+}
+func (m *VolumePlan) XXX_Size() int {
+	return xxx_messageInfo_VolumePlan.Size(m)
+}
+func (m *VolumePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_VolumePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VolumePlan proto.InternalMessageInfo
+
+func (m *VolumePlan) GetVolumePlan() map[string]*Volume {
+	if m != nil {
+		return m.VolumePlan
+	}
+	return nil
+}
+
+type Devices struct {
+	Ids                  []string `protobuf:"bytes,1,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Devices) Reset()         { *m = Devices{} }
+func (m *Devices) String() string { return proto.CompactTextString(m) }
+func (*Devices) ProtoMessage()    {}
+func (*Devices) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{82}
+}
+
+func (m *Devices) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Devices.Unmarshal(m, b)
+}
+func (m *Devices) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Devices.Marshal(b, m, deterministic)
+}
+func (m *Devices) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Devices.Merge(m, src)
+}
+func (m *Devices) XXX_Size() int {
+	return xxx_messageInfo_Devices.Size(m)
+}
+func (m *Devices) XXX_DiscardUnknown() {
+	xxx_messageInfo_Devices.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Devices proto.InternalMessageInfo
+
+func (m *Devices) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
+type DevicePlan struct {
+	Devices              map[string]*Devices `protobuf:"bytes,1,rep,name=devices,proto3" json:"devices,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *DevicePlan) Reset()         { *m = DevicePlan{} }
+func (m *DevicePlan) String() string { return proto.CompactTextString(m) }
+func (*DevicePlan) ProtoMessage()    {}
+func (*DevicePlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{83}
+}
+
+func (m *DevicePlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DevicePlan.Unmarshal(m, b)
+}
+func (m *DevicePlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DevicePlan.Marshal(b, m, deterministic)
+}
+func (m *DevicePlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DevicePlan.Merge(m, src)
+}
+func (m *DevicePlan) XXX_Size() int {
+	return xxx_messageInfo_DevicePlan.Size(m)
+}
+func (m *DevicePlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_DevicePlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DevicePlan proto.InternalMessageInfo
+
+func (m *DevicePlan) GetDevices() map[string]*Devices {
+	if m != nil {
+		return m.Devices
+	}
+	return nil
+}
+
+type NodeDeployPlan struct {
+	Nodename             string        `protobuf:"bytes,1,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	Deploy               int32         `protobuf:"varint,2,opt,name=deploy,proto3" json:"deploy,omitempty"`
+	Capacity             int32         `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Count                int32         `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	CpuPlans             []*CPUPlan    `protobuf:"bytes,5,rep,name=cpu_plans,json=cpuPlans,proto3" json:"cpu_plans,omitempty"`
+	VolumePlans          []*VolumePlan `protobuf:"bytes,6,rep,name=volume_plans,json=volumePlans,proto3" json:"volume_plans,omitempty"`
+	Score                float64       `protobuf:"fixed64,7,opt,name=score,proto3" json:"score,omitempty"`
+	DevicePlans          []*DevicePlan `protobuf:"bytes,8,rep,name=device_plans,json=devicePlans,proto3" json:"device_plans,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NodeDeployPlan) Reset()         { *m = NodeDeployPlan{} }
+func (m *NodeDeployPlan) String() string { return proto.CompactTextString(m) }
+func (*NodeDeployPlan) ProtoMessage()    {}
+func (*NodeDeployPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{84}
+}
+
+func (m *NodeDeployPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeDeployPlan.Unmarshal(m, b)
+}
+func (m *NodeDeployPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeDeployPlan.Marshal(b, m, deterministic)
+}
+func (m *NodeDeployPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeDeployPlan.Merge(m, src)
+}
+func (m *NodeDeployPlan) XXX_Size() int {
+	return xxx_messageInfo_NodeDeployPlan.Size(m)
+}
+func (m *NodeDeployPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeDeployPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeDeployPlan proto.InternalMessageInfo
+
+func (m *NodeDeployPlan) GetNodename() string {
+	if m != nil {
+		return m.Nodename
+	}
+	return ""
+}
+
+func (m *NodeDeployPlan) GetDeploy() int32 {
+	if m != nil {
+		return m.Deploy
+	}
+	return 0
+}
+
+func (m *NodeDeployPlan) GetCapacity() int32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
+func (m *NodeDeployPlan) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *NodeDeployPlan) GetCpuPlans() []*CPUPlan {
+	if m != nil {
+		return m.CpuPlans
+	}
+	return nil
+}
+
+func (m *NodeDeployPlan) GetVolumePlans() []*VolumePlan {
+	if m != nil {
+		return m.VolumePlans
+	}
+	return nil
+}
+
+func (m *NodeDeployPlan) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *NodeDeployPlan) GetDevicePlans() []*DevicePlan {
+	if m != nil {
+		return m.DevicePlans
+	}
+	return nil
+}
+
+type DeployPlan struct {
+	Total                int32             `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Nodes                []*NodeDeployPlan `protobuf:"bytes,2,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	Error                string            `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	NodeErrors           map[string]string `protobuf:"bytes,4,rep,name=node_errors,json=nodeErrors,proto3" json:"node_errors,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DeployPlan) Reset()         { *m = DeployPlan{} }
+func (m *DeployPlan) String() string { return proto.CompactTextString(m) }
+func (*DeployPlan) ProtoMessage()    {}
+func (*DeployPlan) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{85}
+}
+
+func (m *DeployPlan) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeployPlan.Unmarshal(m, b)
+}
+func (m *DeployPlan) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeployPlan.Marshal(b, m, deterministic)
+}
+func (m *DeployPlan) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeployPlan.Merge(m, src)
+}
+func (m *DeployPlan) XXX_Size() int {
+	return xxx_messageInfo_DeployPlan.Size(m)
+}
+func (m *DeployPlan) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeployPlan.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeployPlan proto.InternalMessageInfo
+
+func (m *DeployPlan) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *DeployPlan) GetNodes() []*NodeDeployPlan {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+func (m *DeployPlan) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *DeployPlan) GetNodeErrors() map[string]string {
+	if m != nil {
+		return m.NodeErrors
+	}
+	return nil
+}
+
+type ReplaceBatchMessage struct {
+	Index                int32    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Total                int32    `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Done                 bool     `protobuf:"varint,3,opt,name=done,proto3" json:"done,omitempty"`
+	Ids                  []string `protobuf:"bytes,4,rep,name=ids,proto3" json:"ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReplaceBatchMessage) Reset()         { *m = ReplaceBatchMessage{} }
+func (m *ReplaceBatchMessage) String() string { return proto.CompactTextString(m) }
+func (*ReplaceBatchMessage) ProtoMessage()    {}
+func (*ReplaceBatchMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{86}
+}
+
+func (m *ReplaceBatchMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReplaceBatchMessage.Unmarshal(m, b)
+}
+func (m *ReplaceBatchMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReplaceBatchMessage.Marshal(b, m, deterministic)
+}
+func (m *ReplaceBatchMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReplaceBatchMessage.Merge(m, src)
+}
+func (m *ReplaceBatchMessage) XXX_Size() int {
+	return xxx_messageInfo_ReplaceBatchMessage.Size(m)
+}
+func (m *ReplaceBatchMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReplaceBatchMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReplaceBatchMessage proto.InternalMessageInfo
+
+func (m *ReplaceBatchMessage) GetIndex() int32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *ReplaceBatchMessage) GetTotal() int32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ReplaceBatchMessage) GetDone() bool {
+	if m != nil {
+		return m.Done
+	}
+	return false
+}
+
+func (m *ReplaceBatchMessage) GetIds() []string {
+	if m != nil {
+		return m.Ids
+	}
+	return nil
+}
+
 type ReplaceContainerMessage struct {
 	Create               *CreateContainerMessage `protobuf:"bytes,1,opt,name=create,proto3" json:"create,omitempty"`
 	Remove               *RemoveContainerMessage `protobuf:"bytes,2,opt,name=remove,proto3" json:"remove,omitempty"`
 	Error                string                  `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Batch                *ReplaceBatchMessage    `protobuf:"bytes,4,opt,name=batch,proto3" json:"batch,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
 	XXX_unrecognized     []byte                  `json:"-"`
 	XXX_sizecache        int32                   `json:"-"`
@@ -3460,7 +5932,7 @@ func (m *ReplaceContainerMessage) Reset()         { *m = ReplaceContainerMessage
 func (m *ReplaceContainerMessage) String() string { return proto.CompactTextString(m) }
 func (*ReplaceContainerMessage) ProtoMessage()    {}
 func (*ReplaceContainerMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{50}
+	return fileDescriptor_f7e43720d1edc0fe, []int{87}
 }
 
 func (m *ReplaceContainerMessage) XXX_Unmarshal(b []byte) error {
@@ -3502,6 +5974,13 @@ func (m *ReplaceContainerMessage) GetError() string {
 	return ""
 }
 
+func (m *ReplaceContainerMessage) GetBatch() *ReplaceBatchMessage {
+	if m != nil {
+		return m.Batch
+	}
+	return nil
+}
+
 type CacheImageMessage struct {
 	Image                string   `protobuf:"bytes,1,opt,name=image,proto3" json:"image,omitempty"`
 	Success              bool     `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
@@ -3516,7 +5995,7 @@ func (m *CacheImageMessage) Reset()         { *m = CacheImageMessage{} }
 func (m *CacheImageMessage) String() string { return proto.CompactTextString(m) }
 func (*CacheImageMessage) ProtoMessage()    {}
 func (*CacheImageMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{51}
+	return fileDescriptor_f7e43720d1edc0fe, []int{88}
 }
 
 func (m *CacheImageMessage) XXX_Unmarshal(b []byte) error {
@@ -3578,7 +6057,7 @@ func (m *RemoveImageMessage) Reset()         { *m = RemoveImageMessage{} }
 func (m *RemoveImageMessage) String() string { return proto.CompactTextString(m) }
 func (*RemoveImageMessage) ProtoMessage()    {}
 func (*RemoveImageMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{52}
+	return fileDescriptor_f7e43720d1edc0fe, []int{89}
 }
 
 func (m *RemoveImageMessage) XXX_Unmarshal(b []byte) error {
@@ -3633,7 +6112,7 @@ func (m *RemoveContainerMessage) Reset()         { *m = RemoveContainerMessage{}
 func (m *RemoveContainerMessage) String() string { return proto.CompactTextString(m) }
 func (*RemoveContainerMessage) ProtoMessage()    {}
 func (*RemoveContainerMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{53}
+	return fileDescriptor_f7e43720d1edc0fe, []int{90}
 }
 
 func (m *RemoveContainerMessage) XXX_Unmarshal(b []byte) error {
@@ -3675,6 +6154,165 @@ func (m *RemoveContainerMessage) GetHook() string {
 	return ""
 }
 
+type DrainNodeMessage struct {
+	Id                   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	NewId                string                  `protobuf:"bytes,2,opt,name=new_id,json=newId,proto3" json:"new_id,omitempty"`
+	NewNodename          string                  `protobuf:"bytes,3,opt,name=new_nodename,json=newNodename,proto3" json:"new_nodename,omitempty"`
+	Remove               *RemoveContainerMessage `protobuf:"bytes,4,opt,name=remove,proto3" json:"remove,omitempty"`
+	Success              bool                    `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	Error                string                  `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *DrainNodeMessage) Reset()         { *m = DrainNodeMessage{} }
+func (m *DrainNodeMessage) String() string { return proto.CompactTextString(m) }
+func (*DrainNodeMessage) ProtoMessage()    {}
+func (*DrainNodeMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{91}
+}
+
+func (m *DrainNodeMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DrainNodeMessage.Unmarshal(m, b)
+}
+func (m *DrainNodeMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DrainNodeMessage.Marshal(b, m, deterministic)
+}
+func (m *DrainNodeMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainNodeMessage.Merge(m, src)
+}
+func (m *DrainNodeMessage) XXX_Size() int {
+	return xxx_messageInfo_DrainNodeMessage.Size(m)
+}
+func (m *DrainNodeMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainNodeMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainNodeMessage proto.InternalMessageInfo
+
+func (m *DrainNodeMessage) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DrainNodeMessage) GetNewId() string {
+	if m != nil {
+		return m.NewId
+	}
+	return ""
+}
+
+func (m *DrainNodeMessage) GetNewNodename() string {
+	if m != nil {
+		return m.NewNodename
+	}
+	return ""
+}
+
+func (m *DrainNodeMessage) GetRemove() *RemoveContainerMessage {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
+}
+
+func (m *DrainNodeMessage) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *DrainNodeMessage) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+type AuditNodeMessage struct {
+	Nodename string   `protobuf:"bytes,1,opt,name=nodename,proto3" json:"nodename,omitempty"`
+	Orphans  []string `protobuf:"bytes,2,rep,name=orphans,proto3" json:"orphans,omitempty"`
+	Ghosts   []string `protobuf:"bytes,3,rep,name=ghosts,proto3" json:"ghosts,omitempty"`
+	Handled  []string `protobuf:"bytes,4,rep,name=handled,proto3" json:"handled,omitempty"`
+	Error    string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	// engine can't list containers, orphans not audited, ghosts found by inspecting
+	Unsupported          bool     `protobuf:"varint,6,opt,name=unsupported,proto3" json:"unsupported,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditNodeMessage) Reset()         { *m = AuditNodeMessage{} }
+func (m *AuditNodeMessage) String() string { return proto.CompactTextString(m) }
+func (*AuditNodeMessage) ProtoMessage()    {}
+func (*AuditNodeMessage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f7e43720d1edc0fe, []int{92}
+}
+
+func (m *AuditNodeMessage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditNodeMessage.Unmarshal(m, b)
+}
+func (m *AuditNodeMessage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditNodeMessage.Marshal(b, m, deterministic)
+}
+func (m *AuditNodeMessage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditNodeMessage.Merge(m, src)
+}
+func (m *AuditNodeMessage) XXX_Size() int {
+	return xxx_messageInfo_AuditNodeMessage.Size(m)
+}
+func (m *AuditNodeMessage) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditNodeMessage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditNodeMessage proto.InternalMessageInfo
+
+func (m *AuditNodeMessage) GetNodename() string {
+	if m != nil {
+		return m.Nodename
+	}
+	return ""
+}
+
+func (m *AuditNodeMessage) GetOrphans() []string {
+	if m != nil {
+		return m.Orphans
+	}
+	return nil
+}
+
+func (m *AuditNodeMessage) GetGhosts() []string {
+	if m != nil {
+		return m.Ghosts
+	}
+	return nil
+}
+
+func (m *AuditNodeMessage) GetHandled() []string {
+	if m != nil {
+		return m.Handled
+	}
+	return nil
+}
+
+func (m *AuditNodeMessage) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *AuditNodeMessage) GetUnsupported() bool {
+	if m != nil {
+		return m.Unsupported
+	}
+	return false
+}
+
 type DissociateContainerMessage struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Error                string   `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
@@ -3687,7 +6325,7 @@ func (m *DissociateContainerMessage) Reset()         { *m = DissociateContainerM
 func (m *DissociateContainerMessage) String() string { return proto.CompactTextString(m) }
 func (*DissociateContainerMessage) ProtoMessage()    {}
 func (*DissociateContainerMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{54}
+	return fileDescriptor_f7e43720d1edc0fe, []int{93}
 }
 
 func (m *DissociateContainerMessage) XXX_Unmarshal(b []byte) error {
@@ -3734,7 +6372,7 @@ func (m *ReallocResourceMessage) Reset()         { *m = ReallocResourceMessage{}
 func (m *ReallocResourceMessage) String() string { return proto.CompactTextString(m) }
 func (*ReallocResourceMessage) ProtoMessage()    {}
 func (*ReallocResourceMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{55}
+	return fileDescriptor_f7e43720d1edc0fe, []int{94}
 }
 
 func (m *ReallocResourceMessage) XXX_Unmarshal(b []byte) error {
@@ -3785,7 +6423,7 @@ func (m *CopyMessage) Reset()         { *m = CopyMessage{} }
 func (m *CopyMessage) String() string { return proto.CompactTextString(m) }
 func (*CopyMessage) ProtoMessage()    {}
 func (*CopyMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{56}
+	return fileDescriptor_f7e43720d1edc0fe, []int{95}
 }
 
 func (m *CopyMessage) XXX_Unmarshal(b []byte) error {
@@ -3861,7 +6499,7 @@ func (m *SendMessage) Reset()         { *m = SendMessage{} }
 func (m *SendMessage) String() string { return proto.CompactTextString(m) }
 func (*SendMessage) ProtoMessage()    {}
 func (*SendMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{57}
+	return fileDescriptor_f7e43720d1edc0fe, []int{96}
 }
 
 func (m *SendMessage) XXX_Unmarshal(b []byte) error {
@@ -3915,7 +6553,7 @@ func (m *AttachContainerMessage) Reset()         { *m = AttachContainerMessage{}
 func (m *AttachContainerMessage) String() string { return proto.CompactTextString(m) }
 func (*AttachContainerMessage) ProtoMessage()    {}
 func (*AttachContainerMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{58}
+	return fileDescriptor_f7e43720d1edc0fe, []int{97}
 }
 
 func (m *AttachContainerMessage) XXX_Unmarshal(b []byte) error {
@@ -3964,7 +6602,7 @@ func (m *RunAndWaitOptions) Reset()         { *m = RunAndWaitOptions{} }
 func (m *RunAndWaitOptions) String() string { return proto.CompactTextString(m) }
 func (*RunAndWaitOptions) ProtoMessage()    {}
 func (*RunAndWaitOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{59}
+	return fileDescriptor_f7e43720d1edc0fe, []int{98}
 }
 
 func (m *RunAndWaitOptions) XXX_Unmarshal(b []byte) error {
@@ -4026,7 +6664,7 @@ func (m *ControlContainerOptions) Reset()         { *m = ControlContainerOptions
 func (m *ControlContainerOptions) String() string { return proto.CompactTextString(m) }
 func (*ControlContainerOptions) ProtoMessage()    {}
 func (*ControlContainerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{60}
+	return fileDescriptor_f7e43720d1edc0fe, []int{99}
 }
 
 func (m *ControlContainerOptions) XXX_Unmarshal(b []byte) error {
@@ -4081,7 +6719,7 @@ func (m *ControlContainerMessage) Reset()         { *m = ControlContainerMessage
 func (m *ControlContainerMessage) String() string { return proto.CompactTextString(m) }
 func (*ControlContainerMessage) ProtoMessage()    {}
 func (*ControlContainerMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{61}
+	return fileDescriptor_f7e43720d1edc0fe, []int{100}
 }
 
 func (m *ControlContainerMessage) XXX_Unmarshal(b []byte) error {
@@ -4136,7 +6774,7 @@ func (m *LogStreamMessage) Reset()         { *m = LogStreamMessage{} }
 func (m *LogStreamMessage) String() string { return proto.CompactTextString(m) }
 func (*LogStreamMessage) ProtoMessage()    {}
 func (*LogStreamMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{62}
+	return fileDescriptor_f7e43720d1edc0fe, []int{101}
 }
 
 func (m *LogStreamMessage) XXX_Unmarshal(b []byte) error {
@@ -4194,7 +6832,7 @@ func (m *ExecuteContainerOptions) Reset()         { *m = ExecuteContainerOptions
 func (m *ExecuteContainerOptions) String() string { return proto.CompactTextString(m) }
 func (*ExecuteContainerOptions) ProtoMessage()    {}
 func (*ExecuteContainerOptions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f7e43720d1edc0fe, []int{63}
+	return fileDescriptor_f7e43720d1edc0fe, []int{102}
 }
 
 func (m *ExecuteContainerOptions) XXX_Unmarshal(b []byte) error {
@@ -4264,18 +6902,24 @@ func init() {
 	proto.RegisterType((*Pod)(nil), "pb.Pod")
 	proto.RegisterType((*Pods)(nil), "pb.Pods")
 	proto.RegisterType((*PodResource)(nil), "pb.PodResource")
+	proto.RegisterMapType((map[string]float64)(nil), "pb.PodResource.CpuOvercommitPercentsEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "pb.PodResource.CpuPercentsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.PodResource.DetailsEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "pb.PodResource.MemoryOvercommitPercentsEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "pb.PodResource.MemoryPercentsEntry")
 	proto.RegisterMapType((map[string]float64)(nil), "pb.PodResource.StoragePercentsEntry")
 	proto.RegisterMapType((map[string]bool)(nil), "pb.PodResource.VerificationsEntry")
 	proto.RegisterType((*NodeResource)(nil), "pb.NodeResource")
+	proto.RegisterType((*FixNodeResourceOptions)(nil), "pb.FixNodeResourceOptions")
+	proto.RegisterType((*NodeResourceFix)(nil), "pb.NodeResourceFix")
 	proto.RegisterType((*ListNetworkOptions)(nil), "pb.ListNetworkOptions")
 	proto.RegisterType((*Network)(nil), "pb.Network")
 	proto.RegisterType((*Networks)(nil), "pb.Networks")
 	proto.RegisterType((*Node)(nil), "pb.Node")
 	proto.RegisterMapType((map[string]int32)(nil), "pb.Node.CpuEntry")
+	proto.RegisterMapType((map[string]*Devices)(nil), "pb.Node.DevicesEntry")
 	proto.RegisterMapType((map[string]int32)(nil), "pb.Node.InitCpuEntry")
+	proto.RegisterMapType((map[string]*Devices)(nil), "pb.Node.InitDevicesEntry")
 	proto.RegisterMapType((map[string]int64)(nil), "pb.Node.InitVolumeEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.Node.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.Node.NumaEntry")
@@ -4287,12 +6931,18 @@ func init() {
 	proto.RegisterMapType((map[string]int32)(nil), "pb.SetNodeOptions.DeltaCpuEntry")
 	proto.RegisterMapType((map[string]int64)(nil), "pb.SetNodeOptions.DeltaNumaMemoryEntry")
 	proto.RegisterMapType((map[string]int64)(nil), "pb.SetNodeOptions.DeltaVolumeEntry")
+	proto.RegisterMapType((map[string]*Devices)(nil), "pb.SetNodeOptions.DevicesEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.SetNodeOptions.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.SetNodeOptions.NumaEntry")
+	proto.RegisterType((*DrainNodeOptions)(nil), "pb.DrainNodeOptions")
+	proto.RegisterType((*AuditOptions)(nil), "pb.AuditOptions")
 	proto.RegisterType((*Container)(nil), "pb.Container")
 	proto.RegisterMapType((map[string]int32)(nil), "pb.Container.CpuEntry")
+	proto.RegisterMapType((map[string]*Devices)(nil), "pb.Container.DevicesEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.Container.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.Container.PublishEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pb.Container.SecretDataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pb.Container.SecretEnvEntry")
 	proto.RegisterMapType((map[string]*Volume)(nil), "pb.Container.VolumePlanEntry")
 	proto.RegisterType((*ContainerStatus)(nil), "pb.ContainerStatus")
 	proto.RegisterMapType((map[string]string)(nil), "pb.ContainerStatus.NetworksEntry")
@@ -4301,6 +6951,16 @@ func init() {
 	proto.RegisterType((*SetContainersStatusOptions)(nil), "pb.SetContainersStatusOptions")
 	proto.RegisterType((*ContainerStatusStreamOptions)(nil), "pb.ContainerStatusStreamOptions")
 	proto.RegisterMapType((map[string]string)(nil), "pb.ContainerStatusStreamOptions.LabelsEntry")
+	proto.RegisterType((*WatchEventsOptions)(nil), "pb.WatchEventsOptions")
+	proto.RegisterType((*Event)(nil), "pb.Event")
+	proto.RegisterType((*ListAuditLogsOptions)(nil), "pb.ListAuditLogsOptions")
+	proto.RegisterType((*AuditLog)(nil), "pb.AuditLog")
+	proto.RegisterType((*AuditLogs)(nil), "pb.AuditLogs")
+	proto.RegisterType((*SetSecretOptions)(nil), "pb.SetSecretOptions")
+	proto.RegisterType((*Secret)(nil), "pb.Secret")
+	proto.RegisterType((*Secrets)(nil), "pb.Secrets")
+	proto.RegisterType((*RemoveSecretOptions)(nil), "pb.RemoveSecretOptions")
+	proto.RegisterType((*RotateSecretOptions)(nil), "pb.RotateSecretOptions")
 	proto.RegisterType((*Containers)(nil), "pb.Containers")
 	proto.RegisterType((*ContainerID)(nil), "pb.ContainerID")
 	proto.RegisterType((*ContainerIDs)(nil), "pb.ContainerIDs")
@@ -4310,7 +6970,9 @@ func init() {
 	proto.RegisterType((*AddPodOptions)(nil), "pb.AddPodOptions")
 	proto.RegisterType((*RemovePodOptions)(nil), "pb.RemovePodOptions")
 	proto.RegisterType((*GetPodOptions)(nil), "pb.GetPodOptions")
+	proto.RegisterType((*SetPodOvercommitOptions)(nil), "pb.SetPodOvercommitOptions")
 	proto.RegisterType((*AddNodeOptions)(nil), "pb.AddNodeOptions")
+	proto.RegisterMapType((map[string]*Devices)(nil), "pb.AddNodeOptions.DevicesEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.AddNodeOptions.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.AddNodeOptions.NumaEntry")
 	proto.RegisterMapType((map[string]int64)(nil), "pb.AddNodeOptions.NumaMemoryEntry")
@@ -4337,12 +6999,30 @@ func init() {
 	proto.RegisterMapType((map[string]string)(nil), "pb.EntrypointOptions.SysctlsEntry")
 	proto.RegisterType((*DeployOptions)(nil), "pb.DeployOptions")
 	proto.RegisterMapType((map[string][]byte)(nil), "pb.DeployOptions.DataEntry")
+	proto.RegisterMapType((map[string]int32)(nil), "pb.DeployOptions.DevicesEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.DeployOptions.LabelsEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.DeployOptions.NetworksEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.DeployOptions.NodelabelsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pb.DeployOptions.SecretDataEntry")
+	proto.RegisterMapType((map[string]string)(nil), "pb.DeployOptions.SecretEnvEntry")
+	proto.RegisterType((*Affinity)(nil), "pb.Affinity")
 	proto.RegisterType((*ReplaceOptions)(nil), "pb.ReplaceOptions")
 	proto.RegisterMapType((map[string]string)(nil), "pb.ReplaceOptions.CopyEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.ReplaceOptions.FilterLabelsEntry")
+	proto.RegisterType((*Deployment)(nil), "pb.Deployment")
+	proto.RegisterType((*Deployments)(nil), "pb.Deployments")
+	proto.RegisterType((*RemoveDeploymentOptions)(nil), "pb.RemoveDeploymentOptions")
+	proto.RegisterType((*AutoscalePolicy)(nil), "pb.AutoscalePolicy")
+	proto.RegisterType((*AutoscalePolicies)(nil), "pb.AutoscalePolicies")
+	proto.RegisterType((*RemoveAutoscalePolicyOptions)(nil), "pb.RemoveAutoscalePolicyOptions")
+	proto.RegisterType((*AutoscaleDecision)(nil), "pb.AutoscaleDecision")
+	proto.RegisterType((*AutoscaleDecisions)(nil), "pb.AutoscaleDecisions")
+	proto.RegisterType((*ListAutoscaleDecisionsOptions)(nil), "pb.ListAutoscaleDecisionsOptions")
+	proto.RegisterType((*Quota)(nil), "pb.Quota")
+	proto.RegisterType((*RemoveQuotaOptions)(nil), "pb.RemoveQuotaOptions")
+	proto.RegisterType((*ListQuotaUsagesOptions)(nil), "pb.ListQuotaUsagesOptions")
+	proto.RegisterType((*QuotaUsage)(nil), "pb.QuotaUsage")
+	proto.RegisterType((*QuotaUsages)(nil), "pb.QuotaUsages")
 	proto.RegisterType((*CacheImageOptions)(nil), "pb.CacheImageOptions")
 	proto.RegisterType((*RemoveImageOptions)(nil), "pb.RemoveImageOptions")
 	proto.RegisterType((*CopyPaths)(nil), "pb.CopyPaths")
@@ -4356,12 +7036,26 @@ func init() {
 	proto.RegisterMapType((map[string]int64)(nil), "pb.Volume.VolumeEntry")
 	proto.RegisterType((*CreateContainerMessage)(nil), "pb.CreateContainerMessage")
 	proto.RegisterMapType((map[string]int32)(nil), "pb.CreateContainerMessage.CpuEntry")
+	proto.RegisterMapType((map[string]*Devices)(nil), "pb.CreateContainerMessage.DevicesEntry")
 	proto.RegisterMapType((map[string]string)(nil), "pb.CreateContainerMessage.PublishEntry")
 	proto.RegisterMapType((map[string]*Volume)(nil), "pb.CreateContainerMessage.VolumePlanEntry")
+	proto.RegisterType((*CPUPlan)(nil), "pb.CPUPlan")
+	proto.RegisterMapType((map[string]int32)(nil), "pb.CPUPlan.CpuEntry")
+	proto.RegisterType((*VolumePlan)(nil), "pb.VolumePlan")
+	proto.RegisterMapType((map[string]*Volume)(nil), "pb.VolumePlan.VolumePlanEntry")
+	proto.RegisterType((*Devices)(nil), "pb.Devices")
+	proto.RegisterType((*DevicePlan)(nil), "pb.DevicePlan")
+	proto.RegisterMapType((map[string]*Devices)(nil), "pb.DevicePlan.DevicesEntry")
+	proto.RegisterType((*NodeDeployPlan)(nil), "pb.NodeDeployPlan")
+	proto.RegisterType((*DeployPlan)(nil), "pb.DeployPlan")
+	proto.RegisterMapType((map[string]string)(nil), "pb.DeployPlan.NodeErrorsEntry")
+	proto.RegisterType((*ReplaceBatchMessage)(nil), "pb.ReplaceBatchMessage")
 	proto.RegisterType((*ReplaceContainerMessage)(nil), "pb.ReplaceContainerMessage")
 	proto.RegisterType((*CacheImageMessage)(nil), "pb.CacheImageMessage")
 	proto.RegisterType((*RemoveImageMessage)(nil), "pb.RemoveImageMessage")
 	proto.RegisterType((*RemoveContainerMessage)(nil), "pb.RemoveContainerMessage")
+	proto.RegisterType((*DrainNodeMessage)(nil), "pb.DrainNodeMessage")
+	proto.RegisterType((*AuditNodeMessage)(nil), "pb.AuditNodeMessage")
 	proto.RegisterType((*DissociateContainerMessage)(nil), "pb.DissociateContainerMessage")
 	proto.RegisterType((*ReallocResourceMessage)(nil), "pb.ReallocResourceMessage")
 	proto.RegisterType((*CopyMessage)(nil), "pb.CopyMessage")
@@ -4377,269 +7071,414 @@ func init() {
 func init() { proto.RegisterFile("core.proto", fileDescriptor_f7e43720d1edc0fe) }
 
 var fileDescriptor_f7e43720d1edc0fe = []byte{
-	// 4179 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3b, 0x4d, 0x6f, 0x1c, 0x47,
-	0x76, 0x9a, 0x2f, 0xce, 0xcc, 0x9b, 0x4f, 0x96, 0x28, 0x7a, 0xdc, 0x92, 0x2d, 0xaa, 0x15, 0x5b,
-	0xf2, 0xae, 0x4d, 0x6b, 0xe5, 0x58, 0x96, 0xbf, 0xe4, 0xa5, 0x48, 0x9a, 0x26, 0x22, 0xd9, 0xdc,
-	0xa6, 0xd7, 0x41, 0x4e, 0x93, 0x66, 0x77, 0x71, 0xd8, 0xf0, 0x4c, 0x77, 0xa7, 0xbb, 0x87, 0x36,
-	0x8f, 0x01, 0x02, 0xec, 0x25, 0x40, 0x72, 0xca, 0x02, 0xb9, 0xe4, 0x9e, 0x4b, 0x0e, 0x01, 0x02,
-	0xe4, 0x96, 0x1f, 0x90, 0xe3, 0x1e, 0xf3, 0x0b, 0x72, 0x0c, 0x10, 0x24, 0x97, 0x00, 0x41, 0xbd,
-	0xfa, 0xe8, 0xaa, 0x9e, 0x1e, 0x52, 0x23, 0x65, 0x77, 0x73, 0x9a, 0xae, 0x57, 0xaf, 0x5e, 0x55,
-	0xbd, 0x7a, 0xf5, 0x3e, 0x6b, 0x00, 0xbc, 0x28, 0xa1, 0xdb, 0x71, 0x12, 0x65, 0x11, 0xa9, 0xc6,
-	0x27, 0x76, 0x13, 0x1a, 0xfb, 0xb3, 0x38, 0xbb, 0xb0, 0xff, 0xa7, 0x02, 0x37, 0x9e, 0x05, 0x69,
-	0xb6, 0x1b, 0x85, 0x99, 0x1b, 0x84, 0x34, 0x49, 0xbf, 0x89, 0xb3, 0x20, 0x0a, 0x53, 0x32, 0x82,
-	0xa6, 0x1b, 0xc7, 0xa1, 0x3b, 0xa3, 0xa3, 0xca, 0x56, 0xe5, 0x7e, 0xdb, 0x91, 0x4d, 0xf2, 0x26,
-	0x00, 0x0d, 0xb3, 0xe4, 0x22, 0x8e, 0x82, 0x30, 0x1b, 0x55, 0xb1, 0x53, 0x83, 0x10, 0x0b, 0x5a,
-	0x61, 0xe4, 0x53, 0x1c, 0x5a, 0xc3, 0x5e, 0xd5, 0x26, 0x9f, 0xc3, 0xda, 0xd4, 0x3d, 0xa1, 0xd3,
-	0x74, 0x54, 0xdf, 0xaa, 0xdd, 0xef, 0x3c, 0x7c, 0x6b, 0x3b, 0x3e, 0xd9, 0x2e, 0x5d, 0xc0, 0xf6,
-	0x33, 0xc4, 0xdb, 0x67, 0x74, 0x1d, 0x31, 0x88, 0x6c, 0x40, 0x63, 0x1a, 0xcc, 0x82, 0x6c, 0xd4,
-	0xd8, 0xaa, 0xdc, 0xaf, 0x39, 0xbc, 0x61, 0x7d, 0x0c, 0x1d, 0x0d, 0x99, 0x0c, 0xa1, 0xf6, 0x3d,
-	0xbd, 0x10, 0xab, 0x66, 0x9f, 0x6c, 0xd8, 0xb9, 0x3b, 0x9d, 0x53, 0xb1, 0x58, 0xde, 0xf8, 0xa4,
-	0xfa, 0xb8, 0x62, 0xbf, 0x07, 0xb5, 0xa3, 0xc8, 0x27, 0x04, 0xea, 0xda, 0x4e, 0xf1, 0x9b, 0xc1,
-	0x7c, 0x9a, 0x7a, 0x62, 0x0c, 0x7e, 0xdb, 0x77, 0xa1, 0x7e, 0x14, 0xf9, 0x29, 0xb9, 0x09, 0xf5,
-	0x38, 0xf2, 0xd3, 0x51, 0x05, 0x37, 0xd1, 0x64, 0x9b, 0x38, 0x8a, 0x7c, 0x07, 0x81, 0xf6, 0xbf,
-	0x36, 0xa0, 0xc3, 0x5a, 0x34, 0x8d, 0xe6, 0x89, 0x47, 0x4b, 0x89, 0xef, 0x42, 0xd7, 0x8b, 0xe7,
-	0xe3, 0x98, 0x26, 0x1e, 0x0d, 0xb3, 0x74, 0x54, 0x45, 0x42, 0x5b, 0x92, 0x90, 0x18, 0xba, 0xbd,
-	0x1b, 0xcf, 0x8f, 0x04, 0x0a, 0x67, 0x44, 0xc7, 0xcb, 0x21, 0xe4, 0x19, 0x0c, 0x66, 0x74, 0x16,
-	0x25, 0x17, 0x39, 0x9d, 0x1a, 0xd2, 0xb9, 0x5b, 0xa4, 0xf3, 0x1c, 0xd1, 0x4c, 0x52, 0xfd, 0x99,
-	0x01, 0x24, 0x5f, 0x41, 0xef, 0x9c, 0x26, 0xc1, 0x69, 0xe0, 0xb9, 0x78, 0x00, 0xe2, 0x84, 0xec,
-	0x22, 0xad, 0xef, 0x74, 0x24, 0x4e, 0xca, 0x1c, 0x48, 0x1e, 0x41, 0xd3, 0xa7, 0x99, 0x1b, 0x4c,
-	0xd3, 0x51, 0x03, 0x69, 0xdc, 0x2a, 0xd2, 0xd8, 0xe3, 0xdd, 0x7c, 0xb4, 0x44, 0x26, 0xdf, 0xc0,
-	0x30, 0xcd, 0xa2, 0xc4, 0x9d, 0xd0, 0x7c, 0x43, 0x6b, 0x48, 0xe0, 0x0f, 0x8a, 0x04, 0x8e, 0x39,
-	0x9e, 0xb9, 0xa3, 0x41, 0x6a, 0x42, 0xad, 0x27, 0x30, 0x2c, 0x72, 0xf0, 0x2a, 0xe9, 0xa8, 0x68,
-	0xd2, 0x61, 0xed, 0xc0, 0xf5, 0x12, 0xce, 0xad, 0x44, 0xe2, 0xe7, 0x40, 0x16, 0x19, 0x76, 0x15,
-	0x85, 0x96, 0x4e, 0xe1, 0x13, 0xe8, 0xea, 0xec, 0x5a, 0x45, 0xbc, 0xad, 0xa7, 0xb0, 0x51, 0xc6,
-	0xa9, 0x55, 0x76, 0x60, 0xff, 0x77, 0x05, 0xba, 0x5f, 0x47, 0x3e, 0xbd, 0x54, 0x9e, 0x6f, 0x43,
-	0x47, 0x93, 0x67, 0x41, 0x04, 0x72, 0x61, 0x25, 0x6f, 0x41, 0xdf, 0x94, 0x55, 0x54, 0x0d, 0x15,
-	0xa7, 0x67, 0x48, 0x21, 0xb1, 0xa1, 0xab, 0xcb, 0xd2, 0xa8, 0x8e, 0xdc, 0x30, 0x60, 0x4c, 0x33,
-	0xe9, 0xe2, 0xd5, 0xce, 0x05, 0xe8, 0x1e, 0x0c, 0x0a, 0x02, 0x34, 0x5a, 0xc3, 0x59, 0xfa, 0xa6,
-	0x64, 0xb0, 0xd5, 0x9c, 0x47, 0xd3, 0xf9, 0x2c, 0xc7, 0x6b, 0xf2, 0xd5, 0x70, 0xa8, 0x40, 0xb3,
-	0xbf, 0x04, 0xc2, 0x74, 0xd3, 0xd7, 0x34, 0xfb, 0x21, 0x4a, 0xbe, 0xd7, 0x34, 0x63, 0x1c, 0xf9,
-	0xba, 0x66, 0x14, 0x4d, 0xb2, 0x09, 0x6b, 0x7e, 0x12, 0x9c, 0xd3, 0x44, 0x9c, 0x84, 0x68, 0xd9,
-	0x1f, 0x41, 0x53, 0xd0, 0x28, 0x65, 0xde, 0x08, 0x9a, 0xe9, 0xfc, 0x24, 0xa4, 0x42, 0x0f, 0xb4,
-	0x1d, 0xd9, 0xb4, 0x3f, 0x80, 0x96, 0x18, 0xc8, 0x36, 0xd7, 0x0a, 0xc5, 0xb7, 0xd0, 0x3b, 0x1d,
-	0x76, 0x2b, 0x44, 0xbf, 0xa3, 0x3a, 0xed, 0x7f, 0x6f, 0x41, 0x9d, 0x1d, 0x58, 0xe9, 0x5c, 0x16,
-	0xb4, 0x68, 0xe8, 0xeb, 0xaa, 0x5b, 0xb5, 0xf5, 0x8d, 0xd5, 0xcc, 0x8d, 0xdd, 0x85, 0x9a, 0x17,
-	0xcf, 0x85, 0x46, 0x58, 0xc7, 0x69, 0x23, 0x1f, 0xd5, 0x13, 0xbf, 0x79, 0xac, 0x97, 0xbc, 0x0e,
-	0x2d, 0x26, 0x03, 0xf3, 0x94, 0xfa, 0xa8, 0x9f, 0x2b, 0x4e, 0xd3, 0x8b, 0xe7, 0xbf, 0x4c, 0xa9,
-	0xcf, 0x18, 0xc3, 0xcf, 0x19, 0xcf, 0xa3, 0xe6, 0x88, 0x16, 0x13, 0x1b, 0x21, 0x15, 0x38, 0xaa,
-	0x89, 0x9d, 0xc0, 0x41, 0x38, 0xf0, 0x16, 0xb4, 0xdd, 0x73, 0x37, 0x98, 0xba, 0x27, 0x53, 0x3a,
-	0x6a, 0xa1, 0x30, 0xe4, 0x00, 0xf2, 0xae, 0xb2, 0x26, 0x6d, 0x5c, 0xd9, 0x86, 0x5a, 0x59, 0x99,
-	0xf1, 0xb8, 0x0d, 0x9d, 0x20, 0x0c, 0xb2, 0xb1, 0x58, 0x09, 0xf0, 0xc9, 0x18, 0x88, 0x5f, 0x72,
-	0xf2, 0x00, 0x5a, 0x88, 0xc0, 0xb6, 0xda, 0x41, 0x82, 0x37, 0x14, 0xc1, 0xc3, 0x30, 0xc8, 0xd4,
-	0x76, 0x9b, 0x01, 0x6f, 0x31, 0x0e, 0x07, 0xe1, 0x69, 0x34, 0xea, 0x72, 0x0e, 0xb3, 0x6f, 0xf2,
-	0x36, 0xd4, 0xc3, 0xf9, 0xcc, 0x1d, 0xf5, 0x90, 0x02, 0x51, 0x14, 0xbe, 0x9e, 0xcf, 0x5c, 0x3e,
-	0x1c, 0xfb, 0xc9, 0xc7, 0xd0, 0x61, 0xbf, 0x72, 0x39, 0x7d, 0x44, 0x1f, 0x19, 0xe8, 0x7c, 0x5d,
-	0x7c, 0x10, 0x84, 0x0a, 0x80, 0x02, 0xc3, 0x05, 0x7a, 0x34, 0xc0, 0x5d, 0xc8, 0x26, 0xb9, 0x03,
-	0x5d, 0x79, 0x03, 0x90, 0xa3, 0x43, 0xec, 0xee, 0x08, 0x18, 0xb2, 0xf4, 0x0e, 0x74, 0x71, 0x97,
-	0x92, 0xc2, 0x3a, 0x47, 0x61, 0x30, 0xa1, 0x2b, 0xd8, 0xd2, 0x10, 0x85, 0xdf, 0x86, 0x11, 0x29,
-	0x2c, 0x8d, 0xf1, 0xe2, 0x3b, 0xec, 0x12, 0x4b, 0x0b, 0x14, 0x80, 0x1d, 0x89, 0x18, 0x75, 0xbd,
-	0x70, 0x24, 0xfa, 0x08, 0x81, 0xc3, 0x8e, 0x44, 0xdc, 0x43, 0x5c, 0xed, 0x06, 0x3f, 0x12, 0x0e,
-	0x62, 0x8b, 0xb5, 0x1e, 0x41, 0x4b, 0x72, 0xfd, 0x2a, 0xa5, 0xd5, 0xd0, 0x15, 0xdf, 0xcb, 0xbb,
-	0x04, 0x4c, 0xdf, 0xea, 0x87, 0xbd, 0xd2, 0xb4, 0x1f, 0x41, 0x5b, 0x1d, 0xf3, 0x4a, 0x93, 0x7e,
-	0x0e, 0x83, 0xc2, 0x81, 0x5f, 0x35, 0xbc, 0x56, 0x18, 0x5e, 0x38, 0x94, 0x95, 0x86, 0x7f, 0x0c,
-	0x9d, 0x97, 0x1c, 0x6a, 0xdf, 0x83, 0x06, 0x3b, 0xdd, 0x94, 0xbc, 0x09, 0x0d, 0xe6, 0xe5, 0x49,
-	0xdd, 0xd4, 0x92, 0xe7, 0xee, 0x70, 0xb0, 0xbd, 0x0f, 0x3d, 0xd6, 0xdc, 0x51, 0x97, 0x57, 0x77,
-	0x13, 0x2b, 0x05, 0x37, 0x51, 0xd3, 0x44, 0x55, 0x43, 0x13, 0xd9, 0xbf, 0x5a, 0x83, 0xfe, 0x31,
-	0xcd, 0x18, 0x29, 0xa9, 0x8f, 0x2f, 0x23, 0xb4, 0x09, 0x6b, 0x69, 0xe6, 0x66, 0xf3, 0x54, 0x9c,
-	0x95, 0x68, 0x91, 0xcf, 0xa1, 0xed, 0xd3, 0x69, 0xe6, 0xe2, 0x5d, 0xaf, 0xe5, 0xce, 0x97, 0x49,
-	0x7a, 0x7b, 0x8f, 0xe1, 0xa8, 0x6b, 0xdf, 0xf2, 0x45, 0x93, 0xdd, 0x21, 0x3e, 0x5c, 0x5c, 0xde,
-	0x3a, 0xbf, 0x43, 0x08, 0x13, 0x77, 0xf4, 0x2e, 0xf4, 0x38, 0x8a, 0xbc, 0x67, 0xdc, 0x65, 0xe5,
-	0xe3, 0xe4, 0x45, 0x3b, 0x86, 0x75, 0x8e, 0xa4, 0x6b, 0x02, 0xee, 0xf2, 0xdc, 0x5b, 0xb6, 0x9c,
-	0xa2, 0x62, 0x18, 0xf8, 0x26, 0x94, 0x3c, 0x10, 0x0a, 0xa8, 0x99, 0xfb, 0x5e, 0x05, 0x3a, 0x45,
-	0x55, 0xf4, 0x48, 0xe9, 0xd1, 0x16, 0x8e, 0x79, 0xb3, 0x64, 0x4c, 0x99, 0x46, 0xfd, 0x52, 0xb2,
-	0x41, 0x5c, 0xf9, 0x76, 0xee, 0x7d, 0x96, 0xad, 0x5c, 0xd7, 0x00, 0x9c, 0x57, 0x1c, 0x62, 0x7d,
-	0x0a, 0x3d, 0x83, 0xd3, 0x2b, 0xdd, 0xb9, 0xa7, 0xb0, 0x51, 0xc6, 0x97, 0x95, 0x2e, 0xc0, 0x4b,
-	0xdf, 0xdb, 0x57, 0xd0, 0x33, 0x4f, 0x60, 0x58, 0xe4, 0xca, 0x4a, 0x37, 0xef, 0xbf, 0x1a, 0xd0,
-	0x56, 0x51, 0x13, 0xe9, 0x43, 0x35, 0xf0, 0xc5, 0xc0, 0x6a, 0xe0, 0x2f, 0xbf, 0x41, 0x97, 0x86,
-	0x67, 0xd2, 0x63, 0xa8, 0x6b, 0x1e, 0xc3, 0x7d, 0x6e, 0xfb, 0xb9, 0x27, 0xbf, 0xc9, 0xce, 0x56,
-	0xcd, 0x5a, 0x70, 0x00, 0x36, 0xa0, 0xf1, 0x67, 0xf3, 0x28, 0x73, 0x85, 0xd3, 0xc5, 0x1b, 0x9a,
-	0xed, 0x6f, 0x1a, 0xb6, 0xff, 0x4d, 0x80, 0x38, 0x09, 0xce, 0x83, 0x29, 0x9d, 0x50, 0x5f, 0xd8,
-	0x76, 0x0d, 0x42, 0x7e, 0x56, 0x30, 0xee, 0xaf, 0x9b, 0x53, 0x97, 0xc9, 0xe3, 0x1f, 0x42, 0x33,
-	0x9e, 0x9f, 0x4c, 0x83, 0xf4, 0x6c, 0x04, 0x38, 0xc6, 0x32, 0xc7, 0x1c, 0xf1, 0x4e, 0x61, 0xc4,
-	0x05, 0x2a, 0x5b, 0x76, 0x30, 0x63, 0x37, 0xb4, 0xc3, 0x8f, 0x08, 0x1b, 0xba, 0x8d, 0xed, 0x9a,
-	0x36, 0xf6, 0xa7, 0x4a, 0xa7, 0xf4, 0xb6, 0x2a, 0xf7, 0x3b, 0x0f, 0xaf, 0x1b, 0x93, 0x1c, 0x63,
-	0x97, 0x52, 0x34, 0x23, 0x68, 0xf2, 0xcb, 0x91, 0xa2, 0x85, 0x6f, 0x3b, 0xb2, 0x49, 0x9e, 0x28,
-	0xdb, 0x17, 0x4f, 0xdd, 0x70, 0x34, 0xc0, 0x05, 0xbf, 0x61, 0x2e, 0x98, 0xcb, 0xc6, 0xd1, 0xd4,
-	0x0d, 0x85, 0xa5, 0x3d, 0x57, 0x80, 0xdf, 0x93, 0x69, 0xd4, 0x59, 0xb8, 0xd2, 0xd8, 0x43, 0x18,
-	0x14, 0x76, 0x53, 0x32, 0x7c, 0x4b, 0x1f, 0xde, 0x79, 0x08, 0x8c, 0x1b, 0x7c, 0x94, 0x2e, 0xf9,
-	0x7f, 0x5e, 0x85, 0x41, 0x81, 0xdf, 0x65, 0xf2, 0x9f, 0xcc, 0xc3, 0x30, 0x08, 0x27, 0x22, 0xa2,
-	0x92, 0x4d, 0xd6, 0x73, 0x46, 0xdd, 0x69, 0x76, 0x76, 0x81, 0xe2, 0xdf, 0x72, 0x64, 0x93, 0x7c,
-	0xae, 0x79, 0xd8, 0xdc, 0xd5, 0xbd, 0x53, 0x72, 0xb4, 0xd2, 0xe3, 0x16, 0xb2, 0xa7, 0x86, 0x30,
-	0x5f, 0x95, 0xfe, 0x98, 0xd1, 0x30, 0x65, 0x81, 0x0b, 0xd3, 0xf6, 0x5d, 0x27, 0x07, 0xb0, 0xcd,
-	0x66, 0xd9, 0x54, 0xf8, 0xbf, 0xec, 0x93, 0x69, 0x3d, 0x83, 0xd4, 0x4a, 0x89, 0x8b, 0x2f, 0x60,
-	0x98, 0xa7, 0x4c, 0x04, 0x0f, 0x72, 0xc1, 0xe4, 0x36, 0xf8, 0x32, 0xc1, 0xb4, 0xff, 0xa9, 0x02,
-	0xb7, 0x0a, 0x7d, 0xc7, 0x59, 0x42, 0xdd, 0xd9, 0x73, 0x9a, 0xa6, 0x4c, 0xcc, 0x8b, 0x1c, 0xfd,
-	0x29, 0xb4, 0x3d, 0x89, 0x2f, 0xce, 0xa7, 0x67, 0x4c, 0xe0, 0xe4, 0xfd, 0xda, 0x52, 0x6a, 0x57,
-	0xdf, 0x91, 0x0d, 0x68, 0xd0, 0x24, 0x89, 0x12, 0xa1, 0x76, 0x78, 0x03, 0x83, 0x29, 0x3a, 0xa5,
-	0x19, 0xb7, 0x9c, 0x2d, 0x47, 0xb4, 0xec, 0x43, 0xb0, 0x8e, 0x69, 0x56, 0xdc, 0xbc, 0x74, 0x06,
-	0x56, 0xe2, 0xc1, 0x7f, 0x2e, 0xe3, 0xc1, 0x6f, 0x37, 0x09, 0xb6, 0x57, 0x48, 0x82, 0xbd, 0x5b,
-	0xb2, 0x46, 0x63, 0x1d, 0x65, 0xca, 0xee, 0x55, 0xb2, 0x5e, 0x9f, 0x02, 0xe4, 0xfc, 0x23, 0xef,
-	0x01, 0xa8, 0x83, 0x93, 0x6c, 0x2b, 0x9c, 0xac, 0x86, 0x60, 0xbf, 0x01, 0x1d, 0xd5, 0x71, 0xb8,
-	0x57, 0x14, 0x13, 0x7b, 0x0b, 0xba, 0x5a, 0x77, 0xca, 0xd6, 0x15, 0x88, 0x4c, 0x59, 0xdb, 0x61,
-	0x9f, 0xf6, 0xb7, 0xb0, 0xe9, 0xd0, 0x59, 0x74, 0x4e, 0x15, 0x9e, 0x64, 0xf7, 0x02, 0x2e, 0xdb,
-	0xc3, 0x69, 0x94, 0x78, 0x2a, 0x2d, 0x82, 0x0d, 0x66, 0xa6, 0xd2, 0x8c, 0xc6, 0xc8, 0xd8, 0x86,
-	0x83, 0xdf, 0xf6, 0x36, 0x58, 0x7b, 0x41, 0x9a, 0x46, 0x5e, 0xe0, 0x66, 0x2f, 0x40, 0xd9, 0x3e,
-	0x85, 0xbe, 0x43, 0xdd, 0xe9, 0x34, 0xf2, 0x96, 0xcf, 0x3e, 0xe4, 0xa6, 0x8f, 0x67, 0x33, 0xd0,
-	0xc4, 0xe5, 0xc6, 0xac, 0x66, 0x18, 0x33, 0x4d, 0xcd, 0xd7, 0x0d, 0x35, 0x6f, 0x7f, 0x04, 0xbd,
-	0x1d, 0xdf, 0x3f, 0x8a, 0x7c, 0x39, 0xcd, 0x8b, 0xe6, 0x1a, 0xdf, 0x86, 0x21, 0x67, 0xd3, 0xe5,
-	0x63, 0xed, 0xbb, 0xd0, 0x3b, 0xa0, 0xd9, 0x15, 0x48, 0xbf, 0x69, 0x40, 0x7f, 0xc7, 0xf7, 0x5f,
-	0xd4, 0x6d, 0x7e, 0xb9, 0x2c, 0x41, 0x1f, 0xaa, 0x9e, 0x2b, 0x2e, 0x71, 0xd5, 0x73, 0xd9, 0x42,
-	0x3c, 0x9a, 0xf0, 0x64, 0x6d, 0xdb, 0xc1, 0x6f, 0x29, 0xa6, 0x6b, 0xb9, 0x98, 0x0a, 0x26, 0x37,
-	0xf1, 0x2c, 0xa5, 0x1f, 0x91, 0x9e, 0xb9, 0x09, 0x0f, 0xf8, 0x1b, 0x0e, 0x6f, 0x68, 0xac, 0x6f,
-	0x1b, 0xac, 0xcf, 0x9d, 0x57, 0xc8, 0x9d, 0x57, 0x73, 0xaf, 0xa5, 0xce, 0x82, 0x74, 0x93, 0x3b,
-	0xb9, 0x9b, 0x5c, 0x18, 0x55, 0x74, 0x93, 0x77, 0xcd, 0x88, 0xbd, 0x9b, 0xe7, 0x47, 0x4b, 0x06,
-	0xbe, 0x40, 0xec, 0xde, 0x33, 0xfd, 0x8a, 0x9f, 0x83, 0x30, 0xef, 0xe3, 0x99, 0x1b, 0x8b, 0x7c,
-	0xc0, 0x9d, 0x12, 0xea, 0xdc, 0x20, 0x3e, 0x77, 0x63, 0x4e, 0xbc, 0x7d, 0x2e, 0xdb, 0xaf, 0x62,
-	0xda, 0x7f, 0x5f, 0x91, 0xeb, 0x67, 0xd0, 0x37, 0xf7, 0xb3, 0x92, 0x0f, 0xfc, 0x3e, 0xac, 0xf3,
-	0x3b, 0xf2, 0x82, 0x82, 0x6d, 0xff, 0x5d, 0x05, 0xfa, 0x07, 0x2f, 0x1e, 0x3e, 0xe6, 0xb2, 0x55,
-	0xcd, 0x65, 0xeb, 0xe0, 0xca, 0xc0, 0xe8, 0x55, 0x74, 0xf3, 0x3f, 0x56, 0x60, 0x88, 0x49, 0x47,
-	0x16, 0x35, 0x5f, 0x9d, 0x72, 0x1c, 0x42, 0xcd, 0x9d, 0x4e, 0x85, 0x7a, 0x64, 0x9f, 0xe4, 0xb1,
-	0x5a, 0xb3, 0x16, 0xd7, 0x16, 0x29, 0xfe, 0x5f, 0xaf, 0xfa, 0xdf, 0x1a, 0xd0, 0x78, 0x3a, 0x0f,
-	0xa6, 0x58, 0x4a, 0x39, 0x71, 0x53, 0xa5, 0x7d, 0xd8, 0x37, 0x83, 0x25, 0x34, 0x8e, 0xa4, 0x7a,
-	0x63, 0xdf, 0xa8, 0x31, 0x69, 0x82, 0xbe, 0x92, 0x50, 0x23, 0xa2, 0xc9, 0xe6, 0xf5, 0x03, 0xe9,
-	0x0c, 0xb0, 0x4f, 0xe6, 0x59, 0xa5, 0xf3, 0x93, 0x59, 0xe4, 0xcf, 0xa7, 0xd2, 0x1b, 0xc8, 0x01,
-	0xec, 0x00, 0xbd, 0x68, 0x36, 0x73, 0x43, 0x9f, 0x97, 0x0b, 0xda, 0x8e, 0x6a, 0x93, 0x7b, 0x50,
-	0xa7, 0xe1, 0x79, 0x2a, 0x62, 0x61, 0x74, 0x06, 0x70, 0x99, 0xdb, 0xfb, 0xe1, 0xb9, 0xd8, 0x3d,
-	0x22, 0x30, 0x44, 0x37, 0x99, 0xc8, 0x00, 0x58, 0x43, 0xdc, 0x49, 0x26, 0x12, 0x91, 0x21, 0x90,
-	0xf7, 0x0a, 0x61, 0xc9, 0x8d, 0x1c, 0xb5, 0x4c, 0xcb, 0x3c, 0x82, 0xb6, 0x9b, 0x64, 0xc1, 0xa9,
-	0xeb, 0x65, 0x52, 0x41, 0x8d, 0x74, 0xe2, 0xa2, 0x4b, 0x5c, 0x65, 0x85, 0x4a, 0x7e, 0x02, 0x0d,
-	0xcf, 0xf5, 0xce, 0xa8, 0x50, 0x4f, 0x1b, 0xf9, 0x98, 0x5d, 0x06, 0xe6, 0xf8, 0x1c, 0x85, 0xdc,
-	0x86, 0x4e, 0x9a, 0x45, 0xf1, 0x38, 0x0d, 0x26, 0xa1, 0x3b, 0x15, 0xc9, 0x48, 0x60, 0xa0, 0x63,
-	0x84, 0x30, 0x0e, 0xa5, 0xd4, 0x9b, 0x27, 0x41, 0x76, 0x81, 0x4a, 0xa7, 0xe5, 0xa8, 0x36, 0xbb,
-	0xf8, 0x8a, 0x17, 0xab, 0x6a, 0x0c, 0xc5, 0x9b, 0xdf, 0x55, 0xcc, 0xfc, 0x19, 0xf4, 0x4d, 0x96,
-	0xad, 0x34, 0xfa, 0x31, 0x40, 0xce, 0xbc, 0x95, 0xc4, 0xfb, 0x6f, 0x2a, 0xb0, 0x86, 0xdc, 0x4f,
-	0x45, 0x46, 0x69, 0x42, 0xa5, 0xa3, 0x20, 0x5a, 0x64, 0x1b, 0xd6, 0x4e, 0x10, 0x43, 0xa8, 0x8a,
-	0x4d, 0x75, 0x62, 0xa9, 0xf8, 0x11, 0x82, 0xc1, 0xb1, 0xac, 0x3d, 0xe8, 0x68, 0xe0, 0x92, 0xd5,
-	0xdc, 0x36, 0x63, 0xa1, 0xb6, 0xa2, 0xa7, 0x2f, 0xec, 0xd7, 0x15, 0x58, 0x47, 0xe0, 0x21, 0x0b,
-	0x5a, 0xaf, 0x70, 0x31, 0xe6, 0xa9, 0xaa, 0x4c, 0xe0, 0x37, 0x9b, 0x74, 0x1e, 0xf8, 0xc2, 0x8d,
-	0x62, 0x9f, 0x0c, 0x2b, 0x73, 0x27, 0xd2, 0x89, 0xc1, 0x6f, 0x62, 0xab, 0x9d, 0x35, 0xf2, 0xa8,
-	0x8c, 0xaf, 0x5d, 0xee, 0x06, 0xa3, 0x1b, 0x37, 0x41, 0xb3, 0xde, 0x75, 0xd8, 0xa7, 0x4d, 0xa1,
-	0xf3, 0x55, 0x14, 0xa9, 0xa2, 0xc9, 0x6d, 0xe8, 0xb8, 0xa7, 0x19, 0x4d, 0xc6, 0x69, 0xe6, 0x26,
-	0x99, 0xe0, 0x1d, 0x20, 0xe8, 0x98, 0x41, 0x18, 0xc2, 0x09, 0x3d, 0x8d, 0x12, 0x3a, 0x66, 0x82,
-	0x2b, 0x0a, 0x21, 0xc0, 0x41, 0xc7, 0x59, 0x14, 0xe7, 0xae, 0x60, 0x4d, 0x73, 0x05, 0xed, 0x0c,
-	0xc8, 0x57, 0x18, 0xbe, 0xed, 0x9e, 0x51, 0x4f, 0xcd, 0x76, 0x13, 0xda, 0x99, 0x17, 0x8f, 0xe3,
-	0x28, 0xc9, 0xe4, 0x39, 0xb5, 0x32, 0x2f, 0x3e, 0x62, 0x6d, 0xd6, 0x79, 0x96, 0x65, 0xbc, 0x57,
-	0x7a, 0x37, 0x0c, 0xc0, 0x7a, 0x91, 0x25, 0xc9, 0x54, 0xa8, 0x24, 0xf6, 0x89, 0x5e, 0x4c, 0xe4,
-	0xf3, 0x9c, 0x48, 0xc3, 0xc1, 0x6f, 0xfb, 0xaf, 0x2a, 0x00, 0xcf, 0xa2, 0x89, 0xc6, 0xef, 0xec,
-	0x22, 0x56, 0xfc, 0x66, 0xdf, 0xe4, 0x21, 0xac, 0x79, 0x51, 0x78, 0x1a, 0x4c, 0x84, 0x3c, 0x60,
-	0x2a, 0x22, 0x1f, 0xc3, 0x9c, 0xeb, 0xd3, 0x60, 0x22, 0x64, 0x82, 0x63, 0xb2, 0x9b, 0xa1, 0x81,
-	0x57, 0x92, 0xd0, 0x7f, 0xa8, 0xc1, 0xfa, 0xbe, 0x0a, 0x3f, 0x2e, 0x13, 0x84, 0x11, 0x34, 0x85,
-	0x7a, 0x94, 0x99, 0x21, 0xd1, 0x2c, 0x64, 0x64, 0x6a, 0x0b, 0x19, 0x99, 0x45, 0xc5, 0xbc, 0x05,
-	0xb5, 0x69, 0x34, 0x11, 0x72, 0xd1, 0x37, 0x77, 0xe8, 0xb0, 0x2e, 0xb4, 0x5c, 0x22, 0x25, 0xc3,
-	0x75, 0xb3, 0x4a, 0xbb, 0x3c, 0x86, 0x0e, 0x0f, 0xbc, 0x3d, 0x76, 0x72, 0xe8, 0xff, 0x89, 0x5b,
-	0xb3, 0x78, 0xa0, 0x8e, 0x8e, 0x4a, 0xee, 0x42, 0xfd, 0x2c, 0x8a, 0xbe, 0x47, 0xf7, 0xb0, 0xf3,
-	0x70, 0x80, 0x43, 0x72, 0x51, 0x73, 0xb0, 0x93, 0xbc, 0x05, 0xfd, 0x84, 0xa2, 0xb0, 0x8d, 0xe3,
-	0x68, 0x1a, 0x78, 0xdc, 0x6d, 0x6c, 0x3b, 0x3d, 0x01, 0x3d, 0x42, 0x20, 0xf9, 0x0c, 0x9a, 0xe9,
-	0x45, 0xea, 0x65, 0xca, 0x7d, 0x44, 0x7f, 0x6e, 0x81, 0x93, 0xdb, 0xc7, 0x1c, 0x49, 0xa4, 0x8e,
-	0xc4, 0x10, 0xeb, 0x13, 0xe8, 0xea, 0x1d, 0x2b, 0x9d, 0xd8, 0xdf, 0xb7, 0xa1, 0xb7, 0x47, 0xe3,
-	0x69, 0x74, 0x71, 0xd9, 0x69, 0x7d, 0xb8, 0x10, 0x67, 0x0a, 0x93, 0xb3, 0xb0, 0x44, 0x23, 0xfc,
-	0x5c, 0xee, 0xa4, 0xeb, 0xee, 0x4e, 0xbd, 0xe0, 0xee, 0xa8, 0x4c, 0x58, 0x43, 0xcf, 0x84, 0xbd,
-	0x01, 0x40, 0x7f, 0xcc, 0x12, 0x77, 0x8c, 0x06, 0x92, 0x7b, 0xee, 0x6d, 0x84, 0x30, 0xfd, 0xcf,
-	0xae, 0x93, 0x17, 0xcf, 0xc7, 0x3c, 0xf3, 0xc7, 0xcb, 0xa8, 0x2d, 0x2f, 0x9e, 0xff, 0xa2, 0x90,
-	0xfc, 0x6b, 0x19, 0x4e, 0xfb, 0x06, 0x34, 0xbc, 0x68, 0x1e, 0x66, 0x78, 0x28, 0x0d, 0x87, 0x37,
-	0x18, 0xfb, 0x68, 0x78, 0x8e, 0x07, 0xd1, 0x76, 0xd8, 0x27, 0x8a, 0x5c, 0x98, 0xa2, 0x11, 0x64,
-	0x22, 0xc7, 0x15, 0x09, 0x5f, 0xcd, 0x59, 0x94, 0x66, 0x29, 0x3a, 0xe1, 0x2c, 0xf2, 0x66, 0xa0,
-	0xaf, 0x18, 0x44, 0x0f, 0xc5, 0x7a, 0x66, 0xc6, 0xed, 0x53, 0x2d, 0xbf, 0xc3, 0xdd, 0xeb, 0xdb,
-	0x8c, 0x93, 0xc6, 0x21, 0x2c, 0xcd, 0xee, 0x6c, 0x41, 0x47, 0x7c, 0xcf, 0x98, 0x36, 0x18, 0x20,
-	0x1b, 0x74, 0x90, 0xd2, 0xb0, 0x43, 0x4d, 0xc3, 0x6e, 0x40, 0xc3, 0xa7, 0x27, 0xf3, 0x09, 0x56,
-	0xd9, 0x5a, 0x0e, 0x6f, 0x30, 0x7f, 0x26, 0x8a, 0x69, 0x78, 0x9c, 0xf9, 0x41, 0x38, 0x22, 0xdc,
-	0x9f, 0x51, 0x00, 0xf2, 0xa1, 0xf2, 0x30, 0xae, 0xe7, 0x39, 0x41, 0x73, 0x91, 0x65, 0x9e, 0xc6,
-	0x0e, 0x00, 0x3b, 0x48, 0x31, 0x74, 0x23, 0x0f, 0x1f, 0x0a, 0xfb, 0x53, 0x38, 0x32, 0x36, 0x51,
-	0x00, 0x5e, 0xb3, 0x60, 0xc8, 0xe3, 0x19, 0xcd, 0xce, 0x22, 0x7f, 0x74, 0x03, 0xb7, 0xd2, 0xe5,
-	0xc0, 0xe7, 0x08, 0x23, 0xef, 0x43, 0xdd, 0x77, 0x33, 0x77, 0xb4, 0x89, 0x33, 0xdc, 0x5c, 0x9c,
-	0x61, 0xcf, 0xcd, 0x64, 0xd8, 0xc4, 0x10, 0x99, 0xfc, 0xa4, 0xd1, 0x69, 0x36, 0xe6, 0x2f, 0x77,
-	0x5e, 0x13, 0xee, 0x5b, 0x74, 0x9a, 0x3d, 0x63, 0x00, 0x76, 0xa0, 0x58, 0x21, 0x12, 0xfd, 0x23,
-	0x14, 0x08, 0x5c, 0x55, 0xca, 0x11, 0x44, 0x5d, 0xf9, 0x24, 0x08, 0xfd, 0xd1, 0xeb, 0x3c, 0x63,
-	0xe7, 0xc5, 0xf3, 0xa7, 0x41, 0xe8, 0x63, 0x49, 0x77, 0x12, 0x32, 0xa3, 0x81, 0x0a, 0xc1, 0xe2,
-	0x2a, 0x8b, 0x83, 0x98, 0x4a, 0x20, 0x77, 0xa0, 0xcb, 0xcd, 0x8e, 0x97, 0x50, 0x37, 0xa3, 0xa3,
-	0x9b, 0x28, 0x11, 0xdc, 0x14, 0xed, 0x22, 0x88, 0x91, 0x4f, 0xdc, 0x1f, 0xb8, 0x70, 0xdf, 0x42,
-	0xfb, 0xd5, 0x4c, 0xdc, 0x1f, 0x50, 0xb4, 0xb5, 0x58, 0xed, 0x0d, 0x23, 0x56, 0x7b, 0xa5, 0xdc,
-	0xdd, 0xab, 0x38, 0x40, 0x2c, 0xda, 0x32, 0x0f, 0x70, 0x55, 0x9f, 0x4d, 0x9d, 0xce, 0x55, 0x03,
-	0xbb, 0xba, 0xb2, 0xfa, 0x8f, 0x2a, 0xf4, 0x1d, 0x1a, 0x4f, 0x5d, 0x4f, 0x39, 0x19, 0xef, 0x43,
-	0xdb, 0x97, 0xe7, 0x8e, 0x44, 0xc4, 0xcb, 0x00, 0x43, 0x18, 0x9c, 0x1c, 0x87, 0xbc, 0x0d, 0x7d,
-	0x71, 0x5d, 0x82, 0xf0, 0x8c, 0x26, 0x41, 0x26, 0xa2, 0x96, 0x02, 0x94, 0x1c, 0x42, 0xef, 0x34,
-	0x98, 0xb2, 0x43, 0x33, 0xe2, 0x18, 0x7c, 0x03, 0x64, 0xae, 0x61, 0xfb, 0x4b, 0xc4, 0xd3, 0x6f,
-	0x43, 0xf7, 0x54, 0x03, 0xb1, 0x18, 0xdf, 0x8b, 0xe2, 0x0b, 0x91, 0x67, 0xbb, 0x55, 0x42, 0x61,
-	0x37, 0x8a, 0x45, 0x90, 0x8e, 0x98, 0x32, 0x09, 0xd4, 0x50, 0x49, 0x20, 0xeb, 0x0b, 0x58, 0x5f,
-	0x98, 0x66, 0x55, 0xa6, 0xab, 0x59, 0x56, 0xb2, 0x10, 0x73, 0x58, 0x47, 0x7f, 0xd5, 0xf0, 0xed,
-	0x96, 0x87, 0x82, 0xba, 0x66, 0xaf, 0x2e, 0xd6, 0x41, 0x51, 0x99, 0x73, 0x66, 0xb6, 0x1d, 0xd1,
-	0x52, 0x99, 0xb4, 0xba, 0x96, 0x49, 0xfb, 0xcb, 0x0a, 0x10, 0x1e, 0x55, 0xff, 0x6e, 0x27, 0x66,
-	0x9c, 0x88, 0x93, 0x79, 0x28, 0x43, 0x3c, 0xde, 0xb0, 0xef, 0x70, 0xf6, 0x1d, 0xb9, 0xd9, 0x19,
-	0xe6, 0x03, 0x63, 0xf6, 0x21, 0x9c, 0x3a, 0xde, 0xb0, 0xff, 0xba, 0xc2, 0x1c, 0xa7, 0x58, 0x19,
-	0xd2, 0x47, 0xd0, 0xcc, 0xdc, 0x64, 0x42, 0x33, 0x99, 0xce, 0xbc, 0xc5, 0xd3, 0x99, 0x0a, 0x63,
-	0xfb, 0x5b, 0xde, 0x2d, 0xcc, 0xb9, 0x40, 0xb6, 0x0e, 0xa1, 0xab, 0x77, 0x94, 0x1c, 0xd6, 0x5d,
-	0xd3, 0x29, 0xef, 0x49, 0xba, 0xb8, 0x3a, 0xfd, 0xec, 0x7e, 0x55, 0x81, 0xce, 0x31, 0x0d, 0xfd,
-	0xe5, 0xc9, 0xc5, 0xf7, 0x84, 0x1e, 0xad, 0xe6, 0xd5, 0x2d, 0x6d, 0x40, 0x51, 0x8b, 0xbe, 0xfc,
-	0xd5, 0xfd, 0x14, 0x3a, 0xfb, 0x49, 0x12, 0x25, 0xfc, 0x11, 0x99, 0x72, 0x67, 0x2b, 0xa8, 0xd0,
-	0xf0, 0x9b, 0x1d, 0xed, 0x8c, 0x67, 0xfd, 0xa5, 0x4b, 0x28, 0x9a, 0xf6, 0x3f, 0x1b, 0xf1, 0xc5,
-	0xb2, 0xd2, 0x80, 0x59, 0x65, 0x6f, 0xab, 0xc4, 0xbe, 0x05, 0xad, 0x38, 0x89, 0x26, 0x09, 0x4d,
-	0x53, 0x99, 0x04, 0x97, 0xed, 0xe5, 0x49, 0xff, 0x14, 0x33, 0xdf, 0xc2, 0x05, 0x11, 0x2d, 0xf2,
-	0x10, 0xba, 0x88, 0x30, 0xe6, 0x4f, 0xbd, 0xd0, 0x0b, 0x11, 0xae, 0x9f, 0xb6, 0x39, 0xa7, 0x43,
-	0xf3, 0x86, 0x9d, 0xc2, 0x9a, 0x78, 0x94, 0xb2, 0xad, 0x1e, 0xa5, 0x54, 0xf2, 0xd8, 0x8c, 0xf7,
-	0x95, 0x3d, 0x4b, 0x79, 0x95, 0xf7, 0x10, 0x7f, 0xd1, 0x80, 0x4d, 0x6e, 0x58, 0x54, 0x0e, 0x5a,
-	0x72, 0xed, 0xe5, 0x2e, 0x10, 0xe7, 0x75, 0x4d, 0xf1, 0xba, 0xac, 0x44, 0xab, 0x78, 0xd9, 0xd0,
-	0x79, 0x89, 0xcf, 0xca, 0x3c, 0x8f, 0x31, 0x7f, 0x8d, 0x9b, 0x4d, 0xd1, 0x24, 0x1f, 0xca, 0x94,
-	0xab, 0x2a, 0xd7, 0x97, 0x2f, 0x79, 0x59, 0x7d, 0xb7, 0x55, 0x5e, 0xdf, 0x35, 0xf3, 0xb2, 0x3b,
-	0xc5, 0x62, 0xec, 0xbd, 0x4b, 0x26, 0x2a, 0xaf, 0xcc, 0x12, 0xe1, 0xe8, 0x77, 0x50, 0xa6, 0xb9,
-	0x5f, 0xbf, 0xbc, 0x2e, 0xfb, 0x47, 0x66, 0x41, 0x95, 0xbf, 0xbf, 0xfa, 0xc9, 0x25, 0x93, 0xfe,
-	0x36, 0xaa, 0xab, 0xff, 0x4f, 0x4a, 0xa4, 0xbf, 0xae, 0xc0, 0x6b, 0xc2, 0xd2, 0x2d, 0xc8, 0x21,
-	0x8b, 0x4c, 0xb9, 0x37, 0xc4, 0xad, 0xb6, 0xb5, 0x9c, 0x45, 0x8e, 0xc0, 0x64, 0x63, 0x12, 0x34,
-	0x09, 0x62, 0x5a, 0x8b, 0x9b, 0x52, 0xa3, 0x8a, 0xa3, 0xc6, 0x70, 0xcc, 0x5c, 0x2a, 0x6b, 0x9a,
-	0x54, 0xda, 0x17, 0xba, 0x51, 0x93, 0x4b, 0x52, 0x81, 0x47, 0xa5, 0x58, 0x82, 0x17, 0x02, 0x5c,
-	0x35, 0x05, 0xf8, 0xb2, 0xea, 0x9a, 0xa6, 0xcc, 0xea, 0xa6, 0x32, 0xfb, 0x53, 0xc3, 0xae, 0xbd,
-	0xc2, 0xdc, 0x82, 0xa0, 0xb4, 0x69, 0xaa, 0x6d, 0x7f, 0xb7, 0x50, 0xda, 0x5a, 0xa6, 0x32, 0x97,
-	0xd3, 0x97, 0x42, 0xcf, 0xf7, 0x85, 0xdf, 0xf6, 0xd3, 0xd2, 0xe2, 0xd6, 0x32, 0xda, 0x8a, 0xf1,
-	0x55, 0x9d, 0xf1, 0x4f, 0xd9, 0xda, 0xb0, 0xe0, 0x25, 0x5f, 0xf2, 0xae, 0xbc, 0x36, 0xb4, 0x6a,
-	0xcc, 0xdc, 0xad, 0x6a, 0x08, 0xa4, 0xd2, 0xaa, 0x99, 0x09, 0x29, 0x66, 0xbd, 0xa5, 0x22, 0x63,
-	0xdf, 0x4b, 0x14, 0x19, 0x11, 0x96, 0x92, 0x67, 0x97, 0xf0, 0xdb, 0x3e, 0xe0, 0xe6, 0x75, 0xd9,
-	0x42, 0x24, 0xf1, 0x6a, 0x19, 0x71, 0x43, 0x1e, 0xbf, 0x81, 0xcd, 0x9d, 0x2c, 0x73, 0xbd, 0xb3,
-	0x05, 0xb6, 0xde, 0x81, 0xae, 0x2a, 0x7b, 0x8e, 0x15, 0xf5, 0x8e, 0x82, 0x1d, 0xfa, 0x6a, 0x65,
-	0x55, 0x6d, 0x65, 0x7f, 0x5b, 0x81, 0x75, 0x67, 0x1e, 0xee, 0x84, 0xfe, 0x1f, 0xbb, 0x81, 0xca,
-	0xc4, 0x3c, 0x86, 0xbe, 0x08, 0xad, 0x22, 0x0e, 0x59, 0xee, 0x32, 0xf7, 0x7c, 0x23, 0x2b, 0x30,
-	0x84, 0x9a, 0x37, 0xf3, 0xc5, 0x14, 0xec, 0x93, 0x6d, 0xc4, 0x4d, 0x2f, 0x42, 0x4f, 0x66, 0xc2,
-	0xb0, 0xc1, 0x82, 0x37, 0xfc, 0x18, 0x67, 0xc1, 0x8c, 0x46, 0xf3, 0x4c, 0xb8, 0x56, 0x5d, 0x04,
-	0x7e, 0xcb, 0x61, 0xf6, 0x2f, 0xe1, 0x35, 0xb6, 0xcf, 0x24, 0x9a, 0xbe, 0x40, 0xf1, 0x55, 0xa6,
-	0xb5, 0xaa, 0x5a, 0x5a, 0xab, 0x3c, 0x0b, 0x77, 0xbc, 0x48, 0x76, 0x25, 0xe1, 0x34, 0x84, 0x5e,
-	0x68, 0x7a, 0xfb, 0x19, 0x0c, 0x9f, 0x45, 0x93, 0xcb, 0x1f, 0x25, 0x2c, 0xa5, 0x86, 0xc7, 0x52,
-	0xd3, 0x8e, 0xe5, 0x5f, 0x2a, 0xf0, 0xda, 0xfe, 0x8f, 0xd4, 0x9b, 0x97, 0x54, 0x87, 0x5f, 0xe0,
-	0xa4, 0xf5, 0x22, 0x43, 0xb5, 0x50, 0x64, 0x20, 0xa2, 0xc8, 0xc0, 0xb5, 0x01, 0xaf, 0x27, 0x8c,
-	0xa0, 0xc9, 0x62, 0x9a, 0x3c, 0x5f, 0x26, 0x9b, 0x2c, 0x1c, 0x66, 0xb1, 0xfe, 0x38, 0xc5, 0xe8,
-	0xbf, 0x51, 0x8c, 0xfe, 0x59, 0x38, 0x4a, 0xe3, 0xe9, 0x98, 0x9d, 0xf9, 0x9a, 0x08, 0x47, 0x69,
-	0x3c, 0xdd, 0x9d, 0xf9, 0x0f, 0x7f, 0x33, 0x80, 0xe6, 0x6e, 0x94, 0x50, 0xe7, 0x68, 0x97, 0x3c,
-	0x82, 0xae, 0xf6, 0x34, 0x3d, 0x25, 0x9b, 0xaa, 0xca, 0x63, 0x3c, 0x56, 0xb7, 0xba, 0xda, 0x1b,
-	0xf1, 0xd4, 0xbe, 0x46, 0xee, 0x40, 0x8b, 0x61, 0xe1, 0xbf, 0x58, 0x30, 0xa5, 0x8c, 0xff, 0x03,
-	0xb2, 0x5a, 0xe2, 0x0f, 0x16, 0x0c, 0xe5, 0x6d, 0x58, 0xe3, 0x15, 0x6b, 0xb2, 0x2e, 0xaa, 0x8f,
-	0x79, 0x71, 0xd9, 0x92, 0xff, 0x75, 0xb1, 0xaf, 0x91, 0x6d, 0x68, 0xab, 0x02, 0x35, 0xd9, 0xc8,
-	0x0d, 0x82, 0x86, 0x9d, 0xcf, 0xc0, 0xe9, 0xf2, 0x42, 0x35, 0xa7, 0x6b, 0x14, 0xad, 0x75, 0xba,
-	0x8f, 0xb0, 0x44, 0xa7, 0xff, 0x83, 0xa6, 0x04, 0x7f, 0x50, 0xf8, 0x47, 0x88, 0x7d, 0x8d, 0xfc,
-	0x8c, 0xb3, 0xe4, 0x28, 0xf2, 0xf9, 0x8b, 0xd4, 0x8d, 0xb2, 0xc2, 0x17, 0x5f, 0x12, 0x42, 0xec,
-	0x6b, 0xe4, 0x1d, 0x68, 0x8a, 0xe2, 0x2a, 0x21, 0x8b, 0x95, 0x56, 0x4b, 0x3d, 0x62, 0xb5, 0xaf,
-	0x91, 0x07, 0x00, 0x79, 0xa9, 0x91, 0xdc, 0xc8, 0xb7, 0xab, 0x0f, 0x30, 0xf6, 0xfb, 0x0e, 0x34,
-	0xc5, 0x2b, 0x48, 0x4e, 0xdc, 0x7c, 0x12, 0x69, 0x10, 0x7f, 0x07, 0x9a, 0x07, 0x3a, 0xea, 0xc1,
-	0x72, 0xd4, 0x8f, 0x61, 0x20, 0x7a, 0xf3, 0x3f, 0x64, 0x94, 0x0c, 0x19, 0xaa, 0xf7, 0xb7, 0x39,
-	0x83, 0x1e, 0x40, 0xf7, 0x40, 0x7b, 0x39, 0x43, 0x06, 0xc6, 0x23, 0x8f, 0xc3, 0x3d, 0xcb, 0x7c,
-	0xf5, 0x61, 0x5f, 0x23, 0x1f, 0xe0, 0xdb, 0x02, 0xed, 0xad, 0xc8, 0xb0, 0x30, 0x24, 0xb5, 0xfa,
-	0x06, 0x84, 0x31, 0xf5, 0x09, 0xf4, 0xcd, 0x7f, 0x74, 0x91, 0xd7, 0x97, 0xfe, 0xcb, 0x6b, 0x61,
-	0xca, 0x07, 0x15, 0xf2, 0x89, 0xf8, 0xd7, 0x45, 0xe4, 0x53, 0x8d, 0x46, 0xd9, 0x26, 0x17, 0xe7,
-	0xfe, 0x02, 0xae, 0x1f, 0x2c, 0x3e, 0x0e, 0x2a, 0x59, 0xf6, 0x86, 0x39, 0x94, 0xe3, 0xd9, 0xd7,
-	0xc8, 0x73, 0xb8, 0x5e, 0xf2, 0xba, 0x88, 0xc8, 0x17, 0xb1, 0x4b, 0x9e, 0x1d, 0x2d, 0x25, 0x37,
-	0x86, 0x1b, 0xa5, 0x0f, 0x7b, 0xc8, 0xd6, 0x55, 0x6f, 0x7e, 0xac, 0xe5, 0x18, 0x42, 0x19, 0x22,
-	0xb3, 0xde, 0x85, 0x3a, 0x33, 0xc8, 0xf2, 0x2c, 0x55, 0x84, 0x6b, 0x29, 0x40, 0x01, 0x9b, 0x59,
-	0x4d, 0x8e, 0xad, 0x45, 0x9b, 0x96, 0x02, 0xe8, 0xd8, 0x4f, 0x00, 0xf2, 0xd8, 0x8f, 0xe4, 0x85,
-	0x4e, 0x3d, 0x2d, 0x60, 0x15, 0xc0, 0x85, 0xf1, 0xb9, 0xab, 0xc7, 0xc7, 0x2f, 0xe4, 0x33, 0xac,
-	0x02, 0x58, 0x1f, 0xbf, 0x03, 0x1d, 0xcd, 0x5f, 0xe3, 0x2a, 0x6e, 0x31, 0x31, 0x61, 0x15, 0xe1,
-	0x3a, 0x89, 0x3d, 0x18, 0x14, 0x3c, 0x5b, 0xb2, 0x68, 0x71, 0xad, 0x4b, 0x3c, 0x60, 0xa4, 0x72,
-	0x00, 0xc3, 0xa2, 0x33, 0xcd, 0xe5, 0xd1, 0x4c, 0x26, 0x59, 0x37, 0x35, 0x58, 0x29, 0xa1, 0xe7,
-	0x30, 0x28, 0xf8, 0x87, 0xa4, 0xcc, 0x93, 0x36, 0xd6, 0x55, 0xee, 0x50, 0x22, 0xb9, 0x3f, 0x81,
-	0xeb, 0x25, 0x6e, 0x21, 0x17, 0xd6, 0xe5, 0x8f, 0xa1, 0xac, 0x65, 0xfd, 0x3a, 0xe9, 0x23, 0xfe,
-	0xbe, 0x50, 0xb7, 0xe8, 0xe4, 0xa6, 0x94, 0xc8, 0x12, 0xf7, 0xc1, 0x2a, 0xed, 0xd4, 0x29, 0xee,
-	0xb3, 0xbd, 0x1b, 0xfe, 0xa7, 0xe4, 0xa1, 0xfe, 0x0a, 0x4b, 0xee, 0xb9, 0xcc, 0x51, 0x45, 0x32,
-	0x8f, 0xa0, 0xad, 0xbc, 0x82, 0x45, 0x0d, 0xb6, 0x21, 0x6a, 0x50, 0x8b, 0x17, 0x65, 0x1f, 0x20,
-	0xf7, 0xca, 0x84, 0xfe, 0x2e, 0x7a, 0x69, 0x7c, 0xf2, 0x72, 0x77, 0xd0, 0xbe, 0x76, 0xbf, 0xf2,
-	0xa0, 0x42, 0x7e, 0x01, 0xc3, 0xa2, 0x17, 0xc1, 0xf9, 0xb2, 0xc4, 0xb7, 0xb8, 0x9a, 0xe4, 0xc9,
-	0x1a, 0xfe, 0x2f, 0xf7, 0x83, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x74, 0x24, 0x34, 0x91, 0xa5,
-	0x3b, 0x00, 0x00,
+	// 6510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x7c, 0xcd, 0x8f, 0x1c, 0xc7,
+	0x75, 0x38, 0xe7, 0x7b, 0xe6, 0xcd, 0xec, 0xec, 0x6c, 0x71, 0x49, 0x0e, 0x87, 0xa2, 0xb8, 0x6c,
+	0xca, 0x24, 0x65, 0x9b, 0x2b, 0x9a, 0x92, 0x68, 0x49, 0x94, 0x44, 0x2f, 0xbf, 0x17, 0xa6, 0xa4,
+	0x75, 0xaf, 0x25, 0xff, 0x7c, 0x9a, 0x5f, 0x6f, 0x77, 0xed, 0x6e, 0x83, 0x33, 0xdd, 0xed, 0xee,
+	0x9e, 0x25, 0xd7, 0xa7, 0x18, 0x48, 0xe0, 0x8b, 0x81, 0x24, 0x87, 0x7c, 0x22, 0xc7, 0x9c, 0x72,
+	0x09, 0x12, 0x18, 0x01, 0x82, 0x5c, 0x9c, 0x4b, 0x4e, 0x01, 0x72, 0x0b, 0x72, 0xc8, 0x29, 0x7f,
+	0x43, 0x90, 0x73, 0xf0, 0xea, 0xbb, 0x7b, 0xba, 0x77, 0x77, 0x96, 0xb2, 0x05, 0xe4, 0x34, 0x5d,
+	0xaf, 0xaa, 0x5e, 0x55, 0xbd, 0x7a, 0xf5, 0xea, 0x7d, 0xd5, 0x00, 0xb8, 0x61, 0x4c, 0xd7, 0xa3,
+	0x38, 0x4c, 0x43, 0x52, 0x8d, 0x76, 0xac, 0x16, 0x34, 0x1e, 0x4f, 0xa3, 0xf4, 0xd0, 0xfa, 0x8b,
+	0x2a, 0x9c, 0x7b, 0xee, 0x27, 0xe9, 0xc3, 0x30, 0x48, 0x1d, 0x3f, 0xa0, 0x71, 0xf2, 0x45, 0x94,
+	0xfa, 0x61, 0x90, 0x90, 0x21, 0xb4, 0x9c, 0x28, 0x0a, 0x9c, 0x29, 0x1d, 0x56, 0xd6, 0x2a, 0x37,
+	0x3b, 0xb6, 0x2c, 0x92, 0x37, 0x01, 0x68, 0x90, 0xc6, 0x87, 0x51, 0xe8, 0x07, 0xe9, 0xb0, 0xca,
+	0x2a, 0x0d, 0x08, 0x19, 0x41, 0x3b, 0x08, 0x3d, 0xca, 0xba, 0xd6, 0x58, 0xad, 0x2a, 0x93, 0x4f,
+	0xa0, 0x39, 0x71, 0x76, 0xe8, 0x24, 0x19, 0xd6, 0xd7, 0x6a, 0x37, 0xbb, 0x77, 0xbe, 0xb5, 0x1e,
+	0xed, 0xac, 0x17, 0x4e, 0x60, 0xfd, 0x39, 0x6b, 0xf7, 0x18, 0xf1, 0xda, 0xa2, 0x13, 0x59, 0x85,
+	0xc6, 0xc4, 0x9f, 0xfa, 0xe9, 0xb0, 0xb1, 0x56, 0xb9, 0x59, 0xb3, 0x79, 0x01, 0x07, 0x4c, 0xe8,
+	0x84, 0xba, 0x69, 0x18, 0x0f, 0x9b, 0x7c, 0x40, 0x59, 0x1e, 0x7d, 0x08, 0x5d, 0x03, 0x11, 0x19,
+	0x40, 0xed, 0x05, 0x3d, 0x14, 0x2b, 0xc2, 0x4f, 0x44, 0x79, 0xe0, 0x4c, 0x66, 0x54, 0x2c, 0x84,
+	0x17, 0x3e, 0xaa, 0x7e, 0x50, 0xb1, 0x7e, 0x51, 0x81, 0xda, 0x56, 0xe8, 0x11, 0x02, 0x75, 0x83,
+	0x0c, 0xec, 0x1b, 0x61, 0x1e, 0x4d, 0x5c, 0xd1, 0x89, 0x7d, 0x93, 0x6f, 0x41, 0xdf, 0x8d, 0x66,
+	0xe3, 0xf0, 0x80, 0xc6, 0x6e, 0x38, 0xc5, 0x59, 0xe2, 0xea, 0x2b, 0xf6, 0x92, 0x1b, 0xcd, 0xbe,
+	0x50, 0x40, 0xf2, 0x1d, 0x58, 0x99, 0xd2, 0x69, 0x18, 0x1f, 0x9a, 0x2d, 0xeb, 0xac, 0xe5, 0x80,
+	0x57, 0xe8, 0xc6, 0xd6, 0x35, 0xa8, 0x6f, 0x85, 0x5e, 0x42, 0x2e, 0x41, 0x3d, 0x0a, 0xbd, 0x64,
+	0x58, 0x61, 0x54, 0x6b, 0x21, 0xd5, 0xb6, 0x42, 0xcf, 0x66, 0x40, 0xeb, 0xd7, 0x6d, 0xe8, 0x62,
+	0x89, 0x26, 0xe1, 0x2c, 0x76, 0x69, 0xe1, 0x84, 0x1f, 0x42, 0x0f, 0x27, 0x17, 0xd1, 0xd8, 0xa5,
+	0x41, 0x9a, 0x0c, 0xab, 0x0c, 0xd1, 0x9a, 0x44, 0x24, 0xba, 0xae, 0x3f, 0x8c, 0x66, 0x5b, 0xa2,
+	0x09, 0xa7, 0x7c, 0xd7, 0xd5, 0x10, 0xf2, 0x1c, 0x96, 0xc5, 0xd4, 0x15, 0x9e, 0x1a, 0xc3, 0x73,
+	0x2d, 0x8f, 0xe7, 0x33, 0xd6, 0x2c, 0x8b, 0xaa, 0x3f, 0xcd, 0x00, 0xc9, 0x33, 0x58, 0x3a, 0xa0,
+	0xb1, 0xbf, 0xeb, 0xbb, 0x0e, 0xdb, 0x71, 0xc1, 0x12, 0x56, 0x1e, 0xd7, 0x57, 0x66, 0x23, 0x8e,
+	0x2a, 0xdb, 0x91, 0xdc, 0x85, 0x96, 0x47, 0x53, 0xc7, 0x9f, 0x24, 0xc3, 0x06, 0xc3, 0xf1, 0x46,
+	0x1e, 0xc7, 0x23, 0x5e, 0xcd, 0x7b, 0xcb, 0xc6, 0xe4, 0x0b, 0x18, 0x24, 0x69, 0x18, 0x3b, 0x7b,
+	0x54, 0x2f, 0xa8, 0xc9, 0x10, 0xbc, 0x95, 0x47, 0xb0, 0xcd, 0xdb, 0x65, 0x57, 0xb4, 0x9c, 0x64,
+	0xa1, 0x64, 0x07, 0x2e, 0x64, 0x59, 0x40, 0xe3, 0x6d, 0x31, 0xbc, 0xdf, 0x2e, 0x20, 0xb8, 0xde,
+	0xee, 0x2c, 0xf6, 0x73, 0x6e, 0x51, 0x1d, 0x79, 0x01, 0xa3, 0x39, 0xfe, 0xd1, 0xc3, 0xb4, 0xd9,
+	0x30, 0xb7, 0x8a, 0xf7, 0xa3, 0x6c, 0xa4, 0xe1, 0xb4, 0xa4, 0x7a, 0xf4, 0x29, 0x0c, 0xf2, 0x2c,
+	0x71, 0xdc, 0x19, 0xaa, 0x18, 0x67, 0x68, 0xb4, 0x01, 0x67, 0x0b, 0x58, 0x61, 0x21, 0x14, 0x3f,
+	0x00, 0x32, 0xcf, 0x01, 0xc7, 0x61, 0x68, 0x9b, 0x18, 0x3e, 0x82, 0x9e, 0xb9, 0xff, 0x8b, 0x08,
+	0x81, 0xd1, 0x03, 0x58, 0x2d, 0xda, 0xfa, 0x85, 0x56, 0xf0, 0x0c, 0x46, 0xe5, 0xdb, 0xbc, 0x10,
+	0xa6, 0x1f, 0xc2, 0xe5, 0x23, 0x77, 0x72, 0x11, 0x64, 0xd6, 0x9f, 0xd5, 0xa0, 0xf7, 0x79, 0xe8,
+	0xd1, 0x23, 0xe5, 0xc6, 0x15, 0xe8, 0x1a, 0x72, 0x43, 0x20, 0x01, 0x2d, 0x14, 0x50, 0xea, 0x65,
+	0x65, 0x82, 0x94, 0x7a, 0x99, 0xd3, 0x4e, 0x2c, 0xe8, 0x99, 0x67, 0x96, 0x09, 0xbc, 0xb6, 0x9d,
+	0x81, 0xe1, 0x95, 0x63, 0x1e, 0xe3, 0x8e, 0x3e, 0xa8, 0x37, 0x60, 0x39, 0x77, 0x50, 0x99, 0xa0,
+	0xaf, 0xd8, 0xfd, 0xec, 0x09, 0xc4, 0xd9, 0x1c, 0x84, 0x93, 0xd9, 0x54, 0xb7, 0x6b, 0xf1, 0xd9,
+	0x70, 0xa8, 0x6c, 0xf6, 0x1e, 0x9c, 0x2f, 0x3e, 0xa7, 0xc3, 0x36, 0x6b, 0xbe, 0x5a, 0x74, 0xf4,
+	0xc8, 0x47, 0x70, 0xb1, 0xf4, 0xe4, 0x0d, 0x3b, 0xac, 0xe3, 0x85, 0x92, 0x93, 0x84, 0x52, 0x7f,
+	0xc7, 0x09, 0xbc, 0x97, 0xbe, 0x97, 0xee, 0xab, 0x3e, 0xc0, 0xa5, 0xbe, 0xaa, 0x10, 0x8d, 0xad,
+	0xcf, 0xe0, 0xfc, 0x13, 0xff, 0x95, 0xb9, 0x37, 0xf2, 0x56, 0x36, 0xef, 0xd6, 0x4a, 0xee, 0x6e,
+	0xbd, 0x00, 0x2d, 0x2f, 0x3e, 0x1c, 0xc7, 0xb3, 0x40, 0x1c, 0x81, 0xa6, 0x17, 0x1f, 0xda, 0xb3,
+	0xc0, 0xfa, 0xbd, 0x0a, 0x2c, 0x9b, 0xc8, 0x9e, 0xf8, 0xaf, 0x0a, 0xf7, 0x7a, 0x15, 0x1a, 0x9e,
+	0xbf, 0xbb, 0xcb, 0x2f, 0x87, 0x8e, 0xcd, 0x0b, 0xe4, 0x16, 0x90, 0xa9, 0x9f, 0x24, 0x7e, 0xb0,
+	0x37, 0x76, 0xd5, 0x25, 0xcd, 0xe4, 0x7e, 0xc7, 0x5e, 0x11, 0x35, 0xfa, 0xf6, 0x46, 0x24, 0xbb,
+	0xfe, 0x2b, 0xea, 0x89, 0x1d, 0xe6, 0x05, 0xeb, 0x09, 0x10, 0xbc, 0xe5, 0x3f, 0xa7, 0xe9, 0xcb,
+	0x30, 0x7e, 0x61, 0xe8, 0x18, 0x51, 0xe8, 0x99, 0x3a, 0x86, 0x28, 0x92, 0xf3, 0xd0, 0xf4, 0x62,
+	0xff, 0x80, 0xc6, 0xe2, 0x44, 0x8a, 0x92, 0xf5, 0x7d, 0x68, 0x09, 0x1c, 0x85, 0x2b, 0x18, 0x42,
+	0x2b, 0x99, 0xed, 0x04, 0x34, 0x95, 0x6b, 0x90, 0x45, 0xeb, 0x5d, 0x68, 0x8b, 0x8e, 0xc8, 0x4d,
+	0xed, 0x40, 0x7c, 0x8b, 0x0b, 0xb5, 0x8b, 0xf2, 0x52, 0xd4, 0xdb, 0xaa, 0xd2, 0xfa, 0xf7, 0x2e,
+	0xd4, 0x91, 0x70, 0x85, 0x63, 0x8d, 0xa0, 0x4d, 0x03, 0xcf, 0x54, 0x82, 0x54, 0xd9, 0x5c, 0x58,
+	0x2d, 0xbb, 0xb0, 0x6b, 0x50, 0x73, 0xa3, 0x99, 0xb8, 0xea, 0x56, 0xd8, 0xb0, 0xa1, 0xc7, 0xae,
+	0x01, 0x2e, 0x8a, 0xb1, 0x96, 0x5c, 0x84, 0x36, 0xb2, 0xe7, 0x2c, 0xa1, 0x1e, 0xd3, 0x74, 0x2a,
+	0x76, 0xcb, 0x8d, 0x66, 0x5f, 0x26, 0xd4, 0x43, 0xc2, 0x70, 0x16, 0x63, 0x07, 0xa0, 0x66, 0x8b,
+	0x12, 0x9e, 0x53, 0xc1, 0x9b, 0xac, 0x57, 0x8b, 0x55, 0x02, 0x07, 0xb1, 0x8e, 0x6f, 0x40, 0xc7,
+	0x39, 0x70, 0xfc, 0x89, 0xb3, 0x33, 0xa1, 0x8c, 0xcb, 0xdb, 0xb6, 0x06, 0x90, 0xef, 0x2a, 0xbd,
+	0xac, 0xc3, 0x66, 0xb6, 0xaa, 0x66, 0x56, 0xa4, 0x86, 0x5d, 0x81, 0xae, 0x1f, 0xf8, 0xe9, 0x58,
+	0xcc, 0x04, 0xf8, 0x60, 0x08, 0xe2, 0xd2, 0x89, 0xdc, 0x86, 0x36, 0x6b, 0x80, 0x4b, 0xed, 0x32,
+	0x84, 0xe7, 0x14, 0xc2, 0xcd, 0xc0, 0x4f, 0xd5, 0x72, 0x5b, 0x3e, 0x2f, 0x21, 0x85, 0xfd, 0x60,
+	0x37, 0x1c, 0xf6, 0x38, 0x85, 0xf1, 0x9b, 0x5c, 0x87, 0x7a, 0x30, 0x9b, 0x3a, 0xc3, 0x25, 0x86,
+	0x81, 0x28, 0x0c, 0x9f, 0xcf, 0xa6, 0x0e, 0xef, 0xce, 0xea, 0xc9, 0x87, 0xd0, 0xc5, 0x5f, 0x39,
+	0x9d, 0x3e, 0x6b, 0x3e, 0xcc, 0x34, 0xe7, 0xf3, 0xe2, 0x9d, 0x20, 0x50, 0x00, 0xc6, 0x30, 0x5c,
+	0x82, 0x0c, 0x97, 0xd9, 0x2a, 0x64, 0x91, 0x5c, 0x85, 0x9e, 0x14, 0x39, 0x8c, 0xa2, 0x03, 0x56,
+	0xdd, 0x15, 0x30, 0x46, 0xd2, 0xab, 0xd0, 0x63, 0xab, 0x94, 0x18, 0x56, 0x78, 0x13, 0x84, 0x89,
+	0x3b, 0x03, 0xa7, 0xc6, 0x9a, 0x70, 0xf1, 0x33, 0x24, 0xb9, 0xa9, 0x21, 0x2d, 0xbe, 0x62, 0x55,
+	0x62, 0x6a, 0xbe, 0x02, 0xe0, 0x96, 0x88, 0x5e, 0x67, 0x73, 0x5b, 0x62, 0xf6, 0x10, 0x6d, 0x70,
+	0x4b, 0x84, 0xe0, 0x63, 0xb3, 0x5d, 0xe5, 0x5b, 0xc2, 0x41, 0x6c, 0xb2, 0x1f, 0x8b, 0xc9, 0x7a,
+	0xf4, 0xc0, 0x77, 0x69, 0x32, 0x3c, 0xc7, 0x90, 0x5e, 0xcc, 0x4c, 0xe5, 0x11, 0xaf, 0x13, 0x9a,
+	0x9f, 0xaf, 0x21, 0xe4, 0x1d, 0x14, 0xcd, 0xbc, 0xe3, 0xf9, 0xdc, 0x7e, 0x66, 0x3a, 0xc9, 0x56,
+	0xc8, 0x6e, 0x4a, 0xac, 0x0d, 0x2f, 0xb0, 0xd9, 0x68, 0x00, 0x8a, 0x69, 0x36, 0x19, 0xdd, 0x64,
+	0xc8, 0x9a, 0x2c, 0x21, 0xf4, 0x81, 0x6a, 0xb6, 0x06, 0xdd, 0x59, 0x10, 0x53, 0xc7, 0xdd, 0x67,
+	0x5c, 0x7b, 0x91, 0x71, 0xad, 0x09, 0x1a, 0xdd, 0x85, 0xb6, 0xe4, 0xa5, 0xe3, 0xee, 0xbe, 0x86,
+	0x79, 0x91, 0x9e, 0xde, 0x2c, 0x40, 0x6d, 0xc2, 0x64, 0xe1, 0x85, 0x86, 0xfd, 0x3e, 0x74, 0x14,
+	0xf3, 0x2e, 0x34, 0xe8, 0x27, 0xb0, 0x9c, 0x63, 0xe3, 0xe3, 0xba, 0xd7, 0x72, 0xdd, 0x73, 0xac,
+	0xb6, 0x50, 0xf7, 0x0f, 0xa1, 0x7b, 0xda, 0xae, 0x3f, 0x84, 0x41, 0x9e, 0xb3, 0x0a, 0xfa, 0x5f,
+	0x35, 0xfb, 0x0b, 0x71, 0x2c, 0xba, 0x98, 0xc8, 0x9e, 0x42, 0x4f, 0x40, 0x5f, 0x0f, 0x91, 0x75,
+	0x03, 0x1a, 0xc8, 0xbb, 0x09, 0x79, 0x13, 0x1a, 0x78, 0x7f, 0xca, 0x7b, 0xa0, 0x2d, 0xb9, 0xda,
+	0xe6, 0x60, 0xeb, 0x31, 0x2c, 0x61, 0x71, 0x43, 0x09, 0xca, 0xa3, 0x2e, 0x60, 0x43, 0xea, 0x57,
+	0x33, 0x52, 0xdf, 0xfa, 0x75, 0x0b, 0xfa, 0xdb, 0x34, 0x45, 0x54, 0x27, 0xb9, 0xc9, 0xcf, 0x43,
+	0x33, 0x49, 0x9d, 0x74, 0x96, 0x08, 0x0e, 0x12, 0x25, 0xf2, 0x09, 0x74, 0x3c, 0x3a, 0x49, 0x1d,
+	0x26, 0x57, 0x6b, 0xda, 0x82, 0xcb, 0xa2, 0x5e, 0x7f, 0x84, 0x6d, 0x94, 0x88, 0x6d, 0x7b, 0xa2,
+	0x88, 0xf2, 0x8a, 0x77, 0x17, 0x82, 0xb2, 0xce, 0xe5, 0x15, 0x83, 0x09, 0x79, 0x78, 0x0d, 0x96,
+	0x78, 0x13, 0x29, 0xd3, 0xb8, 0xa1, 0xcd, 0xfb, 0x49, 0xa1, 0xb6, 0x0d, 0x2b, 0xbc, 0x91, 0x29,
+	0x75, 0xb9, 0xdd, 0x74, 0xa3, 0x6c, 0x3a, 0x79, 0x21, 0xbc, 0xec, 0x65, 0xa1, 0xe4, 0xb6, 0x10,
+	0xf6, 0x2d, 0x6d, 0xc0, 0xe5, 0xf0, 0xe4, 0xc5, 0xfe, 0x5d, 0x75, 0x67, 0x71, 0xa3, 0xe7, 0xcd,
+	0x82, 0x3e, 0x45, 0xb7, 0xd7, 0x13, 0x49, 0x06, 0x21, 0x5e, 0x3b, 0xda, 0x84, 0x2d, 0x9a, 0xb9,
+	0x29, 0x6d, 0xbb, 0x9e, 0x86, 0x90, 0x0f, 0xb5, 0x4c, 0x04, 0x86, 0xe2, 0x4a, 0x21, 0x8a, 0x22,
+	0xe9, 0x78, 0x03, 0xf8, 0xfa, 0x0d, 0x01, 0xd8, 0x65, 0x84, 0xee, 0x33, 0xb0, 0x92, 0x80, 0xa3,
+	0x7b, 0xb0, 0x94, 0xd9, 0xcd, 0x85, 0xa4, 0xcd, 0x03, 0x58, 0x2d, 0xa2, 0xfd, 0x42, 0xe7, 0xf7,
+	0xd4, 0x12, 0xeb, 0x35, 0x24, 0xec, 0xa7, 0x30, 0xc8, 0x53, 0x7e, 0xa1, 0x39, 0x7f, 0x6d, 0x62,
+	0x62, 0x1f, 0x06, 0x8f, 0x62, 0xc7, 0x0f, 0x4e, 0x7a, 0x6e, 0xd1, 0x58, 0x0a, 0x63, 0x2f, 0x0c,
+	0xc6, 0x61, 0x30, 0x39, 0x14, 0x5a, 0x38, 0x70, 0xd0, 0x17, 0xc1, 0xe4, 0xd0, 0x54, 0xd1, 0x6b,
+	0x19, 0x15, 0x3d, 0x82, 0xde, 0xc6, 0xcc, 0xf3, 0xd3, 0xe3, 0x35, 0xe3, 0x6b, 0xb0, 0x14, 0xc6,
+	0xd1, 0xbe, 0x13, 0x8c, 0x1d, 0x17, 0xdb, 0x0a, 0xf2, 0xf5, 0x38, 0x70, 0x83, 0xc1, 0xf0, 0xa4,
+	0xef, 0xed, 0x87, 0x49, 0x2a, 0xdb, 0x70, 0x25, 0xb4, 0xcb, 0x60, 0xbc, 0x89, 0xf5, 0x37, 0x1d,
+	0xe8, 0x28, 0xb5, 0x9d, 0xf4, 0xa1, 0xea, 0x7b, 0x62, 0xa8, 0xaa, 0xef, 0x95, 0x8b, 0xb2, 0x23,
+	0xbd, 0x7b, 0x52, 0x4d, 0xae, 0x1b, 0x6a, 0xf2, 0x4d, 0xae, 0xf0, 0x72, 0xbf, 0xcc, 0x79, 0x24,
+	0xb4, 0x1a, 0x35, 0xa7, 0xf5, 0xae, 0x42, 0xe3, 0x67, 0xb3, 0x30, 0x75, 0x84, 0x69, 0xc7, 0x0b,
+	0x86, 0xc2, 0xdb, 0xca, 0x28, 0xbc, 0x6f, 0x02, 0x44, 0xb1, 0x7f, 0xe0, 0x4f, 0xe8, 0x1e, 0xf5,
+	0x84, 0x42, 0x6b, 0x40, 0xc8, 0xf7, 0x72, 0x1a, 0xed, 0xc5, 0xec, 0xd0, 0x45, 0x82, 0xe1, 0x3d,
+	0x68, 0x45, 0xb3, 0x9d, 0x89, 0x9f, 0xec, 0x8b, 0x03, 0x3d, 0xca, 0xf6, 0xd9, 0xe2, 0x95, 0xe2,
+	0x2c, 0x8b, 0xa6, 0x38, 0x6d, 0x7f, 0x8a, 0xa2, 0xb2, 0xcb, 0xf9, 0x98, 0x15, 0x4c, 0xc5, 0xb2,
+	0x97, 0x55, 0x2c, 0xbf, 0xa3, 0x84, 0xfb, 0x12, 0x63, 0xbe, 0xb3, 0x99, 0x41, 0xb6, 0x59, 0x95,
+	0x92, 0xf8, 0x43, 0x68, 0x71, 0x29, 0x95, 0x30, 0xb5, 0xb6, 0x63, 0xcb, 0x22, 0xf9, 0x54, 0x29,
+	0x7c, 0xd1, 0xc4, 0x09, 0x86, 0xcb, 0x6c, 0xc2, 0x97, 0xb3, 0x13, 0xe6, 0x07, 0x68, 0x6b, 0xe2,
+	0x04, 0x42, 0xbd, 0x3c, 0x50, 0x00, 0xdc, 0xc7, 0x28, 0xf6, 0xc3, 0xd8, 0x4f, 0x0f, 0x99, 0x6e,
+	0xdb, 0xb0, 0x55, 0x19, 0x09, 0x21, 0x25, 0xdb, 0x4a, 0x11, 0x21, 0x4e, 0xa0, 0xf2, 0x91, 0xbc,
+	0xca, 0x77, 0x0f, 0x20, 0xa1, 0x6e, 0x4c, 0xd3, 0x31, 0x0d, 0x0e, 0x86, 0x67, 0xb5, 0x94, 0xd7,
+	0x68, 0xb7, 0x59, 0xfd, 0xe3, 0xe0, 0x80, 0x23, 0xee, 0x24, 0xb2, 0x8c, 0x8b, 0x15, 0x9d, 0x3d,
+	0x27, 0x75, 0x86, 0xab, 0x45, 0x8b, 0xe5, 0xbd, 0x1f, 0x39, 0xa9, 0xb8, 0x24, 0x20, 0x51, 0x80,
+	0x6f, 0x48, 0x4d, 0x34, 0xf9, 0x65, 0xa1, 0xbe, 0x9b, 0xb0, 0x9c, 0xdb, 0xba, 0x82, 0xee, 0x6b,
+	0x59, 0x19, 0x06, 0x48, 0x0d, 0xde, 0xeb, 0xb7, 0x21, 0x0b, 0x47, 0x1f, 0xa3, 0x06, 0x63, 0xee,
+	0xcf, 0xa2, 0xfa, 0x6b, 0x6e, 0x7f, 0x16, 0x73, 0xc5, 0x57, 0x61, 0x39, 0x77, 0x44, 0x8a, 0x44,
+	0x56, 0x3c, 0x0b, 0x02, 0x3f, 0xd8, 0x13, 0x82, 0x57, 0x16, 0xb1, 0x66, 0x9f, 0x3a, 0x93, 0x74,
+	0xff, 0x50, 0x48, 0x5d, 0x59, 0x24, 0x9f, 0x18, 0x9e, 0x00, 0x6e, 0x92, 0x5f, 0x2d, 0x38, 0x8d,
+	0xd2, 0x33, 0x20, 0x18, 0x5e, 0x75, 0x41, 0x8e, 0xa7, 0xaf, 0x52, 0x1a, 0x24, 0x28, 0x63, 0x51,
+	0x53, 0xea, 0xd9, 0x1a, 0x80, 0x0b, 0x4c, 0xd3, 0x89, 0xb0, 0xd3, 0xf1, 0x13, 0x6f, 0xf3, 0x0c,
+	0xaa, 0x85, 0x68, 0x70, 0x1f, 0x06, 0x6a, 0x5e, 0x89, 0xa0, 0x81, 0x96, 0x25, 0x5c, 0x7f, 0x3d,
+	0x4a, 0x96, 0x58, 0xff, 0x50, 0x81, 0x37, 0x72, 0x75, 0xdb, 0x69, 0x4c, 0x9d, 0xe9, 0x67, 0x34,
+	0x49, 0x50, 0x32, 0xe5, 0x29, 0xfa, 0x1d, 0xe8, 0x28, 0x8f, 0x8f, 0xe0, 0x8e, 0xa5, 0xcc, 0x00,
+	0xb6, 0xae, 0x37, 0xa6, 0x52, 0x3b, 0x5e, 0xac, 0xad, 0x42, 0x83, 0xc6, 0x71, 0x18, 0x8b, 0x9b,
+	0x82, 0x17, 0x98, 0xd3, 0x87, 0x4e, 0x68, 0xca, 0xb5, 0xce, 0xb6, 0x2d, 0x4a, 0xd6, 0x26, 0x8c,
+	0xb6, 0x69, 0x9a, 0x5f, 0xbc, 0xbc, 0x2a, 0x17, 0xa2, 0xc1, 0x1f, 0x57, 0x4b, 0x68, 0xf0, 0xdb,
+	0x0d, 0x7b, 0x3d, 0xca, 0x85, 0xbd, 0xbe, 0x5b, 0x30, 0xc7, 0xcc, 0x3c, 0x0a, 0xef, 0x27, 0x33,
+	0xce, 0xd5, 0xf8, 0xfa, 0xe2, 0x5c, 0x7f, 0x54, 0x01, 0xf2, 0x13, 0x27, 0x75, 0xf7, 0x1f, 0x1f,
+	0xd0, 0x20, 0x55, 0x74, 0x5d, 0x85, 0xc6, 0x0b, 0x3f, 0x10, 0x31, 0xa7, 0x8e, 0xcd, 0x0b, 0x26,
+	0x7d, 0xaa, 0x59, 0xfa, 0x94, 0xfb, 0xbc, 0x4c, 0xca, 0xd4, 0x73, 0x94, 0x59, 0x85, 0x46, 0xe2,
+	0x07, 0xae, 0x34, 0x34, 0x78, 0xc1, 0xfa, 0xfd, 0x2a, 0x34, 0xd8, 0x6c, 0x50, 0xa5, 0xc0, 0x81,
+	0xa5, 0xe7, 0x0d, 0xbf, 0x91, 0x4f, 0x32, 0xba, 0x8f, 0x28, 0x21, 0x3c, 0x75, 0xe2, 0x3d, 0x9a,
+	0x8a, 0x09, 0x88, 0x12, 0xaa, 0x4c, 0x51, 0x1c, 0xba, 0x34, 0x49, 0xc6, 0xbe, 0x47, 0x83, 0x54,
+	0x4c, 0xa2, 0x27, 0x80, 0x9b, 0x08, 0x33, 0x17, 0xd6, 0x28, 0x5d, 0x58, 0xb3, 0x7c, 0x61, 0xad,
+	0x79, 0x63, 0x30, 0x99, 0xb9, 0x88, 0x5f, 0x28, 0x27, 0xb2, 0xa8, 0x99, 0xbf, 0x63, 0x32, 0x3f,
+	0x81, 0x7a, 0xea, 0x4f, 0xa9, 0x70, 0xa6, 0xb1, 0x6f, 0x2b, 0x82, 0x55, 0xf4, 0x9a, 0x32, 0xcd,
+	0xf0, 0x79, 0xb8, 0x67, 0x6e, 0x4d, 0x92, 0x3a, 0x71, 0x3a, 0xac, 0x08, 0xa2, 0x61, 0x01, 0xf7,
+	0x9c, 0x06, 0x9e, 0x50, 0x87, 0xf1, 0x13, 0xe7, 0x37, 0x4b, 0x68, 0x6c, 0xb2, 0xa4, 0x2c, 0xeb,
+	0x50, 0x6a, 0x9d, 0x5f, 0x7a, 0xac, 0x60, 0xfd, 0x6b, 0x05, 0xda, 0x72, 0x38, 0x35, 0xa5, 0x8a,
+	0x9e, 0x12, 0x57, 0xc7, 0xd2, 0xfd, 0xd0, 0x93, 0xb4, 0xe7, 0xa5, 0x23, 0x87, 0x22, 0x50, 0x8f,
+	0x28, 0x95, 0x87, 0x9d, 0x7d, 0x33, 0x69, 0x4d, 0x7f, 0x36, 0xa3, 0x49, 0x2a, 0xc9, 0x2d, 0x8a,
+	0x88, 0xc9, 0x9b, 0xc5, 0x3c, 0x4a, 0xc0, 0x65, 0xa7, 0x2a, 0x9b, 0x44, 0x6d, 0x95, 0x10, 0xb5,
+	0x6d, 0x10, 0xd5, 0xba, 0x05, 0x1d, 0x45, 0x3c, 0xb2, 0x06, 0xf5, 0x49, 0xb8, 0x27, 0xc5, 0x44,
+	0x0f, 0x8f, 0xa0, 0xac, 0xb4, 0x59, 0x8d, 0xb5, 0x05, 0x83, 0x6d, 0x9a, 0xf2, 0x8b, 0x4a, 0xd2,
+	0xba, 0xc4, 0x51, 0xae, 0xcf, 0x52, 0x4f, 0x9c, 0x25, 0x6c, 0xe9, 0x44, 0x91, 0x74, 0x8d, 0xb3,
+	0x6f, 0xcb, 0x87, 0x26, 0x47, 0x57, 0x16, 0x45, 0x66, 0x3d, 0xaa, 0xba, 0x07, 0xd3, 0xf8, 0x68,
+	0x9c, 0x48, 0xad, 0xbd, 0x66, 0xcb, 0x22, 0xb9, 0x0c, 0x30, 0x8b, 0x3c, 0x27, 0xa5, 0xde, 0xd8,
+	0x49, 0x85, 0xf1, 0xde, 0x11, 0x90, 0x8d, 0xd4, 0x7a, 0x07, 0x5a, 0x7c, 0xa8, 0x84, 0xbc, 0x05,
+	0x2d, 0xae, 0xfc, 0xc8, 0xc5, 0x02, 0xb7, 0x4c, 0x11, 0x64, 0xcb, 0x2a, 0xeb, 0x6d, 0x38, 0x6b,
+	0xd3, 0x69, 0x78, 0x40, 0x8f, 0x5d, 0xb0, 0xf5, 0x53, 0x38, 0x6b, 0x87, 0xa9, 0x93, 0xd2, 0xd3,
+	0xd2, 0x86, 0x6d, 0x77, 0x34, 0x71, 0x5c, 0x2a, 0xaf, 0x60, 0x51, 0xb4, 0xee, 0x01, 0x18, 0xd1,
+	0x83, 0x5b, 0x98, 0xaa, 0x20, 0x4b, 0x62, 0xf2, 0xb9, 0x3b, 0xc7, 0x68, 0x60, 0x5d, 0x86, 0xae,
+	0xaa, 0xd8, 0x7c, 0x94, 0xbf, 0xc0, 0xac, 0x35, 0xe8, 0x19, 0xd5, 0x09, 0x9e, 0x10, 0x5f, 0x09,
+	0x34, 0xfc, 0xb4, 0x7e, 0x0c, 0xe7, 0x39, 0x0d, 0x54, 0x3b, 0xb9, 0xb6, 0xb9, 0xb6, 0x2c, 0xb2,
+	0x11, 0xc6, 0xae, 0x0a, 0x30, 0xb2, 0x02, 0xd2, 0x20, 0x49, 0x69, 0xc4, 0x96, 0xd5, 0xb0, 0xd9,
+	0xb7, 0xb5, 0x0e, 0xa3, 0x47, 0x7e, 0x92, 0x84, 0xae, 0xef, 0xa4, 0x27, 0xc0, 0x6c, 0xed, 0x42,
+	0xdf, 0xa6, 0xce, 0x64, 0x12, 0xba, 0xe5, 0xa3, 0x0f, 0xb8, 0x1d, 0xc5, 0x03, 0x70, 0xf8, 0x69,
+	0x58, 0x46, 0xb5, 0x8c, 0x65, 0x64, 0xd8, 0x0c, 0xf5, 0x8c, 0xcd, 0x60, 0x7d, 0x1f, 0x96, 0x36,
+	0x3c, 0x6f, 0x2b, 0xf4, 0x8e, 0xda, 0xc0, 0x82, 0xd4, 0x06, 0xeb, 0x3a, 0x0c, 0x38, 0x99, 0x8e,
+	0xee, 0x6b, 0x5d, 0x83, 0xa5, 0xa7, 0x34, 0x3d, 0xa6, 0xd1, 0x2f, 0x2a, 0x70, 0x61, 0x9b, 0xb7,
+	0x52, 0x61, 0xb2, 0xa3, 0x26, 0x34, 0x9f, 0x57, 0x51, 0x3d, 0x71, 0x5e, 0x45, 0xad, 0x24, 0xaf,
+	0xe2, 0x7f, 0x9a, 0xd0, 0xdf, 0xf0, 0xbc, 0x93, 0x1a, 0xf6, 0xa7, 0x8b, 0xf5, 0xf4, 0xa1, 0xea,
+	0x3a, 0x42, 0xea, 0x55, 0x5d, 0x07, 0x17, 0xe7, 0xd2, 0x58, 0x0a, 0x3c, 0xf6, 0x2d, 0x2f, 0xea,
+	0xa6, 0xbe, 0xa8, 0xc5, 0x46, 0xb7, 0x18, 0x3f, 0x49, 0xc3, 0x38, 0xd9, 0x77, 0x62, 0x1e, 0xb6,
+	0x69, 0xd8, 0xbc, 0x60, 0x6c, 0x7f, 0x27, 0xb3, 0xfd, 0xda, 0x2d, 0x06, 0xda, 0x2d, 0x96, 0x5d,
+	0x6b, 0xa1, 0x76, 0x21, 0x1d, 0x70, 0x5d, 0x6d, 0x9a, 0xe5, 0x7a, 0xe5, 0x1d, 0x70, 0x0f, 0xb3,
+	0x71, 0x97, 0x9e, 0x4e, 0xdf, 0x28, 0xe8, 0x78, 0x82, 0x08, 0xcc, 0x52, 0xd6, 0x50, 0xfe, 0x01,
+	0x08, 0x7b, 0x75, 0x3c, 0x75, 0xa2, 0x61, 0x5f, 0xab, 0xe7, 0x39, 0xec, 0xdc, 0xe8, 0xf9, 0xcc,
+	0x89, 0x84, 0xd9, 0x78, 0x20, 0xcb, 0xa6, 0x87, 0x6e, 0x59, 0x7b, 0xe8, 0x72, 0xdd, 0x4f, 0x60,
+	0xcc, 0x0e, 0x72, 0xc6, 0xec, 0xeb, 0xd8, 0x85, 0xdf, 0x54, 0x08, 0xe0, 0x63, 0xe8, 0x67, 0x09,
+	0xf5, 0xcd, 0xb8, 0xd4, 0xde, 0x81, 0x15, 0x2e, 0x49, 0x4e, 0x78, 0xf4, 0xac, 0x7f, 0xaa, 0x40,
+	0xff, 0xe9, 0xc9, 0x5d, 0xe7, 0x9a, 0xfb, 0xab, 0x9a, 0xfb, 0x9f, 0x1e, 0xef, 0x14, 0x36, 0x75,
+	0xeb, 0xda, 0xd7, 0xa7, 0x5b, 0xff, 0x5b, 0x05, 0x06, 0x2c, 0xf0, 0x8d, 0xd1, 0x84, 0xe3, 0x9d,
+	0x7b, 0x03, 0xa8, 0x39, 0x93, 0x89, 0xb8, 0x60, 0xf0, 0x93, 0x7c, 0xa0, 0xd6, 0x63, 0xf8, 0xfb,
+	0xf3, 0x18, 0x8f, 0x5d, 0x51, 0xfd, 0xeb, 0x5b, 0xd1, 0x7f, 0x36, 0xa0, 0xf1, 0x60, 0xe6, 0x4f,
+	0x58, 0x5e, 0xdc, 0x8e, 0x93, 0x28, 0x59, 0x8d, 0xdf, 0x08, 0x8b, 0x69, 0x14, 0xca, 0xcb, 0x03,
+	0xbf, 0xf3, 0x1a, 0x4d, 0x47, 0x6b, 0x34, 0x03, 0xa8, 0x79, 0xbe, 0x9c, 0x1d, 0x7e, 0xe2, 0xb1,
+	0x4b, 0x66, 0x3b, 0xd3, 0xd0, 0x9b, 0x4d, 0xa4, 0x15, 0xa8, 0x01, 0xb8, 0x24, 0x94, 0xdf, 0x0e,
+	0x5a, 0x25, 0x4d, 0x76, 0xb5, 0xa9, 0x32, 0xb9, 0x01, 0x75, 0x1a, 0x1c, 0xc8, 0x3c, 0x2b, 0x66,
+	0x04, 0xb2, 0x69, 0xae, 0x3f, 0x0e, 0x0e, 0x04, 0x65, 0x58, 0x03, 0x6c, 0xe8, 0xc4, 0x7b, 0x32,
+	0x68, 0x60, 0x34, 0xdc, 0x88, 0xf7, 0x64, 0x43, 0x6c, 0x40, 0x6e, 0xe5, 0x3c, 0x88, 0xe7, 0x74,
+	0xd3, 0x22, 0x7a, 0xdf, 0x85, 0x8e, 0x13, 0xa7, 0xfe, 0xae, 0xe3, 0xa6, 0x52, 0xf4, 0x0e, 0x4d,
+	0xe4, 0xa2, 0x4a, 0x08, 0x29, 0xd5, 0x94, 0x7c, 0x1b, 0x1a, 0xae, 0xe3, 0xee, 0xd3, 0x61, 0x57,
+	0x87, 0x79, 0x79, 0x9f, 0x87, 0x08, 0xe6, 0xed, 0x79, 0x13, 0x74, 0x30, 0x27, 0x69, 0x18, 0x8d,
+	0x13, 0x7f, 0x2f, 0x70, 0x26, 0x22, 0x58, 0x0e, 0x08, 0xda, 0x66, 0x10, 0xbe, 0xe9, 0xee, 0x8c,
+	0x79, 0xf5, 0x96, 0x18, 0xf9, 0x54, 0x19, 0x25, 0x8f, 0xa2, 0xc5, 0xa2, 0x22, 0x4b, 0xd1, 0xe6,
+	0x77, 0x15, 0x03, 0xf8, 0x18, 0xfa, 0x59, 0x92, 0x2d, 0xd4, 0xfb, 0x03, 0x00, 0x4d, 0xbc, 0x85,
+	0xd8, 0xfb, 0x4f, 0x2b, 0xd0, 0x64, 0xd4, 0x4f, 0x44, 0x14, 0x6e, 0x8f, 0x4a, 0x35, 0x4c, 0x94,
+	0xc8, 0x3a, 0x34, 0x77, 0x58, 0x8b, 0x61, 0x55, 0x3b, 0xb5, 0x79, 0x1f, 0xf1, 0x23, 0x18, 0x83,
+	0xb7, 0x1a, 0x3d, 0x82, 0xae, 0x01, 0x2e, 0x98, 0xcd, 0x95, 0xac, 0xe8, 0xec, 0x28, 0x7c, 0x99,
+	0x6c, 0xad, 0x0a, 0xac, 0x30, 0xe0, 0x26, 0xfa, 0x97, 0x8f, 0x51, 0xe0, 0xd0, 0xf4, 0x92, 0x67,
+	0x10, 0xbf, 0x71, 0xd0, 0x99, 0xef, 0x09, 0x25, 0x15, 0x3f, 0xb1, 0x55, 0xea, 0xec, 0x49, 0x15,
+	0x91, 0x7d, 0x13, 0x4b, 0xad, 0xac, 0xa1, 0x7d, 0x8a, 0x7c, 0xee, 0x72, 0x35, 0x88, 0x29, 0x75,
+	0x78, 0x9e, 0x6d, 0xcf, 0xc6, 0x4f, 0x8b, 0x42, 0xf7, 0x59, 0x18, 0xaa, 0xa4, 0x9e, 0x2b, 0xd0,
+	0x75, 0x76, 0x53, 0x1a, 0x8f, 0xa5, 0x89, 0x8a, 0xf8, 0x81, 0x81, 0xb6, 0x11, 0x82, 0x0d, 0x76,
+	0xe8, 0x6e, 0x18, 0x53, 0x0c, 0x32, 0x46, 0xc2, 0xf8, 0x01, 0x0e, 0xda, 0x4e, 0xc3, 0x48, 0x2b,
+	0xda, 0x35, 0x43, 0xd1, 0xb6, 0x52, 0x20, 0xcf, 0x98, 0xdb, 0xee, 0xe1, 0x3e, 0x75, 0xd5, 0x68,
+	0x97, 0xa0, 0x93, 0xba, 0xd1, 0x38, 0x0a, 0xe3, 0x54, 0xee, 0x53, 0x3b, 0x75, 0xa3, 0x2d, 0x2c,
+	0x63, 0xe5, 0x7e, 0x9a, 0xf2, 0x5a, 0xa9, 0xb7, 0x21, 0x00, 0x6b, 0x19, 0x49, 0xe2, 0x89, 0x10,
+	0x49, 0xf8, 0xc9, 0xf4, 0xb3, 0xd0, 0xa3, 0xc2, 0x22, 0x66, 0xdf, 0xd6, 0x1f, 0x56, 0x00, 0x9e,
+	0x87, 0x7b, 0x06, 0xbd, 0xd3, 0xc3, 0x48, 0xd1, 0x1b, 0xbf, 0xc9, 0x1d, 0x68, 0xba, 0x61, 0xb0,
+	0xeb, 0xef, 0x0d, 0xab, 0xda, 0x59, 0xae, 0xfb, 0xa0, 0xe9, 0xb2, 0xeb, 0xef, 0x09, 0x9e, 0xe0,
+	0x2d, 0xf1, 0x64, 0x18, 0xe0, 0x85, 0x38, 0xf4, 0x6f, 0x6b, 0xb0, 0xf2, 0x58, 0xb9, 0x9d, 0x8e,
+	0x62, 0x84, 0x21, 0xb4, 0x84, 0x78, 0x94, 0xbe, 0x1a, 0x51, 0xcc, 0x05, 0x4f, 0x6a, 0x73, 0xc1,
+	0x93, 0x79, 0xc1, 0xbc, 0x06, 0xb5, 0x49, 0xb8, 0x27, 0xf8, 0xa2, 0x9f, 0x5d, 0xa1, 0x8d, 0x55,
+	0xec, 0x56, 0x13, 0xd1, 0x13, 0x2e, 0x9b, 0x65, 0x91, 0x7c, 0x00, 0x5d, 0xee, 0x70, 0x75, 0x71,
+	0xe7, 0x98, 0x66, 0x2b, 0x4e, 0xcd, 0xfc, 0x86, 0xda, 0x66, 0x53, 0x72, 0x0d, 0xea, 0xfb, 0x61,
+	0xf8, 0x82, 0x29, 0xbe, 0xdd, 0x3b, 0xcb, 0xac, 0x8b, 0x66, 0x35, 0x9b, 0x55, 0xa2, 0x7d, 0x10,
+	0x53, 0xc6, 0x6c, 0xe3, 0x28, 0x9c, 0xf8, 0xee, 0xa1, 0x70, 0xac, 0x2c, 0x09, 0xe8, 0x16, 0x03,
+	0x92, 0x8f, 0xa1, 0x95, 0x1c, 0x26, 0x6e, 0xaa, 0x14, 0x63, 0xa6, 0xa9, 0xce, 0x51, 0x72, 0x7d,
+	0x9b, 0x37, 0x12, 0xfa, 0xa0, 0xe8, 0x82, 0xee, 0x7c, 0xb3, 0x62, 0xa1, 0x1d, 0xfb, 0xef, 0x25,
+	0x8c, 0xe2, 0x46, 0x93, 0xf0, 0xf0, 0xa8, 0xdd, 0x7a, 0x7f, 0xce, 0xbf, 0x28, 0xae, 0x9c, 0xb9,
+	0x29, 0x66, 0xdc, 0x8e, 0xa7, 0x76, 0xbb, 0xf1, 0xa0, 0x55, 0xc3, 0x0c, 0x5a, 0x5d, 0x06, 0xa0,
+	0xaf, 0xd2, 0xd8, 0x19, 0xb3, 0x0b, 0x92, 0xdb, 0x24, 0x1d, 0x06, 0x41, 0xf9, 0x8f, 0xc7, 0x09,
+	0x0d, 0x31, 0x1e, 0xa4, 0xe3, 0x79, 0x95, 0x98, 0xa7, 0xf6, 0xa3, 0x5c, 0x9c, 0xae, 0x9d, 0x31,
+	0x47, 0x56, 0xa1, 0xe1, 0x86, 0x33, 0x91, 0x20, 0xd9, 0xb0, 0x79, 0x81, 0xfb, 0xaa, 0x0e, 0xd8,
+	0x46, 0x74, 0xd0, 0x57, 0x75, 0xc0, 0x58, 0x2e, 0x48, 0xd8, 0x25, 0x88, 0x2c, 0xc7, 0x05, 0x09,
+	0x9f, 0x0d, 0x46, 0x2d, 0x13, 0x66, 0x5e, 0xa0, 0xc7, 0x15, 0x41, 0xcf, 0x10, 0x62, 0x1a, 0xba,
+	0x4b, 0xd9, 0xe0, 0xd8, 0x3d, 0xc3, 0xaf, 0xdf, 0xd7, 0x9a, 0x7f, 0x66, 0x13, 0x4a, 0xbd, 0xfa,
+	0x6b, 0xd0, 0x15, 0xdf, 0xd3, 0xd0, 0xe3, 0x79, 0x61, 0x1d, 0xdb, 0x04, 0x29, 0x09, 0x3b, 0x30,
+	0x24, 0x2c, 0x26, 0x4f, 0xd2, 0x9d, 0xd9, 0x1e, 0xcb, 0x02, 0x6b, 0xdb, 0xbc, 0x80, 0xfa, 0x4c,
+	0x18, 0xd1, 0x60, 0x3b, 0xf5, 0xfc, 0x80, 0xc5, 0xc4, 0xda, 0xb6, 0x06, 0x90, 0xf7, 0x95, 0x86,
+	0x71, 0x56, 0x47, 0xb4, 0xb2, 0x93, 0x2c, 0xd2, 0x34, 0x36, 0x00, 0x70, 0x23, 0x45, 0xd7, 0x55,
+	0x6d, 0x18, 0xe5, 0xd6, 0xa7, 0xda, 0x48, 0xab, 0x4b, 0x01, 0x78, 0x9e, 0x07, 0x36, 0x1e, 0x0b,
+	0x6f, 0xde, 0x39, 0xee, 0x12, 0xe5, 0xc0, 0xcf, 0x18, 0x8c, 0xbc, 0x03, 0x75, 0x16, 0x6e, 0xe3,
+	0x19, 0x5f, 0x97, 0xe6, 0x47, 0xd0, 0xc1, 0x36, 0xd6, 0x10, 0xf9, 0x27, 0x09, 0x77, 0xd3, 0x31,
+	0x77, 0x2c, 0x5e, 0x10, 0xea, 0x5b, 0xb8, 0x9b, 0x3e, 0x47, 0x00, 0x6e, 0x28, 0x4e, 0x21, 0x11,
+	0xf5, 0x43, 0xc6, 0x10, 0x6c, 0x56, 0x09, 0x6f, 0x20, 0xf2, 0x1e, 0x77, 0xd0, 0xe1, 0xcb, 0x93,
+	0xbd, 0x30, 0xef, 0xf1, 0x01, 0xfa, 0x7c, 0x31, 0xe5, 0x70, 0x2f, 0xc0, 0x4b, 0x83, 0x09, 0x84,
+	0x11, 0xab, 0x05, 0x0e, 0x42, 0x91, 0x80, 0x21, 0x6f, 0x7e, 0xed, 0xb8, 0x31, 0x75, 0x52, 0x3a,
+	0xbc, 0xc4, 0x38, 0x82, 0x5f, 0x45, 0x0f, 0x19, 0x08, 0xd1, 0xc7, 0xce, 0x4b, 0xce, 0xdc, 0x6f,
+	0xb0, 0xfb, 0xab, 0x15, 0x3b, 0x2f, 0x19, 0x6b, 0x1b, 0x56, 0xe8, 0xe5, 0xac, 0x15, 0xfa, 0x16,
+	0xf4, 0x9d, 0xc9, 0x64, 0x1c, 0xc6, 0xe3, 0x20, 0x4c, 0xf7, 0x31, 0xba, 0xf4, 0x26, 0x4f, 0x5d,
+	0x76, 0x26, 0x93, 0x2f, 0xe2, 0xcf, 0x39, 0x0c, 0x47, 0x7f, 0xe9, 0xf8, 0xe9, 0x58, 0xc6, 0x99,
+	0xae, 0xb0, 0xb5, 0x75, 0x11, 0xc6, 0x65, 0xdc, 0x21, 0xb9, 0x09, 0x6d, 0x67, 0x77, 0xd7, 0x0f,
+	0x50, 0x35, 0x5b, 0x5b, 0xab, 0x28, 0x17, 0xa4, 0x80, 0xd9, 0xaa, 0x16, 0x37, 0x07, 0x89, 0x32,
+	0x56, 0xea, 0xfb, 0x55, 0xbe, 0x39, 0x08, 0xdc, 0x16, 0xb0, 0x4c, 0xfc, 0xd6, 0xca, 0xc5, 0x6f,
+	0x3f, 0xd0, 0x76, 0xef, 0x35, 0x6d, 0x05, 0xe5, 0xf6, 0xee, 0x78, 0xb3, 0xf7, 0xad, 0x7c, 0x0c,
+	0xf7, 0x7e, 0x26, 0x86, 0xfb, 0x2d, 0x6d, 0x90, 0x64, 0x51, 0x97, 0xc7, 0x71, 0x1f, 0x64, 0xe3,
+	0xb8, 0xd7, 0xcb, 0x58, 0xf7, 0xa8, 0x58, 0xee, 0xeb, 0x04, 0xd1, 0x5e, 0x47, 0x23, 0xfd, 0x84,
+	0x27, 0x51, 0x4f, 0x4e, 0x6f, 0xf7, 0x9f, 0x38, 0xf6, 0xd9, 0x9b, 0x7b, 0xbd, 0x70, 0xa4, 0xe9,
+	0x5d, 0x1e, 0xbf, 0xfe, 0x46, 0x83, 0xb6, 0x2f, 0xa0, 0x2d, 0x99, 0x9b, 0xac, 0x41, 0x6f, 0xea,
+	0xbc, 0xc2, 0xc4, 0xf7, 0x31, 0x72, 0x31, 0x43, 0xd0, 0xb0, 0x61, 0xea, 0xbc, 0xda, 0xa2, 0x31,
+	0x92, 0x95, 0xe5, 0xdb, 0x46, 0x31, 0x75, 0xbc, 0x31, 0xa3, 0xb0, 0x40, 0xd7, 0xe5, 0x30, 0xb6,
+	0x67, 0xdc, 0xfc, 0x9b, 0x84, 0x2e, 0x1e, 0xef, 0x9a, 0x34, 0xff, 0x78, 0xd9, 0xfa, 0x8f, 0x3a,
+	0xfa, 0x50, 0x99, 0x4b, 0x59, 0x5e, 0xb2, 0xef, 0x60, 0xb6, 0x9c, 0xe0, 0x2a, 0x36, 0xa0, 0x48,
+	0xb8, 0xce, 0xb0, 0x9a, 0xad, 0xdb, 0x90, 0xeb, 0xd0, 0x17, 0x52, 0xde, 0x0f, 0xf6, 0x69, 0x2c,
+	0x1c, 0x8d, 0x6d, 0x3b, 0x07, 0x25, 0x9b, 0xb0, 0xb4, 0xeb, 0x4f, 0x50, 0xd6, 0x64, 0x4c, 0x73,
+	0xf6, 0x66, 0x28, 0x3b, 0x87, 0xf5, 0x27, 0xac, 0x9d, 0x29, 0xc4, 0x7b, 0xbb, 0x06, 0x08, 0x9d,
+	0x6e, 0x6e, 0x18, 0x1d, 0x0e, 0xeb, 0xda, 0xe9, 0x96, 0xc3, 0xf0, 0x30, 0x8c, 0x84, 0xd7, 0x8c,
+	0xb5, 0x94, 0x9e, 0xe1, 0x86, 0xf6, 0x0c, 0x5f, 0x06, 0xd8, 0xc1, 0xf0, 0xdd, 0x38, 0xf1, 0x7f,
+	0xce, 0x43, 0x54, 0x0d, 0x3c, 0xb4, 0xa9, 0xbb, 0xbf, 0xed, 0xff, 0x9c, 0x62, 0xae, 0x19, 0x92,
+	0x7e, 0x16, 0xe8, 0xf4, 0x6f, 0xee, 0x5b, 0xec, 0x4f, 0x9d, 0x57, 0x5f, 0x6a, 0x28, 0xea, 0x51,
+	0x1c, 0x8f, 0x1f, 0xa4, 0x34, 0x3e, 0x70, 0x26, 0xc2, 0xdf, 0xb8, 0xc4, 0xa0, 0x9b, 0x02, 0x48,
+	0x6e, 0xc3, 0x2a, 0x97, 0x72, 0x63, 0xa6, 0xa3, 0x8d, 0x31, 0x2c, 0x14, 0xce, 0xe4, 0xfd, 0x4e,
+	0xf6, 0xb5, 0x4a, 0xf7, 0x63, 0x5e, 0x83, 0xfb, 0x16, 0x87, 0x93, 0xc9, 0x8e, 0xe3, 0xbe, 0x60,
+	0xe1, 0xad, 0xb6, 0xad, 0xca, 0x38, 0x3b, 0x41, 0x4b, 0x25, 0xed, 0x78, 0x1e, 0x4d, 0x9f, 0x83,
+	0xa5, 0xbc, 0x1b, 0xdd, 0x87, 0x95, 0x39, 0x62, 0x2e, 0x7a, 0x00, 0x15, 0x2d, 0x17, 0xe2, 0xe3,
+	0x03, 0x00, 0xce, 0x32, 0xd3, 0x5c, 0x9c, 0x70, 0xc1, 0x00, 0x71, 0xb9, 0xa6, 0xa6, 0x74, 0xa4,
+	0xba, 0xa1, 0x23, 0x59, 0xf7, 0xa1, 0xab, 0xc7, 0x45, 0x56, 0xe9, 0x7a, 0xba, 0x28, 0x62, 0x23,
+	0x7d, 0xcd, 0xd0, 0x08, 0xb6, 0xcd, 0x26, 0xd6, 0x36, 0x5c, 0xe0, 0xbe, 0x36, 0xdd, 0xe0, 0xb5,
+	0xc3, 0xdc, 0xd6, 0x3f, 0x57, 0x60, 0x79, 0x63, 0x96, 0x86, 0x89, 0xeb, 0x4c, 0xa8, 0x50, 0xad,
+	0x4f, 0x4f, 0x93, 0x01, 0xd4, 0xa6, 0x7e, 0x20, 0xed, 0xd2, 0xa9, 0xcf, 0x7c, 0x42, 0x53, 0xe7,
+	0x95, 0xa0, 0x04, 0x7e, 0x8a, 0x90, 0x63, 0xec, 0xbb, 0x42, 0x59, 0x15, 0x25, 0xbc, 0xab, 0xd2,
+	0xfd, 0x98, 0x26, 0xfb, 0xe1, 0xc4, 0x13, 0x39, 0x63, 0x1a, 0xc0, 0x85, 0x45, 0x38, 0xf1, 0xc2,
+	0x97, 0x81, 0xc8, 0x1c, 0x53, 0x65, 0xeb, 0x11, 0xac, 0x64, 0x97, 0xe0, 0xb3, 0x14, 0xf7, 0x76,
+	0x24, 0xbe, 0xcd, 0x4c, 0x82, 0xdc, 0x5a, 0x6d, 0xd5, 0xc8, 0xfa, 0x7f, 0xf0, 0x06, 0x27, 0x6f,
+	0xae, 0xc9, 0xeb, 0xd3, 0xf8, 0xef, 0x2a, 0xc6, 0x04, 0x1f, 0x51, 0xd7, 0x4f, 0x44, 0xf0, 0xf3,
+	0x94, 0x54, 0x96, 0x81, 0xdc, 0x9a, 0x11, 0xc8, 0x25, 0x50, 0xdf, 0x8d, 0xc3, 0xa9, 0x34, 0x76,
+	0xf1, 0x1b, 0x03, 0x16, 0x69, 0xc8, 0xa8, 0xdc, 0xb0, 0xab, 0x69, 0xa8, 0xcf, 0x44, 0xd3, 0x78,
+	0x3b, 0xa6, 0x43, 0xad, 0x2d, 0x33, 0xd4, 0xba, 0x09, 0x64, 0x6e, 0xca, 0x09, 0x79, 0x17, 0x65,
+	0xb0, 0x28, 0x08, 0xaa, 0x9e, 0xcb, 0x50, 0x55, 0x36, 0xb5, 0x75, 0x3b, 0x2b, 0x84, 0xcb, 0x3c,
+	0xec, 0x9d, 0x47, 0xf7, 0xda, 0x94, 0xd5, 0x51, 0xef, 0x9a, 0xf1, 0x80, 0xd8, 0xfa, 0x93, 0x0a,
+	0x34, 0xb8, 0x15, 0x53, 0x8e, 0xb9, 0x3c, 0x23, 0x52, 0x04, 0x6c, 0x6a, 0x45, 0x91, 0xb9, 0x7a,
+	0x3e, 0x32, 0x97, 0xcd, 0xab, 0x96, 0x45, 0x2d, 0x01, 0x9a, 0xa6, 0x04, 0x78, 0x06, 0x84, 0x73,
+	0x18, 0x9b, 0xdc, 0xf1, 0xab, 0x2f, 0x4f, 0x40, 0xbf, 0x03, 0xe7, 0x91, 0xa4, 0x0c, 0xcf, 0x97,
+	0x98, 0xec, 0x73, 0x3c, 0x2d, 0xad, 0x5f, 0x56, 0x00, 0x74, 0x07, 0xf4, 0x52, 0x71, 0xcb, 0xaf,
+	0xa2, 0xbd, 0x54, 0xac, 0x5a, 0x66, 0x6a, 0x2e, 0x14, 0xa1, 0x94, 0x74, 0xa8, 0x97, 0xd0, 0xa1,
+	0x61, 0xd2, 0xe1, 0x7d, 0xe8, 0x1a, 0x33, 0x27, 0xd7, 0xa1, 0x39, 0x4b, 0x94, 0x63, 0x4e, 0x08,
+	0x41, 0xdd, 0xc0, 0x16, 0xb5, 0xd6, 0x0c, 0x56, 0x98, 0x17, 0x30, 0xe3, 0x31, 0x2b, 0x77, 0xbe,
+	0x9b, 0xf6, 0x72, 0x75, 0x3e, 0x23, 0x9f, 0x99, 0xc8, 0x32, 0xba, 0x2f, 0x4a, 0x2a, 0xfa, 0x5b,
+	0x37, 0xa2, 0xbf, 0xbf, 0xaa, 0xc8, 0x6d, 0xfb, 0xdd, 0x0e, 0x8c, 0xc4, 0x8b, 0xe2, 0x59, 0x20,
+	0x1d, 0xe7, 0xbc, 0x60, 0x5d, 0xe5, 0xf7, 0xde, 0x96, 0x93, 0xee, 0xb3, 0x18, 0x76, 0x84, 0x1f,
+	0x32, 0xa9, 0x87, 0x15, 0x30, 0x03, 0xa8, 0x8b, 0x6d, 0xe4, 0x54, 0xef, 0x42, 0x8b, 0xa7, 0xce,
+	0x48, 0x0a, 0x8b, 0x44, 0x4d, 0xd5, 0x62, 0xfd, 0xc7, 0xbc, 0x5a, 0x58, 0x0f, 0xa2, 0xf1, 0x68,
+	0x13, 0x7a, 0x66, 0x45, 0xc1, 0x2d, 0x7b, 0x2d, 0xeb, 0xea, 0x5c, 0x92, 0x78, 0xd9, 0xec, 0xcc,
+	0x4b, 0xf7, 0x97, 0x15, 0xe8, 0x6e, 0xd3, 0xc0, 0x2b, 0x0f, 0x88, 0xdf, 0x12, 0xd6, 0x69, 0x55,
+	0xa7, 0xf7, 0x1a, 0x1d, 0xf2, 0xb6, 0xe9, 0xa9, 0xf5, 0x6f, 0xeb, 0x1e, 0x74, 0x1f, 0xa3, 0x84,
+	0xe3, 0x4f, 0x88, 0x95, 0x93, 0x50, 0x24, 0xc5, 0xe0, 0x37, 0x6e, 0xed, 0x94, 0xe7, 0xd0, 0xc9,
+	0x73, 0x27, 0x8a, 0xd6, 0x3f, 0x66, 0xbc, 0xb6, 0x65, 0x89, 0x76, 0xd9, 0xf7, 0x1e, 0x1d, 0x95,
+	0x26, 0xc7, 0x6c, 0xbc, 0x70, 0x2f, 0xa6, 0x49, 0x22, 0x83, 0x52, 0xb2, 0x5c, 0x9e, 0x42, 0x97,
+	0xb0, 0x3c, 0x32, 0x79, 0x57, 0xf2, 0x12, 0xb9, 0x03, 0x3d, 0xd6, 0x60, 0xcc, 0x5f, 0xd4, 0x0e,
+	0x9b, 0xda, 0xa1, 0x66, 0x2c, 0xce, 0xee, 0x52, 0x5d, 0xb0, 0x12, 0x68, 0x8a, 0x97, 0x0e, 0xeb,
+	0xea, 0x29, 0x5a, 0x45, 0x7b, 0xbc, 0x79, 0x5d, 0xd1, 0x63, 0xb4, 0xd7, 0x78, 0x2f, 0x64, 0xfd,
+	0x41, 0x0b, 0xce, 0x73, 0x73, 0x5d, 0xe5, 0x4d, 0x48, 0xaa, 0x9d, 0xee, 0x00, 0x71, 0x5a, 0xd7,
+	0x14, 0xad, 0x8b, 0x72, 0xd4, 0x15, 0x2d, 0x1b, 0x26, 0x2d, 0x8d, 0x64, 0xa3, 0x66, 0x36, 0xd9,
+	0xe8, 0x7d, 0x19, 0xa2, 0x57, 0x0f, 0x47, 0x8a, 0xa7, 0x5c, 0x96, 0xe0, 0xde, 0x2e, 0x4e, 0x70,
+	0xcf, 0xc6, 0xf1, 0x37, 0xf2, 0xd9, 0xe8, 0x37, 0x8e, 0x18, 0xa8, 0x38, 0x35, 0x9d, 0x08, 0xf7,
+	0x69, 0x97, 0xf1, 0x34, 0xfb, 0x3e, 0x22, 0x31, 0xfd, 0x87, 0xd9, 0x8c, 0xf2, 0x25, 0xfd, 0x87,
+	0x05, 0x25, 0x83, 0x1e, 0x95, 0x5e, 0x7e, 0x05, 0xba, 0xa8, 0xe3, 0x53, 0x6f, 0xcc, 0xd4, 0xfe,
+	0x3e, 0xf7, 0xd7, 0x70, 0xd0, 0x03, 0x54, 0xfc, 0xdf, 0x83, 0x16, 0x5a, 0xb5, 0x29, 0xf5, 0x98,
+	0x87, 0x4d, 0xb8, 0xcd, 0x73, 0xc9, 0x38, 0x62, 0x24, 0x5b, 0x36, 0x45, 0xa2, 0x48, 0xcf, 0xc6,
+	0xe0, 0x58, 0xa2, 0x9c, 0xc0, 0xc5, 0xb1, 0x92, 0x8f, 0xec, 0x9f, 0x36, 0x53, 0xfc, 0xff, 0x58,
+	0xba, 0xb7, 0xe5, 0x43, 0xeb, 0xe1, 0xd6, 0x97, 0x6c, 0x2b, 0xaf, 0x73, 0x6e, 0xaf, 0xe8, 0xf0,
+	0xa4, 0xa8, 0xc9, 0xb2, 0xf7, 0x69, 0x49, 0x67, 0xfd, 0x79, 0x05, 0x40, 0xaf, 0x9f, 0xdc, 0xcf,
+	0xb2, 0x61, 0x45, 0x3b, 0xb0, 0x74, 0xa3, 0xa3, 0x58, 0xef, 0x6b, 0x24, 0xa7, 0x75, 0x09, 0x5a,
+	0x82, 0x36, 0x05, 0x59, 0x5b, 0xbf, 0xaa, 0x00, 0xf0, 0x5a, 0x36, 0xef, 0xf7, 0x35, 0x6b, 0x56,
+	0x4c, 0x87, 0xa9, 0x6c, 0x50, 0xcc, 0x8e, 0x5f, 0xdf, 0x8e, 0xfd, 0x55, 0x15, 0xfa, 0xe8, 0x49,
+	0xe1, 0xc6, 0x9e, 0x7c, 0xe3, 0x71, 0xd4, 0x1b, 0x43, 0x6e, 0x2b, 0xca, 0x37, 0x86, 0xbc, 0x84,
+	0x7d, 0x5c, 0x27, 0x72, 0x5c, 0xf4, 0x2b, 0x72, 0xb3, 0x4c, 0x95, 0x8b, 0xed, 0x54, 0x72, 0x93,
+	0x87, 0x05, 0x70, 0xb7, 0xe4, 0xff, 0xaf, 0x74, 0x0d, 0x2e, 0x61, 0x31, 0x02, 0xfc, 0x48, 0xc8,
+	0xf7, 0xa0, 0x67, 0x6c, 0xad, 0xfc, 0xaf, 0x95, 0x7e, 0x76, 0x6f, 0xed, 0xae, 0xde, 0x4b, 0x9e,
+	0xea, 0xea, 0x86, 0x31, 0x15, 0xf1, 0x06, 0x5e, 0x40, 0x44, 0x9c, 0x7e, 0x02, 0x51, 0xdb, 0x34,
+	0x86, 0x25, 0xc1, 0xd1, 0x18, 0x96, 0xdf, 0x89, 0xf5, 0x5f, 0x15, 0x69, 0xc6, 0x33, 0xd2, 0xac,
+	0x42, 0x23, 0x0d, 0x53, 0x67, 0x22, 0x3c, 0x51, 0xbc, 0x40, 0x6e, 0xca, 0xe7, 0xa0, 0xd5, 0xec,
+	0x93, 0x73, 0xdd, 0x51, 0x3c, 0x0c, 0xd5, 0x57, 0x46, 0xcd, 0xbc, 0x32, 0xee, 0x73, 0x0f, 0xf7,
+	0x98, 0x95, 0x64, 0xb2, 0xb7, 0xe1, 0x7c, 0x65, 0x7c, 0x80, 0x08, 0xd9, 0xa5, 0x6b, 0xfa, 0xe5,
+	0x39, 0x40, 0x3a, 0x19, 0x8d, 0xea, 0x85, 0x5c, 0x15, 0x7b, 0x70, 0x56, 0xb8, 0x8f, 0x1e, 0xa0,
+	0xcf, 0x46, 0xde, 0x9c, 0x18, 0xed, 0x09, 0x3c, 0xfa, 0x4a, 0x2e, 0x96, 0x15, 0x34, 0x09, 0xaa,
+	0x26, 0x09, 0x30, 0xfd, 0x2f, 0x0c, 0x64, 0xec, 0x95, 0x7d, 0x4b, 0xde, 0xaf, 0x6b, 0xde, 0xff,
+	0x97, 0x0a, 0x5c, 0x10, 0x23, 0xcd, 0xdd, 0xd3, 0x18, 0x0f, 0xe5, 0x3e, 0xf8, 0x8a, 0x16, 0xec,
+	0xc5, 0x22, 0xda, 0x16, 0x2d, 0xb1, 0x4f, 0xcc, 0x44, 0xff, 0xb0, 0xaa, 0xfb, 0x94, 0x5c, 0x06,
+	0xa2, 0x65, 0xc9, 0x16, 0xdc, 0x82, 0x06, 0xf3, 0x57, 0x31, 0x1e, 0xed, 0xde, 0xb9, 0x60, 0xb8,
+	0xd4, 0x4c, 0x9a, 0xd8, 0xbc, 0x95, 0x75, 0x68, 0xda, 0x08, 0x26, 0xbd, 0xb0, 0x2c, 0x88, 0x6e,
+	0x3c, 0xe9, 0x12, 0xfa, 0x40, 0x35, 0xab, 0x0f, 0x1c, 0x95, 0xfa, 0x6f, 0xe8, 0x86, 0xf5, 0xac,
+	0x6e, 0xf8, 0xff, 0x33, 0x66, 0xc2, 0x6b, 0x8c, 0x2d, 0x10, 0x4a, 0x13, 0x41, 0x95, 0xad, 0xaf,
+	0xe6, 0xb2, 0x5b, 0xcb, 0x34, 0xd0, 0x72, 0xfc, 0x52, 0x87, 0xe0, 0xeb, 0x62, 0xdf, 0xd6, 0x6f,
+	0x2a, 0xc6, 0xc3, 0xc8, 0x32, 0x94, 0xe7, 0xa0, 0x19, 0xd0, 0x97, 0x63, 0x5f, 0x06, 0x9f, 0x1b,
+	0x01, 0x7d, 0xb9, 0xc9, 0xfe, 0x34, 0x01, 0xc1, 0x39, 0x7a, 0x75, 0x03, 0xfa, 0xf2, 0x73, 0x49,
+	0x32, 0xcd, 0x0c, 0xf5, 0x13, 0x33, 0x83, 0xb1, 0x80, 0x46, 0x49, 0x66, 0x78, 0xd3, 0x74, 0x57,
+	0xfc, 0x7d, 0x05, 0x06, 0x2c, 0xfb, 0xdb, 0x5c, 0xc2, 0x31, 0x8f, 0xbb, 0xf9, 0x13, 0x4b, 0xf5,
+	0xd7, 0x22, 0xa2, 0x88, 0x92, 0x74, 0x8f, 0x87, 0x28, 0x85, 0x89, 0xc6, 0x4b, 0xd8, 0x63, 0xdf,
+	0x09, 0xbc, 0x09, 0xfb, 0x2f, 0x14, 0xd6, 0x43, 0x14, 0x4b, 0xf4, 0x4d, 0xf6, 0x6f, 0x07, 0xc9,
+	0x2c, 0x8a, 0xc2, 0x18, 0xb5, 0xa2, 0xa6, 0xfc, 0xb7, 0x03, 0x05, 0xb2, 0x1e, 0x14, 0xe6, 0x15,
+	0x97, 0x6d, 0x80, 0x1a, 0xa5, 0x6a, 0x2e, 0xfc, 0x01, 0x9c, 0x17, 0xb9, 0xc6, 0xf2, 0xef, 0x60,
+	0x16, 0xe6, 0x09, 0x66, 0x9c, 0xa1, 0xd5, 0xb6, 0xa8, 0x3d, 0x23, 0x75, 0xef, 0x5a, 0x36, 0x5b,
+	0x05, 0x8d, 0x50, 0xf5, 0x38, 0xc0, 0x49, 0xf7, 0x4b, 0xe8, 0x43, 0x84, 0xc1, 0xc7, 0x53, 0x4f,
+	0xd8, 0xb7, 0xf5, 0x94, 0x5b, 0x89, 0x65, 0x13, 0x91, 0xc8, 0xab, 0x45, 0xc8, 0x4d, 0xb1, 0x61,
+	0x7d, 0x01, 0xe7, 0x37, 0xd2, 0xd4, 0x71, 0xf7, 0xe7, 0xc8, 0x7a, 0x15, 0x7a, 0x2a, 0xe3, 0x7c,
+	0xac, 0xb0, 0x77, 0x15, 0x6c, 0xd3, 0x53, 0x33, 0xab, 0x1a, 0x33, 0xfb, 0xcb, 0x0a, 0xac, 0xd8,
+	0xb3, 0x60, 0x23, 0xf0, 0x7e, 0xe2, 0xe8, 0xfc, 0xe6, 0x0f, 0xa0, 0x2f, 0xe2, 0xae, 0x21, 0x87,
+	0x94, 0x07, 0x26, 0x96, 0x3c, 0xb3, 0xc8, 0xbc, 0x2b, 0x53, 0x4f, 0x0c, 0x81, 0x9f, 0xb8, 0x10,
+	0x27, 0x39, 0x0c, 0x5c, 0x99, 0x26, 0xc3, 0x0a, 0x18, 0x3c, 0x64, 0x1f, 0xca, 0x2f, 0xcf, 0xef,
+	0xea, 0x1e, 0x03, 0x0a, 0x8f, 0xbc, 0xf5, 0x25, 0x5c, 0xc0, 0x75, 0xc6, 0xe1, 0xe4, 0x04, 0x79,
+	0xef, 0x32, 0xe7, 0xa5, 0x6a, 0xe4, 0xbc, 0x14, 0xa7, 0xe8, 0x6c, 0xcf, 0xa3, 0x5d, 0x88, 0x39,
+	0x33, 0xc2, 0x46, 0x18, 0x2c, 0xd6, 0x73, 0x18, 0x3c, 0x0f, 0xf7, 0x8e, 0x7e, 0xa9, 0x56, 0x8a,
+	0x8d, 0x6d, 0x4b, 0xcd, 0xd8, 0x96, 0xdf, 0x54, 0xe0, 0xc2, 0xe3, 0x57, 0xd4, 0x9d, 0x15, 0x24,
+	0xe6, 0x9f, 0x60, 0xa7, 0xcd, 0x0c, 0xc4, 0x6a, 0x2e, 0x03, 0x91, 0x88, 0x0c, 0x44, 0xf1, 0xfe,
+	0x03, 0xbf, 0xf1, 0x0c, 0x61, 0xe4, 0x48, 0x27, 0xd3, 0xc8, 0x22, 0x46, 0x6d, 0xc2, 0x88, 0x06,
+	0xe3, 0x84, 0xa5, 0x06, 0x34, 0xf2, 0xa9, 0x01, 0x18, 0xab, 0xa6, 0xd1, 0x64, 0x8c, 0x7b, 0xde,
+	0x14, 0xb1, 0x6a, 0x1a, 0x4d, 0x1e, 0x4e, 0xbd, 0x3b, 0x7f, 0x7d, 0x11, 0x5a, 0x0f, 0xc3, 0x98,
+	0xda, 0x5b, 0x0f, 0xc9, 0x5d, 0xe8, 0x19, 0xff, 0xab, 0x94, 0x90, 0xf3, 0x2a, 0x3d, 0x34, 0xf3,
+	0x4f, 0x4b, 0xa3, 0x9e, 0xf1, 0x07, 0x47, 0x89, 0x75, 0x86, 0x5c, 0x85, 0x36, 0xb6, 0x62, 0xff,
+	0x2d, 0xc8, 0x3c, 0x79, 0xec, 0xef, 0x20, 0x47, 0x6d, 0xf1, 0xbf, 0x71, 0xd8, 0xe4, 0x3a, 0x34,
+	0xf9, 0x63, 0x01, 0xb2, 0x22, 0xb2, 0xa6, 0x75, 0x5e, 0xff, 0x48, 0xfe, 0x03, 0xa1, 0x75, 0x86,
+	0xac, 0x43, 0x47, 0xbd, 0x0d, 0x20, 0xab, 0x5a, 0x54, 0x1b, 0xad, 0xf5, 0x08, 0x1c, 0x2f, 0x7f,
+	0x23, 0xc0, 0xf1, 0x66, 0xde, 0x0b, 0x98, 0x78, 0x3f, 0x62, 0x8f, 0x71, 0x32, 0xaf, 0x04, 0xc8,
+	0x25, 0xf1, 0x0f, 0x0b, 0x45, 0x6f, 0x07, 0xcc, 0xbe, 0x77, 0x59, 0xce, 0xb0, 0xf9, 0x9f, 0x88,
+	0x05, 0x63, 0x2d, 0xe7, 0xfe, 0x24, 0xcf, 0x3a, 0x83, 0x7a, 0xa5, 0x20, 0x0b, 0xff, 0x7b, 0x90,
+	0xd5, 0xa2, 0x6c, 0x5b, 0xbe, 0x1c, 0x06, 0xb1, 0xce, 0x90, 0xb7, 0xa1, 0x25, 0x12, 0xca, 0x09,
+	0x99, 0xcf, 0x2e, 0x1f, 0xa9, 0x7f, 0x14, 0xb1, 0xce, 0x90, 0xdb, 0x00, 0x3a, 0xf7, 0x99, 0x9c,
+	0xd3, 0xa4, 0x32, 0x3b, 0x64, 0x68, 0xf5, 0x36, 0xbe, 0xe9, 0x49, 0x35, 0xf2, 0xec, 0x9f, 0x4b,
+	0x64, 0x90, 0xbf, 0x0d, 0xad, 0xa7, 0x66, 0xd3, 0xa7, 0xe5, 0x4d, 0x3f, 0x84, 0x65, 0x51, 0xab,
+	0xc8, 0x53, 0xd4, 0x65, 0x20, 0xbb, 0x18, 0x04, 0x7a, 0x04, 0xcb, 0xb9, 0x7f, 0x26, 0x23, 0xec,
+	0x76, 0x2e, 0xfe, 0xbb, 0xb2, 0xd1, 0xd9, 0x3c, 0x8a, 0x27, 0xfe, 0x2b, 0xeb, 0x0c, 0xb9, 0x07,
+	0x1d, 0xa5, 0x3e, 0x70, 0x1a, 0xe7, 0xff, 0x66, 0x61, 0x94, 0x85, 0x8a, 0x73, 0x6f, 0x9d, 0xb9,
+	0x5d, 0x21, 0x9f, 0x60, 0xfc, 0xc9, 0xf3, 0x8d, 0xf7, 0xa0, 0x64, 0xa0, 0xde, 0x72, 0x65, 0xba,
+	0xe7, 0xef, 0x77, 0xd6, 0xfd, 0x36, 0xf4, 0x9e, 0x1a, 0x8f, 0x49, 0xc9, 0x72, 0xe6, 0x75, 0xd1,
+	0xe6, 0xa3, 0x51, 0xf6, 0xb9, 0x91, 0x75, 0x86, 0xbc, 0xcb, 0x1e, 0xb5, 0xe4, 0x87, 0x33, 0xba,
+	0x24, 0xa3, 0x7e, 0x06, 0x82, 0x6c, 0xf1, 0x29, 0xf4, 0xb3, 0x7f, 0x6b, 0x4a, 0x2e, 0x96, 0xfe,
+	0xd5, 0xe9, 0xdc, 0x90, 0xb7, 0x2b, 0xe4, 0x23, 0xf1, 0x87, 0x69, 0xa1, 0x47, 0x0d, 0x1c, 0x45,
+	0xdb, 0x34, 0x3f, 0xf6, 0x7d, 0x38, 0xfb, 0x74, 0xfe, 0xbd, 0x6c, 0xc1, 0xb4, 0x57, 0xb3, 0x5d,
+	0x79, 0x3b, 0xeb, 0x0c, 0xf9, 0x0c, 0xce, 0x16, 0x3c, 0xb8, 0x25, 0xf2, 0x0f, 0x56, 0x4a, 0x5e,
+	0xe2, 0x96, 0xa2, 0x1b, 0xc3, 0xb9, 0xc2, 0xb7, 0xae, 0x64, 0xed, 0xb8, 0x67, 0xb0, 0xa3, 0xf2,
+	0x16, 0xe6, 0x9e, 0xbe, 0x07, 0x5d, 0xe3, 0x01, 0x2b, 0x17, 0x82, 0xf3, 0x2f, 0x5a, 0xc5, 0xd1,
+	0x42, 0x90, 0x20, 0xf1, 0x52, 0xe6, 0x75, 0x25, 0x19, 0xca, 0x1d, 0xca, 0x3f, 0xb8, 0xe4, 0x1b,
+	0xa4, 0xa0, 0xd6, 0x19, 0xcc, 0x2d, 0x50, 0x2f, 0x05, 0x39, 0x07, 0xe7, 0x1f, 0x0e, 0x8e, 0x8c,
+	0x37, 0x77, 0xd6, 0x19, 0x72, 0x03, 0xba, 0x88, 0x59, 0xbe, 0xd0, 0x33, 0x64, 0x6e, 0x57, 0xb7,
+	0x43, 0xcc, 0xef, 0x41, 0xcf, 0x7c, 0x95, 0x47, 0x2e, 0x68, 0x31, 0x91, 0xc5, 0x9f, 0x11, 0x14,
+	0xcf, 0xa0, 0x67, 0x3e, 0xd0, 0x13, 0xbd, 0xe6, 0x9f, 0xec, 0x8d, 0x2e, 0x19, 0xf6, 0x50, 0xfe,
+	0x92, 0x66, 0x54, 0xf9, 0x2e, 0xd4, 0x51, 0xb5, 0x23, 0xcb, 0x39, 0x97, 0xff, 0x48, 0x01, 0x72,
+	0xad, 0x51, 0xff, 0xe2, 0xad, 0x0d, 0xf7, 0xfb, 0x48, 0x01, 0xcc, 0xd6, 0x9f, 0x02, 0x68, 0x67,
+	0x38, 0xd1, 0xf9, 0xf4, 0x66, 0x9c, 0x64, 0x94, 0x03, 0xe7, 0xfa, 0x6b, 0x63, 0x8d, 0xf7, 0x9f,
+	0x0b, 0xf0, 0x8c, 0x72, 0x60, 0xb3, 0xff, 0x06, 0x74, 0x0d, 0x8b, 0x8b, 0xf3, 0xc9, 0x7c, 0xa4,
+	0x66, 0x94, 0x87, 0x9b, 0x28, 0xbe, 0x07, 0x80, 0x46, 0x3c, 0x57, 0xd5, 0xc8, 0xbc, 0xda, 0x36,
+	0xea, 0x67, 0xad, 0x7d, 0x2e, 0x33, 0x73, 0xd6, 0x6f, 0x51, 0xbf, 0x23, 0xac, 0x64, 0x36, 0xf0,
+	0x53, 0x18, 0xe4, 0xb7, 0x8d, 0x8b, 0x83, 0x6c, 0xbe, 0xc8, 0xf1, 0x1b, 0xfc, 0x1e, 0x2c, 0x6d,
+	0xd3, 0xd4, 0xc8, 0x68, 0x38, 0x7a, 0x11, 0x53, 0x76, 0x5c, 0xc8, 0xc7, 0xf2, 0x05, 0xa0, 0xd1,
+	0xf1, 0x92, 0xa6, 0xd3, 0x5c, 0x86, 0x41, 0x96, 0x3d, 0xdf, 0x81, 0x65, 0xe4, 0x7e, 0x33, 0x9d,
+	0xc1, 0x38, 0x01, 0xcb, 0xd9, 0xd1, 0x12, 0x76, 0x81, 0x93, 0x6d, 0x9a, 0xe6, 0xf3, 0x0c, 0x8a,
+	0x02, 0xf2, 0xd9, 0x81, 0x9e, 0xc0, 0xb9, 0xc2, 0x98, 0x3c, 0x17, 0x35, 0x47, 0x85, 0xeb, 0xb3,
+	0x78, 0xee, 0xf1, 0xbf, 0xc5, 0x9e, 0xcf, 0x12, 0x30, 0xa6, 0x7d, 0x6e, 0x7e, 0x36, 0x3e, 0x53,
+	0x09, 0xbe, 0xe4, 0xc1, 0xd6, 0x82, 0x70, 0xf8, 0x55, 0x2d, 0x61, 0x4a, 0x62, 0xdb, 0x9c, 0xf9,
+	0xe6, 0xab, 0xad, 0x33, 0xc4, 0x82, 0xf6, 0x36, 0xe5, 0x21, 0x5c, 0xa2, 0xa3, 0xaf, 0xd9, 0x79,
+	0xdf, 0x91, 0x1c, 0x2e, 0x92, 0x72, 0xf5, 0xaa, 0xcd, 0x10, 0x72, 0xb6, 0xcf, 0x0f, 0xf8, 0xe6,
+	0x98, 0x11, 0xd6, 0x91, 0x9c, 0xe7, 0x7c, 0xc0, 0x98, 0xef, 0x96, 0x01, 0x67, 0xf7, 0xc5, 0x72,
+	0xce, 0x3c, 0x27, 0x45, 0x36, 0x7b, 0x86, 0xd5, 0x8b, 0xed, 0x79, 0xc6, 0xa1, 0x3f, 0x85, 0xb3,
+	0x05, 0x66, 0x2e, 0xbf, 0x7e, 0xca, 0xdf, 0xd5, 0x8e, 0xca, 0xea, 0x4d, 0xd4, 0x5b, 0x30, 0xc8,
+	0x5b, 0x28, 0x9c, 0x8d, 0x4b, 0xcc, 0xa1, 0x51, 0x61, 0xa5, 0x89, 0xf1, 0x31, 0x2c, 0xe7, 0xec,
+	0x69, 0x79, 0x2c, 0xcd, 0x07, 0xbd, 0xa3, 0x91, 0x01, 0xcb, 0x19, 0xde, 0x0c, 0xcd, 0x5d, 0xe8,
+	0x28, 0x2b, 0x67, 0x5e, 0x27, 0x59, 0x15, 0x09, 0xf7, 0xf3, 0x57, 0xdf, 0x63, 0x00, 0x6d, 0x65,
+	0x0a, 0x9d, 0x32, 0x6f, 0x75, 0xf2, 0xc1, 0x8b, 0xcd, 0x5b, 0xeb, 0xcc, 0xcd, 0xca, 0xed, 0x0a,
+	0xf9, 0x11, 0x0c, 0xf2, 0x56, 0x11, 0xa7, 0x4b, 0x89, 0xad, 0x74, 0x3c, 0xca, 0x9d, 0x26, 0xfb,
+	0xbb, 0xf9, 0x77, 0xff, 0x77, 0x00, 0xd9, 0x82, 0x3f, 0x55, 0x7c, 0x5e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -4659,6 +7498,7 @@ type CoreRPCClient interface {
 	AddPod(ctx context.Context, in *AddPodOptions, opts ...grpc.CallOption) (*Pod, error)
 	RemovePod(ctx context.Context, in *RemovePodOptions, opts ...grpc.CallOption) (*Empty, error)
 	GetPod(ctx context.Context, in *GetPodOptions, opts ...grpc.CallOption) (*Pod, error)
+	SetPodOvercommit(ctx context.Context, in *SetPodOvercommitOptions, opts ...grpc.CallOption) (*Pod, error)
 	GetPodResource(ctx context.Context, in *GetPodOptions, opts ...grpc.CallOption) (*PodResource, error)
 	ListPodNodes(ctx context.Context, in *ListNodesOptions, opts ...grpc.CallOption) (*Nodes, error)
 	AddNode(ctx context.Context, in *AddNodeOptions, opts ...grpc.CallOption) (*Node, error)
@@ -4666,6 +7506,9 @@ type CoreRPCClient interface {
 	SetNode(ctx context.Context, in *SetNodeOptions, opts ...grpc.CallOption) (*Node, error)
 	GetNode(ctx context.Context, in *GetNodeOptions, opts ...grpc.CallOption) (*Node, error)
 	GetNodeResource(ctx context.Context, in *GetNodeOptions, opts ...grpc.CallOption) (*NodeResource, error)
+	FixNodeResource(ctx context.Context, in *FixNodeResourceOptions, opts ...grpc.CallOption) (*NodeResourceFix, error)
+	DrainNode(ctx context.Context, in *DrainNodeOptions, opts ...grpc.CallOption) (CoreRPC_DrainNodeClient, error)
+	AuditContainers(ctx context.Context, in *AuditOptions, opts ...grpc.CallOption) (CoreRPC_AuditContainersClient, error)
 	GetContainer(ctx context.Context, in *ContainerID, opts ...grpc.CallOption) (*Container, error)
 	GetContainers(ctx context.Context, in *ContainerIDs, opts ...grpc.CallOption) (*Containers, error)
 	ListContainers(ctx context.Context, in *ListContainersOptions, opts ...grpc.CallOption) (CoreRPC_ListContainersClient, error)
@@ -4673,13 +7516,30 @@ type CoreRPCClient interface {
 	GetContainersStatus(ctx context.Context, in *ContainerIDs, opts ...grpc.CallOption) (*ContainersStatus, error)
 	SetContainersStatus(ctx context.Context, in *SetContainersStatusOptions, opts ...grpc.CallOption) (*ContainersStatus, error)
 	ContainerStatusStream(ctx context.Context, in *ContainerStatusStreamOptions, opts ...grpc.CallOption) (CoreRPC_ContainerStatusStreamClient, error)
+	WatchEvents(ctx context.Context, in *WatchEventsOptions, opts ...grpc.CallOption) (CoreRPC_WatchEventsClient, error)
+	ListAuditLogs(ctx context.Context, in *ListAuditLogsOptions, opts ...grpc.CallOption) (*AuditLogs, error)
+	SetSecret(ctx context.Context, in *SetSecretOptions, opts ...grpc.CallOption) (*Secret, error)
+	ListSecrets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Secrets, error)
+	RemoveSecret(ctx context.Context, in *RemoveSecretOptions, opts ...grpc.CallOption) (*Empty, error)
+	RotateSecret(ctx context.Context, in *RotateSecretOptions, opts ...grpc.CallOption) (CoreRPC_RotateSecretClient, error)
 	Copy(ctx context.Context, in *CopyOptions, opts ...grpc.CallOption) (CoreRPC_CopyClient, error)
 	Send(ctx context.Context, in *SendOptions, opts ...grpc.CallOption) (CoreRPC_SendClient, error)
 	BuildImage(ctx context.Context, in *BuildImageOptions, opts ...grpc.CallOption) (CoreRPC_BuildImageClient, error)
 	CacheImage(ctx context.Context, in *CacheImageOptions, opts ...grpc.CallOption) (CoreRPC_CacheImageClient, error)
 	RemoveImage(ctx context.Context, in *RemoveImageOptions, opts ...grpc.CallOption) (CoreRPC_RemoveImageClient, error)
+	PlanDeploy(ctx context.Context, in *DeployOptions, opts ...grpc.CallOption) (*DeployPlan, error)
 	CreateContainer(ctx context.Context, in *DeployOptions, opts ...grpc.CallOption) (CoreRPC_CreateContainerClient, error)
 	ReplaceContainer(ctx context.Context, in *ReplaceOptions, opts ...grpc.CallOption) (CoreRPC_ReplaceContainerClient, error)
+	SetDeployment(ctx context.Context, in *DeployOptions, opts ...grpc.CallOption) (*Deployment, error)
+	RemoveDeployment(ctx context.Context, in *RemoveDeploymentOptions, opts ...grpc.CallOption) (*Empty, error)
+	ListDeployments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Deployments, error)
+	SetAutoscalePolicy(ctx context.Context, in *AutoscalePolicy, opts ...grpc.CallOption) (*Empty, error)
+	RemoveAutoscalePolicy(ctx context.Context, in *RemoveAutoscalePolicyOptions, opts ...grpc.CallOption) (*Empty, error)
+	ListAutoscalePolicies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AutoscalePolicies, error)
+	ListAutoscaleDecisions(ctx context.Context, in *ListAutoscaleDecisionsOptions, opts ...grpc.CallOption) (*AutoscaleDecisions, error)
+	SetQuota(ctx context.Context, in *Quota, opts ...grpc.CallOption) (*Empty, error)
+	RemoveQuota(ctx context.Context, in *RemoveQuotaOptions, opts ...grpc.CallOption) (*Empty, error)
+	ListQuotaUsages(ctx context.Context, in *ListQuotaUsagesOptions, opts ...grpc.CallOption) (*QuotaUsages, error)
 	RemoveContainer(ctx context.Context, in *RemoveContainerOptions, opts ...grpc.CallOption) (CoreRPC_RemoveContainerClient, error)
 	DissociateContainer(ctx context.Context, in *DissociateContainerOptions, opts ...grpc.CallOption) (CoreRPC_DissociateContainerClient, error)
 	ControlContainer(ctx context.Context, in *ControlContainerOptions, opts ...grpc.CallOption) (CoreRPC_ControlContainerClient, error)
@@ -4742,6 +7602,15 @@ func (c *coreRPCClient) GetPod(ctx context.Context, in *GetPodOptions, opts ...g
 	return out, nil
 }
 
+func (c *coreRPCClient) SetPodOvercommit(ctx context.Context, in *SetPodOvercommitOptions, opts ...grpc.CallOption) (*Pod, error) {
+	out := new(Pod)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/SetPodOvercommit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreRPCClient) GetPodResource(ctx context.Context, in *GetPodOptions, opts ...grpc.CallOption) (*PodResource, error) {
 	out := new(PodResource)
 	err := c.cc.Invoke(ctx, "/pb.CoreRPC/GetPodResource", in, out, opts...)
@@ -4805,6 +7674,79 @@ func (c *coreRPCClient) GetNodeResource(ctx context.Context, in *GetNodeOptions,
 	return out, nil
 }
 
+func (c *coreRPCClient) FixNodeResource(ctx context.Context, in *FixNodeResourceOptions, opts ...grpc.CallOption) (*NodeResourceFix, error) {
+	out := new(NodeResourceFix)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/FixNodeResource", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) DrainNode(ctx context.Context, in *DrainNodeOptions, opts ...grpc.CallOption) (CoreRPC_DrainNodeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[0], "/pb.CoreRPC/DrainNode", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &coreRPCDrainNodeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CoreRPC_DrainNodeClient interface {
+	Recv() (*DrainNodeMessage, error)
+	grpc.ClientStream
+}
+
+type coreRPCDrainNodeClient struct {
+	grpc.ClientStream
+}
+
+func (x *coreRPCDrainNodeClient) Recv() (*DrainNodeMessage, error) {
+	m := new(DrainNodeMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *coreRPCClient) AuditContainers(ctx context.Context, in *AuditOptions, opts ...grpc.CallOption) (CoreRPC_AuditContainersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[1], "/pb.CoreRPC/AuditContainers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &coreRPCAuditContainersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CoreRPC_AuditContainersClient interface {
+	Recv() (*AuditNodeMessage, error)
+	grpc.ClientStream
+}
+
+type coreRPCAuditContainersClient struct {
+	grpc.ClientStream
+}
+
+func (x *coreRPCAuditContainersClient) Recv() (*AuditNodeMessage, error) {
+	m := new(AuditNodeMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *coreRPCClient) GetContainer(ctx context.Context, in *ContainerID, opts ...grpc.CallOption) (*Container, error) {
 	out := new(Container)
 	err := c.cc.Invoke(ctx, "/pb.CoreRPC/GetContainer", in, out, opts...)
@@ -4820,15 +7762,106 @@ func (c *coreRPCClient) GetContainers(ctx context.Context, in *ContainerIDs, opt
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	return out, nil
+}
+
+func (c *coreRPCClient) ListContainers(ctx context.Context, in *ListContainersOptions, opts ...grpc.CallOption) (CoreRPC_ListContainersClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[2], "/pb.CoreRPC/ListContainers", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &coreRPCListContainersClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CoreRPC_ListContainersClient interface {
+	Recv() (*Container, error)
+	grpc.ClientStream
+}
+
+type coreRPCListContainersClient struct {
+	grpc.ClientStream
+}
+
+func (x *coreRPCListContainersClient) Recv() (*Container, error) {
+	m := new(Container)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *coreRPCClient) ListNodeContainers(ctx context.Context, in *GetNodeOptions, opts ...grpc.CallOption) (*Containers, error) {
+	out := new(Containers)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/ListNodeContainers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) GetContainersStatus(ctx context.Context, in *ContainerIDs, opts ...grpc.CallOption) (*ContainersStatus, error) {
+	out := new(ContainersStatus)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/GetContainersStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) SetContainersStatus(ctx context.Context, in *SetContainersStatusOptions, opts ...grpc.CallOption) (*ContainersStatus, error) {
+	out := new(ContainersStatus)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/SetContainersStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) ContainerStatusStream(ctx context.Context, in *ContainerStatusStreamOptions, opts ...grpc.CallOption) (CoreRPC_ContainerStatusStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[3], "/pb.CoreRPC/ContainerStatusStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &coreRPCContainerStatusStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CoreRPC_ContainerStatusStreamClient interface {
+	Recv() (*ContainerStatusStreamMessage, error)
+	grpc.ClientStream
+}
+
+type coreRPCContainerStatusStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *coreRPCContainerStatusStreamClient) Recv() (*ContainerStatusStreamMessage, error) {
+	m := new(ContainerStatusStreamMessage)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
-func (c *coreRPCClient) ListContainers(ctx context.Context, in *ListContainersOptions, opts ...grpc.CallOption) (CoreRPC_ListContainersClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[0], "/pb.CoreRPC/ListContainers", opts...)
+func (c *coreRPCClient) WatchEvents(ctx context.Context, in *WatchEventsOptions, opts ...grpc.CallOption) (CoreRPC_WatchEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[4], "/pb.CoreRPC/WatchEvents", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &coreRPCListContainersClient{stream}
+	x := &coreRPCWatchEventsClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -4838,56 +7871,65 @@ func (c *coreRPCClient) ListContainers(ctx context.Context, in *ListContainersOp
 	return x, nil
 }
 
-type CoreRPC_ListContainersClient interface {
-	Recv() (*Container, error)
+type CoreRPC_WatchEventsClient interface {
+	Recv() (*Event, error)
 	grpc.ClientStream
 }
 
-type coreRPCListContainersClient struct {
+type coreRPCWatchEventsClient struct {
 	grpc.ClientStream
 }
 
-func (x *coreRPCListContainersClient) Recv() (*Container, error) {
-	m := new(Container)
+func (x *coreRPCWatchEventsClient) Recv() (*Event, error) {
+	m := new(Event)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
 	return m, nil
 }
 
-func (c *coreRPCClient) ListNodeContainers(ctx context.Context, in *GetNodeOptions, opts ...grpc.CallOption) (*Containers, error) {
-	out := new(Containers)
-	err := c.cc.Invoke(ctx, "/pb.CoreRPC/ListNodeContainers", in, out, opts...)
+func (c *coreRPCClient) ListAuditLogs(ctx context.Context, in *ListAuditLogsOptions, opts ...grpc.CallOption) (*AuditLogs, error) {
+	out := new(AuditLogs)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/ListAuditLogs", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreRPCClient) GetContainersStatus(ctx context.Context, in *ContainerIDs, opts ...grpc.CallOption) (*ContainersStatus, error) {
-	out := new(ContainersStatus)
-	err := c.cc.Invoke(ctx, "/pb.CoreRPC/GetContainersStatus", in, out, opts...)
+func (c *coreRPCClient) SetSecret(ctx context.Context, in *SetSecretOptions, opts ...grpc.CallOption) (*Secret, error) {
+	out := new(Secret)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/SetSecret", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreRPCClient) SetContainersStatus(ctx context.Context, in *SetContainersStatusOptions, opts ...grpc.CallOption) (*ContainersStatus, error) {
-	out := new(ContainersStatus)
-	err := c.cc.Invoke(ctx, "/pb.CoreRPC/SetContainersStatus", in, out, opts...)
+func (c *coreRPCClient) ListSecrets(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Secrets, error) {
+	out := new(Secrets)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/ListSecrets", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *coreRPCClient) ContainerStatusStream(ctx context.Context, in *ContainerStatusStreamOptions, opts ...grpc.CallOption) (CoreRPC_ContainerStatusStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[1], "/pb.CoreRPC/ContainerStatusStream", opts...)
+func (c *coreRPCClient) RemoveSecret(ctx context.Context, in *RemoveSecretOptions, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/RemoveSecret", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &coreRPCContainerStatusStreamClient{stream}
+	return out, nil
+}
+
+func (c *coreRPCClient) RotateSecret(ctx context.Context, in *RotateSecretOptions, opts ...grpc.CallOption) (CoreRPC_RotateSecretClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[5], "/pb.CoreRPC/RotateSecret", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &coreRPCRotateSecretClient{stream}
 	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
@@ -4897,17 +7939,17 @@ func (c *coreRPCClient) ContainerStatusStream(ctx context.Context, in *Container
 	return x, nil
 }
 
-type CoreRPC_ContainerStatusStreamClient interface {
-	Recv() (*ContainerStatusStreamMessage, error)
+type CoreRPC_RotateSecretClient interface {
+	Recv() (*ReplaceContainerMessage, error)
 	grpc.ClientStream
 }
 
-type coreRPCContainerStatusStreamClient struct {
+type coreRPCRotateSecretClient struct {
 	grpc.ClientStream
 }
 
-func (x *coreRPCContainerStatusStreamClient) Recv() (*ContainerStatusStreamMessage, error) {
-	m := new(ContainerStatusStreamMessage)
+func (x *coreRPCRotateSecretClient) Recv() (*ReplaceContainerMessage, error) {
+	m := new(ReplaceContainerMessage)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -4915,7 +7957,7 @@ func (x *coreRPCContainerStatusStreamClient) Recv() (*ContainerStatusStreamMessa
 }
 
 func (c *coreRPCClient) Copy(ctx context.Context, in *CopyOptions, opts ...grpc.CallOption) (CoreRPC_CopyClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[2], "/pb.CoreRPC/Copy", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[6], "/pb.CoreRPC/Copy", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4947,7 +7989,7 @@ func (x *coreRPCCopyClient) Recv() (*CopyMessage, error) {
 }
 
 func (c *coreRPCClient) Send(ctx context.Context, in *SendOptions, opts ...grpc.CallOption) (CoreRPC_SendClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[3], "/pb.CoreRPC/Send", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[7], "/pb.CoreRPC/Send", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -4979,7 +8021,7 @@ func (x *coreRPCSendClient) Recv() (*SendMessage, error) {
 }
 
 func (c *coreRPCClient) BuildImage(ctx context.Context, in *BuildImageOptions, opts ...grpc.CallOption) (CoreRPC_BuildImageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[4], "/pb.CoreRPC/BuildImage", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[8], "/pb.CoreRPC/BuildImage", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5011,7 +8053,7 @@ func (x *coreRPCBuildImageClient) Recv() (*BuildImageMessage, error) {
 }
 
 func (c *coreRPCClient) CacheImage(ctx context.Context, in *CacheImageOptions, opts ...grpc.CallOption) (CoreRPC_CacheImageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[5], "/pb.CoreRPC/CacheImage", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[9], "/pb.CoreRPC/CacheImage", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5043,7 +8085,7 @@ func (x *coreRPCCacheImageClient) Recv() (*CacheImageMessage, error) {
 }
 
 func (c *coreRPCClient) RemoveImage(ctx context.Context, in *RemoveImageOptions, opts ...grpc.CallOption) (CoreRPC_RemoveImageClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[6], "/pb.CoreRPC/RemoveImage", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[10], "/pb.CoreRPC/RemoveImage", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5074,8 +8116,17 @@ func (x *coreRPCRemoveImageClient) Recv() (*RemoveImageMessage, error) {
 	return m, nil
 }
 
+func (c *coreRPCClient) PlanDeploy(ctx context.Context, in *DeployOptions, opts ...grpc.CallOption) (*DeployPlan, error) {
+	out := new(DeployPlan)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/PlanDeploy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreRPCClient) CreateContainer(ctx context.Context, in *DeployOptions, opts ...grpc.CallOption) (CoreRPC_CreateContainerClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[7], "/pb.CoreRPC/CreateContainer", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[11], "/pb.CoreRPC/CreateContainer", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5107,7 +8158,7 @@ func (x *coreRPCCreateContainerClient) Recv() (*CreateContainerMessage, error) {
 }
 
 func (c *coreRPCClient) ReplaceContainer(ctx context.Context, in *ReplaceOptions, opts ...grpc.CallOption) (CoreRPC_ReplaceContainerClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[8], "/pb.CoreRPC/ReplaceContainer", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[12], "/pb.CoreRPC/ReplaceContainer", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5138,8 +8189,98 @@ func (x *coreRPCReplaceContainerClient) Recv() (*ReplaceContainerMessage, error)
 	return m, nil
 }
 
+func (c *coreRPCClient) SetDeployment(ctx context.Context, in *DeployOptions, opts ...grpc.CallOption) (*Deployment, error) {
+	out := new(Deployment)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/SetDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) RemoveDeployment(ctx context.Context, in *RemoveDeploymentOptions, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/RemoveDeployment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) ListDeployments(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*Deployments, error) {
+	out := new(Deployments)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/ListDeployments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) SetAutoscalePolicy(ctx context.Context, in *AutoscalePolicy, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/SetAutoscalePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) RemoveAutoscalePolicy(ctx context.Context, in *RemoveAutoscalePolicyOptions, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/RemoveAutoscalePolicy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) ListAutoscalePolicies(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*AutoscalePolicies, error) {
+	out := new(AutoscalePolicies)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/ListAutoscalePolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) ListAutoscaleDecisions(ctx context.Context, in *ListAutoscaleDecisionsOptions, opts ...grpc.CallOption) (*AutoscaleDecisions, error) {
+	out := new(AutoscaleDecisions)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/ListAutoscaleDecisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) SetQuota(ctx context.Context, in *Quota, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/SetQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) RemoveQuota(ctx context.Context, in *RemoveQuotaOptions, opts ...grpc.CallOption) (*Empty, error) {
+	out := new(Empty)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/RemoveQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreRPCClient) ListQuotaUsages(ctx context.Context, in *ListQuotaUsagesOptions, opts ...grpc.CallOption) (*QuotaUsages, error) {
+	out := new(QuotaUsages)
+	err := c.cc.Invoke(ctx, "/pb.CoreRPC/ListQuotaUsages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreRPCClient) RemoveContainer(ctx context.Context, in *RemoveContainerOptions, opts ...grpc.CallOption) (CoreRPC_RemoveContainerClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[9], "/pb.CoreRPC/RemoveContainer", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[13], "/pb.CoreRPC/RemoveContainer", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5171,7 +8312,7 @@ func (x *coreRPCRemoveContainerClient) Recv() (*RemoveContainerMessage, error) {
 }
 
 func (c *coreRPCClient) DissociateContainer(ctx context.Context, in *DissociateContainerOptions, opts ...grpc.CallOption) (CoreRPC_DissociateContainerClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[10], "/pb.CoreRPC/DissociateContainer", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[14], "/pb.CoreRPC/DissociateContainer", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5203,7 +8344,7 @@ func (x *coreRPCDissociateContainerClient) Recv() (*DissociateContainerMessage,
 }
 
 func (c *coreRPCClient) ControlContainer(ctx context.Context, in *ControlContainerOptions, opts ...grpc.CallOption) (CoreRPC_ControlContainerClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[11], "/pb.CoreRPC/ControlContainer", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[15], "/pb.CoreRPC/ControlContainer", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5235,7 +8376,7 @@ func (x *coreRPCControlContainerClient) Recv() (*ControlContainerMessage, error)
 }
 
 func (c *coreRPCClient) ReallocResource(ctx context.Context, in *ReallocOptions, opts ...grpc.CallOption) (CoreRPC_ReallocResourceClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[12], "/pb.CoreRPC/ReallocResource", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[16], "/pb.CoreRPC/ReallocResource", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5267,7 +8408,7 @@ func (x *coreRPCReallocResourceClient) Recv() (*ReallocResourceMessage, error) {
 }
 
 func (c *coreRPCClient) LogStream(ctx context.Context, in *ContainerID, opts ...grpc.CallOption) (CoreRPC_LogStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[13], "/pb.CoreRPC/LogStream", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[17], "/pb.CoreRPC/LogStream", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5299,7 +8440,7 @@ func (x *coreRPCLogStreamClient) Recv() (*LogStreamMessage, error) {
 }
 
 func (c *coreRPCClient) RunAndWait(ctx context.Context, opts ...grpc.CallOption) (CoreRPC_RunAndWaitClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[14], "/pb.CoreRPC/RunAndWait", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[18], "/pb.CoreRPC/RunAndWait", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5330,7 +8471,7 @@ func (x *coreRPCRunAndWaitClient) Recv() (*AttachContainerMessage, error) {
 }
 
 func (c *coreRPCClient) ExecuteContainer(ctx context.Context, opts ...grpc.CallOption) (CoreRPC_ExecuteContainerClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[15], "/pb.CoreRPC/ExecuteContainer", opts...)
+	stream, err := c.cc.NewStream(ctx, &_CoreRPC_serviceDesc.Streams[19], "/pb.CoreRPC/ExecuteContainer", opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -5367,6 +8508,7 @@ type CoreRPCServer interface {
 	AddPod(context.Context, *AddPodOptions) (*Pod, error)
 	RemovePod(context.Context, *RemovePodOptions) (*Empty, error)
 	GetPod(context.Context, *GetPodOptions) (*Pod, error)
+	SetPodOvercommit(context.Context, *SetPodOvercommitOptions) (*Pod, error)
 	GetPodResource(context.Context, *GetPodOptions) (*PodResource, error)
 	ListPodNodes(context.Context, *ListNodesOptions) (*Nodes, error)
 	AddNode(context.Context, *AddNodeOptions) (*Node, error)
@@ -5374,6 +8516,9 @@ type CoreRPCServer interface {
 	SetNode(context.Context, *SetNodeOptions) (*Node, error)
 	GetNode(context.Context, *GetNodeOptions) (*Node, error)
 	GetNodeResource(context.Context, *GetNodeOptions) (*NodeResource, error)
+	FixNodeResource(context.Context, *FixNodeResourceOptions) (*NodeResourceFix, error)
+	DrainNode(*DrainNodeOptions, CoreRPC_DrainNodeServer) error
+	AuditContainers(*AuditOptions, CoreRPC_AuditContainersServer) error
 	GetContainer(context.Context, *ContainerID) (*Container, error)
 	GetContainers(context.Context, *ContainerIDs) (*Containers, error)
 	ListContainers(*ListContainersOptions, CoreRPC_ListContainersServer) error
@@ -5381,13 +8526,30 @@ type CoreRPCServer interface {
 	GetContainersStatus(context.Context, *ContainerIDs) (*ContainersStatus, error)
 	SetContainersStatus(context.Context, *SetContainersStatusOptions) (*ContainersStatus, error)
 	ContainerStatusStream(*ContainerStatusStreamOptions, CoreRPC_ContainerStatusStreamServer) error
+	WatchEvents(*WatchEventsOptions, CoreRPC_WatchEventsServer) error
+	ListAuditLogs(context.Context, *ListAuditLogsOptions) (*AuditLogs, error)
+	SetSecret(context.Context, *SetSecretOptions) (*Secret, error)
+	ListSecrets(context.Context, *Empty) (*Secrets, error)
+	RemoveSecret(context.Context, *RemoveSecretOptions) (*Empty, error)
+	RotateSecret(*RotateSecretOptions, CoreRPC_RotateSecretServer) error
 	Copy(*CopyOptions, CoreRPC_CopyServer) error
 	Send(*SendOptions, CoreRPC_SendServer) error
 	BuildImage(*BuildImageOptions, CoreRPC_BuildImageServer) error
 	CacheImage(*CacheImageOptions, CoreRPC_CacheImageServer) error
 	RemoveImage(*RemoveImageOptions, CoreRPC_RemoveImageServer) error
+	PlanDeploy(context.Context, *DeployOptions) (*DeployPlan, error)
 	CreateContainer(*DeployOptions, CoreRPC_CreateContainerServer) error
 	ReplaceContainer(*ReplaceOptions, CoreRPC_ReplaceContainerServer) error
+	SetDeployment(context.Context, *DeployOptions) (*Deployment, error)
+	RemoveDeployment(context.Context, *RemoveDeploymentOptions) (*Empty, error)
+	ListDeployments(context.Context, *Empty) (*Deployments, error)
+	SetAutoscalePolicy(context.Context, *AutoscalePolicy) (*Empty, error)
+	RemoveAutoscalePolicy(context.Context, *RemoveAutoscalePolicyOptions) (*Empty, error)
+	ListAutoscalePolicies(context.Context, *Empty) (*AutoscalePolicies, error)
+	ListAutoscaleDecisions(context.Context, *ListAutoscaleDecisionsOptions) (*AutoscaleDecisions, error)
+	SetQuota(context.Context, *Quota) (*Empty, error)
+	RemoveQuota(context.Context, *RemoveQuotaOptions) (*Empty, error)
+	ListQuotaUsages(context.Context, *ListQuotaUsagesOptions) (*QuotaUsages, error)
 	RemoveContainer(*RemoveContainerOptions, CoreRPC_RemoveContainerServer) error
 	DissociateContainer(*DissociateContainerOptions, CoreRPC_DissociateContainerServer) error
 	ControlContainer(*ControlContainerOptions, CoreRPC_ControlContainerServer) error
@@ -5416,6 +8578,9 @@ func (*UnimplementedCoreRPCServer) RemovePod(ctx context.Context, req *RemovePod
 func (*UnimplementedCoreRPCServer) GetPod(ctx context.Context, req *GetPodOptions) (*Pod, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPod not implemented")
 }
+func (*UnimplementedCoreRPCServer) SetPodOvercommit(ctx context.Context, req *SetPodOvercommitOptions) (*Pod, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPodOvercommit not implemented")
+}
 func (*UnimplementedCoreRPCServer) GetPodResource(ctx context.Context, req *GetPodOptions) (*PodResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPodResource not implemented")
 }
@@ -5437,6 +8602,15 @@ func (*UnimplementedCoreRPCServer) GetNode(ctx context.Context, req *GetNodeOpti
 func (*UnimplementedCoreRPCServer) GetNodeResource(ctx context.Context, req *GetNodeOptions) (*NodeResource, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNodeResource not implemented")
 }
+func (*UnimplementedCoreRPCServer) FixNodeResource(ctx context.Context, req *FixNodeResourceOptions) (*NodeResourceFix, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FixNodeResource not implemented")
+}
+func (*UnimplementedCoreRPCServer) DrainNode(req *DrainNodeOptions, srv CoreRPC_DrainNodeServer) error {
+	return status.Errorf(codes.Unimplemented, "method DrainNode not implemented")
+}
+func (*UnimplementedCoreRPCServer) AuditContainers(req *AuditOptions, srv CoreRPC_AuditContainersServer) error {
+	return status.Errorf(codes.Unimplemented, "method AuditContainers not implemented")
+}
 func (*UnimplementedCoreRPCServer) GetContainer(ctx context.Context, req *ContainerID) (*Container, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetContainer not implemented")
 }
@@ -5458,6 +8632,24 @@ func (*UnimplementedCoreRPCServer) SetContainersStatus(ctx context.Context, req
 func (*UnimplementedCoreRPCServer) ContainerStatusStream(req *ContainerStatusStreamOptions, srv CoreRPC_ContainerStatusStreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method ContainerStatusStream not implemented")
 }
+func (*UnimplementedCoreRPCServer) WatchEvents(req *WatchEventsOptions, srv CoreRPC_WatchEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchEvents not implemented")
+}
+func (*UnimplementedCoreRPCServer) ListAuditLogs(ctx context.Context, req *ListAuditLogsOptions) (*AuditLogs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditLogs not implemented")
+}
+func (*UnimplementedCoreRPCServer) SetSecret(ctx context.Context, req *SetSecretOptions) (*Secret, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSecret not implemented")
+}
+func (*UnimplementedCoreRPCServer) ListSecrets(ctx context.Context, req *Empty) (*Secrets, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
+}
+func (*UnimplementedCoreRPCServer) RemoveSecret(ctx context.Context, req *RemoveSecretOptions) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSecret not implemented")
+}
+func (*UnimplementedCoreRPCServer) RotateSecret(req *RotateSecretOptions, srv CoreRPC_RotateSecretServer) error {
+	return status.Errorf(codes.Unimplemented, "method RotateSecret not implemented")
+}
 func (*UnimplementedCoreRPCServer) Copy(req *CopyOptions, srv CoreRPC_CopyServer) error {
 	return status.Errorf(codes.Unimplemented, "method Copy not implemented")
 }
@@ -5473,12 +8665,45 @@ func (*UnimplementedCoreRPCServer) CacheImage(req *CacheImageOptions, srv CoreRP
 func (*UnimplementedCoreRPCServer) RemoveImage(req *RemoveImageOptions, srv CoreRPC_RemoveImageServer) error {
 	return status.Errorf(codes.Unimplemented, "method RemoveImage not implemented")
 }
+func (*UnimplementedCoreRPCServer) PlanDeploy(ctx context.Context, req *DeployOptions) (*DeployPlan, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanDeploy not implemented")
+}
 func (*UnimplementedCoreRPCServer) CreateContainer(req *DeployOptions, srv CoreRPC_CreateContainerServer) error {
 	return status.Errorf(codes.Unimplemented, "method CreateContainer not implemented")
 }
 func (*UnimplementedCoreRPCServer) ReplaceContainer(req *ReplaceOptions, srv CoreRPC_ReplaceContainerServer) error {
 	return status.Errorf(codes.Unimplemented, "method ReplaceContainer not implemented")
 }
+func (*UnimplementedCoreRPCServer) SetDeployment(ctx context.Context, req *DeployOptions) (*Deployment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDeployment not implemented")
+}
+func (*UnimplementedCoreRPCServer) RemoveDeployment(ctx context.Context, req *RemoveDeploymentOptions) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveDeployment not implemented")
+}
+func (*UnimplementedCoreRPCServer) ListDeployments(ctx context.Context, req *Empty) (*Deployments, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeployments not implemented")
+}
+func (*UnimplementedCoreRPCServer) SetAutoscalePolicy(ctx context.Context, req *AutoscalePolicy) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoscalePolicy not implemented")
+}
+func (*UnimplementedCoreRPCServer) RemoveAutoscalePolicy(ctx context.Context, req *RemoveAutoscalePolicyOptions) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveAutoscalePolicy not implemented")
+}
+func (*UnimplementedCoreRPCServer) ListAutoscalePolicies(ctx context.Context, req *Empty) (*AutoscalePolicies, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAutoscalePolicies not implemented")
+}
+func (*UnimplementedCoreRPCServer) ListAutoscaleDecisions(ctx context.Context, req *ListAutoscaleDecisionsOptions) (*AutoscaleDecisions, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAutoscaleDecisions not implemented")
+}
+func (*UnimplementedCoreRPCServer) SetQuota(ctx context.Context, req *Quota) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuota not implemented")
+}
+func (*UnimplementedCoreRPCServer) RemoveQuota(ctx context.Context, req *RemoveQuotaOptions) (*Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveQuota not implemented")
+}
+func (*UnimplementedCoreRPCServer) ListQuotaUsages(ctx context.Context, req *ListQuotaUsagesOptions) (*QuotaUsages, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuotaUsages not implemented")
+}
 func (*UnimplementedCoreRPCServer) RemoveContainer(req *RemoveContainerOptions, srv CoreRPC_RemoveContainerServer) error {
 	return status.Errorf(codes.Unimplemented, "method RemoveContainer not implemented")
 }
@@ -5595,6 +8820,24 @@ func _CoreRPC_GetPod_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CoreRPC_SetPodOvercommit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPodOvercommitOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).SetPodOvercommit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/SetPodOvercommit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).SetPodOvercommit(ctx, req.(*SetPodOvercommitOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CoreRPC_GetPodResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetPodOptions)
 	if err := dec(in); err != nil {
@@ -5721,6 +8964,66 @@ func _CoreRPC_GetNodeResource_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CoreRPC_FixNodeResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FixNodeResourceOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).FixNodeResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/FixNodeResource",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).FixNodeResource(ctx, req.(*FixNodeResourceOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_DrainNode_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DrainNodeOptions)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreRPCServer).DrainNode(m, &coreRPCDrainNodeServer{stream})
+}
+
+type CoreRPC_DrainNodeServer interface {
+	Send(*DrainNodeMessage) error
+	grpc.ServerStream
+}
+
+type coreRPCDrainNodeServer struct {
+	grpc.ServerStream
+}
+
+func (x *coreRPCDrainNodeServer) Send(m *DrainNodeMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CoreRPC_AuditContainers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AuditOptions)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreRPCServer).AuditContainers(m, &coreRPCAuditContainersServer{stream})
+}
+
+type CoreRPC_AuditContainersServer interface {
+	Send(*AuditNodeMessage) error
+	grpc.ServerStream
+}
+
+type coreRPCAuditContainersServer struct {
+	grpc.ServerStream
+}
+
+func (x *coreRPCAuditContainersServer) Send(m *AuditNodeMessage) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CoreRPC_GetContainer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ContainerID)
 	if err := dec(in); err != nil {
@@ -5757,99 +9060,213 @@ func _CoreRPC_GetContainers_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoreRPC_ListContainers_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListContainersOptions)
+func _CoreRPC_ListContainers_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListContainersOptions)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreRPCServer).ListContainers(m, &coreRPCListContainersServer{stream})
+}
+
+type CoreRPC_ListContainersServer interface {
+	Send(*Container) error
+	grpc.ServerStream
+}
+
+type coreRPCListContainersServer struct {
+	grpc.ServerStream
+}
+
+func (x *coreRPCListContainersServer) Send(m *Container) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CoreRPC_ListNodeContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNodeOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).ListNodeContainers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/ListNodeContainers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).ListNodeContainers(ctx, req.(*GetNodeOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_GetContainersStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainerIDs)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).GetContainersStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/GetContainersStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).GetContainersStatus(ctx, req.(*ContainerIDs))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_SetContainersStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetContainersStatusOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).SetContainersStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/SetContainersStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).SetContainersStatus(ctx, req.(*SetContainersStatusOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_ContainerStatusStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ContainerStatusStreamOptions)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(CoreRPCServer).ListContainers(m, &coreRPCListContainersServer{stream})
+	return srv.(CoreRPCServer).ContainerStatusStream(m, &coreRPCContainerStatusStreamServer{stream})
 }
 
-type CoreRPC_ListContainersServer interface {
-	Send(*Container) error
+type CoreRPC_ContainerStatusStreamServer interface {
+	Send(*ContainerStatusStreamMessage) error
 	grpc.ServerStream
 }
 
-type coreRPCListContainersServer struct {
+type coreRPCContainerStatusStreamServer struct {
 	grpc.ServerStream
 }
 
-func (x *coreRPCListContainersServer) Send(m *Container) error {
+func (x *coreRPCContainerStatusStreamServer) Send(m *ContainerStatusStreamMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _CoreRPC_ListNodeContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetNodeOptions)
+func _CoreRPC_WatchEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchEventsOptions)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreRPCServer).WatchEvents(m, &coreRPCWatchEventsServer{stream})
+}
+
+type CoreRPC_WatchEventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type coreRPCWatchEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *coreRPCWatchEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _CoreRPC_ListAuditLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditLogsOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreRPCServer).ListNodeContainers(ctx, in)
+		return srv.(CoreRPCServer).ListAuditLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.CoreRPC/ListNodeContainers",
+		FullMethod: "/pb.CoreRPC/ListAuditLogs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreRPCServer).ListNodeContainers(ctx, req.(*GetNodeOptions))
+		return srv.(CoreRPCServer).ListAuditLogs(ctx, req.(*ListAuditLogsOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoreRPC_GetContainersStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainerIDs)
+func _CoreRPC_SetSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSecretOptions)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreRPCServer).GetContainersStatus(ctx, in)
+		return srv.(CoreRPCServer).SetSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.CoreRPC/GetContainersStatus",
+		FullMethod: "/pb.CoreRPC/SetSecret",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreRPCServer).GetContainersStatus(ctx, req.(*ContainerIDs))
+		return srv.(CoreRPCServer).SetSecret(ctx, req.(*SetSecretOptions))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoreRPC_SetContainersStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetContainersStatusOptions)
+func _CoreRPC_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(CoreRPCServer).SetContainersStatus(ctx, in)
+		return srv.(CoreRPCServer).ListSecrets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.CoreRPC/SetContainersStatus",
+		FullMethod: "/pb.CoreRPC/ListSecrets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(CoreRPCServer).SetContainersStatus(ctx, req.(*SetContainersStatusOptions))
+		return srv.(CoreRPCServer).ListSecrets(ctx, req.(*Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _CoreRPC_ContainerStatusStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ContainerStatusStreamOptions)
+func _CoreRPC_RemoveSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSecretOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).RemoveSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/RemoveSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).RemoveSecret(ctx, req.(*RemoveSecretOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_RotateSecret_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(RotateSecretOptions)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(CoreRPCServer).ContainerStatusStream(m, &coreRPCContainerStatusStreamServer{stream})
+	return srv.(CoreRPCServer).RotateSecret(m, &coreRPCRotateSecretServer{stream})
 }
 
-type CoreRPC_ContainerStatusStreamServer interface {
-	Send(*ContainerStatusStreamMessage) error
+type CoreRPC_RotateSecretServer interface {
+	Send(*ReplaceContainerMessage) error
 	grpc.ServerStream
 }
 
-type coreRPCContainerStatusStreamServer struct {
+type coreRPCRotateSecretServer struct {
 	grpc.ServerStream
 }
 
-func (x *coreRPCContainerStatusStreamServer) Send(m *ContainerStatusStreamMessage) error {
+func (x *coreRPCRotateSecretServer) Send(m *ReplaceContainerMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
@@ -5958,6 +9375,24 @@ func (x *coreRPCRemoveImageServer) Send(m *RemoveImageMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CoreRPC_PlanDeploy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).PlanDeploy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/PlanDeploy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).PlanDeploy(ctx, req.(*DeployOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CoreRPC_CreateContainer_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(DeployOptions)
 	if err := stream.RecvMsg(m); err != nil {
@@ -6000,6 +9435,186 @@ func (x *coreRPCReplaceContainerServer) Send(m *ReplaceContainerMessage) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CoreRPC_SetDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeployOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).SetDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/SetDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).SetDeployment(ctx, req.(*DeployOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_RemoveDeployment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveDeploymentOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).RemoveDeployment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/RemoveDeployment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).RemoveDeployment(ctx, req.(*RemoveDeploymentOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_ListDeployments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).ListDeployments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/ListDeployments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).ListDeployments(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_SetAutoscalePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AutoscalePolicy)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).SetAutoscalePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/SetAutoscalePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).SetAutoscalePolicy(ctx, req.(*AutoscalePolicy))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_RemoveAutoscalePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveAutoscalePolicyOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).RemoveAutoscalePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/RemoveAutoscalePolicy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).RemoveAutoscalePolicy(ctx, req.(*RemoveAutoscalePolicyOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_ListAutoscalePolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).ListAutoscalePolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/ListAutoscalePolicies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).ListAutoscalePolicies(ctx, req.(*Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_ListAutoscaleDecisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAutoscaleDecisionsOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).ListAutoscaleDecisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/ListAutoscaleDecisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).ListAutoscaleDecisions(ctx, req.(*ListAutoscaleDecisionsOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Quota)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/SetQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).SetQuota(ctx, req.(*Quota))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_RemoveQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveQuotaOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).RemoveQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/RemoveQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).RemoveQuota(ctx, req.(*RemoveQuotaOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CoreRPC_ListQuotaUsages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuotaUsagesOptions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreRPCServer).ListQuotaUsages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.CoreRPC/ListQuotaUsages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreRPCServer).ListQuotaUsages(ctx, req.(*ListQuotaUsagesOptions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CoreRPC_RemoveContainer_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(RemoveContainerOptions)
 	if err := stream.RecvMsg(m); err != nil {
@@ -6181,6 +9796,10 @@ var _CoreRPC_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetPod",
 			Handler:    _CoreRPC_GetPod_Handler,
 		},
+		{
+			MethodName: "SetPodOvercommit",
+			Handler:    _CoreRPC_SetPodOvercommit_Handler,
+		},
 		{
 			MethodName: "GetPodResource",
 			Handler:    _CoreRPC_GetPodResource_Handler,
@@ -6209,6 +9828,10 @@ var _CoreRPC_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetNodeResource",
 			Handler:    _CoreRPC_GetNodeResource_Handler,
 		},
+		{
+			MethodName: "FixNodeResource",
+			Handler:    _CoreRPC_FixNodeResource_Handler,
+		},
 		{
 			MethodName: "GetContainer",
 			Handler:    _CoreRPC_GetContainer_Handler,
@@ -6229,8 +9852,78 @@ var _CoreRPC_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetContainersStatus",
 			Handler:    _CoreRPC_SetContainersStatus_Handler,
 		},
+		{
+			MethodName: "ListAuditLogs",
+			Handler:    _CoreRPC_ListAuditLogs_Handler,
+		},
+		{
+			MethodName: "SetSecret",
+			Handler:    _CoreRPC_SetSecret_Handler,
+		},
+		{
+			MethodName: "ListSecrets",
+			Handler:    _CoreRPC_ListSecrets_Handler,
+		},
+		{
+			MethodName: "RemoveSecret",
+			Handler:    _CoreRPC_RemoveSecret_Handler,
+		},
+		{
+			MethodName: "PlanDeploy",
+			Handler:    _CoreRPC_PlanDeploy_Handler,
+		},
+		{
+			MethodName: "SetDeployment",
+			Handler:    _CoreRPC_SetDeployment_Handler,
+		},
+		{
+			MethodName: "RemoveDeployment",
+			Handler:    _CoreRPC_RemoveDeployment_Handler,
+		},
+		{
+			MethodName: "ListDeployments",
+			Handler:    _CoreRPC_ListDeployments_Handler,
+		},
+		{
+			MethodName: "SetAutoscalePolicy",
+			Handler:    _CoreRPC_SetAutoscalePolicy_Handler,
+		},
+		{
+			MethodName: "RemoveAutoscalePolicy",
+			Handler:    _CoreRPC_RemoveAutoscalePolicy_Handler,
+		},
+		{
+			MethodName: "ListAutoscalePolicies",
+			Handler:    _CoreRPC_ListAutoscalePolicies_Handler,
+		},
+		{
+			MethodName: "ListAutoscaleDecisions",
+			Handler:    _CoreRPC_ListAutoscaleDecisions_Handler,
+		},
+		{
+			MethodName: "SetQuota",
+			Handler:    _CoreRPC_SetQuota_Handler,
+		},
+		{
+			MethodName: "RemoveQuota",
+			Handler:    _CoreRPC_RemoveQuota_Handler,
+		},
+		{
+			MethodName: "ListQuotaUsages",
+			Handler:    _CoreRPC_ListQuotaUsages_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "DrainNode",
+			Handler:       _CoreRPC_DrainNode_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "AuditContainers",
+			Handler:       _CoreRPC_AuditContainers_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "ListContainers",
 			Handler:       _CoreRPC_ListContainers_Handler,
@@ -6241,6 +9934,16 @@ var _CoreRPC_serviceDesc = grpc.ServiceDesc{
 			Handler:       _CoreRPC_ContainerStatusStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "WatchEvents",
+			Handler:       _CoreRPC_WatchEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "RotateSecret",
+			Handler:       _CoreRPC_RotateSecret_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Copy",
 			Handler:       _CoreRPC_Copy_Handler,
@@ -0,0 +1,45 @@
+package rpc
+
+import (
+	"context"
+	"testing"
+
+	grpcmocks "github.com/projecteru2/core/3rdmocks"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+)
+
+func TestChainInterceptors(t *testing.T) {
+	order := []string{}
+	unary := func(name string) grpc.UnaryServerInterceptor {
+		return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			order = append(order, name)
+			return handler(ctx, req)
+		}
+	}
+	interceptor := ChainUnaryInterceptors(unary("a"), unary("b"))
+	resp, err := interceptor(context.Background(), 1, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		order = append(order, "handler")
+		return req, nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, resp)
+	assert.Equal(t, []string{"a", "b", "handler"}, order)
+
+	order = []string{}
+	stream := func(name string) grpc.StreamServerInterceptor {
+		return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			order = append(order, name)
+			return handler(srv, ss)
+		}
+	}
+	streamInterceptor := ChainStreamInterceptors(stream("a"), stream("b"))
+	err = streamInterceptor(1, &grpcmocks.ServerStream{}, &grpc.StreamServerInfo{}, func(srv interface{}, ss grpc.ServerStream) error {
+		order = append(order, "handler")
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "handler"}, order)
+	// no interceptor
+	assert.NoError(t, ChainStreamInterceptors()(1, nil, nil, func(interface{}, grpc.ServerStream) error { return nil }))
+}
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/projecteru2/core/audit"
 	"github.com/projecteru2/core/cluster"
 	pb "github.com/projecteru2/core/rpc/gen"
 	"github.com/projecteru2/core/types"
@@ -18,11 +19,12 @@ import (
 // Vibranium is implementations for grpc server interface
 // Many data types should be transformed
 type Vibranium struct {
-	cluster cluster.Cluster
-	config  types.Config
-	counter sync.WaitGroup
-	rpcch   chan struct{}
-	TaskNum int
+	cluster   cluster.Cluster
+	config    types.Config
+	auditSink audit.Sink
+	counter   sync.WaitGroup
+	rpcch     chan struct{}
+	TaskNum   int
 }
 
 // AddPod saves a pod, and returns it to client
@@ -75,7 +77,12 @@ func (v *Vibranium) ListPods(ctx context.Context, _ *pb.Empty) (*pb.Pods, error)
 
 // ListPodNodes returns a list of node for pod
 func (v *Vibranium) ListPodNodes(ctx context.Context, opts *pb.ListNodesOptions) (*pb.Nodes, error) {
-	ns, err := v.cluster.ListPodNodes(ctx, opts.Podname, opts.Labels, opts.All)
+	selector, err := toCoreLabelSelector(opts.Labels, opts.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	ns, err := v.cluster.ListPodNodes(ctx, opts.Podname, selector, opts.All)
 	if err != nil {
 		return nil, err
 	}
@@ -89,12 +96,17 @@ func (v *Vibranium) ListPodNodes(ctx context.Context, opts *pb.ListNodesOptions)
 
 // ListContainers by appname with optional entrypoint and nodename
 func (v *Vibranium) ListContainers(opts *pb.ListContainersOptions, stream pb.CoreRPC_ListContainersServer) error {
+	selector, err := toCoreLabelSelector(opts.Labels, opts.Selector)
+	if err != nil {
+		return err
+	}
+
 	lsopts := &types.ListContainersOptions{
 		Appname:    opts.Appname,
 		Entrypoint: opts.Entrypoint,
 		Nodename:   opts.Nodename,
 		Limit:      opts.Limit,
-		Labels:     opts.Labels,
+		Labels:     selector,
 	}
 	ctx := stream.Context()
 
@@ -103,7 +115,7 @@ func (v *Vibranium) ListContainers(opts *pb.ListContainersOptions, stream pb.Cor
 		return err
 	}
 
-	for _, c := range toRPCContainers(ctx, containers, opts.Labels) {
+	for _, c := range toRPCContainers(ctx, containers, selector) {
 		if err = stream.Send(c); err != nil {
 			v.logUnsentMessages("ListContainers", c)
 			return err
@@ -114,7 +126,12 @@ func (v *Vibranium) ListContainers(opts *pb.ListContainersOptions, stream pb.Cor
 
 // ListNodeContainers list node containers
 func (v *Vibranium) ListNodeContainers(ctx context.Context, opts *pb.GetNodeOptions) (*pb.Containers, error) {
-	containers, err := v.cluster.ListNodeContainers(ctx, opts.Nodename, opts.Labels)
+	selector, err := toCoreLabelSelector(opts.Labels, opts.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	containers, err := v.cluster.ListNodeContainers(ctx, opts.Nodename, selector)
 	if err != nil {
 		return nil, err
 	}
@@ -145,6 +162,16 @@ func (v *Vibranium) GetPod(ctx context.Context, opts *pb.GetPodOptions) (*pb.Pod
 	return toRPCPod(p), nil
 }
 
+// SetPodOvercommit set overcommit ratios of a pod
+func (v *Vibranium) SetPodOvercommit(ctx context.Context, opts *pb.SetPodOvercommitOptions) (*pb.Pod, error) {
+	p, err := v.cluster.SetPodOvercommit(ctx, opts.Name, opts.CpuOvercommit, opts.MemoryOvercommit)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRPCPod(p), nil
+}
+
 // GetPodResource get pod nodes resource usage
 func (v *Vibranium) GetPodResource(ctx context.Context, opts *pb.GetPodOptions) (*pb.PodResource, error) {
 	r, err := v.cluster.PodResource(ctx, opts.Name)
@@ -174,6 +201,63 @@ func (v *Vibranium) GetNodeResource(ctx context.Context, opts *pb.GetNodeOptions
 	return toRPCNodeResource(nr), nil
 }
 
+// FixNodeResource recompute node resource from its containers
+func (v *Vibranium) FixNodeResource(ctx context.Context, opts *pb.FixNodeResourceOptions) (*pb.NodeResourceFix, error) {
+	fix, err := v.cluster.FixNodeResource(ctx, opts.Nodename, opts.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRPCNodeResourceFix(fix), nil
+}
+
+// DrainNode mark node unavailable and evacuate its containers
+func (v *Vibranium) DrainNode(opts *pb.DrainNodeOptions, stream pb.CoreRPC_DrainNodeServer) error {
+	v.taskAdd("DrainNode", true)
+	defer v.taskDone("DrainNode", true)
+
+	//这里不能让 client 打断迁移
+	ch, err := v.cluster.DrainNode(context.Background(), &types.DrainNodeOptions{
+		Nodename:   opts.Nodename,
+		CordonOnly: opts.CordonOnly,
+		DryRun:     opts.DryRun,
+	})
+	if err != nil {
+		return err
+	}
+
+	for m := range ch {
+		if err = stream.Send(toRPCDrainNodeMessage(m)); err != nil {
+			v.logUnsentMessages("DrainNode", m)
+		}
+	}
+
+	return err
+}
+
+// AuditContainers compare containers in engines and store, handle the difference if asked
+func (v *Vibranium) AuditContainers(opts *pb.AuditOptions, stream pb.CoreRPC_AuditContainersServer) error {
+	v.taskAdd("AuditContainers", true)
+	defer v.taskDone("AuditContainers", true)
+
+	ch, err := v.cluster.AuditContainers(stream.Context(), &types.AuditOptions{
+		Podname:      opts.Podname,
+		OrphanAction: opts.OrphanAction,
+		GhostAction:  opts.GhostAction,
+	})
+	if err != nil {
+		return err
+	}
+
+	for m := range ch {
+		if err = stream.Send(toRPCAuditNodeMessage(m)); err != nil {
+			v.logUnsentMessages("AuditContainers", m)
+		}
+	}
+
+	return err
+}
+
 // GetContainer get a container
 // More information will be shown
 func (v *Vibranium) GetContainer(ctx context.Context, id *pb.ContainerID) (*pb.Container, error) {
@@ -449,6 +533,21 @@ func (v *Vibranium) RunAndWait(stream pb.CoreRPC_RunAndWaitServer) error {
 	})
 }
 
+// PlanDeploy show how containers will be deployed, nothing will be allocated
+func (v *Vibranium) PlanDeploy(ctx context.Context, opts *pb.DeployOptions) (*pb.DeployPlan, error) {
+	deployOpts, err := toCoreDeployOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := v.cluster.PlanDeploy(ctx, deployOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRPCDeployPlan(plan), nil
+}
+
 // CreateContainer create containers
 func (v *Vibranium) CreateContainer(opts *pb.DeployOptions, stream pb.CoreRPC_CreateContainerServer) error {
 	v.taskAdd("CreateContainer", true)
@@ -464,7 +563,7 @@ func (v *Vibranium) CreateContainer(opts *pb.DeployOptions, stream pb.CoreRPC_Cr
 		// 这里考虑用全局 Background
 		ch, err := v.cluster.CreateContainer(context.Background(), deployOpts)
 		if err != nil {
-			return err
+			return toRPCError(err)
 		}
 
 		for m := range ch {
@@ -505,6 +604,106 @@ func (v *Vibranium) ReplaceContainer(opts *pb.ReplaceOptions, stream pb.CoreRPC_
 	})
 }
 
+// SetDeployment declare desired deployment, reconciler will converge containers to it
+func (v *Vibranium) SetDeployment(ctx context.Context, opts *pb.DeployOptions) (*pb.Deployment, error) {
+	deployOpts, err := toCoreDeployOptions(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	deployment, err := v.cluster.SetDeployment(ctx, deployOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return toRPCDeployment(deployment), nil
+}
+
+// RemoveDeployment remove desired deployment
+func (v *Vibranium) RemoveDeployment(ctx context.Context, opts *pb.RemoveDeploymentOptions) (*pb.Empty, error) {
+	return &pb.Empty{}, v.cluster.RemoveDeployment(ctx, opts.Appname, opts.Entrypoint)
+}
+
+// ListDeployments list desired deployments
+func (v *Vibranium) ListDeployments(ctx context.Context, _ *pb.Empty) (*pb.Deployments, error) {
+	ds, err := v.cluster.ListDeployments(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deployments := []*pb.Deployment{}
+	for _, d := range ds {
+		deployments = append(deployments, toRPCDeployment(d))
+	}
+
+	return &pb.Deployments{Deployments: deployments}, nil
+}
+
+// SetAutoscalePolicy set autoscale policy of a desired deployment
+func (v *Vibranium) SetAutoscalePolicy(ctx context.Context, opts *pb.AutoscalePolicy) (*pb.Empty, error) {
+	return &pb.Empty{}, v.cluster.SetAutoscalePolicy(ctx, toCoreAutoscalePolicy(opts))
+}
+
+// RemoveAutoscalePolicy remove autoscale policy
+func (v *Vibranium) RemoveAutoscalePolicy(ctx context.Context, opts *pb.RemoveAutoscalePolicyOptions) (*pb.Empty, error) {
+	return &pb.Empty{}, v.cluster.RemoveAutoscalePolicy(ctx, opts.Appname, opts.Entrypoint)
+}
+
+// ListAutoscalePolicies list autoscale policies
+func (v *Vibranium) ListAutoscalePolicies(ctx context.Context, _ *pb.Empty) (*pb.AutoscalePolicies, error) {
+	ps, err := v.cluster.ListAutoscalePolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := []*pb.AutoscalePolicy{}
+	for _, p := range ps {
+		policies = append(policies, toRPCAutoscalePolicy(p))
+	}
+
+	return &pb.AutoscalePolicies{Policies: policies}, nil
+}
+
+// ListAutoscaleDecisions list recent autoscale decisions
+func (v *Vibranium) ListAutoscaleDecisions(ctx context.Context, opts *pb.ListAutoscaleDecisionsOptions) (*pb.AutoscaleDecisions, error) {
+	ds, err := v.cluster.ListAutoscaleDecisions(ctx, opts.Appname, opts.Entrypoint, opts.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := []*pb.AutoscaleDecision{}
+	for _, d := range ds {
+		decisions = append(decisions, toRPCAutoscaleDecision(d))
+	}
+
+	return &pb.AutoscaleDecisions{Decisions: decisions}, nil
+}
+
+// SetQuota set resource quota of an app
+func (v *Vibranium) SetQuota(ctx context.Context, opts *pb.Quota) (*pb.Empty, error) {
+	return &pb.Empty{}, v.cluster.SetQuota(ctx, toCoreQuota(opts))
+}
+
+// RemoveQuota remove resource quota of an app
+func (v *Vibranium) RemoveQuota(ctx context.Context, opts *pb.RemoveQuotaOptions) (*pb.Empty, error) {
+	return &pb.Empty{}, v.cluster.RemoveQuota(ctx, opts.Appname, opts.Podname)
+}
+
+// ListQuotaUsages list quotas with current usage
+func (v *Vibranium) ListQuotaUsages(ctx context.Context, opts *pb.ListQuotaUsagesOptions) (*pb.QuotaUsages, error) {
+	us, err := v.cluster.ListQuotaUsages(ctx, opts.Appname)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := []*pb.QuotaUsage{}
+	for _, u := range us {
+		usages = append(usages, toRPCQuotaUsage(u))
+	}
+
+	return &pb.QuotaUsages{Usages: usages}, nil
+}
+
 // RemoveContainer remove containers
 func (v *Vibranium) RemoveContainer(opts *pb.RemoveContainerOptions, stream pb.CoreRPC_RemoveContainerServer) error {
 	v.taskAdd("RemoveContainer", true)
@@ -680,9 +879,14 @@ func (v *Vibranium) ContainerStatusStream(opts *pb.ContainerStatusStreamOptions,
 	log.Infof("[rpc] ContainerStatusStream start %s", opts.Appname)
 	defer log.Infof("[rpc] ContainerStatusStream stop %s", opts.Appname)
 
+	selector, err := toCoreLabelSelector(opts.Labels, opts.Selector)
+	if err != nil {
+		return err
+	}
+
 	ch := v.cluster.ContainerStatusStream(
 		stream.Context(),
-		opts.Appname, opts.Entrypoint, opts.Nodename, opts.Labels,
+		opts.Appname, opts.Entrypoint, opts.Nodename, selector,
 	)
 	for {
 		select {
@@ -710,6 +914,98 @@ func (v *Vibranium) ContainerStatusStream(opts *pb.ContainerStatusStreamOptions,
 	}
 }
 
+// WatchEvents watch events of core
+func (v *Vibranium) WatchEvents(opts *pb.WatchEventsOptions, stream pb.CoreRPC_WatchEventsServer) error {
+	log.Infof("[rpc] WatchEvents start %v", opts.Kinds)
+	defer log.Infof("[rpc] WatchEvents stop %v", opts.Kinds)
+
+	ch := v.cluster.WatchEvents(stream.Context(), &types.WatchEventsOptions{
+		Kinds:    opts.Kinds,
+		Appname:  opts.Appname,
+		Podname:  opts.Podname,
+		Nodename: opts.Nodename,
+		Since:    opts.Since,
+	})
+	for {
+		select {
+		case m, ok := <-ch:
+			if !ok {
+				return nil
+			}
+			if err := stream.Send(toRPCEvent(m)); err != nil {
+				v.logUnsentMessages("WatchEvents", m)
+			}
+		case <-v.rpcch:
+			return nil
+		}
+	}
+}
+
+// ListAuditLogs list audit logs by time range and user, newest first
+func (v *Vibranium) ListAuditLogs(ctx context.Context, opts *pb.ListAuditLogsOptions) (*pb.AuditLogs, error) {
+	if v.auditSink == nil {
+		return nil, types.ErrAuditLogDisabled
+	}
+	logs, err := v.auditSink.Query(ctx, &types.AuditLogQuery{
+		Start:    opts.Start,
+		End:      opts.End,
+		Username: opts.Username,
+		Limit:    int(opts.Limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toRPCAuditLogs(logs), nil
+}
+
+// SetSecret create or update a secret
+func (v *Vibranium) SetSecret(ctx context.Context, opts *pb.SetSecretOptions) (*pb.Secret, error) {
+	secret, err := v.cluster.SetSecret(ctx, &types.SetSecretOptions{Name: opts.Name, Value: opts.Value, Apps: opts.Apps})
+	if err != nil {
+		return nil, err
+	}
+	return toRPCSecret(secret), nil
+}
+
+// ListSecrets list secrets, values are never returned
+func (v *Vibranium) ListSecrets(ctx context.Context, _ *pb.Empty) (*pb.Secrets, error) {
+	ss, err := v.cluster.ListSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := []*pb.Secret{}
+	for _, s := range ss {
+		secrets = append(secrets, toRPCSecret(s))
+	}
+
+	return &pb.Secrets{Secrets: secrets}, nil
+}
+
+// RemoveSecret remove a secret not in use
+func (v *Vibranium) RemoveSecret(ctx context.Context, opts *pb.RemoveSecretOptions) (*pb.Empty, error) {
+	return &pb.Empty{}, v.cluster.RemoveSecret(ctx, opts.Name)
+}
+
+// RotateSecret update value of a secret, and replace containers referencing it if asked
+func (v *Vibranium) RotateSecret(opts *pb.RotateSecretOptions, stream pb.CoreRPC_RotateSecretServer) error {
+	v.taskAdd("RotateSecret", true)
+	defer v.taskDone("RotateSecret", true)
+
+	// 这里考虑用全局 Background
+	ch, err := v.cluster.RotateSecret(context.Background(), &types.RotateSecretOptions{Name: opts.Name, Value: opts.Value, Replace: opts.Replace})
+	if err != nil {
+		return err
+	}
+
+	for m := range ch {
+		if err = stream.Send(toRPCReplaceContainerMessage(m)); err != nil {
+			v.logUnsentMessages("RotateSecret", m)
+		}
+	}
+	return nil
+}
+
 // ExecuteContainer runs a command in a running container
 func (v *Vibranium) ExecuteContainer(stream pb.CoreRPC_ExecuteContainerServer) (err error) {
 	v.taskAdd("ExecuteContainer", true)
@@ -753,6 +1049,6 @@ func (v *Vibranium) logUnsentMessages(msgType string, msg interface{}) {
 }
 
 // New will new a new cluster instance
-func New(cluster cluster.Cluster, config types.Config, rpcch chan struct{}) *Vibranium {
-	return &Vibranium{cluster: cluster, config: config, counter: sync.WaitGroup{}, rpcch: rpcch}
+func New(cluster cluster.Cluster, config types.Config, auditSink audit.Sink, rpcch chan struct{}) *Vibranium {
+	return &Vibranium{cluster: cluster, config: config, auditSink: auditSink, counter: sync.WaitGroup{}, rpcch: rpcch}
 }
@@ -5,6 +5,7 @@ import (
 
 	"context"
 
+	"github.com/projecteru2/core/audit"
 	clustermock "github.com/projecteru2/core/cluster/mocks"
 	enginemock "github.com/projecteru2/core/engine/mocks"
 	enginetypes "github.com/projecteru2/core/engine/types"
@@ -12,6 +13,9 @@ import (
 	"github.com/projecteru2/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func newVibranium() *Vibranium {
@@ -58,3 +62,33 @@ func TestAddNode(t *testing.T) {
 	_, err = v.AddNode(context.Background(), opts)
 	assert.NoError(t, err)
 }
+
+func TestToRPCError(t *testing.T) {
+	assert.Equal(t, types.ErrNoETCD, toRPCError(types.ErrNoETCD))
+
+	err := toRPCError(types.NewScheduleError(types.ErrInsufficientMEM, map[string]string{"n2": "capacity 1", "n1": "memory short by 1 bytes"}))
+	st, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.ResourceExhausted, st.Code())
+	assert.Len(t, st.Details(), 1)
+	failure := st.Details()[0].(*errdetails.PreconditionFailure)
+	assert.Len(t, failure.Violations, 2)
+	assert.Equal(t, "n1", failure.Violations[0].Subject)
+	assert.Equal(t, "memory short by 1 bytes", failure.Violations[0].Description)
+}
+
+func TestListAuditLogs(t *testing.T) {
+	v := newVibranium()
+	ctx := context.Background()
+	// disabled
+	_, err := v.ListAuditLogs(ctx, &pb.ListAuditLogsOptions{})
+	assert.Equal(t, types.ErrAuditLogDisabled, err)
+
+	cluster := v.cluster.(*clustermock.Cluster)
+	v.auditSink = audit.NewStoreSink(cluster)
+	cluster.On("ListAuditLogs", mock.Anything, &types.AuditLogQuery{Start: 1, End: 2, Username: "u1", Limit: 3}).Return([]*types.AuditLog{{Method: "AddPod", Username: "u1"}}, nil)
+	logs, err := v.ListAuditLogs(ctx, &pb.ListAuditLogsOptions{Start: 1, End: 2, Username: "u1", Limit: 3})
+	assert.NoError(t, err)
+	assert.Len(t, logs.Logs, 1)
+	assert.Equal(t, "AddPod", logs.Logs[0].Method)
+}
@@ -3,6 +3,8 @@ package rpc
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
+	"sort"
 
 	enginetypes "github.com/projecteru2/core/engine/types"
 	pb "github.com/projecteru2/core/rpc/gen"
@@ -10,8 +12,38 @@ import (
 	"github.com/projecteru2/core/utils"
 	log "github.com/sirupsen/logrus"
 	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
+// toRPCError attaches rejection reasons of nodes as precondition failure details
+func toRPCError(err error) error {
+	se := &types.ScheduleError{}
+	if !errors.As(err, &se) {
+		return err
+	}
+	nodenames := []string{}
+	for nodename := range se.Reasons {
+		nodenames = append(nodenames, nodename)
+	}
+	sort.Strings(nodenames)
+	failure := &errdetails.PreconditionFailure{}
+	for _, nodename := range nodenames {
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        "NODE",
+			Subject:     nodename,
+			Description: se.Reasons[nodename],
+		})
+	}
+	st, e := status.New(codes.ResourceExhausted, err.Error()).WithDetails(failure)
+	if e != nil {
+		log.Errorf("[toRPCError] attach details failed %v", e)
+		return err
+	}
+	return st.Err()
+}
+
 func toRPCCPUMap(m types.CPUMap) map[string]int32 {
 	cpu := make(map[string]int32)
 	for label, value := range m {
@@ -21,17 +53,19 @@ func toRPCCPUMap(m types.CPUMap) map[string]int32 {
 }
 
 func toRPCPod(p *types.Pod) *pb.Pod {
-	return &pb.Pod{Name: p.Name, Desc: p.Desc}
+	return &pb.Pod{Name: p.Name, Desc: p.Desc, CpuOvercommit: p.CPUOvercommit, MemoryOvercommit: p.MemoryOvercommit}
 }
 
 func toRPCPodResource(p *types.PodResource) *pb.PodResource {
 	r := &pb.PodResource{
-		Name:            p.Name,
-		CpuPercents:     p.CPUPercents,
-		MemoryPercents:  p.MemoryPercents,
-		StoragePercents: p.StoragePercents,
-		Verifications:   p.Verifications,
-		Details:         p.Details,
+		Name:                     p.Name,
+		CpuPercents:              p.CPUPercents,
+		MemoryPercents:           p.MemoryPercents,
+		StoragePercents:          p.StoragePercents,
+		CpuOvercommitPercents:    p.CPUOvercommitPercents,
+		MemoryOvercommitPercents: p.MemoryOvercommitPercents,
+		Verifications:            p.Verifications,
+		Details:                  p.Details,
 	}
 	return r
 }
@@ -50,38 +84,55 @@ func toRPCNode(ctx context.Context, n *types.Node) *pb.Node {
 	}
 
 	return &pb.Node{
-		Name:        n.Name,
-		Endpoint:    n.Endpoint,
-		Podname:     n.Podname,
-		Cpu:         toRPCCPUMap(n.CPU),
-		CpuUsed:     n.CPUUsed,
-		Memory:      n.MemCap,
-		MemoryUsed:  n.InitMemCap - n.MemCap,
-		Storage:     n.StorageCap,
-		StorageUsed: n.StorageUsed(),
-		Volume:      n.Volume,
-		VolumeUsed:  int64(n.VolumeUsed),
-		Available:   n.Available,
-		Labels:      n.Labels,
-		InitCpu:     toRPCCPUMap(n.InitCPU),
-		InitMemory:  n.InitMemCap,
-		InitStorage: n.InitStorageCap,
-		InitVolume:  n.InitVolume,
-		Info:        nodeInfo,
-		Numa:        n.NUMA,
-		NumaMemory:  n.NUMAMemory,
+		Name:          n.Name,
+		Endpoint:      n.Endpoint,
+		Podname:       n.Podname,
+		Cpu:           toRPCCPUMap(n.CPU),
+		CpuUsed:       n.CPUUsed,
+		Memory:        n.MemCap,
+		MemoryUsed:    n.InitMemCap - n.MemCap,
+		Storage:       n.StorageCap,
+		StorageUsed:   n.StorageUsed(),
+		Volume:        n.Volume,
+		VolumeUsed:    int64(n.VolumeUsed),
+		Available:     n.Available,
+		Labels:        n.Labels,
+		InitCpu:       toRPCCPUMap(n.InitCPU),
+		InitMemory:    n.InitMemCap,
+		InitStorage:   n.InitStorageCap,
+		InitVolume:    n.InitVolume,
+		Info:          nodeInfo,
+		Numa:          n.NUMA,
+		NumaMemory:    n.NUMAMemory,
+		InitDevices:   toRPCDeviceMap(n.InitDevices),
+		Devices:       toRPCDeviceMap(n.Devices),
+		Bandwidth:     n.Bandwidth,
+		InitBandwidth: n.InitBandwidth,
+		Unreachable:   n.Unreachable,
 	}
 }
 
 func toRPCNodeResource(nr *types.NodeResource) *pb.NodeResource {
 	return &pb.NodeResource{
-		Name:           nr.Name,
-		CpuPercent:     nr.CPUPercent,
-		MemoryPercent:  nr.MemoryPercent,
-		StoragePercent: nr.StoragePercent,
-		VolumePercent:  nr.VolumePercent,
-		Verification:   nr.Verification,
-		Details:        nr.Details,
+		Name:                    nr.Name,
+		CpuPercent:              nr.CPUPercent,
+		MemoryPercent:           nr.MemoryPercent,
+		StoragePercent:          nr.StoragePercent,
+		VolumePercent:           nr.VolumePercent,
+		CpuOvercommitPercent:    nr.CPUOvercommitPercent,
+		MemoryOvercommitPercent: nr.MemoryOvercommitPercent,
+		BandwidthPercent:        nr.BandwidthPercent,
+		Verification:            nr.Verification,
+		Details:                 nr.Details,
+	}
+}
+
+func toRPCNodeResourceFix(fix *types.NodeResourceFix) *pb.NodeResourceFix {
+	return &pb.NodeResourceFix{
+		Name:              fix.Name,
+		Diffs:             fix.Diffs,
+		MissingContainers: fix.MissingContainers,
+		Fixed:             fix.Fixed,
 	}
 }
 
@@ -124,10 +175,12 @@ func toCoreAddNodeOptions(b *pb.AddNodeOptions) *types.AddNodeOptions {
 		Share:      int(b.Share),
 		Memory:     b.Memory,
 		Storage:    b.Storage,
+		Bandwidth:  b.Bandwidth,
 		Labels:     b.Labels,
 		Numa:       b.Numa,
 		NumaMemory: b.NumaMemory,
 		Volume:     types.VolumeMap{},
+		Devices:    toCoreDeviceMap(b.Devices),
 	}
 	return r
 }
@@ -139,10 +192,12 @@ func toCoreSetNodeOptions(b *pb.SetNodeOptions) (*types.SetNodeOptions, error) {
 		DeltaCPU:        types.CPUMap{},
 		DeltaMemory:     b.DeltaMemory,
 		DeltaStorage:    b.DeltaStorage,
+		DeltaBandwidth:  b.DeltaBandwidth,
 		DeltaNUMAMemory: b.DeltaNumaMemory,
 		DeltaVolume:     b.DeltaVolume,
 		NUMA:            b.Numa,
 		Labels:          b.Labels,
+		Devices:         toCoreDeviceMap(b.Devices),
 	}
 	for cpuID, cpuShare := range b.DeltaCpu {
 		r.DeltaCPU[cpuID] = int64(cpuShare)
@@ -194,15 +249,37 @@ func toCoreBuildOptions(b *pb.BuildImageOptions) (*enginetypes.BuildOptions, err
 func toCoreReplaceOptions(r *pb.ReplaceOptions) (*types.ReplaceOptions, error) {
 	deployOpts, err := toCoreDeployOptions(r.DeployOpt)
 
-	replaceOpts := &types.ReplaceOptions{
-		DeployOptions:  *deployOpts,
-		NetworkInherit: r.Networkinherit,
-		FilterLabels:   r.FilterLabels,
-		Copy:           r.Copy,
-		IDs:            r.Ids,
+	if err != nil {
+		return nil, err
+	}
+	filterLabels, err := toCoreLabelSelector(r.FilterLabels, r.FilterSelector)
+	if err != nil {
+		return nil, err
 	}
 
-	return replaceOpts, err
+	replaceOpts := &types.ReplaceOptions{
+		DeployOptions:      *deployOpts,
+		NetworkInherit:     r.Networkinherit,
+		FilterLabels:       filterLabels,
+		Copy:               r.Copy,
+		IDs:                r.Ids,
+		BatchSize:          int(r.BatchSize),
+		MaxUnavailable:     int(r.MaxUnavailable),
+		BatchInterval:      int(r.BatchInterval),
+		HealthCheckTimeout: int(r.HealthCheckTimeout),
+		Rollback:           r.Rollback,
+	}
+
+	return replaceOpts, nil
+}
+
+// toCoreLabelSelector legacy labels and selector expression are both matched
+func toCoreLabelSelector(labels map[string]string, expr string) (types.LabelSelector, error) {
+	selector, err := types.ParseLabelSelector(expr)
+	if err != nil {
+		return nil, err
+	}
+	return append(types.MakeLabelSelector(labels), selector...), nil
 }
 
 func toCoreDeployOptions(d *pb.DeployOptions) (*types.DeployOptions, error) {
@@ -248,6 +325,16 @@ func toCoreDeployOptions(d *pb.DeployOptions) (*types.DeployOptions, error) {
 		return nil, err
 	}
 
+	nodeLabels, err := toCoreLabelSelector(d.Nodelabels, d.NodeSelector)
+	if err != nil {
+		return nil, err
+	}
+
+	devices := map[string]int{}
+	for kind, count := range d.Devices {
+		devices[kind] = int(count)
+	}
+
 	return &types.DeployOptions{
 		Name:         d.Name,
 		Entrypoint:   entry,
@@ -259,6 +346,7 @@ func toCoreDeployOptions(d *pb.DeployOptions) (*types.DeployOptions, error) {
 		CPUBind:      d.CpuBind,
 		Memory:       d.Memory,
 		Storage:      d.Storage + vbs.AdditionalStorage(),
+		Bandwidth:    d.Bandwidth,
 		Count:        int(d.Count),
 		Env:          d.Env,
 		DNS:          d.Dns,
@@ -270,16 +358,34 @@ func toCoreDeployOptions(d *pb.DeployOptions) (*types.DeployOptions, error) {
 		Debug:        d.Debug,
 		OpenStdin:    d.OpenStdin,
 		Labels:       d.Labels,
-		NodeLabels:   d.Nodelabels,
+		NodeLabels:   nodeLabels,
 		DeployMethod: d.DeployMethod,
 		SoftLimit:    d.SoftLimit,
 		NodesLimit:   int(d.NodesLimit),
 		IgnoreHook:   d.IgnoreHook,
 		AfterCreate:  d.AfterCreate,
 		RawArgs:      d.RawArgs,
+		AllOrNothing: d.AllOrNothing,
+		WaitHealthy:  int(d.WaitHealthy),
+		Affinity:     toCoreAffinity(d.Affinity),
+		Priority:     int(d.Priority),
+		Devices:      devices,
+		SecretEnv:    d.SecretEnv,
+		SecretData:   d.SecretData,
 	}, nil
 }
 
+func toCoreAffinity(a *pb.Affinity) *types.Affinity {
+	if a == nil {
+		return nil
+	}
+	return &types.Affinity{
+		MaxPerNode:  int(a.MaxPerNode),
+		SpreadLabel: a.SpreadLabel,
+		Colocate:    a.Colocate,
+	}
+}
+
 func toRPCVolumePlan(v types.VolumePlan) map[string]*pb.Volume {
 	if v == nil {
 		return nil
@@ -292,6 +398,138 @@ func toRPCVolumePlan(v types.VolumePlan) map[string]*pb.Volume {
 	return msg
 }
 
+func toRPCDeviceMap(d types.DeviceMap) map[string]*pb.Devices {
+	if d == nil {
+		return nil
+	}
+
+	msg := map[string]*pb.Devices{}
+	for kind, IDs := range d {
+		msg[kind] = &pb.Devices{Ids: IDs}
+	}
+	return msg
+}
+
+func toCoreDeviceMap(d map[string]*pb.Devices) types.DeviceMap {
+	r := types.DeviceMap{}
+	for kind, devices := range d {
+		r[kind] = []string{}
+		if devices != nil {
+			r[kind] = append(r[kind], devices.Ids...)
+		}
+	}
+	return r
+}
+
+func toRPCDeployPlan(p *types.DeployPlan) *pb.DeployPlan {
+	plan := &pb.DeployPlan{
+		Total:      int32(p.Total),
+		Nodes:      []*pb.NodeDeployPlan{},
+		NodeErrors: map[string]string{},
+	}
+	for _, nodeInfo := range p.NodesInfo {
+		nodePlan := &pb.NodeDeployPlan{
+			Nodename: nodeInfo.Name,
+			Deploy:   int32(nodeInfo.Deploy),
+			Capacity: int32(nodeInfo.Capacity),
+			Count:    int32(nodeInfo.Count),
+			Score:    nodeInfo.Score,
+		}
+		for _, cpu := range nodeInfo.CPUPlan {
+			nodePlan.CpuPlans = append(nodePlan.CpuPlans, &pb.CPUPlan{Cpu: toRPCCPUMap(cpu)})
+		}
+		for _, volumePlan := range nodeInfo.VolumePlans {
+			nodePlan.VolumePlans = append(nodePlan.VolumePlans, &pb.VolumePlan{VolumePlan: toRPCVolumePlan(volumePlan)})
+		}
+		for _, devicePlan := range nodeInfo.DevicePlans {
+			nodePlan.DevicePlans = append(nodePlan.DevicePlans, &pb.DevicePlan{Devices: toRPCDeviceMap(devicePlan)})
+		}
+		plan.Nodes = append(plan.Nodes, nodePlan)
+	}
+	if p.Error != nil {
+		plan.Error = p.Error.Error()
+	}
+	for nodename, err := range p.NodeErrors {
+		plan.NodeErrors[nodename] = err.Error()
+	}
+	return plan
+}
+
+func toRPCDeployment(d *types.Deployment) *pb.Deployment {
+	return &pb.Deployment{
+		Appname:    d.Appname,
+		Entrypoint: d.Entrypoint,
+		Podname:    d.Podname,
+		Count:      int32(d.Count),
+	}
+}
+
+func toCoreAutoscalePolicy(p *pb.AutoscalePolicy) *types.AutoscalePolicy {
+	return &types.AutoscalePolicy{
+		Appname:    p.Appname,
+		Entrypoint: p.Entrypoint,
+		Min:        int(p.Min),
+		Max:        int(p.Max),
+		Metric:     p.Metric,
+		Threshold:  p.Threshold,
+		Cooldown:   p.Cooldown,
+	}
+}
+
+func toRPCAutoscalePolicy(p *types.AutoscalePolicy) *pb.AutoscalePolicy {
+	return &pb.AutoscalePolicy{
+		Appname:    p.Appname,
+		Entrypoint: p.Entrypoint,
+		Min:        int32(p.Min),
+		Max:        int32(p.Max),
+		Metric:     p.Metric,
+		Threshold:  p.Threshold,
+		Cooldown:   p.Cooldown,
+	}
+}
+
+func toRPCAutoscaleDecision(d *types.AutoscaleDecision) *pb.AutoscaleDecision {
+	return &pb.AutoscaleDecision{
+		Appname:    d.Appname,
+		Entrypoint: d.Entrypoint,
+		Time:       d.Time,
+		From:       int32(d.From),
+		To:         int32(d.To),
+		Value:      d.Value,
+		Error:      d.Error,
+	}
+}
+
+func toCoreQuota(q *pb.Quota) *types.Quota {
+	return &types.Quota{
+		Appname: q.Appname,
+		Podname: q.Podname,
+		QuotaResource: types.QuotaResource{
+			CPU:     q.Cpu,
+			Memory:  q.Memory,
+			Storage: q.Storage,
+			Count:   int(q.Count),
+		},
+	}
+}
+
+func toRPCQuotaUsage(u *types.QuotaUsage) *pb.QuotaUsage {
+	return &pb.QuotaUsage{
+		Quota: &pb.Quota{
+			Appname: u.Quota.Appname,
+			Podname: u.Quota.Podname,
+			Cpu:     u.Quota.CPU,
+			Memory:  u.Quota.Memory,
+			Storage: u.Quota.Storage,
+			Count:   int32(u.Quota.Count),
+		},
+		Cpu:     u.Used.CPU,
+		Memory:  u.Used.Memory,
+		Storage: u.Used.Storage,
+		Count:   int32(u.Used.Count),
+	}
+}
+
 func toRPCCreateContainerMessage(c *types.CreateContainerMessage) *pb.CreateContainerMessage {
 	if c == nil {
 		return nil
@@ -309,6 +547,10 @@ func toRPCCreateContainerMessage(c *types.CreateContainerMessage) *pb.CreateCont
 		VolumePlan: toRPCVolumePlan(c.VolumePlan),
 		Publish:    utils.EncodePublishInfo(c.Publish),
 		Hook:       types.HookOutput(c.Hook),
+		RolledBack: c.RolledBack,
+		Evicted:    toRPCRemoveContainerMessage(c.Evicted),
+		Devices:    toRPCDeviceMap(c.Devices),
+		Bandwidth:  c.Bandwidth,
 	}
 	if c.Error != nil {
 		msg.Error = c.Error.Error()
@@ -321,6 +563,14 @@ func toRPCReplaceContainerMessage(r *types.ReplaceContainerMessage) *pb.ReplaceC
 		Create: toRPCCreateContainerMessage(r.Create),
 		Remove: toRPCRemoveContainerMessage(r.Remove),
 	}
+	if r.Batch != nil {
+		msg.Batch = &pb.ReplaceBatchMessage{
+			Index: int32(r.Batch.Index),
+			Total: int32(r.Batch.Total),
+			Done:  r.Batch.Done,
+			Ids:   r.Batch.IDs,
+		}
+	}
 	if r.Error != nil {
 		msg.Error = r.Error.Error()
 	}
@@ -373,6 +623,75 @@ func toRPCRemoveContainerMessage(r *types.RemoveContainerMessage) *pb.RemoveCont
 	}
 }
 
+func toRPCEvent(e *types.Event) *pb.Event {
+	return &pb.Event{
+		Kind:         e.Kind,
+		Action:       e.Action,
+		Target:       e.Target,
+		ProcessIdent: e.ProcessIdent,
+		Appname:      e.Appname,
+		Podname:      e.Podname,
+		Nodename:     e.Nodename,
+		Success:      e.Success,
+		Error:        e.Error,
+		Time:         e.Time,
+	}
+}
+
+func toRPCAuditLogs(logs []*types.AuditLog) *pb.AuditLogs {
+	r := &pb.AuditLogs{Logs: []*pb.AuditLog{}}
+	for _, l := range logs {
+		r.Logs = append(r.Logs, &pb.AuditLog{
+			Time:     l.Time,
+			Method:   l.Method,
+			Username: l.Username,
+			Peer:     l.Peer,
+			Request:  l.Request,
+			Duration: l.Duration,
+			Success:  l.Success,
+			Error:    l.Error,
+		})
+	}
+	return r
+}
+
+func toRPCSecret(s *types.Secret) *pb.Secret {
+	return &pb.Secret{
+		Name:      s.Name,
+		Apps:      s.Apps,
+		Version:   s.Version,
+		UpdatedAt: s.UpdatedAt,
+	}
+}
+
+func toRPCDrainNodeMessage(m *types.DrainNodeMessage) *pb.DrainNodeMessage {
+	msg := &pb.DrainNodeMessage{
+		Id:          m.ContainerID,
+		NewId:       m.NewContainerID,
+		NewNodename: m.NewNodename,
+		Remove:      toRPCRemoveContainerMessage(m.Remove),
+		Success:     m.Success,
+	}
+	if m.Error != nil {
+		msg.Error = m.Error.Error()
+	}
+	return msg
+}
+
+func toRPCAuditNodeMessage(m *types.AuditNodeMessage) *pb.AuditNodeMessage {
+	msg := &pb.AuditNodeMessage{
+		Nodename:    m.Nodename,
+		Orphans:     m.Orphans,
+		Ghosts:      m.Ghosts,
+		Handled:     m.Handled,
+		Unsupported: m.Unsupported,
+	}
+	if m.Error != nil {
+		msg.Error = m.Error.Error()
+	}
+	return msg
+}
+
 func toRPCDissociateContainerMessage(r *types.DissociateContainerMessage) *pb.DissociateContainerMessage {
 	resp := &pb.DissociateContainerMessage{
 		Id: r.ContainerID,
@@ -413,7 +732,7 @@ func toRPCContainersStatus(containersStatus []*types.StatusMeta) []*pb.Container
 	return r
 }
 
-func toRPCContainers(ctx context.Context, containers []*types.Container, labels map[string]string) []*pb.Container {
+func toRPCContainers(ctx context.Context, containers []*types.Container, selector types.LabelSelector) []*pb.Container {
 	cs := []*pb.Container{}
 	for _, c := range containers {
 		pContainer, err := toRPCContainer(ctx, c)
@@ -421,7 +740,7 @@ func toRPCContainers(ctx context.Context, containers []*types.Container, labels
 			log.Errorf("[toRPCContainers] trans to pb container failed %v", err)
 			continue
 		}
-		if !utils.FilterContainer(pContainer.Labels, labels) {
+		if !selector.Matches(pContainer.Labels) {
 			continue
 		}
 		cs = append(cs, pContainer)
@@ -454,6 +773,11 @@ func toRPCContainer(ctx context.Context, c *types.Container) (*pb.Container, err
 		Volumes:    c.Volumes.ToStringSlice(false, false),
 		VolumePlan: toRPCVolumePlan(c.VolumePlan),
 		Status:     toRPCContainerStatus(c.StatusMeta),
+		Priority:   int32(c.Priority),
+		Devices:    toRPCDeviceMap(c.Devices),
+		Bandwidth:  c.Bandwidth,
+		SecretEnv:  c.SecretEnv,
+		SecretData: c.SecretData,
 	}, nil
 }
 
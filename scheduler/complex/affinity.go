@@ -0,0 +1,52 @@
+package complexscheduler
+
+import (
+	"fmt"
+
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// AffinityPlan filter nodes by affinity and cut their capacity
+func AffinityPlan(nodesInfo []types.NodeInfo, affinity *types.Affinity) ([]types.NodeInfo, int, error) {
+	log.Debugf("[AffinityPlan] affinity %v", affinity)
+	result := []types.NodeInfo{}
+	reasons := map[string]string{}
+	total := 0
+	for _, nodeInfo := range nodesInfo {
+		if reason := affinityRejection(nodeInfo, affinity); reason != "" {
+			reasons[nodeInfo.Name] = reason
+			continue
+		}
+		if affinity.MaxPerNode > 0 {
+			nodeInfo.Capacity = utils.Min(nodeInfo.Capacity, affinity.MaxPerNode-nodeInfo.Count)
+		}
+		if nodeInfo.Capacity <= 0 {
+			reasons[nodeInfo.Name] = fmt.Sprintf("max %d per node reached", affinity.MaxPerNode)
+			continue
+		}
+		total += nodeInfo.Capacity
+		result = append(result, nodeInfo)
+	}
+	if len(result) == 0 {
+		return nil, 0, types.NewScheduleError(types.ErrAffinityNotFit, reasons)
+	}
+	return result, total, nil
+}
+
+// affinityRejection returns why node doesn't fit affinity, empty if fit
+func affinityRejection(nodeInfo types.NodeInfo, affinity *types.Affinity) string {
+	// 没有这个 label 的节点无法参与打散
+	if affinity.SpreadLabel != "" {
+		if _, ok := nodeInfo.Labels[affinity.SpreadLabel]; !ok {
+			return fmt.Sprintf("missing spread label %s", affinity.SpreadLabel)
+		}
+	}
+	for _, appname := range affinity.Colocate {
+		if nodeInfo.Colocated[appname] <= 0 {
+			return fmt.Sprintf("no %s containers to colocate", appname)
+		}
+	}
+	return ""
+}
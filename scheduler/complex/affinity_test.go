@@ -0,0 +1,51 @@
+package complexscheduler
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAffinityPlan(t *testing.T) {
+	nodesInfo := []types.NodeInfo{
+		{Name: "n1", Capacity: 5, Count: 0, Labels: map[string]string{"rack": "r1"}, Colocated: map[string]int{"db": 1}},
+		{Name: "n2", Capacity: 5, Count: 2, Labels: map[string]string{"rack": "r2"}, Colocated: map[string]int{"db": 0}},
+		{Name: "n3", Capacity: 5, Count: 1},
+	}
+
+	// max per node
+	r, total, err := AffinityPlan(nodesInfo, &types.Affinity{MaxPerNode: 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 3, total)
+	assert.Len(t, r, 2)
+	assert.Equal(t, 2, r[0].Capacity)
+	assert.Equal(t, 1, r[1].Capacity)
+	// origin not changed
+	assert.Equal(t, 5, nodesInfo[0].Capacity)
+
+	// spread label
+	r, total, err = AffinityPlan(nodesInfo, &types.Affinity{SpreadLabel: "rack"})
+	assert.NoError(t, err)
+	assert.Equal(t, 10, total)
+	assert.Len(t, r, 2)
+
+	// colocate
+	r, _, err = AffinityPlan(nodesInfo, &types.Affinity{Colocate: []string{"db"}})
+	assert.NoError(t, err)
+	assert.Len(t, r, 1)
+	assert.Equal(t, "n1", r[0].Name)
+
+	// nothing fit
+	_, _, err = AffinityPlan(nodesInfo, &types.Affinity{Colocate: []string{"cache"}})
+	assert.True(t, errors.Is(err, types.ErrAffinityNotFit))
+	assert.Equal(t, "no cache containers to colocate", err.(*types.ScheduleError).Reasons["n1"])
+
+	// max per node
+	_, _, err = AffinityPlan(nodesInfo, &types.Affinity{MaxPerNode: 1, SpreadLabel: "rack"})
+	assert.NoError(t, err)
+	_, _, err = AffinityPlan(nodesInfo[1:], &types.Affinity{MaxPerNode: 1})
+	assert.Equal(t, "max 1 per node reached", err.(*types.ScheduleError).Reasons["n3"])
+	assert.Equal(t, "missing spread label rack", affinityRejection(nodesInfo[2], &types.Affinity{SpreadLabel: "rack"}))
+}
@@ -19,7 +19,9 @@ func AveragePlan(nodesInfo []types.NodeInfo, need, limit int) ([]types.NodeInfo,
 	sort.Slice(nodesInfo, func(i, j int) bool { return nodesInfo[i].Capacity < nodesInfo[j].Capacity })
 	p := sort.Search(nodesInfoLength, func(i int) bool { return nodesInfo[i].Capacity >= need })
 	if p == nodesInfoLength {
-		return nil, types.ErrInsufficientCap
+		return nil, types.NewScheduleError(types.ErrInsufficientCap, makeRejections(nodesInfo, func(nodeInfo types.NodeInfo) string {
+			return fmt.Sprintf("capacity %d less than %d", nodeInfo.Capacity, need)
+		}))
 	}
 	nodesInfo = nodesInfo[p:]
 	if limit > 0 {
@@ -5,6 +5,7 @@ CPU 分配的核心算法
 package complexscheduler
 
 import (
+	"fmt"
 	"sort"
 
 	"github.com/projecteru2/core/types"
@@ -79,7 +80,12 @@ func cpuPriorPlan(cpu float64, memory int64, nodesInfo []types.NodeInfo, maxShar
 	sort.Slice(nodesInfo, func(i, j int) bool { return nodesInfo[i].Capacity < nodesInfo[j].Capacity })
 	p := sort.Search(len(nodesInfo), func(i int) bool { return nodesInfo[i].Capacity > 0 })
 	if p == len(nodesInfo) {
-		return nil, nil, 0, types.ErrInsufficientRes
+		return nil, nil, 0, types.NewScheduleError(types.ErrInsufficientRes, makeRejections(nodesInfo, func(nodeInfo types.NodeInfo) string {
+			if nodeInfo.MemCap < memory {
+				return fmt.Sprintf("memory short by %d bytes", memory-nodeInfo.MemCap)
+			}
+			return fmt.Sprintf("cpu pieces unavailable for quota %v, %d pieces free", cpu, nodeInfo.CPUMap.Total())
+		}))
 	}
 
 	return nodesInfo[p:], nodeContainer, volTotal, nil
@@ -0,0 +1,35 @@
+package complexscheduler
+
+import (
+	"math"
+
+	"github.com/projecteru2/core/types"
+)
+
+// calculateDevicePlan split free devices into plans, each plan holds required count of each type
+func calculateDevicePlan(free types.DeviceMap, required map[string]int) (int, []types.DeviceMap) {
+	capacity := math.MaxInt16
+	for kind, count := range required {
+		if count <= 0 {
+			continue
+		}
+		if cap := len(free[kind]) / count; cap < capacity {
+			capacity = cap
+		}
+	}
+	if capacity == math.MaxInt16 {
+		return capacity, nil
+	}
+
+	plans := make([]types.DeviceMap, capacity)
+	for i := range plans {
+		plans[i] = types.DeviceMap{}
+		for kind, count := range required {
+			if count <= 0 {
+				continue
+			}
+			plans[i][kind] = append([]string{}, free[kind][i*count:(i+1)*count]...)
+		}
+	}
+	return capacity, plans
+}
@@ -19,7 +19,9 @@ func FillPlan(nodesInfo []types.NodeInfo, need, limit int) ([]types.NodeInfo, er
 	sort.Slice(nodesInfo, func(i, j int) bool { return nodesInfo[i].Count > nodesInfo[j].Count })
 	p := sort.Search(nodesInfoLength, func(i int) bool { return nodesInfo[i].Count < need })
 	if p == nodesInfoLength {
-		return nil, types.ErrAlreadyFilled
+		return nil, types.NewScheduleError(types.ErrAlreadyFilled, makeRejections(nodesInfo, func(nodeInfo types.NodeInfo) string {
+			return fmt.Sprintf("already has %d", nodeInfo.Count)
+		}))
 	}
 	nodesInfo = nodesInfo[p:]
 	if limit > 0 && len(nodesInfo) > limit {
@@ -28,8 +30,9 @@ func FillPlan(nodesInfo []types.NodeInfo, need, limit int) ([]types.NodeInfo, er
 	for i := range nodesInfo {
 		diff := need - nodesInfo[i].Count
 		if nodesInfo[i].Capacity < diff {
-			return nil, types.NewDetailedErr(types.ErrInsufficientRes,
-				fmt.Sprintf("node %s cannot alloc a fill node plan", nodesInfo[i].Name))
+			return nil, types.NewScheduleError(types.NewDetailedErr(types.ErrInsufficientRes,
+				fmt.Sprintf("node %s cannot alloc a fill node plan", nodesInfo[i].Name)),
+				map[string]string{nodesInfo[i].Name: fmt.Sprintf("capacity %d less than %d to fill", nodesInfo[i].Capacity, diff)})
 		}
 		nodesInfo[i].Deploy = diff
 		nodesInfo[i].Capacity -= diff
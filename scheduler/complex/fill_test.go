@@ -59,6 +59,7 @@ func TestFillPlan(t *testing.T) {
 	_, err = FillPlan(nodes, n, 0)
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "each node has enough containers")
+	assert.Equal(t, "already has 3", err.(*types.ScheduleError).Reasons["n2"])
 
 	// LimitNode
 	n = 15
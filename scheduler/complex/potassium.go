@@ -55,7 +55,9 @@ func (m *Potassium) SelectStorageNodes(nodesInfo []types.NodeInfo, storage int64
 	sort.Slice(nodesInfo, func(i, j int) bool { return nodesInfo[i].StorageCap < nodesInfo[j].StorageCap })
 	p := sort.Search(leng, func(i int) bool { return nodesInfo[i].StorageCap >= storage })
 	if p == leng {
-		return nil, 0, types.ErrInsufficientStorage
+		return nil, 0, types.NewScheduleError(types.ErrInsufficientStorage, makeRejections(nodesInfo, func(nodeInfo types.NodeInfo) string {
+			return fmt.Sprintf("storage short by %d bytes", storage-nodeInfo.StorageCap)
+		}))
 	}
 
 	nodesInfo = nodesInfo[p:]
@@ -70,6 +72,42 @@ func (m *Potassium) SelectStorageNodes(nodesInfo []types.NodeInfo, storage int64
 	return nodesInfo, total, nil
 }
 
+// SelectBandwidthNodes filters nodes with enough network bandwidth
+func (m *Potassium) SelectBandwidthNodes(nodesInfo []types.NodeInfo, bandwidth int64) ([]types.NodeInfo, int, error) {
+	switch {
+	case bandwidth < 0:
+		return nil, 0, types.ErrNegativeBandwidth
+	case bandwidth == 0:
+		return nodesInfo, math.MaxInt32, nil
+	default:
+		log.Infof("[SelectBandwidthNodes] nodesInfo: %v, need: %d", nodesInfo, bandwidth)
+	}
+
+	leng := len(nodesInfo)
+
+	sort.Slice(nodesInfo, func(i, j int) bool { return nodesInfo[i].BandwidthCap < nodesInfo[j].BandwidthCap })
+	p := sort.Search(leng, func(i int) bool { return nodesInfo[i].BandwidthCap >= bandwidth })
+	if p == leng {
+		return nil, 0, types.NewScheduleError(types.ErrInsufficientBandwidth, makeRejections(nodesInfo, func(nodeInfo types.NodeInfo) string {
+			return fmt.Sprintf("bandwidth short by %d bps", bandwidth-nodeInfo.BandwidthCap)
+		}))
+	}
+
+	nodesInfo = nodesInfo[p:]
+
+	total := 0
+	for i := range nodesInfo {
+		bwCap := math.MaxInt32
+		if c := nodesInfo[i].BandwidthCap / bandwidth; c < int64(bwCap) {
+			bwCap = int(c)
+		}
+		nodesInfo[i].Capacity = utils.Min(bwCap, nodesInfo[i].Capacity)
+		total += nodesInfo[i].Capacity
+	}
+
+	return nodesInfo, total, nil
+}
+
 // SelectMemoryNodes filter nodes with enough memory
 func (m *Potassium) SelectMemoryNodes(nodesInfo []types.NodeInfo, quota float64, memory int64) ([]types.NodeInfo, int, error) {
 	log.Infof("[SelectMemoryNodes] nodesInfo: %v, need cpu: %f, memory: %d", nodesInfo, quota, memory)
@@ -81,9 +119,12 @@ func (m *Potassium) SelectMemoryNodes(nodesInfo []types.NodeInfo, quota float64,
 	// 筛选出能满足 CPU 需求的
 	sort.Slice(nodesInfo, func(i, j int) bool { return len(nodesInfo[i].CPUMap) < len(nodesInfo[j].CPUMap) })
 	p := sort.Search(nodesInfoLength, func(i int) bool { return float64(len(nodesInfo[i].CPUMap)) >= quota })
+	reasons := makeRejections(nodesInfo[:p], func(nodeInfo types.NodeInfo) string {
+		return fmt.Sprintf("%d cpus less than quota %v", len(nodeInfo.CPUMap), quota)
+	})
 	// p 最大也就是 nodesInfoLength - 1
 	if p == nodesInfoLength {
-		return nil, 0, types.ErrInsufficientCPU
+		return nil, 0, types.NewScheduleError(types.ErrInsufficientCPU, reasons)
 	}
 	nodesInfoLength -= p
 	nodesInfo = nodesInfo[p:]
@@ -92,19 +133,37 @@ func (m *Potassium) SelectMemoryNodes(nodesInfo []types.NodeInfo, quota float64,
 	sort.Slice(nodesInfo, func(i, j int) bool { return nodesInfo[i].MemCap < nodesInfo[j].MemCap })
 	p = sort.Search(nodesInfoLength, func(i int) bool { return nodesInfo[i].MemCap >= memory })
 	if p == nodesInfoLength {
-		return nil, 0, types.ErrInsufficientMEM
+		for nodename, reason := range makeRejections(nodesInfo, func(nodeInfo types.NodeInfo) string {
+			return fmt.Sprintf("memory short by %d bytes", memory-nodeInfo.MemCap)
+		}) {
+			reasons[nodename] = reason
+		}
+		return nil, 0, types.NewScheduleError(types.ErrInsufficientMEM, reasons)
 	}
 	nodesInfoLength -= p
 	nodesInfo = nodesInfo[p:]
 
 	// 这里 memCap 一定是大于 memory 的所以不用判断 cap 内容
+	// 非绑核的 quota 受超售比例限制
 	volTotal := 0
-	for i, nodeInfo := range nodesInfo {
+	selected := []types.NodeInfo{}
+	for _, nodeInfo := range nodesInfo {
 		capacity := int(nodeInfo.MemCap / memory)
+		if cpuCapacity, limited := cpuQuotaCapacity(nodeInfo); limited && cpuCapacity < capacity {
+			capacity = cpuCapacity
+		}
+		if capacity <= 0 {
+			reasons[nodeInfo.Name] = fmt.Sprintf("cpu usage %v reaches overcommit %v", nodeInfo.CPUUsed, nodeInfo.CPUOvercommit)
+			continue
+		}
 		volTotal += capacity
-		nodesInfo[i].Capacity = capacity
+		nodeInfo.Capacity = capacity
+		selected = append(selected, nodeInfo)
+	}
+	if len(selected) == 0 {
+		return nil, 0, types.NewScheduleError(types.ErrInsufficientCPU, reasons)
 	}
-	return nodesInfo, volTotal, nil
+	return selected, volTotal, nil
 }
 
 // SelectCPUNodes select nodes with enough cpus
@@ -173,19 +232,52 @@ func (m *Potassium) SelectVolumeNodes(nodesInfo []types.NodeInfo, vbs types.Volu
 	sort.Slice(nodesInfo, func(i, j int) bool { return nodesInfo[i].Capacity < nodesInfo[j].Capacity })
 	p := sort.Search(len(nodesInfo), func(i int) bool { return nodesInfo[i].Capacity > 0 })
 	if p == len(nodesInfo) {
-		return nil, nil, 0, types.ErrInsufficientRes
+		need := append(vbsNorm, vbsMono...).ToStringSlice(false, false)
+		return nil, nil, 0, types.NewScheduleError(types.ErrInsufficientRes, makeRejections(nodesInfo, func(nodeInfo types.NodeInfo) string {
+			return fmt.Sprintf("volume devices full, need %v, free %v", need, nodeInfo.VolumeMap)
+		}))
 	}
 
 	return nodesInfo[p:], volumePlans, volTotal, nil
 }
 
+// SelectDeviceNodes calculates plans for device request
+func (m *Potassium) SelectDeviceNodes(nodesInfo []types.NodeInfo, devices map[string]int) ([]types.NodeInfo, map[string][]types.DeviceMap, int, error) {
+	log.Infof("[SelectDeviceNodes] nodesInfo %d, need devices: %v", len(nodesInfo), devices)
+	for _, count := range devices {
+		if count < 0 {
+			return nil, nil, 0, types.NewDetailedErr(types.ErrBadDevice, devices)
+		}
+	}
+
+	devTotal := 0
+	devicePlans := map[string][]types.DeviceMap{}
+	for idx, nodeInfo := range nodesInfo {
+		capacity, plans := calculateDevicePlan(nodeInfo.DeviceMap, devices)
+		devTotal += updateNodeInfoCapacity(&nodesInfo[idx], capacity)
+		if plans != nil {
+			devicePlans[nodeInfo.Name] = plans[:nodesInfo[idx].Capacity]
+		}
+	}
+
+	sort.Slice(nodesInfo, func(i, j int) bool { return nodesInfo[i].Capacity < nodesInfo[j].Capacity })
+	p := sort.Search(len(nodesInfo), func(i int) bool { return nodesInfo[i].Capacity > 0 })
+	if p == len(nodesInfo) {
+		return nil, nil, 0, types.NewScheduleError(types.ErrInsufficientRes, makeRejections(nodesInfo, func(nodeInfo types.NodeInfo) string {
+			return fmt.Sprintf("devices not enough, need %v, free %v", devices, nodeInfo.DeviceMap)
+		}))
+	}
+
+	return nodesInfo[p:], devicePlans, devTotal, nil
+}
+
 // CommonDivision deploy containers by their deploy status
 // 部署完 N 个后全局尽可能平均
 // need 是所需总量，total 是支持部署总量
 func (m *Potassium) CommonDivision(nodesInfo []types.NodeInfo, need, total int) ([]types.NodeInfo, error) {
 	if total < need {
-		return nil, types.NewDetailedErr(types.ErrInsufficientRes,
-			fmt.Sprintf("need: %d, vol: %d", need, total))
+		return nil, types.NewScheduleError(types.NewDetailedErr(types.ErrInsufficientRes,
+			fmt.Sprintf("need: %d, vol: %d", need, total)), makeRejections(nodesInfo, capacityRejection))
 	}
 	return CommunismDivisionPlan(nodesInfo, need)
 }
@@ -209,8 +301,21 @@ func (m *Potassium) FillDivision(nodesInfo []types.NodeInfo, need, limit int) ([
 // need 是所需总量，total 是支持部署总量
 func (m *Potassium) GlobalDivision(nodesInfo []types.NodeInfo, need, total int) ([]types.NodeInfo, error) {
 	if total < need {
-		return nil, types.NewDetailedErr(types.ErrInsufficientRes,
-			fmt.Sprintf("need: %d, vol: %d", need, total))
+		return nil, types.NewScheduleError(types.NewDetailedErr(types.ErrInsufficientRes,
+			fmt.Sprintf("need: %d, vol: %d", need, total)), makeRejections(nodesInfo, capacityRejection))
 	}
 	return GlobalDivisionPlan(nodesInfo, need)
 }
+
+// SelectAffinityNodes filter nodes by affinity
+// nodes without spread label or colocated apps are dropped, capacity is cut by max per node
+func (m *Potassium) SelectAffinityNodes(nodesInfo []types.NodeInfo, affinity *types.Affinity) ([]types.NodeInfo, int, error) {
+	return AffinityPlan(nodesInfo, affinity)
+}
+
+// SpreadDivision deploy containers spread by node label
+// 按照 label 的值打散, 例如机架
+// need 是所需总量
+func (m *Potassium) SpreadDivision(nodesInfo []types.NodeInfo, need int, label string) ([]types.NodeInfo, error) {
+	return SpreadPlan(nodesInfo, need, label)
+}
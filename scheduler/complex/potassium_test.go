@@ -8,6 +8,7 @@ import (
 	"math"
 
 	"github.com/docker/go-units"
+	"github.com/projecteru2/core/scheduler"
 	"github.com/projecteru2/core/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -25,9 +26,14 @@ func newConfig() types.Config {
 	}
 }
 
-func newPotassium() (*Potassium, error) {
+// newTestScheduler creates scheduler under test, cases are shared by all schedulers
+var newTestScheduler = func(config types.Config) (scheduler.Scheduler, error) {
+	return New(config)
+}
+
+func newPotassium() (scheduler.Scheduler, error) {
 	coreCfg := newConfig()
-	potassium, err := New(coreCfg)
+	potassium, err := newTestScheduler(coreCfg)
 	if err != nil {
 		return nil, fmt.Errorf("Create Potassim error: %v", err)
 	}
@@ -188,7 +194,7 @@ func getEvenPlanNodes() []types.NodeInfo {
 	}
 }
 
-func SelectCPUNodes(k *Potassium, nodesInfo []types.NodeInfo, quota float64, memory int64, need int, each bool) (map[string][]types.CPUMap, map[string]types.CPUMap, error) {
+func SelectCPUNodes(k scheduler.Scheduler, nodesInfo []types.NodeInfo, quota float64, memory int64, need int, each bool) (map[string][]types.CPUMap, map[string]types.CPUMap, error) {
 	nodesInfo, nodePlans, total, err := k.SelectCPUNodes(nodesInfo, quota, memory)
 	if err != nil {
 		return nil, nil, err
@@ -222,7 +228,7 @@ func SelectCPUNodes(k *Potassium, nodesInfo []types.NodeInfo, quota float64, mem
 	return result, changed, nil
 }
 
-func SelectMemoryNodes(k *Potassium, nodesInfo []types.NodeInfo, rate float64, memory int64, need int, each bool) ([]types.NodeInfo, error) {
+func SelectMemoryNodes(k scheduler.Scheduler, nodesInfo []types.NodeInfo, rate float64, memory int64, need int, each bool) ([]types.NodeInfo, error) {
 	nodesInfo, total, err := k.SelectMemoryNodes(nodesInfo, rate, memory)
 	if err != nil {
 		return nodesInfo, err
@@ -304,12 +310,14 @@ func TestSelectCPUNodesWithMemoryLimit(t *testing.T) {
 	// 测试 2 个 Node，内存不足
 	nodes = generateNodes(2, 2, 1024, 0, 10)
 	_, _, err = SelectCPUNodes(k, nodes, 0.1, 1025, 1, true)
-	assert.EqualError(t, err, types.ErrInsufficientRes.Error())
+	assert.True(t, errors.Is(err, types.ErrInsufficientRes))
+	assert.Equal(t, "memory short by 1 bytes", err.(*types.ScheduleError).Reasons["n0"])
 
 	// 测试 need 超过 each node 的 capacity
 	nodes = generateNodes(2, 2, 1024, 0, 10)
 	_, _, err = SelectCPUNodes(k, nodes, 0.1, 1024, 2, true)
-	assert.EqualError(t, err, types.ErrInsufficientCap.Error())
+	assert.True(t, errors.Is(err, types.ErrInsufficientCap))
+	assert.Equal(t, "capacity 1 less than 2", err.(*types.ScheduleError).Reasons["n1"])
 }
 
 func TestRecurrence(t *testing.T) {
@@ -827,16 +835,19 @@ func TestSelectMemoryNodesNotEnough(t *testing.T) {
 	_, err := SelectMemoryNodes(k, pod, 1, 512*int64(units.MiB), 40, false)
 	assert.True(t, errors.Is(err, types.ErrInsufficientRes))
 	assert.Contains(t, err.Error(), "need: 40, vol: 16")
+	assert.Equal(t, "capacity 8", err.(*types.ScheduleError).Reasons["n0"])
 
 	// 2 nodes [memory not enough]
 	pod = generateNodes(2, 2, memory, 0, 10)
 	_, err = SelectMemoryNodes(k, pod, 1, 5*int64(units.GiB), 1, false)
-	assert.Equal(t, err, types.ErrInsufficientMEM)
+	assert.True(t, errors.Is(err, types.ErrInsufficientMEM))
+	assert.Equal(t, fmt.Sprintf("memory short by %d bytes", 5*int64(units.GiB)-memory), err.(*types.ScheduleError).Reasons["n1"])
 
 	// 2 nodes [cpu not enough]
 	pod = generateNodes(2, 2, memory, 0, 10)
 	_, err = SelectMemoryNodes(k, pod, 1e10, 512*int64(units.MiB), 1, false)
-	assert.Equal(t, err, types.ErrInsufficientCPU)
+	assert.True(t, errors.Is(err, types.ErrInsufficientCPU))
+	assert.Equal(t, "2 cpus less than quota 1e+10", err.(*types.ScheduleError).Reasons["n0"])
 }
 
 func TestSelectMemoryNodesSequence(t *testing.T) {
@@ -920,6 +931,38 @@ func TestSelectMemoryNodesGiven(t *testing.T) {
 	}
 }
 
+func TestSelectMemoryNodesOvercommit(t *testing.T) {
+	k, _ := newPotassium()
+	pod := generateNodes(2, 2, 4*int64(units.GiB), 0, 10)
+	// 2 cores with ratio 2, 1 used, 0.5 each
+	for i := range pod {
+		pod[i].CPURate = 0.25
+		pod[i].CPUUsed = 0.5
+		pod[i].CPUOvercommit = 2
+	}
+	pod[1].CPUUsed = 2
+	res, total, err := k.SelectMemoryNodes(pod, 0.5, 512*int64(units.MiB))
+	assert.NoError(t, err)
+	assert.Equal(t, 6, total)
+	assert.Len(t, res, 1)
+	assert.Equal(t, "n0", res[0].Name)
+
+	// memory is still the limit
+	pod = generateNodes(1, 2, int64(units.GiB), 0, 10)
+	pod[0].CPURate = 0.25
+	pod[0].CPUOvercommit = 4
+	_, total, err = k.SelectMemoryNodes(pod, 0.5, 512*int64(units.MiB))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, total)
+
+	pod[0].CPUUsed = 4
+	_, _, err = k.SelectMemoryNodes(pod, 0.5, 512*int64(units.MiB))
+	assert.True(t, errors.Is(err, types.ErrInsufficientCPU))
+	se := &types.ScheduleError{}
+	assert.True(t, errors.As(err, &se))
+	assert.Contains(t, se.Reasons["n0"], "overcommit 4")
+}
+
 func TestMaxIdleNode(t *testing.T) {
 	n1 := &types.Node{
 		Name:       "n1",
@@ -1099,7 +1142,8 @@ func TestSelectStorageNodesNotEnough(t *testing.T) {
 	assert.Equal(t, 4, nodesInfo[0].Capacity)
 
 	res, err := SelectStorageNodes(k, nodesInfo, int64(units.GiB), 1, false)
-	assert.Equal(t, types.ErrInsufficientStorage, err)
+	assert.True(t, errors.Is(err, types.ErrInsufficientStorage))
+	assert.Equal(t, fmt.Sprintf("storage short by %d bytes", int64(units.GiB-units.MiB)), err.(*types.ScheduleError).Reasons["n0"])
 	assert.Nil(t, res)
 }
 
@@ -1178,7 +1222,7 @@ func TestSelectStorageNodesSequence(t *testing.T) {
 	assert.Equal(t, 0, res[0].Capacity)
 }
 
-func SelectStorageNodes(k *Potassium, nodesInfo []types.NodeInfo, storage int64, need int, each bool) ([]types.NodeInfo, error) {
+func SelectStorageNodes(k scheduler.Scheduler, nodesInfo []types.NodeInfo, storage int64, need int, each bool) ([]types.NodeInfo, error) {
 	switch nodesInfo, total, err := k.SelectStorageNodes(nodesInfo, storage); {
 	case err != nil:
 		return nil, err
@@ -1189,7 +1233,7 @@ func SelectStorageNodes(k *Potassium, nodesInfo []types.NodeInfo, storage int64,
 	}
 }
 
-func SelectVolumeNodes(k *Potassium, nodesInfo []types.NodeInfo, volumes []string, need int, each bool) (map[string][]types.VolumePlan, map[string]types.VolumeMap, error) {
+func SelectVolumeNodes(k scheduler.Scheduler, nodesInfo []types.NodeInfo, volumes []string, need int, each bool) (map[string][]types.VolumePlan, map[string]types.VolumeMap, error) {
 	nodesInfo, plans, total, err := k.SelectVolumeNodes(nodesInfo, types.MustToVolumeBindings(volumes))
 	if err != nil {
 		return nil, nil, err
@@ -1265,6 +1309,7 @@ func TestSelectVolumeNodesAutoInsufficient(t *testing.T) {
 	volumes := []string{"AUTO:/data:rw:2049"}
 	_, _, err := SelectVolumeNodes(k, nodes, volumes, 1, true)
 	assert.True(t, errors.Is(err, types.ErrInsufficientRes))
+	assert.Equal(t, "volume devices full, need [AUTO:/data:rw:2049], free map[/data0:1024 /data1:2048]", err.(*types.ScheduleError).Reasons["0"])
 
 	volumes = []string{"AUTO:/data:rw:1024", "AUTO:/dir:rw:1024"}
 	_, _, err = SelectVolumeNodes(k, nodes, volumes, 2, true)
@@ -1545,3 +1590,69 @@ func TestSelectMonopolyInsufficient(t *testing.T) {
 	_, _, err = SelectVolumeNodes(k, nodes, volumes, 2, true)
 	assert.True(t, errors.Is(err, types.ErrInsufficientCap))
 }
+
+func TestSelectDeviceNodes(t *testing.T) {
+	k, _ := newPotassium()
+	nodes := []types.NodeInfo{
+		{
+			Name:      "n0",
+			DeviceMap: types.DeviceMap{"gpu": {"0", "1", "2", "3", "4"}, "fpga": {"f0"}},
+		},
+		{
+			Name:      "n1",
+			DeviceMap: types.DeviceMap{"gpu": {"0"}},
+		},
+	}
+	res, plans, total, err := k.SelectDeviceNodes(nodes, map[string]int{"gpu": 2})
+	assert.NoError(t, err)
+	assert.Equal(t, 2, total)
+	assert.Len(t, res, 1)
+	assert.Equal(t, "n0", res[0].Name)
+	assert.Equal(t, []types.DeviceMap{{"gpu": {"0", "1"}}, {"gpu": {"2", "3"}}}, plans["n0"])
+	assert.Empty(t, plans["n1"])
+
+	// capacity is limited by the scarcest type
+	nodes[0].Capacity, nodes[1].Capacity = 0, 0
+	_, plans, total, err = k.SelectDeviceNodes(nodes, map[string]int{"gpu": 1, "fpga": 1})
+	assert.NoError(t, err)
+	assert.Equal(t, 1, total)
+	assert.Equal(t, []types.DeviceMap{{"gpu": {"0"}, "fpga": {"f0"}}}, plans["n0"])
+
+	nodes[0].Capacity, nodes[1].Capacity = 0, 0
+	_, _, _, err = k.SelectDeviceNodes(nodes, map[string]int{"fpga": 2})
+	assert.True(t, errors.Is(err, types.ErrInsufficientRes))
+	se := &types.ScheduleError{}
+	assert.True(t, errors.As(err, &se))
+	assert.Contains(t, se.Reasons["n1"], "devices not enough")
+
+	_, _, _, err = k.SelectDeviceNodes(nodes, map[string]int{"gpu": -1})
+	assert.True(t, errors.Is(err, types.ErrBadDevice))
+}
+
+func TestSelectBandwidthNodes(t *testing.T) {
+	k, _ := newPotassium()
+	_, _, err := k.SelectBandwidthNodes(nil, -1)
+	assert.True(t, errors.Is(err, types.ErrNegativeBandwidth))
+	_, total, err := k.SelectBandwidthNodes(nil, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, math.MaxInt32, total)
+
+	nodesInfo := []types.NodeInfo{
+		{Name: "n0", BandwidthCap: 250, Capacity: 10},
+		{Name: "n1", BandwidthCap: 50, Capacity: 10},
+		{Name: "n2", BandwidthCap: math.MaxInt64, Capacity: 3},
+	}
+	res, total, err := k.SelectBandwidthNodes(nodesInfo, 100)
+	assert.NoError(t, err)
+	assert.Equal(t, 5, total)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "n0", res[0].Name)
+	assert.Equal(t, 2, res[0].Capacity)
+	assert.Equal(t, 3, res[1].Capacity)
+
+	_, _, err = k.SelectBandwidthNodes([]types.NodeInfo{{Name: "n1", BandwidthCap: 50, Capacity: 10}}, 100)
+	assert.True(t, errors.Is(err, types.ErrInsufficientBandwidth))
+	se := &types.ScheduleError{}
+	assert.True(t, errors.As(err, &se))
+	assert.Equal(t, "bandwidth short by 50 bps", se.Reasons["n1"])
+}
@@ -0,0 +1,111 @@
+package complexscheduler
+
+import (
+	"fmt"
+
+	"github.com/projecteru2/core/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// scoring strategies
+const (
+	// ScoringSpread prefer idle nodes
+	ScoringSpread = "spread"
+	// ScoringBinpack prefer busy nodes, keep idle nodes idle
+	ScoringBinpack = "binpack"
+)
+
+// Scoring is a scheduler scores every candidate node
+// nodes are filtered the same as Potassium, only divisions differ
+type Scoring struct {
+	*Potassium
+	binpack bool
+	weights types.ScoreWeights
+}
+
+// NewScoring new a scoring scheduler with strategy
+func NewScoring(config types.Config, strategy string) (*Scoring, error) {
+	potassium, err := New(config)
+	if err != nil {
+		return nil, err
+	}
+	switch strategy {
+	case ScoringSpread, ScoringBinpack:
+	default:
+		return nil, types.NewDetailedErr(types.ErrBadSchedulerType, strategy)
+	}
+	return &Scoring{Potassium: potassium, binpack: strategy == ScoringBinpack, weights: config.Scheduler.Weights}, nil
+}
+
+// CommonDivision deploy containers one by one to the node with highest score
+// need 是所需总量，total 是支持部署总量
+func (s *Scoring) CommonDivision(nodesInfo []types.NodeInfo, need, total int) ([]types.NodeInfo, error) {
+	if total < need {
+		return nil, types.NewScheduleError(types.NewDetailedErr(types.ErrInsufficientRes,
+			fmt.Sprintf("need: %d, vol: %d", need, total)), makeRejections(nodesInfo, capacityRejection))
+	}
+	return ScoringPlan(nodesInfo, need, s.Score)
+}
+
+// GlobalDivision is the same as CommonDivision, resource usage is already scored
+func (s *Scoring) GlobalDivision(nodesInfo []types.NodeInfo, need, total int) ([]types.NodeInfo, error) {
+	return s.CommonDivision(nodesInfo, need, total)
+}
+
+// Score how good the node is if one more container deployed on it, higher is better
+func (s *Scoring) Score(nodeInfo types.NodeInfo) float64 {
+	n := float64(nodeInfo.Deploy + 1)
+	usage := s.weights.CPU*(nodeInfo.CPUUsed+nodeInfo.CPURate*n) +
+		s.weights.Memory*(nodeInfo.MemUsage+nodeInfo.MemRate*n) +
+		s.weights.Storage*(nodeInfo.StorageUsage+nodeInfo.StorageRate*n) +
+		s.weights.Volume*volumeUsage(nodeInfo)
+	if sum := s.weights.CPU + s.weights.Memory + s.weights.Storage + s.weights.Volume; sum > 0 {
+		usage /= sum
+	}
+	if s.binpack {
+		return usage
+	}
+	return 1 - usage
+}
+
+// ScoringPlan 每次部署一个到得分最高的节点, 得分相同时选容器少的
+func ScoringPlan(nodesInfo []types.NodeInfo, need int, score func(types.NodeInfo) float64) ([]types.NodeInfo, error) {
+	for i := range nodesInfo {
+		nodesInfo[i].Score = score(nodesInfo[i])
+	}
+	for ; need > 0; need-- {
+		p, best := -1, 0.0
+		for i := range nodesInfo {
+			if nodesInfo[i].Capacity <= 0 {
+				continue
+			}
+			s := score(nodesInfo[i])
+			if p == -1 || s > best || (s == best && nodesInfo[i].Count+nodesInfo[i].Deploy < nodesInfo[p].Count+nodesInfo[p].Deploy) {
+				p, best = i, s
+			}
+		}
+		if p == -1 {
+			return nil, types.NewScheduleError(types.NewDetailedErr(types.ErrInsufficientRes,
+				fmt.Sprintf("%d left cannot alloc a scoring plan", need)), makeRejections(nodesInfo, fullRejection))
+		}
+		nodesInfo[p].Deploy++
+		nodesInfo[p].Capacity--
+	}
+	log.Debugf("[ScoringPlan] nodesInfo: %v", nodesInfo)
+	return nodesInfo, nil
+}
+
+// volumeUsage 卷的已用比例
+func volumeUsage(nodeInfo types.NodeInfo) float64 {
+	var free, init int64
+	for _, v := range nodeInfo.VolumeMap {
+		free += v
+	}
+	for _, v := range nodeInfo.InitVolumeMap {
+		init += v
+	}
+	if init <= 0 {
+		return 0
+	}
+	return 1 - float64(free)/float64(init)
+}
@@ -0,0 +1,93 @@
+package complexscheduler
+
+import (
+	"testing"
+
+	"github.com/projecteru2/core/scheduler"
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoringWithPotassiumCases(t *testing.T) {
+	origin := newTestScheduler
+	defer func() { newTestScheduler = origin }()
+	newTestScheduler = func(config types.Config) (scheduler.Scheduler, error) {
+		config.Scheduler.Weights = types.ScoreWeights{CPU: 1, Memory: 1, Storage: 1, Volume: 1}
+		return NewScoring(config, ScoringSpread)
+	}
+	// TestSelectMemoryNodesGiven and TestSelectStorageNodesSequence are not here
+	// they assert the containers count based division of Potassium
+	for name, f := range map[string]func(*testing.T){
+		"TestSelectCPUNodes":                            TestSelectCPUNodes,
+		"TestSelectCPUNodesWithMemoryLimit":             TestSelectCPUNodesWithMemoryLimit,
+		"TestRecurrence":                                TestRecurrence,
+		"TestComplexNodes":                              TestComplexNodes,
+		"TestCPUWithMaxShareLimit":                      TestCPUWithMaxShareLimit,
+		"TestCpuOverSell":                               TestCpuOverSell,
+		"TestCPUOverSellAndStableFragmentCore":          TestCPUOverSellAndStableFragmentCore,
+		"TestEvenPlan":                                  TestEvenPlan,
+		"TestSpecialCase":                               TestSpecialCase,
+		"TestGetPodVol":                                 TestGetPodVol,
+		"TestSelectMemoryNodes":                         TestSelectMemoryNodes,
+		"TestSelectMemoryNodesNotEnough":                TestSelectMemoryNodesNotEnough,
+		"TestSelectMemoryNodesSequence":                 TestSelectMemoryNodesSequence,
+		"TestMaxIdleNode":                               TestMaxIdleNode,
+		"TestGlobalDivision":                            TestGlobalDivision,
+		"TestSelectStorageNodesMultipleDeployedPerNode": TestSelectStorageNodesMultipleDeployedPerNode,
+		"TestSelectStorageNodesDeployedOnFirstNode":     TestSelectStorageNodesDeployedOnFirstNode,
+		"TestSelectStorageNodesOneDeployedPerNode":      TestSelectStorageNodesOneDeployedPerNode,
+		"TestSelectStorageNodesWithPreOccupied":         TestSelectStorageNodesWithPreOccupied,
+		"TestSelectStorageNodesAllocEachDivition":       TestSelectStorageNodesAllocEachDivition,
+		"TestSelectStorageNodesCapacityLessThanMemory":  TestSelectStorageNodesCapacityLessThanMemory,
+		"TestSelectStorageNodesNotEnough":               TestSelectStorageNodesNotEnough,
+		"TestSelectVolumeNodesNonAuto":                  TestSelectVolumeNodesNonAuto,
+		"TestSelectVolumeNodesAutoInsufficient":         TestSelectVolumeNodesAutoInsufficient,
+		"TestSelectVolumeNodesAutoSingle":               TestSelectVolumeNodesAutoSingle,
+		"TestSelectVolumeNodesAutoDouble":               TestSelectVolumeNodesAutoDouble,
+		"TestSelectVolumeNodesAutoTriple":               TestSelectVolumeNodesAutoTriple,
+		"TestSelectMonopoly":                            TestSelectMonopoly,
+		"TestSelectMultipleMonopoly":                    TestSelectMultipleMonopoly,
+		"TestSelectHyperMonopoly":                       TestSelectHyperMonopoly,
+		"TestSelectMonopolyOnMultipleNodes":             TestSelectMonopolyOnMultipleNodes,
+		"TestSelectMonopolyInsufficient":                TestSelectMonopolyInsufficient,
+	} {
+		t.Run(name, f)
+	}
+}
+
+func TestScoringPlan(t *testing.T) {
+	config := newConfig()
+	config.Scheduler.Weights = types.ScoreWeights{CPU: 1, Memory: 1}
+	_, err := NewScoring(config, "unknown")
+	assert.Error(t, err)
+
+	nodesInfo := func() []types.NodeInfo {
+		return []types.NodeInfo{
+			{Name: "n1", Capacity: 10, CPUUsed: 0.5, MemUsage: 0.5, CPURate: 0.1, MemRate: 0.1},
+			{Name: "n2", Capacity: 10, CPUUsed: 0.1, MemUsage: 0.1, CPURate: 0.1, MemRate: 0.1},
+			{Name: "n3", Capacity: 1, CPUUsed: 0, MemUsage: 0, CPURate: 0.1, MemRate: 0.1},
+		}
+	}
+
+	// spread prefer idle nodes
+	spread, err := NewScoring(config, ScoringSpread)
+	assert.NoError(t, err)
+	_, err = spread.CommonDivision(nodesInfo(), 22, 21)
+	assert.Error(t, err)
+	r, err := spread.CommonDivision(nodesInfo(), 4, 21)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, r[0].Deploy)
+	assert.Equal(t, 3, r[1].Deploy)
+	assert.Equal(t, 1, r[2].Deploy)
+	assert.InDelta(t, 0.4, r[0].Score, 0.0001)
+	assert.InDelta(t, 0.8, r[1].Score, 0.0001)
+
+	// binpack prefer busy nodes
+	binpack, err := NewScoring(config, ScoringBinpack)
+	assert.NoError(t, err)
+	r, err = binpack.GlobalDivision(nodesInfo(), 4, 21)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, r[0].Deploy)
+	assert.Equal(t, 0, r[1].Deploy)
+	assert.Equal(t, 0, r[2].Deploy)
+}
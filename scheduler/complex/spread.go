@@ -0,0 +1,53 @@
+package complexscheduler
+
+import (
+	"fmt"
+
+	"github.com/projecteru2/core/types"
+	log "github.com/sirupsen/logrus"
+)
+
+// SpreadPlan deploy containers evenly across values of node label
+// 先在 label 值之间平均, 再在同一个值的节点之间平均
+func SpreadPlan(nodesInfo []types.NodeInfo, need int, label string) ([]types.NodeInfo, error) {
+	log.Debugf("[SpreadPlan] need %d spread by %s", need, label)
+	groupsCount := map[string]int{}
+	for _, nodeInfo := range nodesInfo {
+		groupsCount[nodeInfo.Labels[label]] += nodeInfo.Count
+	}
+	for ; need > 0; need-- {
+		// 找到容器最少且还有容量的组里容器最少的节点
+		p := -1
+		for i, nodeInfo := range nodesInfo {
+			if nodeInfo.Capacity <= 0 {
+				continue
+			}
+			if p == -1 || isSpreadPrior(nodeInfo, nodesInfo[p], groupsCount, label) {
+				p = i
+			}
+		}
+		if p == -1 {
+			return nil, types.NewScheduleError(types.NewDetailedErr(types.ErrInsufficientRes,
+				fmt.Sprintf("%d left cannot alloc a spread plan", need)), makeRejections(nodesInfo, fullRejection))
+		}
+		nodesInfo[p].Deploy++
+		nodesInfo[p].Capacity--
+		groupsCount[nodesInfo[p].Labels[label]]++
+	}
+	log.Debugf("[SpreadPlan] nodesInfo: %v", nodesInfo)
+	return nodesInfo, nil
+}
+
+func isSpreadPrior(a, b types.NodeInfo, groupsCount map[string]int, label string) bool {
+	ga, gb := a.Labels[label], b.Labels[label]
+	if groupsCount[ga] != groupsCount[gb] {
+		return groupsCount[ga] < groupsCount[gb]
+	}
+	if ga != gb {
+		return ga < gb
+	}
+	if a.Count+a.Deploy != b.Count+b.Deploy {
+		return a.Count+a.Deploy < b.Count+b.Deploy
+	}
+	return a.Name < b.Name
+}
@@ -0,0 +1,42 @@
+package complexscheduler
+
+import (
+	"testing"
+
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpreadPlan(t *testing.T) {
+	nodesInfo := func() []types.NodeInfo {
+		return []types.NodeInfo{
+			{Name: "n1", Capacity: 10, Count: 2, Labels: map[string]string{"rack": "r1"}},
+			{Name: "n2", Capacity: 10, Count: 0, Labels: map[string]string{"rack": "r1"}},
+			{Name: "n3", Capacity: 10, Count: 0, Labels: map[string]string{"rack": "r2"}},
+			{Name: "n4", Capacity: 1, Count: 0, Labels: map[string]string{"rack": "r3"}},
+		}
+	}
+
+	// r1 has 2 already, fill r2 and r3 first
+	r, err := SpreadPlan(nodesInfo(), 3, "rack")
+	assert.NoError(t, err)
+	deploy := map[string]int{}
+	for _, nodeInfo := range r {
+		deploy[nodeInfo.Name] = nodeInfo.Deploy
+	}
+	assert.Equal(t, map[string]int{"n1": 0, "n2": 0, "n3": 2, "n4": 1}, deploy)
+
+	// r3 is full, spread in r1 prefer less containers node
+	r, err = SpreadPlan(nodesInfo(), 6, "rack")
+	assert.NoError(t, err)
+	deploy = map[string]int{}
+	for _, nodeInfo := range r {
+		deploy[nodeInfo.Name] = nodeInfo.Deploy
+	}
+	assert.Equal(t, map[string]int{"n1": 0, "n2": 2, "n3": 3, "n4": 1}, deploy)
+
+	// not enough capacity
+	_, err = SpreadPlan(nodesInfo(), 32, "rack")
+	assert.Error(t, err)
+	assert.Contains(t, err.(*types.ScheduleError).Reasons["n1"], "full after")
+}
@@ -1,6 +1,8 @@
 package complexscheduler
 
 import (
+	"fmt"
+
 	"github.com/projecteru2/core/types"
 	"github.com/projecteru2/core/utils"
 )
@@ -14,6 +16,38 @@ func updateNodeInfoCapacity(nodeInfo *types.NodeInfo, capacity int) int {
 	return nodeInfo.Capacity
 }
 
+// cpuQuotaCapacity returns how many containers can be deployed by non-bound cpu quota
+// CPUUsed and CPURate are both divided by cores, so is the overcommit ratio
+func cpuQuotaCapacity(nodeInfo types.NodeInfo) (int, bool) {
+	if nodeInfo.CPUOvercommit <= 0 || nodeInfo.CPURate <= 0 {
+		return 0, false
+	}
+	capacity := int((nodeInfo.CPUOvercommit-nodeInfo.CPUUsed)/nodeInfo.CPURate + 1e-9)
+	if capacity < 0 {
+		capacity = 0
+	}
+	return capacity, true
+}
+
+// makeRejections records why nodes are rejected, keyed by nodename
+func makeRejections(nodesInfo []types.NodeInfo, reason func(types.NodeInfo) string) map[string]string {
+	reasons := map[string]string{}
+	for _, nodeInfo := range nodesInfo {
+		reasons[nodeInfo.Name] = reason(nodeInfo)
+	}
+	return reasons
+}
+
+// capacityRejection for nodes not enough to hold all containers
+func capacityRejection(nodeInfo types.NodeInfo) string {
+	return fmt.Sprintf("capacity %d", nodeInfo.Capacity)
+}
+
+// fullRejection for nodes ran out of capacity during division
+func fullRejection(nodeInfo types.NodeInfo) string {
+	return fmt.Sprintf("full after %d deployed", nodeInfo.Deploy)
+}
+
 func onSameSource(plan []types.ResourceMap) bool {
 	sourceID := ""
 	for _, p := range plan {
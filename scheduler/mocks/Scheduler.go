@@ -126,6 +126,66 @@ func (_m *Scheduler) MaxIdleNode(nodes []*types.Node) (*types.Node, error) {
 	return r0, r1
 }
 
+// SelectAffinityNodes provides a mock function with given fields: nodesInfo, affinity
+func (_m *Scheduler) SelectAffinityNodes(nodesInfo []types.NodeInfo, affinity *types.Affinity) ([]types.NodeInfo, int, error) {
+	ret := _m.Called(nodesInfo, affinity)
+
+	var r0 []types.NodeInfo
+	if rf, ok := ret.Get(0).(func([]types.NodeInfo, *types.Affinity) []types.NodeInfo); ok {
+		r0 = rf(nodesInfo, affinity)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.NodeInfo)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func([]types.NodeInfo, *types.Affinity) int); ok {
+		r1 = rf(nodesInfo, affinity)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func([]types.NodeInfo, *types.Affinity) error); ok {
+		r2 = rf(nodesInfo, affinity)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SelectBandwidthNodes provides a mock function with given fields: nodesInfo, bandwidth
+func (_m *Scheduler) SelectBandwidthNodes(nodesInfo []types.NodeInfo, bandwidth int64) ([]types.NodeInfo, int, error) {
+	ret := _m.Called(nodesInfo, bandwidth)
+
+	var r0 []types.NodeInfo
+	if rf, ok := ret.Get(0).(func([]types.NodeInfo, int64) []types.NodeInfo); ok {
+		r0 = rf(nodesInfo, bandwidth)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.NodeInfo)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func([]types.NodeInfo, int64) int); ok {
+		r1 = rf(nodesInfo, bandwidth)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func([]types.NodeInfo, int64) error); ok {
+		r2 = rf(nodesInfo, bandwidth)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // SelectCPUNodes provides a mock function with given fields: nodesInfo, quota, memory
 func (_m *Scheduler) SelectCPUNodes(nodesInfo []types.NodeInfo, quota float64, memory int64) ([]types.NodeInfo, map[string][]types.ResourceMap, int, error) {
 	ret := _m.Called(nodesInfo, quota, memory)
@@ -165,6 +225,45 @@ func (_m *Scheduler) SelectCPUNodes(nodesInfo []types.NodeInfo, quota float64, m
 	return r0, r1, r2, r3
 }
 
+// SelectDeviceNodes provides a mock function with given fields: nodesInfo, devices
+func (_m *Scheduler) SelectDeviceNodes(nodesInfo []types.NodeInfo, devices map[string]int) ([]types.NodeInfo, map[string][]types.DeviceMap, int, error) {
+	ret := _m.Called(nodesInfo, devices)
+
+	var r0 []types.NodeInfo
+	if rf, ok := ret.Get(0).(func([]types.NodeInfo, map[string]int) []types.NodeInfo); ok {
+		r0 = rf(nodesInfo, devices)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.NodeInfo)
+		}
+	}
+
+	var r1 map[string][]types.DeviceMap
+	if rf, ok := ret.Get(1).(func([]types.NodeInfo, map[string]int) map[string][]types.DeviceMap); ok {
+		r1 = rf(nodesInfo, devices)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(map[string][]types.DeviceMap)
+		}
+	}
+
+	var r2 int
+	if rf, ok := ret.Get(2).(func([]types.NodeInfo, map[string]int) int); ok {
+		r2 = rf(nodesInfo, devices)
+	} else {
+		r2 = ret.Get(2).(int)
+	}
+
+	var r3 error
+	if rf, ok := ret.Get(3).(func([]types.NodeInfo, map[string]int) error); ok {
+		r3 = rf(nodesInfo, devices)
+	} else {
+		r3 = ret.Error(3)
+	}
+
+	return r0, r1, r2, r3
+}
+
 // SelectMemoryNodes provides a mock function with given fields: nodesInfo, quota, memory
 func (_m *Scheduler) SelectMemoryNodes(nodesInfo []types.NodeInfo, quota float64, memory int64) ([]types.NodeInfo, int, error) {
 	ret := _m.Called(nodesInfo, quota, memory)
@@ -263,3 +362,26 @@ func (_m *Scheduler) SelectVolumeNodes(nodeInfo []types.NodeInfo, vbs types.Volu
 
 	return r0, r1, r2, r3
 }
+
+// SpreadDivision provides a mock function with given fields: nodesInfo, need, label
+func (_m *Scheduler) SpreadDivision(nodesInfo []types.NodeInfo, need int, label string) ([]types.NodeInfo, error) {
+	ret := _m.Called(nodesInfo, need, label)
+
+	var r0 []types.NodeInfo
+	if rf, ok := ret.Get(0).(func([]types.NodeInfo, int, string) []types.NodeInfo); ok {
+		r0 = rf(nodesInfo, need, label)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]types.NodeInfo)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]types.NodeInfo, int, string) error); ok {
+		r1 = rf(nodesInfo, need, label)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
@@ -10,12 +10,17 @@ type Scheduler interface {
 	// typically used to build image
 	MaxIdleNode(nodes []*types.Node) (*types.Node, error)
 	SelectStorageNodes(nodesInfo []types.NodeInfo, storage int64) ([]types.NodeInfo, int, error)
+	SelectBandwidthNodes(nodesInfo []types.NodeInfo, bandwidth int64) ([]types.NodeInfo, int, error)
 	SelectMemoryNodes(nodesInfo []types.NodeInfo, quota float64, memory int64) ([]types.NodeInfo, int, error)
 	// select nodes from nodes, return a list of nodenames and the corresponding cpumap, and also the changed nodes with remaining cpumap
 	// quota and number must be given, typically used to determine where to deploy
 	SelectCPUNodes(nodesInfo []types.NodeInfo, quota float64, memory int64) ([]types.NodeInfo, map[string][]types.CPUMap, int, error)
 	// select nodes from nodes, return a list a nodenames and the corresponding volumemap
 	SelectVolumeNodes(nodeInfo []types.NodeInfo, vbs types.VolumeBindings) ([]types.NodeInfo, map[string][]types.VolumePlan, int, error)
+	// select nodes from nodes, return a list of nodenames and the corresponding devices for each container
+	SelectDeviceNodes(nodesInfo []types.NodeInfo, devices map[string]int) ([]types.NodeInfo, map[string][]types.DeviceMap, int, error)
+	// filter nodes by affinity, capacity will be cut by max containers per node
+	SelectAffinityNodes(nodesInfo []types.NodeInfo, affinity *types.Affinity) ([]types.NodeInfo, int, error)
 	// global division
 	GlobalDivision(nodesInfo []types.NodeInfo, need, total int) ([]types.NodeInfo, error)
 	// common division
@@ -24,4 +29,6 @@ type Scheduler interface {
 	EachDivision(nodesInfo []types.NodeInfo, need, limit int) ([]types.NodeInfo, error)
 	// fill division
 	FillDivision(nodesInfo []types.NodeInfo, need, limit int) ([]types.NodeInfo, error)
+	// spread division
+	SpreadDivision(nodesInfo []types.NodeInfo, need int, label string) ([]types.NodeInfo, error)
 }
@@ -0,0 +1,67 @@
+package etcdv3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// AddAuditLog save an audit log, expired after audit ttl
+// storage path in etcd is `/auditlog/:time_:random`
+func (m *Mercury) AddAuditLog(ctx context.Context, auditLog *types.AuditLog) error {
+	bytes, err := json.Marshal(auditLog)
+	if err != nil {
+		return err
+	}
+	key := fmt.Sprintf("%s/%020d_%s", auditLogPrefix, auditLog.Time, utils.RandomString(8))
+	opts := []clientv3.OpOption{}
+	if ttl := m.config.Audit.TTL; ttl >= time.Second {
+		// 同一时间窗口内的审计日志共用一个 lease
+		leaseID, err := m.grantLease(ctx, ttl)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, clientv3.WithLease(leaseID))
+	}
+	_, err = m.Put(ctx, key, string(bytes), opts...)
+	return err
+}
+
+// ListAuditLogs list audit logs matched query, newest first
+func (m *Mercury) ListAuditLogs(ctx context.Context, query *types.AuditLogQuery) ([]*types.AuditLog, error) {
+	prefix := auditLogPrefix + "/"
+	end := clientv3.GetPrefixRangeEnd(prefix)
+	if query.End > 0 {
+		end = fmt.Sprintf("%s%020d", prefix, query.End)
+	}
+	resp, err := m.Get(ctx, fmt.Sprintf("%s%020d", prefix, query.Start),
+		clientv3.WithRange(end),
+		clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := []*types.AuditLog{}
+	for _, kv := range resp.Kvs {
+		auditLog := &types.AuditLog{}
+		if err := json.Unmarshal(kv.Value, auditLog); err != nil {
+			log.Errorf("[ListAuditLogs] Bad audit log %s %v", kv.Value, err)
+			continue
+		}
+		if !query.Matches(auditLog) {
+			continue
+		}
+		logs = append(logs, auditLog)
+		if query.Limit > 0 && len(logs) >= query.Limit {
+			break
+		}
+	}
+	return logs, nil
+}
@@ -0,0 +1,43 @@
+package etcdv3
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLog(t *testing.T) {
+	m := NewMercury(t)
+	defer m.TerminateEmbededStorage()
+	ctx := context.Background()
+
+	assert.NoError(t, m.AddAuditLog(ctx, &types.AuditLog{Time: 10, Method: "AddPod", Username: "u1"}))
+	assert.NoError(t, m.AddAuditLog(ctx, &types.AuditLog{Time: 20, Method: "RemovePod", Username: "u2"}))
+	m.config.Audit.TTL = time.Minute
+	assert.NoError(t, m.AddAuditLog(ctx, &types.AuditLog{Time: 30, Method: "AddNode", Username: "u1"}))
+	assert.NoError(t, m.AddAuditLog(ctx, &types.AuditLog{Time: 40, Method: "SetNode", Username: "u2"}))
+	// logs in the same window share one lease
+	leases, err := m.cliv3.Leases(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, leases.Leases, 1)
+
+	// all, newest first
+	logs, err := m.ListAuditLogs(ctx, &types.AuditLogQuery{})
+	assert.NoError(t, err)
+	assert.Len(t, logs, 4)
+	assert.Equal(t, "SetNode", logs[0].Method)
+	assert.Equal(t, "AddPod", logs[3].Method)
+	// time range
+	logs, err = m.ListAuditLogs(ctx, &types.AuditLogQuery{Start: 15, End: 30})
+	assert.NoError(t, err)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, "RemovePod", logs[0].Method)
+	// user and limit
+	logs, err = m.ListAuditLogs(ctx, &types.AuditLogQuery{Username: "u1", Limit: 1})
+	assert.NoError(t, err)
+	assert.Len(t, logs, 1)
+	assert.Equal(t, "AddNode", logs[0].Method)
+}
@@ -0,0 +1,84 @@
+package etcdv3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/projecteru2/core/types"
+)
+
+// SetAutoscalePolicy save autoscale policy
+// storage path in etcd is `/autoscale/policy/:appname/:entrypoint`
+func (m *Mercury) SetAutoscalePolicy(ctx context.Context, policy *types.AutoscalePolicy) error {
+	bytes, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	_, err = m.Put(ctx, filepath.Join(autoscalePolicyPrefix, policy.Appname, policy.Entrypoint), string(bytes))
+	return err
+}
+
+// ListAutoscalePolicies list all autoscale policies
+func (m *Mercury) ListAutoscalePolicies(ctx context.Context) ([]*types.AutoscalePolicy, error) {
+	resp, err := m.Get(ctx, autoscalePolicyPrefix+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	policies := []*types.AutoscalePolicy{}
+	for _, ev := range resp.Kvs {
+		policy := &types.AutoscalePolicy{}
+		if err := json.Unmarshal(ev.Value, policy); err != nil {
+			return nil, err
+		}
+		policies = append(policies, policy)
+	}
+	return policies, nil
+}
+
+// RemoveAutoscalePolicy remove autoscale policy, decisions will be kept
+func (m *Mercury) RemoveAutoscalePolicy(ctx context.Context, appname, entrypoint string) error {
+	_, err := m.Delete(ctx, filepath.Join(autoscalePolicyPrefix, appname, entrypoint))
+	return err
+}
+
+// AddAutoscaleDecision record a decision, expired after decision ttl
+// storage path in etcd is `/autoscale/decision/:appname/:entrypoint/:time`
+func (m *Mercury) AddAutoscaleDecision(ctx context.Context, decision *types.AutoscaleDecision) error {
+	bytes, err := json.Marshal(decision)
+	if err != nil {
+		return err
+	}
+	// 补齐位数, 保证按 key 排序即按时间排序
+	key := filepath.Join(autoscaleDecisionPrefix, decision.Appname, decision.Entrypoint, fmt.Sprintf("%020d", decision.Time))
+	opts := []clientv3.OpOption{}
+	if ttl := m.config.Reconcile.DecisionTTL; ttl > 0 {
+		leaseID, err := m.grantLease(ctx, ttl)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, clientv3.WithLease(leaseID))
+	}
+	_, err = m.Put(ctx, key, string(bytes), opts...)
+	return err
+}
+
+// ListAutoscaleDecisions list decisions of an app entrypoint, newest first
+func (m *Mercury) ListAutoscaleDecisions(ctx context.Context, appname, entrypoint string, limit int64) ([]*types.AutoscaleDecision, error) {
+	key := filepath.Join(autoscaleDecisionPrefix, appname, entrypoint) + "/"
+	resp, err := m.Get(ctx, key, clientv3.WithPrefix(), clientv3.WithSort(clientv3.SortByKey, clientv3.SortDescend), clientv3.WithLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	decisions := []*types.AutoscaleDecision{}
+	for _, ev := range resp.Kvs {
+		decision := &types.AutoscaleDecision{}
+		if err := json.Unmarshal(ev.Value, decision); err != nil {
+			return nil, err
+		}
+		decisions = append(decisions, decision)
+	}
+	return decisions, nil
+}
@@ -0,0 +1,49 @@
+package etcdv3
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAutoscale(t *testing.T) {
+	m := NewMercury(t)
+	defer m.TerminateEmbededStorage()
+	ctx := context.Background()
+
+	policy := &types.AutoscalePolicy{Appname: "app", Entrypoint: "entry", Min: 1, Max: 3, Metric: "qps", Threshold: 100}
+	assert.NoError(t, m.SetAutoscalePolicy(ctx, policy))
+	policy.Entrypoint = "entry2"
+	assert.NoError(t, m.SetAutoscalePolicy(ctx, policy))
+	ps, err := m.ListAutoscalePolicies(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, ps, 2)
+	assert.NoError(t, m.RemoveAutoscalePolicy(ctx, "app", "entry2"))
+	ps, err = m.ListAutoscalePolicies(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, ps, 1)
+	assert.Equal(t, "entry", ps[0].Entrypoint)
+
+	for i := int64(1); i <= 3; i++ {
+		assert.NoError(t, m.AddAutoscaleDecision(ctx, &types.AutoscaleDecision{Appname: "app", Entrypoint: "entry", Time: i * 10, To: int(i)}))
+	}
+	assert.NoError(t, m.AddAutoscaleDecision(ctx, &types.AutoscaleDecision{Appname: "app", Entrypoint: "entry2", Time: 100}))
+	ds, err := m.ListAutoscaleDecisions(ctx, "app", "entry", 2)
+	assert.NoError(t, err)
+	assert.Len(t, ds, 2)
+	assert.Equal(t, int64(30), ds[0].Time)
+	assert.Equal(t, int64(20), ds[1].Time)
+
+	// decisions expire with lease
+	m.config.Reconcile.DecisionTTL = time.Hour
+	assert.NoError(t, m.AddAutoscaleDecision(ctx, &types.AutoscaleDecision{Appname: "app", Entrypoint: "entry3", Time: 100}))
+	resp, err := m.Get(ctx, filepath.Join(autoscaleDecisionPrefix, "app", "entry3", fmt.Sprintf("%020d", 100)))
+	assert.NoError(t, err)
+	assert.Len(t, resp.Kvs, 1)
+	assert.NotZero(t, resp.Kvs[0].Lease)
+}
@@ -123,7 +123,7 @@ func (m *Mercury) SetContainerStatus(ctx context.Context, container *types.Conta
 }
 
 // ListContainers list containers
-func (m *Mercury) ListContainers(ctx context.Context, appname, entrypoint, nodename string, limit int64, labels map[string]string) ([]*types.Container, error) {
+func (m *Mercury) ListContainers(ctx context.Context, appname, entrypoint, nodename string, limit int64, selector types.LabelSelector) ([]*types.Container, error) {
 	if appname == "" {
 		entrypoint = ""
 	}
@@ -143,7 +143,7 @@ func (m *Mercury) ListContainers(ctx context.Context, appname, entrypoint, noden
 		if err := json.Unmarshal(ev.Value, container); err != nil {
 			return nil, err
 		}
-		if utils.FilterContainer(container.Labels, labels) {
+		if selector.Matches(container.Labels) {
 			containers = append(containers, container)
 		}
 	}
@@ -152,7 +152,7 @@ func (m *Mercury) ListContainers(ctx context.Context, appname, entrypoint, noden
 }
 
 // ListNodeContainers list containers belong to one node
-func (m *Mercury) ListNodeContainers(ctx context.Context, nodename string, labels map[string]string) ([]*types.Container, error) {
+func (m *Mercury) ListNodeContainers(ctx context.Context, nodename string, selector types.LabelSelector) ([]*types.Container, error) {
 	key := fmt.Sprintf(nodeContainersKey, nodename, "")
 	resp, err := m.Get(ctx, key, clientv3.WithPrefix())
 	if err != nil {
@@ -165,7 +165,7 @@ func (m *Mercury) ListNodeContainers(ctx context.Context, nodename string, label
 		if err := json.Unmarshal(ev.Value, container); err != nil {
 			return []*types.Container{}, err
 		}
-		if utils.FilterContainer(container.Labels, labels) {
+		if selector.Matches(container.Labels) {
 			containers = append(containers, container)
 		}
 	}
@@ -174,7 +174,7 @@ func (m *Mercury) ListNodeContainers(ctx context.Context, nodename string, label
 }
 
 // ContainerStatusStream watch deployed status
-func (m *Mercury) ContainerStatusStream(ctx context.Context, appname, entrypoint, nodename string, labels map[string]string) chan *types.ContainerStatus {
+func (m *Mercury) ContainerStatusStream(ctx context.Context, appname, entrypoint, nodename string, selector types.LabelSelector) chan *types.ContainerStatus {
 	if appname == "" {
 		entrypoint = ""
 	}
@@ -201,7 +201,7 @@ func (m *Mercury) ContainerStatusStream(ctx context.Context, appname, entrypoint
 				}
 				if container, err := m.GetContainer(ctx, ID); err != nil {
 					msg.Error = err
-				} else if utils.FilterContainer(container.Labels, labels) {
+				} else if selector.Matches(container.Labels) {
 					log.Debugf("[ContainerStatusStream] container %s status changed", container.ID)
 					msg.Container = container
 				} else {
@@ -195,7 +195,7 @@ func TestListContainers(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, cs)
 	// labels
-	cs, err = m.ListContainers(ctx, "", "a", "b", 1, map[string]string{"x": "z"})
+	cs, err = m.ListContainers(ctx, "", "a", "b", 1, types.MakeLabelSelector(map[string]string{"x": "z"}))
 	assert.NoError(t, err)
 	assert.Empty(t, cs)
 }
@@ -232,7 +232,7 @@ func TestListNodeContainers(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, cs)
 	// labels
-	cs, err = m.ListNodeContainers(ctx, nodename, map[string]string{"x": "z"})
+	cs, err = m.ListNodeContainers(ctx, nodename, types.MakeLabelSelector(map[string]string{"x": "z"}))
 	assert.NoError(t, err)
 	assert.Empty(t, cs)
 }
@@ -25,9 +25,36 @@ func (m *Mercury) MakeDeployStatus(ctx context.Context, opts *types.DeployOption
 	if err != nil {
 		return nil, err
 	}
+	if opts.Affinity != nil {
+		if nodesInfo, err = m.doGetColocateStatus(ctx, opts.Affinity.Colocate, nodesInfo); err != nil {
+			return nil, err
+		}
+	}
 	return m.doLoadProcessing(ctx, opts, nodesInfo)
 }
 
+// doGetColocateStatus count containers of colocated apps on each node
+func (m *Mercury) doGetColocateStatus(ctx context.Context, appnames []string, nodesInfo []types.NodeInfo) ([]types.NodeInfo, error) {
+	for _, appname := range appnames {
+		resp, err := m.Get(ctx, filepath.Join(containerDeployPrefix, appname)+"/", clientv3.WithPrefix(), clientv3.WithKeysOnly())
+		if err != nil {
+			return nil, err
+		}
+		nodesCount := map[string]int{}
+		for _, ev := range resp.Kvs {
+			parts := strings.Split(string(ev.Key), "/")
+			nodesCount[parts[len(parts)-2]]++
+		}
+		for p, nodeInfo := range nodesInfo {
+			if nodesInfo[p].Colocated == nil {
+				nodesInfo[p].Colocated = map[string]int{}
+			}
+			nodesInfo[p].Colocated[appname] = nodesCount[nodeInfo.Name]
+		}
+	}
+	return nodesInfo, nil
+}
+
 func (m *Mercury) doGetDeployStatus(ctx context.Context, resp *clientv3.GetResponse, nodesInfo []types.NodeInfo) ([]types.NodeInfo, error) {
 	nodesCount := map[string]int{}
 	for _, ev := range resp.Kvs {
@@ -38,4 +38,14 @@ func TestDeploy(t *testing.T) {
 	assert.Equal(t, len(nodesInfo), 1)
 	assert.Equal(t, nodesInfo[0].Name, nodeInfo.Name)
 	assert.Equal(t, nodesInfo[0].Count, 2)
+
+	// colocated apps
+	key = filepath.Join(containerDeployPrefix, "other", "web", "node", "id3")
+	_, err = m.Put(ctx, key, "")
+	assert.NoError(t, err)
+	opts.Affinity = &types.Affinity{Colocate: []string{"other", "none"}}
+	nodesInfo, err = m.MakeDeployStatus(ctx, opts, []types.NodeInfo{nodeInfo})
+	assert.NoError(t, err)
+	assert.Equal(t, nodesInfo[0].Colocated["other"], 1)
+	assert.Equal(t, nodesInfo[0].Colocated["none"], 0)
 }
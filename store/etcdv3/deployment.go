@@ -0,0 +1,55 @@
+package etcdv3
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/projecteru2/core/types"
+)
+
+// SetDeployment save desired deployment
+// storage path in etcd is `/deployment/:appname/:entrypoint`
+func (m *Mercury) SetDeployment(ctx context.Context, deployment *types.Deployment) error {
+	key := filepath.Join(deploymentPrefix, deployment.Appname, deployment.Entrypoint)
+	bytes, err := json.Marshal(deployment)
+	if err != nil {
+		return err
+	}
+	_, err = m.Put(ctx, key, string(bytes))
+	return err
+}
+
+// GetDeployment get desired deployment of an app entrypoint
+func (m *Mercury) GetDeployment(ctx context.Context, appname, entrypoint string) (*types.Deployment, error) {
+	ev, err := m.GetOne(ctx, filepath.Join(deploymentPrefix, appname, entrypoint))
+	if err != nil {
+		return nil, err
+	}
+	deployment := &types.Deployment{}
+	return deployment, json.Unmarshal(ev.Value, deployment)
+}
+
+// ListDeployments list all desired deployments
+func (m *Mercury) ListDeployments(ctx context.Context) ([]*types.Deployment, error) {
+	resp, err := m.Get(ctx, deploymentPrefix+"/", clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	deployments := []*types.Deployment{}
+	for _, ev := range resp.Kvs {
+		deployment := &types.Deployment{}
+		if err := json.Unmarshal(ev.Value, deployment); err != nil {
+			return nil, err
+		}
+		deployments = append(deployments, deployment)
+	}
+	return deployments, nil
+}
+
+// RemoveDeployment remove desired deployment, containers will not be touched
+func (m *Mercury) RemoveDeployment(ctx context.Context, appname, entrypoint string) error {
+	_, err := m.Delete(ctx, filepath.Join(deploymentPrefix, appname, entrypoint))
+	return err
+}
@@ -0,0 +1,48 @@
+package etcdv3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeployment(t *testing.T) {
+	m := NewMercury(t)
+	defer m.TerminateEmbededStorage()
+	ctx := context.Background()
+
+	_, err := m.GetDeployment(ctx, "app", "entry")
+	assert.Error(t, err)
+
+	deployment := &types.Deployment{
+		Appname:    "app",
+		Entrypoint: "entry",
+		Podname:    "pod",
+		Count:      3,
+		Options: &types.DeployOptions{
+			Name:       "app",
+			Entrypoint: &types.Entrypoint{Name: "entry"},
+			Volumes:    types.MustToVolumeBindings([]string{"/tmp:/tmp"}),
+		},
+	}
+	assert.NoError(t, m.SetDeployment(ctx, deployment))
+	d, err := m.GetDeployment(ctx, "app", "entry")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, d.Count)
+	assert.Equal(t, "entry", d.Options.Entrypoint.Name)
+	assert.Len(t, d.Options.Volumes, 1)
+
+	deployment.Entrypoint = "entry2"
+	assert.NoError(t, m.SetDeployment(ctx, deployment))
+	ds, err := m.ListDeployments(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, ds, 2)
+
+	assert.NoError(t, m.RemoveDeployment(ctx, "app", "entry"))
+	ds, err = m.ListDeployments(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, ds, 1)
+	assert.Equal(t, "entry2", ds[0].Entrypoint)
+}
@@ -0,0 +1,96 @@
+package etcdv3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/projecteru2/core/types"
+	"github.com/projecteru2/core/utils"
+	log "github.com/sirupsen/logrus"
+)
+
+// AddEvent record an event, expired after event ttl
+// storage path in etcd is `/events/:time_:random`
+func (m *Mercury) AddEvent(ctx context.Context, event *types.Event) error {
+	bytes, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	// 补齐位数, 保证按 key 排序即按时间排序, 随机后缀避免同一时间冲突
+	key := fmt.Sprintf("%s/%020d_%s", eventPrefix, event.Time, utils.RandomString(8))
+	opts := []clientv3.OpOption{}
+	if ttl := m.config.Event.TTL; ttl >= time.Second {
+		// 同一时间窗口内的事件共用一个 lease
+		leaseID, err := m.grantLease(ctx, ttl)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, clientv3.WithLease(leaseID))
+	}
+	_, err = m.Put(ctx, key, string(bytes), opts...)
+	return err
+}
+
+// EventStream watch new events, events after since are sent first if since is set
+func (m *Mercury) EventStream(ctx context.Context, since int64) chan *types.Event {
+	prefix := eventPrefix + "/"
+	ch := make(chan *types.Event)
+	go func() {
+		defer close(ch)
+		watchOpts := []clientv3.OpOption{clientv3.WithPrefix()}
+		if since > 0 {
+			resp, err := m.Get(ctx, fmt.Sprintf("%s%020d", prefix, since), clientv3.WithRange(clientv3.GetPrefixRangeEnd(prefix)))
+			if err != nil {
+				log.Errorf("[EventStream] Get events failed %v", err)
+				return
+			}
+			for _, kv := range resp.Kvs {
+				if event, err := parseEvent(kv.Value); err == nil && !sendEvent(ctx, ch, event) {
+					return
+				}
+			}
+			// 从读到的版本之后开始 watch, 不丢不重
+			watchOpts = append(watchOpts, clientv3.WithRev(resp.Header.Revision+1))
+		}
+		for resp := range m.watch(ctx, prefix, watchOpts...) {
+			if resp.Err() != nil {
+				if !resp.Canceled {
+					log.Errorf("[EventStream] watch failed %v", resp.Err())
+				}
+				return
+			}
+			for _, ev := range resp.Events {
+				// 过期删除的不算
+				if ev.Type != clientv3.EventTypePut {
+					continue
+				}
+				if event, err := parseEvent(ev.Kv.Value); err == nil && !sendEvent(ctx, ch, event) {
+					return
+				}
+			}
+		}
+	}()
+	return ch
+}
+
+// sendEvent return false if ctx done before event sent
+func sendEvent(ctx context.Context, ch chan *types.Event, event *types.Event) bool {
+	select {
+	case ch <- event:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func parseEvent(data []byte) (*types.Event, error) {
+	event := &types.Event{}
+	if err := json.Unmarshal(data, event); err != nil {
+		log.Errorf("[EventStream] Bad event %s %v", data, err)
+		return nil, err
+	}
+	return event, nil
+}
@@ -0,0 +1,53 @@
+package etcdv3
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvent(t *testing.T) {
+	m := NewMercury(t)
+	defer m.TerminateEmbededStorage()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	assert.NoError(t, m.AddEvent(ctx, &types.Event{Kind: "pod", Action: "add", Target: "p1", Time: 10}))
+	assert.NoError(t, m.AddEvent(ctx, &types.Event{Kind: "pod", Action: "add", Target: "p2", Time: 20}))
+
+	// replay events after since, then watch new events
+	ch := m.EventStream(ctx, 15)
+	event := <-ch
+	assert.Equal(t, "p2", event.Target)
+	assert.Equal(t, int64(20), event.Time)
+	// events with ttl
+	m.config.Event.TTL = time.Minute
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		assert.NoError(t, m.AddEvent(ctx, &types.Event{Kind: "node", Action: "remove", Target: "n1", Time: 30}))
+	}()
+	event = <-ch
+	assert.Equal(t, "n1", event.Target)
+	assert.Equal(t, "remove", event.Action)
+	// events in the same window share one lease
+	assert.NoError(t, m.AddEvent(ctx, &types.Event{Kind: "node", Action: "remove", Target: "n2", Time: 40}))
+	<-ch
+	leases, err := m.cliv3.Leases(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, leases.Leases, 1)
+
+	cancel()
+	for range ch {
+	}
+
+	// stream not read any more exits when ctx done
+	ctx, cancel = context.WithCancel(context.Background())
+	ch = m.EventStream(ctx, 1)
+	time.Sleep(100 * time.Millisecond)
+	cancel()
+	for range ch {
+	}
+}
@@ -0,0 +1,35 @@
+package etcdv3
+
+import (
+	"context"
+	"time"
+
+	"github.com/coreos/etcd/clientv3"
+)
+
+// leaseWindows keys put in the same window of ttl share one lease
+const leaseWindows = 10
+
+type sharedLease struct {
+	ID      clientv3.LeaseID
+	renewAt time.Time
+}
+
+// grantLease return a lease living at least ttl from now
+// a lease is shared by keys put in the same window, instead of one lease per key
+// so keys live between ttl and ttl+ttl/leaseWindows
+func (m *Mercury) grantLease(ctx context.Context, ttl time.Duration) (clientv3.LeaseID, error) {
+	m.leaseMutex.Lock()
+	defer m.leaseMutex.Unlock()
+	now := time.Now()
+	if lease, ok := m.leases[ttl]; ok && now.Before(lease.renewAt) {
+		return lease.ID, nil
+	}
+	window := ttl / leaseWindows
+	resp, err := m.cliv3.Grant(ctx, int64((ttl + window).Seconds()))
+	if err != nil {
+		return 0, err
+	}
+	m.leases[ttl] = &sharedLease{ID: resp.ID, renewAt: now.Add(window)}
+	return resp.ID, nil
+}
@@ -0,0 +1,36 @@
+package etcdv3
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGrantLease(t *testing.T) {
+	m := NewMercury(t)
+	defer m.TerminateEmbededStorage()
+	ctx := context.Background()
+
+	// shared in window
+	id1, err := m.grantLease(ctx, 100*time.Second)
+	assert.NoError(t, err)
+	id2, err := m.grantLease(ctx, 100*time.Second)
+	assert.NoError(t, err)
+	assert.Equal(t, id1, id2)
+	resp, err := m.cliv3.TimeToLive(ctx, id1)
+	assert.NoError(t, err)
+	assert.True(t, resp.TTL > 100)
+
+	// other ttl, other lease
+	id3, err := m.grantLease(ctx, 200*time.Second)
+	assert.NoError(t, err)
+	assert.NotEqual(t, id1, id3)
+
+	// window passed, new lease
+	m.leases[100*time.Second].renewAt = time.Now()
+	id4, err := m.grantLease(ctx, 100*time.Second)
+	assert.NoError(t, err)
+	assert.NotEqual(t, id1, id4)
+}
@@ -34,7 +34,16 @@ const (
 	containerInfoKey          = "/containers/%s" // /containers/{containerID}
 	containerDeployPrefix     = "/deploy"        // /deploy/{appname}/{entrypoint}/{nodename}/{containerID}
 	containerStatusPrefix     = "/status"        // /status/{appname}/{entrypoint}/{nodename}/{containerID} value -> something by agent
-	containerProcessingPrefix = "/processing"    // /processing/{appname}/{entrypoint}/{nodename}/{opsIdent} value -> count
+	containerProcessingPrefix = "/processing"    // /processing/{appname}/{entrypoint}/{nodename}/{opsIdent} value -> count with resource
+
+	deploymentPrefix        = "/deployment"         // /deployment/{appname}/{entrypoint}
+	autoscalePolicyPrefix   = "/autoscale/policy"   // /autoscale/policy/{appname}/{entrypoint}
+	autoscaleDecisionPrefix = "/autoscale/decision" // /autoscale/decision/{appname}/{entrypoint}/{time}
+	quotaPrefix             = "/quota"              // /quota/{appname}/{podname}
+	eventPrefix             = "/events"             // /events/{time}_{random}
+	auditLogPrefix          = "/auditlog"           // /auditlog/{time}_{random}
+	rbacPolicyKey           = "/rbac/policy"
+	secretKey               = "/secrets/%s" // /secrets/{name}
 
 	cmpVersion = "version"
 	cmpValue   = "value"
@@ -44,6 +53,9 @@ const (
 type Mercury struct {
 	cliv3  *clientv3.Client
 	config types.Config
+
+	leaseMutex sync.Mutex
+	leases     map[time.Duration]*sharedLease
 }
 
 // New for create a Mercury instance
@@ -80,7 +92,7 @@ func New(config types.Config, embededStorage bool) (*Mercury, error) {
 	cliv3.KV = namespace.NewKV(cliv3.KV, config.Etcd.Prefix)
 	cliv3.Watcher = namespace.NewWatcher(cliv3.Watcher, config.Etcd.Prefix)
 	cliv3.Lease = namespace.NewLease(cliv3.Lease, config.Etcd.Prefix)
-	return &Mercury{cliv3: cliv3, config: config}, nil
+	return &Mercury{cliv3: cliv3, config: config, leases: map[time.Duration]*sharedLease{}}, nil
 }
 
 // TerminateEmbededStorage terminate embeded storage
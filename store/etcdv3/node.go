@@ -14,7 +14,6 @@ import (
 	enginefactory "github.com/projecteru2/core/engine/factory"
 	"github.com/projecteru2/core/metrics"
 	"github.com/projecteru2/core/types"
-	"github.com/projecteru2/core/utils"
 	log "github.com/sirupsen/logrus"
 )
 
@@ -75,7 +74,7 @@ func (m *Mercury) AddNode(ctx context.Context, opts *types.AddNodeOptions) (*typ
 		}
 	}
 
-	return m.doAddNode(ctx, opts.Nodename, opts.Endpoint, opts.Podname, opts.Ca, opts.Cert, opts.Key, opts.CPU, opts.Share, opts.Memory, opts.Storage, opts.Labels, opts.Numa, opts.NumaMemory, opts.Volume)
+	return m.doAddNode(ctx, opts.Nodename, opts.Endpoint, opts.Podname, opts.Ca, opts.Cert, opts.Key, opts.CPU, opts.Share, opts.Memory, opts.Storage, opts.Bandwidth, opts.Labels, opts.Numa, opts.NumaMemory, opts.Volume, opts.Devices)
 }
 
 // RemoveNode delete a node
@@ -112,13 +111,13 @@ func (m *Mercury) GetNodes(ctx context.Context, nodenames []string) ([]*types.No
 
 // GetNodesByPod get all nodes bound to pod
 // here we use podname instead of pod instance
-func (m *Mercury) GetNodesByPod(ctx context.Context, podname string, labels map[string]string, all bool) ([]*types.Node, error) {
+func (m *Mercury) GetNodesByPod(ctx context.Context, podname string, selector types.LabelSelector, all bool) ([]*types.Node, error) {
 	key := fmt.Sprintf(nodePodKey, podname, "")
 	resp, err := m.Get(ctx, key, clientv3.WithPrefix())
 	if err != nil {
 		return []*types.Node{}, err
 	}
-	return m.doGetNodes(ctx, resp.Kvs, labels, all)
+	return m.doGetNodes(ctx, resp.Kvs, selector, all)
 }
 
 // UpdateNode update a node, save it to etcd
@@ -140,25 +139,32 @@ func (m *Mercury) UpdateNode(ctx context.Context, node *types.Node) error {
 }
 
 // UpdateNodeResource update cpu and memory on a node, either add or subtract
-func (m *Mercury) UpdateNodeResource(ctx context.Context, node *types.Node, cpu types.CPUMap, quota float64, memory, storage int64, volume types.VolumeMap, action string) error {
+func (m *Mercury) UpdateNodeResource(ctx context.Context, node *types.Node, cpu types.CPUMap, quota float64, memory, storage, bandwidth int64, volume types.VolumeMap, devices types.DeviceMap, action string) error {
 	switch action {
 	case store.ActionIncr:
 		node.CPU.Add(cpu)
 		node.SetCPUUsed(quota, types.DecrUsage)
 		node.Volume.Add(volume)
 		node.SetVolumeUsed(volume.Total(), types.DecrUsage)
+		node.Devices.Add(devices)
 		node.MemCap += memory
 		node.StorageCap += storage
+		node.Bandwidth += bandwidth
 		if nodeID := node.GetNUMANode(cpu); nodeID != "" {
 			node.IncrNUMANodeMemory(nodeID, memory)
 		}
 	case store.ActionDecr:
+		if !node.Devices.Contains(devices) {
+			return types.NewDetailedErr(types.ErrInsufficientRes, fmt.Sprintf("node %s devices %v not free", node.Name, devices))
+		}
 		node.CPU.Sub(cpu)
 		node.SetCPUUsed(quota, types.IncrUsage)
 		node.Volume.Sub(volume)
 		node.SetVolumeUsed(volume.Total(), types.IncrUsage)
+		node.Devices.Sub(devices)
 		node.MemCap -= memory
 		node.StorageCap -= storage
+		node.Bandwidth -= bandwidth
 		if nodeID := node.GetNUMANode(cpu); nodeID != "" {
 			node.DecrNUMANodeMemory(nodeID, memory)
 		}
@@ -166,10 +172,18 @@ func (m *Mercury) UpdateNodeResource(ctx context.Context, node *types.Node, cpu
 		return types.ErrUnknownControlType
 	}
 
-	go metrics.Client.SendNodeInfo(node)
+	sendNodeInfo(node)
 	return m.UpdateNode(ctx, node)
 }
 
+// sendNodeInfo send a copy of node, callers keep changing node after stored
+func sendNodeInfo(node *types.Node) {
+	n := *node
+	n.CPU = types.CPUMap{}
+	n.CPU.Add(node.CPU)
+	go metrics.Client.SendNodeInfo(&n)
+}
+
 func (m *Mercury) makeClient(ctx context.Context, node *types.Node, force bool) (engine.API, error) {
 	// try get client, if nil, create a new one
 	var client engine.API
@@ -200,7 +214,7 @@ func (m *Mercury) makeClient(ctx context.Context, node *types.Node, force bool)
 	return client, nil
 }
 
-func (m *Mercury) doAddNode(ctx context.Context, name, endpoint, podname, ca, cert, key string, cpu, share int, memory, storage int64, labels map[string]string, numa types.NUMA, numaMemory types.NUMAMemory, volumemap types.VolumeMap) (*types.Node, error) {
+func (m *Mercury) doAddNode(ctx context.Context, name, endpoint, podname, ca, cert, key string, cpu, share int, memory, storage, bandwidth int64, labels map[string]string, numa types.NUMA, numaMemory types.NUMAMemory, volumemap types.VolumeMap, devices types.DeviceMap) (*types.Node, error) {
 	data := map[string]string{}
 	// 如果有tls的证书需要保存就保存一下
 	if ca != "" && cert != "" && key != "" {
@@ -225,8 +239,12 @@ func (m *Mercury) doAddNode(ctx context.Context, name, endpoint, podname, ca, ce
 		InitCPU:        cpumap,
 		InitMemCap:     memory,
 		InitStorageCap: storage,
+		Bandwidth:      bandwidth,
+		InitBandwidth:  bandwidth,
 		InitNUMAMemory: numaMemory,
 		InitVolume:     volumemap,
+		Devices:        devices.Copy(),
+		InitDevices:    devices.Copy(),
 		Available:      true,
 		Labels:         labels,
 		NUMA:           numa,
@@ -247,7 +265,7 @@ func (m *Mercury) doAddNode(ctx context.Context, name, endpoint, podname, ca, ce
 		return nil, err
 	}
 
-	go metrics.Client.SendNodeInfo(node)
+	sendNodeInfo(node)
 	return node, nil
 }
 
@@ -270,7 +288,7 @@ func (m *Mercury) doRemoveNode(ctx context.Context, podname, nodename, endpoint
 	return err
 }
 
-func (m *Mercury) doGetNodes(ctx context.Context, kvs []*mvccpb.KeyValue, labels map[string]string, all bool) ([]*types.Node, error) {
+func (m *Mercury) doGetNodes(ctx context.Context, kvs []*mvccpb.KeyValue, selector types.LabelSelector, all bool) ([]*types.Node, error) {
 	nodes := []*types.Node{}
 	for _, ev := range kvs {
 		node := &types.Node{}
@@ -278,7 +296,7 @@ func (m *Mercury) doGetNodes(ctx context.Context, kvs []*mvccpb.KeyValue, labels
 			return nil, err
 		}
 		node.Init()
-		if (node.Available || all) && utils.FilterContainer(node.Labels, labels) {
+		if (node.Available || all) && selector.Matches(node.Labels) {
 			engine, err := m.makeClient(ctx, node, false)
 			if err != nil {
 				return nil, err
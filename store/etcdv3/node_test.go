@@ -2,6 +2,7 @@ package etcdv3
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 
@@ -131,7 +132,7 @@ RdCPRPt513WozkJZZAjUSP2U
 	nodename3 := "nodename3"
 	endpoint3 := "tcp://path"
 	m.config.CertPath = "/tmp"
-	node3, err := m.doAddNode(ctx, nodename3, endpoint3, podname, ca, cert, certkey, cpu, share, memory, storage, labels, nil, nil, nil)
+	node3, err := m.doAddNode(ctx, nodename3, endpoint3, podname, ca, cert, certkey, cpu, share, memory, storage, 0, labels, nil, nil, nil, nil)
 	assert.NoError(t, err)
 	engine3, err := m.makeClient(ctx, node3, true)
 	assert.NoError(t, err)
@@ -147,7 +148,7 @@ func TestRemoveNode(t *testing.T) {
 	m := NewMercury(t)
 	defer m.TerminateEmbededStorage()
 	ctx := context.Background()
-	node, err := m.doAddNode(ctx, "test", "mock://", "testpod", "", "", "", 100, 100, 100000, 100000, nil, nil, nil, nil)
+	node, err := m.doAddNode(ctx, "test", "mock://", "testpod", "", "", "", 100, 100, 100000, 100000, 0, nil, nil, nil, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, node.Name, "test")
 	assert.NoError(t, m.RemoveNode(ctx, nil))
@@ -158,7 +159,7 @@ func TestGetNode(t *testing.T) {
 	m := NewMercury(t)
 	defer m.TerminateEmbededStorage()
 	ctx := context.Background()
-	node, err := m.doAddNode(ctx, "test", "mock://", "testpod", "", "", "", 100, 100, 100000, 100000, nil, nil, nil, nil)
+	node, err := m.doAddNode(ctx, "test", "mock://", "testpod", "", "", "", 100, 100, 100000, 100000, 0, nil, nil, nil, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, node.Name, "test")
 	_, err = m.GetNode(ctx, "wtf")
@@ -172,7 +173,7 @@ func TestGetNodesByPod(t *testing.T) {
 	m := NewMercury(t)
 	defer m.TerminateEmbededStorage()
 	ctx := context.Background()
-	node, err := m.doAddNode(ctx, "test", "mock://", "testpod", "", "", "", 100, 100, 100000, 100000, map[string]string{"x": "y"}, nil, nil, nil)
+	node, err := m.doAddNode(ctx, "test", "mock://", "testpod", "", "", "", 100, 100, 100000, 100000, 0, map[string]string{"x": "y"}, nil, nil, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, node.Name, "test")
 	ns, err := m.GetNodesByPod(ctx, "wtf", nil, false)
@@ -187,7 +188,7 @@ func TestUpdateNode(t *testing.T) {
 	m := NewMercury(t)
 	defer m.TerminateEmbededStorage()
 	ctx := context.Background()
-	node, err := m.doAddNode(ctx, "test", "mock://", "testpod", "", "", "", 100, 100, 100000, 100000, map[string]string{"x": "y"}, nil, nil, nil)
+	node, err := m.doAddNode(ctx, "test", "mock://", "testpod", "", "", "", 100, 100, 100000, 100000, 0, map[string]string{"x": "y"}, nil, nil, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, node.Name, "test")
 	fakeNode := &types.Node{
@@ -202,10 +203,36 @@ func TestUpdateNodeResource(t *testing.T) {
 	m := NewMercury(t)
 	defer m.TerminateEmbededStorage()
 	ctx := context.Background()
-	node, err := m.doAddNode(ctx, "test", "mock://", "testpod", "", "", "", 1, 100, 100000, 100000, map[string]string{"x": "y"}, map[string]string{"0": "0"}, map[string]int64{"0": 100}, nil)
+	node, err := m.doAddNode(ctx, "test", "mock://", "testpod", "", "", "", 1, 100, 100000, 100000, 0, map[string]string{"x": "y"}, map[string]string{"0": "0"}, map[string]int64{"0": 100}, nil, nil)
 	assert.NoError(t, err)
 	assert.Equal(t, node.Name, "test")
-	assert.Error(t, m.UpdateNodeResource(ctx, node, nil, 0, 0, 0, nil, "wtf"))
-	assert.NoError(t, m.UpdateNodeResource(ctx, node, map[string]int64{"0": 100}, 0, 0, 0, nil, store.ActionIncr))
-	assert.NoError(t, m.UpdateNodeResource(ctx, node, map[string]int64{"0": 100}, 0, 0, 0, nil, store.ActionDecr))
+	assert.Error(t, m.UpdateNodeResource(ctx, node, nil, 0, 0, 0, 0, nil, nil, "wtf"))
+	assert.NoError(t, m.UpdateNodeResource(ctx, node, map[string]int64{"0": 100}, 0, 0, 0, 0, nil, nil, store.ActionIncr))
+	assert.NoError(t, m.UpdateNodeResource(ctx, node, map[string]int64{"0": 100}, 0, 0, 0, 0, nil, nil, store.ActionDecr))
+
+	// only accounting, overcommit is checked by cluster
+	assert.NoError(t, m.UpdateNodeResource(ctx, node, nil, 2, 150000, 0, 0, nil, nil, store.ActionDecr))
+	assert.Equal(t, int64(-50000), node.MemCap)
+	assert.Equal(t, 2.0, node.CPUUsed)
+}
+
+func TestUpdateNodeResourceDevices(t *testing.T) {
+	m := NewMercury(t)
+	defer m.TerminateEmbededStorage()
+	ctx := context.Background()
+	devices := types.DeviceMap{"gpu": {"0", "1"}}
+	node, err := m.doAddNode(ctx, "test", "mock://", "testpod", "", "", "", 1, 100, 100000, 100000, 0, nil, nil, nil, nil, devices)
+	assert.NoError(t, err)
+	assert.Equal(t, devices, node.InitDevices)
+
+	assert.NoError(t, m.UpdateNodeResource(ctx, node, nil, 0, 0, 0, 0, nil, types.DeviceMap{"gpu": {"1"}}, store.ActionDecr))
+	node, err = m.GetNode(ctx, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, types.DeviceMap{"gpu": {"0"}}, node.Devices)
+	assert.Equal(t, devices, node.InitDevices)
+	// device taken already
+	assert.True(t, errors.Is(m.UpdateNodeResource(ctx, node, nil, 0, 0, 0, 0, nil, types.DeviceMap{"gpu": {"1"}}, store.ActionDecr), types.ErrInsufficientRes))
+
+	assert.NoError(t, m.UpdateNodeResource(ctx, node, nil, 0, 0, 0, 0, nil, types.DeviceMap{"gpu": {"1"}}, store.ActionIncr))
+	assert.Equal(t, devices, node.Devices)
 }
@@ -41,6 +41,17 @@ func (m *Mercury) GetPod(ctx context.Context, name string) (*types.Pod, error) {
 	return pod, err
 }
 
+// UpdatePod update a pod, save it to etcd
+// storage path in etcd is `/pod/info/:podname`
+func (m *Mercury) UpdatePod(ctx context.Context, pod *types.Pod) error {
+	bytes, err := json.Marshal(pod)
+	if err != nil {
+		return err
+	}
+	_, err = m.Put(ctx, fmt.Sprintf(podInfoKey, pod.Name), string(bytes))
+	return err
+}
+
 // RemovePod if the pod has no nodes left, otherwise return an error
 func (m *Mercury) RemovePod(ctx context.Context, podname string) error {
 	key := fmt.Sprintf(podInfoKey, podname)
@@ -22,6 +22,13 @@ func TestPod(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, pod2.Name, podname)
 
+	pod2.MemoryOvercommit = 2
+	assert.NoError(t, m.UpdatePod(ctx, pod2))
+	pod2, err = m.GetPod(ctx, podname)
+	assert.NoError(t, err)
+	assert.Equal(t, pod2.Desc, "CPU")
+	assert.Equal(t, pod2.MemoryOvercommit, 2.0)
+
 	pods, err := m.GetAllPods(ctx)
 	assert.NoError(t, err)
 	assert.Equal(t, len(pods), 1)
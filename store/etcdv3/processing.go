@@ -2,10 +2,11 @@ package etcdv3
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/sanity-io/litter"
 
@@ -17,14 +18,22 @@ import (
 // SaveProcessing save processing status in etcd
 func (m *Mercury) SaveProcessing(ctx context.Context, opts *types.DeployOptions, nodeInfo types.NodeInfo) error {
 	processingKey := filepath.Join(containerProcessingPrefix, opts.Name, opts.Entrypoint.Name, nodeInfo.Name, opts.ProcessIdent)
-	_, err := m.Create(ctx, processingKey, fmt.Sprintf("%d", nodeInfo.Deploy))
+	value, err := makeProcessingValue(opts, nodeInfo.Deploy)
+	if err != nil {
+		return err
+	}
+	_, err = m.Create(ctx, processingKey, value)
 	return err
 }
 
 // UpdateProcessing update processing status in etcd
 func (m *Mercury) UpdateProcessing(ctx context.Context, opts *types.DeployOptions, nodename string, count int) error {
 	processingKey := filepath.Join(containerProcessingPrefix, opts.Name, opts.Entrypoint.Name, nodename, opts.ProcessIdent)
-	_, err := m.Update(ctx, processingKey, fmt.Sprintf("%d", count))
+	value, err := makeProcessingValue(opts, count)
+	if err != nil {
+		return err
+	}
+	_, err = m.Update(ctx, processingKey, value)
 	return err
 }
 
@@ -35,6 +44,28 @@ func (m *Mercury) DeleteProcessing(ctx context.Context, opts *types.DeployOption
 	return err
 }
 
+// ListProcessing list processing status of an app, or of all apps if appname is empty
+func (m *Mercury) ListProcessing(ctx context.Context, appname string) ([]*types.Processing, error) {
+	processingKey := containerProcessingPrefix + "/"
+	if appname != "" {
+		processingKey = filepath.Join(containerProcessingPrefix, appname) + "/"
+	}
+	resp, err := m.Get(ctx, processingKey, clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	processing := []*types.Processing{}
+	for _, ev := range resp.Kvs {
+		p, err := parseProcessing(string(ev.Key), ev.Value)
+		if err != nil {
+			log.Errorf("[ListProcessing] Load processing status failed %v", err)
+			continue
+		}
+		processing = append(processing, p)
+	}
+	return processing, nil
+}
+
 func (m *Mercury) doLoadProcessing(ctx context.Context, opts *types.DeployOptions, nodesInfo []types.NodeInfo) ([]types.NodeInfo, error) {
 	// 显式的加 / 保证 prefix 一致性
 	processingKey := filepath.Join(containerProcessingPrefix, opts.Name, opts.Entrypoint.Name) + "/"
@@ -48,22 +79,45 @@ func (m *Mercury) doLoadProcessing(ctx context.Context, opts *types.DeployOption
 	}
 	nodesCount := map[string]int{}
 	for _, ev := range resp.Kvs {
-		key := string(ev.Key)
-		parts := strings.Split(key, "/")
-		nodename := parts[len(parts)-2]
-		count, err := strconv.Atoi(string(ev.Value))
+		p, err := parseProcessing(string(ev.Key), ev.Value)
 		if err != nil {
 			log.Errorf("[doLoadProcessing] Load processing status failed %v", err)
 			continue
 		}
-		if _, ok := nodesCount[nodename]; !ok {
-			nodesCount[nodename] = count
-			continue
-		}
-		nodesCount[nodename] += count
+		nodesCount[p.Nodename] += p.Count
 	}
 
 	log.Debug("[doLoadProcessing] Processing result:")
 	litter.Dump(nodesCount)
 	return setCount(nodesCount, nodesInfo), nil
 }
+
+// makeProcessingValue count with resource of each container
+func makeProcessingValue(opts *types.DeployOptions, count int) (string, error) {
+	bytes, err := json.Marshal(&types.Processing{
+		Podname:   opts.Podname,
+		Count:     count,
+		CPU:       opts.CPUQuota,
+		Memory:    opts.Memory,
+		Storage:   opts.Storage,
+		UpdatedAt: time.Now().UnixNano(),
+	})
+	return string(bytes), err
+}
+
+// parseProcessing key is /processing/{appname}/{entrypoint}/{nodename}/{opsIdent}
+// value of old version is count only
+func parseProcessing(key string, value []byte) (*types.Processing, error) {
+	p := &types.Processing{}
+	if count, err := strconv.Atoi(string(value)); err == nil {
+		p.Count = count
+	} else if err := json.Unmarshal(value, p); err != nil {
+		return nil, err
+	}
+	parts := strings.Split(key, "/")
+	if len(parts) < 4 {
+		return nil, types.NewDetailedErr(types.ErrBadMeta, key)
+	}
+	p.Appname, p.Entrypoint, p.Nodename, p.Ident = parts[len(parts)-4], parts[len(parts)-3], parts[len(parts)-2], parts[len(parts)-1]
+	return p, nil
+}
@@ -3,6 +3,7 @@ package etcdv3
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/projecteru2/core/types"
 	"github.com/stretchr/testify/assert"
@@ -16,6 +17,9 @@ func TestProcessing(t *testing.T) {
 		Name:         "app",
 		Entrypoint:   &types.Entrypoint{Name: "entry"},
 		ProcessIdent: "abc",
+		Podname:      "pod",
+		Memory:       100,
+		CPUQuota:     0.5,
 	}
 	nodeInfo := types.NodeInfo{Name: "node", Deploy: 10}
 
@@ -32,6 +36,31 @@ func TestProcessing(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, len(nodesInfo), 1)
 	assert.Equal(t, nodesInfo[0].Count, 8)
+	// list with resource
+	processing, err := m.ListProcessing(ctx, "app")
+	assert.NoError(t, err)
+	assert.Len(t, processing, 1)
+	assert.False(t, processing[0].Stale(time.Minute))
+	processing[0].UpdatedAt = 0
+	assert.Equal(t, []*types.Processing{{
+		Appname: "app", Entrypoint: "entry", Nodename: "node", Ident: "abc",
+		Podname: "pod", Count: 8, CPU: 0.5, Memory: 100,
+	}}, processing)
+	processing, err = m.ListProcessing(ctx, "ap")
+	assert.NoError(t, err)
+	assert.Empty(t, processing)
+	// value of old version
+	_, err = m.Put(ctx, "/processing/app/entry/node/old", "2")
+	assert.NoError(t, err)
+	processing, err = m.ListProcessing(ctx, "")
+	assert.NoError(t, err)
+	assert.Len(t, processing, 2)
+	for _, p := range processing {
+		assert.Equal(t, p.Ident == "old", p.Stale(time.Minute))
+	}
+	nodesInfo, err = m.doLoadProcessing(ctx, opts, []types.NodeInfo{{Name: "node"}})
+	assert.NoError(t, err)
+	assert.Equal(t, 10, nodesInfo[0].Count)
 	// delete
 	assert.NoError(t, m.DeleteProcessing(ctx, opts, nodeInfo))
 }
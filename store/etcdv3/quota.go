@@ -0,0 +1,47 @@
+package etcdv3
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/projecteru2/core/types"
+)
+
+// SetQuota save quota of an app
+// storage path in etcd is `/quota/:appname/:podname`, podname is omitted for app wide quota
+func (m *Mercury) SetQuota(ctx context.Context, quota *types.Quota) error {
+	bytes, err := json.Marshal(quota)
+	if err != nil {
+		return err
+	}
+	_, err = m.Put(ctx, filepath.Join(quotaPrefix, quota.Appname, quota.Podname), string(bytes))
+	return err
+}
+
+// ListQuotas list quotas of an app, or all quotas if appname is empty
+func (m *Mercury) ListQuotas(ctx context.Context, appname string) ([]*types.Quota, error) {
+	// app wide quota 的 key 没有结尾的 /, 所以拿到的可能有其他 app 的
+	resp, err := m.Get(ctx, filepath.Join(quotaPrefix, appname), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	quotas := []*types.Quota{}
+	for _, ev := range resp.Kvs {
+		quota := &types.Quota{}
+		if err := json.Unmarshal(ev.Value, quota); err != nil {
+			return nil, err
+		}
+		if appname == "" || quota.Appname == appname {
+			quotas = append(quotas, quota)
+		}
+	}
+	return quotas, nil
+}
+
+// RemoveQuota remove quota of an app
+func (m *Mercury) RemoveQuota(ctx context.Context, appname, podname string) error {
+	_, err := m.Delete(ctx, filepath.Join(quotaPrefix, appname, podname))
+	return err
+}
@@ -0,0 +1,35 @@
+package etcdv3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuota(t *testing.T) {
+	m := NewMercury(t)
+	defer m.TerminateEmbededStorage()
+	ctx := context.Background()
+
+	assert.NoError(t, m.SetQuota(ctx, &types.Quota{Appname: "app", QuotaResource: types.QuotaResource{Memory: 100}}))
+	assert.NoError(t, m.SetQuota(ctx, &types.Quota{Appname: "app", Podname: "p1", QuotaResource: types.QuotaResource{Count: 2}}))
+	assert.NoError(t, m.SetQuota(ctx, &types.Quota{Appname: "apple", QuotaResource: types.QuotaResource{CPU: 1}}))
+
+	quotas, err := m.ListQuotas(ctx, "app")
+	assert.NoError(t, err)
+	assert.Len(t, quotas, 2)
+	for _, quota := range quotas {
+		assert.Equal(t, "app", quota.Appname)
+	}
+	quotas, err = m.ListQuotas(ctx, "")
+	assert.NoError(t, err)
+	assert.Len(t, quotas, 3)
+
+	assert.NoError(t, m.RemoveQuota(ctx, "app", ""))
+	quotas, err = m.ListQuotas(ctx, "app")
+	assert.NoError(t, err)
+	assert.Len(t, quotas, 1)
+	assert.Equal(t, "p1", quotas[0].Podname)
+}
@@ -0,0 +1,29 @@
+package etcdv3
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/projecteru2/core/types"
+)
+
+// GetRBACPolicy get rbac policy
+// storage path in etcd is `/rbac/policy`
+func (m *Mercury) GetRBACPolicy(ctx context.Context) (*types.RBACPolicy, error) {
+	ev, err := m.GetOne(ctx, rbacPolicyKey)
+	if err != nil {
+		return nil, err
+	}
+	policy := &types.RBACPolicy{}
+	return policy, json.Unmarshal(ev.Value, policy)
+}
+
+// SetRBACPolicy save rbac policy
+func (m *Mercury) SetRBACPolicy(ctx context.Context, policy *types.RBACPolicy) error {
+	bytes, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	_, err = m.Put(ctx, rbacPolicyKey, string(bytes))
+	return err
+}
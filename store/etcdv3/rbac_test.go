@@ -0,0 +1,26 @@
+package etcdv3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRBACPolicy(t *testing.T) {
+	m := NewMercury(t)
+	defer m.TerminateEmbededStorage()
+	ctx := context.Background()
+
+	_, err := m.GetRBACPolicy(ctx)
+	assert.Error(t, err)
+	policy := &types.RBACPolicy{
+		Users: []types.RBACUser{{Username: "u1", Password: "p1", Roles: []string{"r1"}}},
+		Roles: []types.RBACRole{{Name: "r1", Methods: []string{"List*"}, Pods: []string{"p1"}}},
+	}
+	assert.NoError(t, m.SetRBACPolicy(ctx, policy))
+	p, err := m.GetRBACPolicy(ctx)
+	assert.NoError(t, err)
+	assert.Equal(t, policy, p)
+}
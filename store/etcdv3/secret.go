@@ -0,0 +1,54 @@
+package etcdv3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/coreos/etcd/clientv3"
+	"github.com/projecteru2/core/types"
+)
+
+// SetSecret save secret, value should be encrypted already
+// storage path in etcd is `/secrets/:name`
+func (m *Mercury) SetSecret(ctx context.Context, secret *types.Secret) error {
+	bytes, err := json.Marshal(secret)
+	if err != nil {
+		return err
+	}
+	_, err = m.Put(ctx, fmt.Sprintf(secretKey, secret.Name), string(bytes))
+	return err
+}
+
+// GetSecret get secret with encrypted value
+func (m *Mercury) GetSecret(ctx context.Context, name string) (*types.Secret, error) {
+	ev, err := m.GetOne(ctx, fmt.Sprintf(secretKey, name))
+	if err != nil {
+		return nil, err
+	}
+	secret := &types.Secret{}
+	return secret, json.Unmarshal(ev.Value, secret)
+}
+
+// ListSecrets list all secrets with encrypted value
+func (m *Mercury) ListSecrets(ctx context.Context) ([]*types.Secret, error) {
+	resp, err := m.Get(ctx, fmt.Sprintf(secretKey, ""), clientv3.WithPrefix())
+	if err != nil {
+		return nil, err
+	}
+	secrets := []*types.Secret{}
+	for _, ev := range resp.Kvs {
+		secret := &types.Secret{}
+		if err := json.Unmarshal(ev.Value, secret); err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets, nil
+}
+
+// RemoveSecret remove secret
+func (m *Mercury) RemoveSecret(ctx context.Context, name string) error {
+	_, err := m.Delete(ctx, fmt.Sprintf(secretKey, name))
+	return err
+}
@@ -0,0 +1,34 @@
+package etcdv3
+
+import (
+	"context"
+	"testing"
+
+	"github.com/projecteru2/core/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSecret(t *testing.T) {
+	m := NewMercury(t)
+	defer m.TerminateEmbededStorage()
+	ctx := context.Background()
+
+	_, err := m.GetSecret(ctx, "s1")
+	assert.Error(t, err)
+	s1 := &types.Secret{Name: "s1", Value: []byte("v1"), Apps: []string{"app"}, Version: 1}
+	assert.NoError(t, m.SetSecret(ctx, s1))
+	assert.NoError(t, m.SetSecret(ctx, &types.Secret{Name: "s2", Value: []byte("v2"), Version: 1}))
+	secret, err := m.GetSecret(ctx, "s1")
+	assert.NoError(t, err)
+	assert.Equal(t, s1, secret)
+
+	secrets, err := m.ListSecrets(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 2)
+
+	assert.NoError(t, m.RemoveSecret(ctx, "s1"))
+	secrets, err = m.ListSecrets(ctx)
+	assert.NoError(t, err)
+	assert.Len(t, secrets, 1)
+	assert.Equal(t, "s2", secrets[0].Name)
+}
@@ -14,6 +14,34 @@ type Store struct {
 	mock.Mock
 }
 
+// AddAuditLog provides a mock function with given fields: ctx, log
+func (_m *Store) AddAuditLog(ctx context.Context, log *types.AuditLog) error {
+	ret := _m.Called(ctx, log)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AuditLog) error); ok {
+		r0 = rf(ctx, log)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddAutoscaleDecision provides a mock function with given fields: ctx, decision
+func (_m *Store) AddAutoscaleDecision(ctx context.Context, decision *types.AutoscaleDecision) error {
+	ret := _m.Called(ctx, decision)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AutoscaleDecision) error); ok {
+		r0 = rf(ctx, decision)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddContainer provides a mock function with given fields: ctx, container
 func (_m *Store) AddContainer(ctx context.Context, container *types.Container) error {
 	ret := _m.Called(ctx, container)
@@ -28,6 +56,20 @@ func (_m *Store) AddContainer(ctx context.Context, container *types.Container) e
 	return r0
 }
 
+// AddEvent provides a mock function with given fields: ctx, event
+func (_m *Store) AddEvent(ctx context.Context, event *types.Event) error {
+	ret := _m.Called(ctx, event)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Event) error); ok {
+		r0 = rf(ctx, event)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddNode provides a mock function with given fields: _a0, _a1
 func (_m *Store) AddNode(_a0 context.Context, _a1 *types.AddNodeOptions) (*types.Node, error) {
 	ret := _m.Called(_a0, _a1)
@@ -74,13 +116,13 @@ func (_m *Store) AddPod(ctx context.Context, name string, desc string) (*types.P
 	return r0, r1
 }
 
-// ContainerStatusStream provides a mock function with given fields: ctx, appname, entrypoint, nodename, labels
-func (_m *Store) ContainerStatusStream(ctx context.Context, appname string, entrypoint string, nodename string, labels map[string]string) chan *types.ContainerStatus {
-	ret := _m.Called(ctx, appname, entrypoint, nodename, labels)
+// ContainerStatusStream provides a mock function with given fields: ctx, appname, entrypoint, nodename, selector
+func (_m *Store) ContainerStatusStream(ctx context.Context, appname string, entrypoint string, nodename string, selector types.LabelSelector) chan *types.ContainerStatus {
+	ret := _m.Called(ctx, appname, entrypoint, nodename, selector)
 
 	var r0 chan *types.ContainerStatus
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, map[string]string) chan *types.ContainerStatus); ok {
-		r0 = rf(ctx, appname, entrypoint, nodename, labels)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, types.LabelSelector) chan *types.ContainerStatus); ok {
+		r0 = rf(ctx, appname, entrypoint, nodename, selector)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(chan *types.ContainerStatus)
@@ -127,6 +169,22 @@ func (_m *Store) DeleteProcessing(ctx context.Context, opts *types.DeployOptions
 	return r0
 }
 
+// EventStream provides a mock function with given fields: ctx, since
+func (_m *Store) EventStream(ctx context.Context, since int64) chan *types.Event {
+	ret := _m.Called(ctx, since)
+
+	var r0 chan *types.Event
+	if rf, ok := ret.Get(0).(func(context.Context, int64) chan *types.Event); ok {
+		r0 = rf(ctx, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(chan *types.Event)
+		}
+	}
+
+	return r0
+}
+
 // GetAllPods provides a mock function with given fields: ctx
 func (_m *Store) GetAllPods(ctx context.Context) ([]*types.Pod, error) {
 	ret := _m.Called(ctx)
@@ -219,6 +277,29 @@ func (_m *Store) GetContainers(ctx context.Context, IDs []string) ([]*types.Cont
 	return r0, r1
 }
 
+// GetDeployment provides a mock function with given fields: ctx, appname, entrypoint
+func (_m *Store) GetDeployment(ctx context.Context, appname string, entrypoint string) (*types.Deployment, error) {
+	ret := _m.Called(ctx, appname, entrypoint)
+
+	var r0 *types.Deployment
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *types.Deployment); ok {
+		r0 = rf(ctx, appname, entrypoint)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Deployment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, appname, entrypoint)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetNode provides a mock function with given fields: ctx, nodename
 func (_m *Store) GetNode(ctx context.Context, nodename string) (*types.Node, error) {
 	ret := _m.Called(ctx, nodename)
@@ -265,13 +346,13 @@ func (_m *Store) GetNodes(ctx context.Context, nodenames []string) ([]*types.Nod
 	return r0, r1
 }
 
-// GetNodesByPod provides a mock function with given fields: ctx, podname, labels, all
-func (_m *Store) GetNodesByPod(ctx context.Context, podname string, labels map[string]string, all bool) ([]*types.Node, error) {
-	ret := _m.Called(ctx, podname, labels, all)
+// GetNodesByPod provides a mock function with given fields: ctx, podname, selector, all
+func (_m *Store) GetNodesByPod(ctx context.Context, podname string, selector types.LabelSelector, all bool) ([]*types.Node, error) {
+	ret := _m.Called(ctx, podname, selector, all)
 
 	var r0 []*types.Node
-	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]string, bool) []*types.Node); ok {
-		r0 = rf(ctx, podname, labels, all)
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.LabelSelector, bool) []*types.Node); ok {
+		r0 = rf(ctx, podname, selector, all)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*types.Node)
@@ -279,8 +360,8 @@ func (_m *Store) GetNodesByPod(ctx context.Context, podname string, labels map[s
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]string, bool) error); ok {
-		r1 = rf(ctx, podname, labels, all)
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.LabelSelector, bool) error); ok {
+		r1 = rf(ctx, podname, selector, all)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -311,13 +392,128 @@ func (_m *Store) GetPod(ctx context.Context, podname string) (*types.Pod, error)
 	return r0, r1
 }
 
-// ListContainers provides a mock function with given fields: ctx, appname, entrypoint, nodename, limit, labels
-func (_m *Store) ListContainers(ctx context.Context, appname string, entrypoint string, nodename string, limit int64, labels map[string]string) ([]*types.Container, error) {
-	ret := _m.Called(ctx, appname, entrypoint, nodename, limit, labels)
+// GetRBACPolicy provides a mock function with given fields: ctx
+func (_m *Store) GetRBACPolicy(ctx context.Context) (*types.RBACPolicy, error) {
+	ret := _m.Called(ctx)
+
+	var r0 *types.RBACPolicy
+	if rf, ok := ret.Get(0).(func(context.Context) *types.RBACPolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.RBACPolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSecret provides a mock function with given fields: ctx, name
+func (_m *Store) GetSecret(ctx context.Context, name string) (*types.Secret, error) {
+	ret := _m.Called(ctx, name)
+
+	var r0 *types.Secret
+	if rf, ok := ret.Get(0).(func(context.Context, string) *types.Secret); ok {
+		r0 = rf(ctx, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.Secret)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAuditLogs provides a mock function with given fields: ctx, query
+func (_m *Store) ListAuditLogs(ctx context.Context, query *types.AuditLogQuery) ([]*types.AuditLog, error) {
+	ret := _m.Called(ctx, query)
+
+	var r0 []*types.AuditLog
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AuditLogQuery) []*types.AuditLog); ok {
+		r0 = rf(ctx, query)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.AuditLog)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.AuditLogQuery) error); ok {
+		r1 = rf(ctx, query)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAutoscaleDecisions provides a mock function with given fields: ctx, appname, entrypoint, limit
+func (_m *Store) ListAutoscaleDecisions(ctx context.Context, appname string, entrypoint string, limit int64) ([]*types.AutoscaleDecision, error) {
+	ret := _m.Called(ctx, appname, entrypoint, limit)
+
+	var r0 []*types.AutoscaleDecision
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, int64) []*types.AutoscaleDecision); ok {
+		r0 = rf(ctx, appname, entrypoint, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.AutoscaleDecision)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, int64) error); ok {
+		r1 = rf(ctx, appname, entrypoint, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAutoscalePolicies provides a mock function with given fields: ctx
+func (_m *Store) ListAutoscalePolicies(ctx context.Context) ([]*types.AutoscalePolicy, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*types.AutoscalePolicy
+	if rf, ok := ret.Get(0).(func(context.Context) []*types.AutoscalePolicy); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.AutoscalePolicy)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListContainers provides a mock function with given fields: ctx, appname, entrypoint, nodename, limit, selector
+func (_m *Store) ListContainers(ctx context.Context, appname string, entrypoint string, nodename string, limit int64, selector types.LabelSelector) ([]*types.Container, error) {
+	ret := _m.Called(ctx, appname, entrypoint, nodename, limit, selector)
 
 	var r0 []*types.Container
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int64, map[string]string) []*types.Container); ok {
-		r0 = rf(ctx, appname, entrypoint, nodename, limit, labels)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, int64, types.LabelSelector) []*types.Container); ok {
+		r0 = rf(ctx, appname, entrypoint, nodename, limit, selector)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*types.Container)
@@ -325,8 +521,8 @@ func (_m *Store) ListContainers(ctx context.Context, appname string, entrypoint
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int64, map[string]string) error); ok {
-		r1 = rf(ctx, appname, entrypoint, nodename, limit, labels)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, int64, types.LabelSelector) error); ok {
+		r1 = rf(ctx, appname, entrypoint, nodename, limit, selector)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -334,13 +530,36 @@ func (_m *Store) ListContainers(ctx context.Context, appname string, entrypoint
 	return r0, r1
 }
 
-// ListNodeContainers provides a mock function with given fields: ctx, nodename, labels
-func (_m *Store) ListNodeContainers(ctx context.Context, nodename string, labels map[string]string) ([]*types.Container, error) {
-	ret := _m.Called(ctx, nodename, labels)
+// ListDeployments provides a mock function with given fields: ctx
+func (_m *Store) ListDeployments(ctx context.Context) ([]*types.Deployment, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*types.Deployment
+	if rf, ok := ret.Get(0).(func(context.Context) []*types.Deployment); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Deployment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListNodeContainers provides a mock function with given fields: ctx, nodename, selector
+func (_m *Store) ListNodeContainers(ctx context.Context, nodename string, selector types.LabelSelector) ([]*types.Container, error) {
+	ret := _m.Called(ctx, nodename, selector)
 
 	var r0 []*types.Container
-	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]string) []*types.Container); ok {
-		r0 = rf(ctx, nodename, labels)
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.LabelSelector) []*types.Container); ok {
+		r0 = rf(ctx, nodename, selector)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]*types.Container)
@@ -348,8 +567,77 @@ func (_m *Store) ListNodeContainers(ctx context.Context, nodename string, labels
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]string) error); ok {
-		r1 = rf(ctx, nodename, labels)
+	if rf, ok := ret.Get(1).(func(context.Context, string, types.LabelSelector) error); ok {
+		r1 = rf(ctx, nodename, selector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListProcessing provides a mock function with given fields: ctx, appname
+func (_m *Store) ListProcessing(ctx context.Context, appname string) ([]*types.Processing, error) {
+	ret := _m.Called(ctx, appname)
+
+	var r0 []*types.Processing
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*types.Processing); ok {
+		r0 = rf(ctx, appname)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Processing)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, appname)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListQuotas provides a mock function with given fields: ctx, appname
+func (_m *Store) ListQuotas(ctx context.Context, appname string) ([]*types.Quota, error) {
+	ret := _m.Called(ctx, appname)
+
+	var r0 []*types.Quota
+	if rf, ok := ret.Get(0).(func(context.Context, string) []*types.Quota); ok {
+		r0 = rf(ctx, appname)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Quota)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, appname)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSecrets provides a mock function with given fields: ctx
+func (_m *Store) ListSecrets(ctx context.Context) ([]*types.Secret, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []*types.Secret
+	if rf, ok := ret.Get(0).(func(context.Context) []*types.Secret); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*types.Secret)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -380,6 +668,20 @@ func (_m *Store) MakeDeployStatus(ctx context.Context, opts *types.DeployOptions
 	return r0, r1
 }
 
+// RemoveAutoscalePolicy provides a mock function with given fields: ctx, appname, entrypoint
+func (_m *Store) RemoveAutoscalePolicy(ctx context.Context, appname string, entrypoint string) error {
+	ret := _m.Called(ctx, appname, entrypoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, appname, entrypoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveContainer provides a mock function with given fields: ctx, container
 func (_m *Store) RemoveContainer(ctx context.Context, container *types.Container) error {
 	ret := _m.Called(ctx, container)
@@ -394,6 +696,20 @@ func (_m *Store) RemoveContainer(ctx context.Context, container *types.Container
 	return r0
 }
 
+// RemoveDeployment provides a mock function with given fields: ctx, appname, entrypoint
+func (_m *Store) RemoveDeployment(ctx context.Context, appname string, entrypoint string) error {
+	ret := _m.Called(ctx, appname, entrypoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, appname, entrypoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveNode provides a mock function with given fields: ctx, node
 func (_m *Store) RemoveNode(ctx context.Context, node *types.Node) error {
 	ret := _m.Called(ctx, node)
@@ -422,6 +738,34 @@ func (_m *Store) RemovePod(ctx context.Context, podname string) error {
 	return r0
 }
 
+// RemoveQuota provides a mock function with given fields: ctx, appname, podname
+func (_m *Store) RemoveQuota(ctx context.Context, appname string, podname string) error {
+	ret := _m.Called(ctx, appname, podname)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, appname, podname)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveSecret provides a mock function with given fields: ctx, name
+func (_m *Store) RemoveSecret(ctx context.Context, name string) error {
+	ret := _m.Called(ctx, name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveProcessing provides a mock function with given fields: ctx, opts, nodeInfo
 func (_m *Store) SaveProcessing(ctx context.Context, opts *types.DeployOptions, nodeInfo types.NodeInfo) error {
 	ret := _m.Called(ctx, opts, nodeInfo)
@@ -436,6 +780,20 @@ func (_m *Store) SaveProcessing(ctx context.Context, opts *types.DeployOptions,
 	return r0
 }
 
+// SetAutoscalePolicy provides a mock function with given fields: ctx, policy
+func (_m *Store) SetAutoscalePolicy(ctx context.Context, policy *types.AutoscalePolicy) error {
+	ret := _m.Called(ctx, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.AutoscalePolicy) error); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SetContainerStatus provides a mock function with given fields: ctx, container, ttl
 func (_m *Store) SetContainerStatus(ctx context.Context, container *types.Container, ttl int64) error {
 	ret := _m.Called(ctx, container, ttl)
@@ -450,6 +808,62 @@ func (_m *Store) SetContainerStatus(ctx context.Context, container *types.Contai
 	return r0
 }
 
+// SetDeployment provides a mock function with given fields: ctx, deployment
+func (_m *Store) SetDeployment(ctx context.Context, deployment *types.Deployment) error {
+	ret := _m.Called(ctx, deployment)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Deployment) error); ok {
+		r0 = rf(ctx, deployment)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetQuota provides a mock function with given fields: ctx, quota
+func (_m *Store) SetQuota(ctx context.Context, quota *types.Quota) error {
+	ret := _m.Called(ctx, quota)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Quota) error); ok {
+		r0 = rf(ctx, quota)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetRBACPolicy provides a mock function with given fields: ctx, policy
+func (_m *Store) SetRBACPolicy(ctx context.Context, policy *types.RBACPolicy) error {
+	ret := _m.Called(ctx, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.RBACPolicy) error); ok {
+		r0 = rf(ctx, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetSecret provides a mock function with given fields: ctx, secret
+func (_m *Store) SetSecret(ctx context.Context, secret *types.Secret) error {
+	ret := _m.Called(ctx, secret)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Secret) error); ok {
+		r0 = rf(ctx, secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // TerminateEmbededStorage provides a mock function with given fields:
 func (_m *Store) TerminateEmbededStorage() {
 	_m.Called()
@@ -483,13 +897,27 @@ func (_m *Store) UpdateNode(ctx context.Context, node *types.Node) error {
 	return r0
 }
 
-// UpdateNodeResource provides a mock function with given fields: ctx, node, cpu, quota, memory, storage, volume, action
-func (_m *Store) UpdateNodeResource(ctx context.Context, node *types.Node, cpu types.ResourceMap, quota float64, memory int64, storage int64, volume types.ResourceMap, action string) error {
-	ret := _m.Called(ctx, node, cpu, quota, memory, storage, volume, action)
+// UpdateNodeResource provides a mock function with given fields: ctx, node, cpu, quota, memory, storage, bandwidth, volume, devices, action
+func (_m *Store) UpdateNodeResource(ctx context.Context, node *types.Node, cpu types.ResourceMap, quota float64, memory int64, storage int64, bandwidth int64, volume types.ResourceMap, devices types.DeviceMap, action string) error {
+	ret := _m.Called(ctx, node, cpu, quota, memory, storage, bandwidth, volume, devices, action)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Node, types.ResourceMap, float64, int64, int64, int64, types.ResourceMap, types.DeviceMap, string) error); ok {
+		r0 = rf(ctx, node, cpu, quota, memory, storage, bandwidth, volume, devices, action)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdatePod provides a mock function with given fields: ctx, pod
+func (_m *Store) UpdatePod(ctx context.Context, pod *types.Pod) error {
+	ret := _m.Called(ctx, pod)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *types.Node, types.ResourceMap, float64, int64, int64, types.ResourceMap, string) error); ok {
-		r0 = rf(ctx, node, cpu, quota, memory, storage, volume, action)
+	if rf, ok := ret.Get(0).(func(context.Context, *types.Pod) error); ok {
+		r0 = rf(ctx, pod)
 	} else {
 		r0 = ret.Error(0)
 	}
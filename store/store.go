@@ -20,6 +20,7 @@ type Store interface {
 	// pod
 	AddPod(ctx context.Context, name, desc string) (*types.Pod, error)
 	GetPod(ctx context.Context, podname string) (*types.Pod, error)
+	UpdatePod(ctx context.Context, pod *types.Pod) error
 	RemovePod(ctx context.Context, podname string) error
 	GetAllPods(ctx context.Context) ([]*types.Pod, error)
 
@@ -28,9 +29,9 @@ type Store interface {
 	RemoveNode(ctx context.Context, node *types.Node) error
 	GetNode(ctx context.Context, nodename string) (*types.Node, error)
 	GetNodes(ctx context.Context, nodenames []string) ([]*types.Node, error)
-	GetNodesByPod(ctx context.Context, podname string, labels map[string]string, all bool) ([]*types.Node, error)
+	GetNodesByPod(ctx context.Context, podname string, selector types.LabelSelector, all bool) ([]*types.Node, error)
 	UpdateNode(ctx context.Context, node *types.Node) error
-	UpdateNodeResource(ctx context.Context, node *types.Node, cpu types.CPUMap, quota float64, memory, storage int64, volume types.VolumeMap, action string) error
+	UpdateNodeResource(ctx context.Context, node *types.Node, cpu types.CPUMap, quota float64, memory, storage, bandwidth int64, volume types.VolumeMap, devices types.DeviceMap, action string) error
 
 	// container
 	AddContainer(ctx context.Context, container *types.Container) error
@@ -40,17 +41,54 @@ type Store interface {
 	GetContainers(ctx context.Context, IDs []string) ([]*types.Container, error)
 	GetContainerStatus(ctx context.Context, ID string) (*types.StatusMeta, error)
 	SetContainerStatus(ctx context.Context, container *types.Container, ttl int64) error
-	ListContainers(ctx context.Context, appname, entrypoint, nodename string, limit int64, labels map[string]string) ([]*types.Container, error)
-	ListNodeContainers(ctx context.Context, nodename string, labels map[string]string) ([]*types.Container, error)
-	ContainerStatusStream(ctx context.Context, appname, entrypoint, nodename string, labels map[string]string) chan *types.ContainerStatus
+	ListContainers(ctx context.Context, appname, entrypoint, nodename string, limit int64, selector types.LabelSelector) ([]*types.Container, error)
+	ListNodeContainers(ctx context.Context, nodename string, selector types.LabelSelector) ([]*types.Container, error)
+	ContainerStatusStream(ctx context.Context, appname, entrypoint, nodename string, selector types.LabelSelector) chan *types.ContainerStatus
 
 	// deploy status
 	MakeDeployStatus(ctx context.Context, opts *types.DeployOptions, nodesInfo []types.NodeInfo) ([]types.NodeInfo, error)
 
+	// desired deployment
+	SetDeployment(ctx context.Context, deployment *types.Deployment) error
+	GetDeployment(ctx context.Context, appname, entrypoint string) (*types.Deployment, error)
+	ListDeployments(ctx context.Context) ([]*types.Deployment, error)
+	RemoveDeployment(ctx context.Context, appname, entrypoint string) error
+
+	// autoscale
+	SetAutoscalePolicy(ctx context.Context, policy *types.AutoscalePolicy) error
+	ListAutoscalePolicies(ctx context.Context) ([]*types.AutoscalePolicy, error)
+	RemoveAutoscalePolicy(ctx context.Context, appname, entrypoint string) error
+	AddAutoscaleDecision(ctx context.Context, decision *types.AutoscaleDecision) error
+	ListAutoscaleDecisions(ctx context.Context, appname, entrypoint string, limit int64) ([]*types.AutoscaleDecision, error)
+
+	// quota
+	SetQuota(ctx context.Context, quota *types.Quota) error
+	ListQuotas(ctx context.Context, appname string) ([]*types.Quota, error)
+	RemoveQuota(ctx context.Context, appname, podname string) error
+
+	// event
+	AddEvent(ctx context.Context, event *types.Event) error
+	EventStream(ctx context.Context, since int64) chan *types.Event
+
+	// audit log
+	AddAuditLog(ctx context.Context, log *types.AuditLog) error
+	ListAuditLogs(ctx context.Context, query *types.AuditLogQuery) ([]*types.AuditLog, error)
+
+	// rbac
+	GetRBACPolicy(ctx context.Context) (*types.RBACPolicy, error)
+	SetRBACPolicy(ctx context.Context, policy *types.RBACPolicy) error
+
+	// secret
+	SetSecret(ctx context.Context, secret *types.Secret) error
+	GetSecret(ctx context.Context, name string) (*types.Secret, error)
+	ListSecrets(ctx context.Context) ([]*types.Secret, error)
+	RemoveSecret(ctx context.Context, name string) error
+
 	// processing status
 	SaveProcessing(ctx context.Context, opts *types.DeployOptions, nodeInfo types.NodeInfo) error
 	UpdateProcessing(ctx context.Context, opts *types.DeployOptions, nodename string, count int) error
 	DeleteProcessing(ctx context.Context, opts *types.DeployOptions, nodeInfo types.NodeInfo) error
+	ListProcessing(ctx context.Context, appname string) ([]*types.Processing, error)
 
 	// distributed lock
 	CreateLock(key string, ttl time.Duration) (lock.DistributedLock, error)
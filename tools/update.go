@@ -22,7 +22,7 @@ func main() {
 			Machines: []string{os.Args[2]},
 		},
 	}
-	m, err := etcdv3.New(config)
+	m, err := etcdv3.New(config, false)
 	if err != nil {
 		fmt.Println(err)
 		panic(err)
@@ -34,14 +34,14 @@ func main() {
 		panic(err)
 	}
 	for _, pod := range pods {
-		nodes, err := m.GetNodesByPod(ctx, pod.Name)
+		nodes, err := m.GetNodesByPod(ctx, pod.Name, nil, true)
 		if err != nil {
 			fmt.Println(err)
 			panic(err)
 		}
 		for _, node := range nodes {
 			fmt.Println(node.Name)
-			containers, err := m.ListNodeContainers(ctx, node.Name)
+			containers, err := m.ListNodeContainers(ctx, node.Name, nil)
 			if err != nil {
 				fmt.Println(err)
 				panic(err)
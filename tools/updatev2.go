@@ -38,7 +38,7 @@ func main() {
 			Machines: []string{os.Args[2]},
 		},
 	}
-	m, err := etcdv3.New(config)
+	m, err := etcdv3.New(config, false)
 	if err != nil {
 		fmt.Println(err)
 		panic(err)
@@ -17,7 +17,7 @@ func main() {
 	config, _ := utils.LoadConfig(os.Args[1])
 	store, _ := etcdv3.New(config, false)
 	ctx := context.Background()
-	cs, err := store.ListContainers(ctx, "", "", "", 0)
+	cs, err := store.ListContainers(ctx, "", "", "", 0, nil)
 	if err != nil {
 		panic(err)
 	}
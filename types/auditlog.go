@@ -0,0 +1,28 @@
+package types
+
+// AuditLog record a mutating request to core
+type AuditLog struct {
+	Time     int64  `json:"time"` // unix nano
+	Method   string `json:"method"`
+	Username string `json:"username,omitempty"`
+	Peer     string `json:"peer,omitempty"`
+	Request  string `json:"request,omitempty"` // summary of request, secrets scrubbed
+	Duration int64  `json:"duration"`          // nano second
+	Success  bool   `json:"success"`
+	Error    string `json:"error,omitempty"`
+}
+
+// AuditLogQuery filter audit logs, empty field matches all
+type AuditLogQuery struct {
+	Start    int64 // unix nano, inclusive
+	End      int64 // unix nano, exclusive
+	Username string
+	Limit    int // 0 for no limit
+}
+
+// Matches check audit log fits query
+func (q *AuditLogQuery) Matches(log *AuditLog) bool {
+	return (q.Start == 0 || log.Time >= q.Start) &&
+		(q.End == 0 || log.Time < q.End) &&
+		(q.Username == "" || q.Username == log.Username)
+}
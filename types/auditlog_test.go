@@ -0,0 +1,16 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAuditLogQueryMatches(t *testing.T) {
+	log := &AuditLog{Time: 10, Username: "u1"}
+	assert.True(t, (&AuditLogQuery{}).Matches(log))
+	assert.True(t, (&AuditLogQuery{Start: 10, End: 11, Username: "u1"}).Matches(log))
+	assert.False(t, (&AuditLogQuery{Start: 11}).Matches(log))
+	assert.False(t, (&AuditLogQuery{End: 10}).Matches(log))
+	assert.False(t, (&AuditLogQuery{Username: "u2"}).Matches(log))
+}
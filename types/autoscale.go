@@ -0,0 +1,23 @@
+package types
+
+// AutoscalePolicy scale containers of a desired deployment by metric in status extension
+type AutoscalePolicy struct {
+	Appname    string  `json:"appname"`
+	Entrypoint string  `json:"entrypoint"`
+	Min        int     `json:"min"`
+	Max        int     `json:"max"`
+	Metric     string  `json:"metric"`    // key of metric in extension json
+	Threshold  float64 `json:"threshold"` // target average value of metric per container
+	Cooldown   int64   `json:"cooldown"`  // min interval between two scaling, in second
+}
+
+// AutoscaleDecision record a scaling made by autoscaler
+type AutoscaleDecision struct {
+	Appname    string  `json:"appname"`
+	Entrypoint string  `json:"entrypoint"`
+	Time       int64   `json:"time"` // unix time
+	From       int     `json:"from"`
+	To         int     `json:"to"`
+	Value      float64 `json:"value"` // average value of metric
+	Error      string  `json:"error,omitempty"`
+}
@@ -14,6 +14,9 @@ type Config struct {
 	Profile       string        `yaml:"profile"`                                       // profile ip:port
 	CertPath      string        `yaml:"cert_path"`                                     // docker cert files path
 	Auth          AuthConfig    `yaml:"auth"`                                          // grpc auth
+	RBAC          RBACConfig    `yaml:"rbac"`                                          // grpc role based access control, replace auth if enabled
+	TLS           TLSConfig     `yaml:"tls"`                                           // grpc tls
+	Token         TokenConfig   `yaml:"token"`                                         // grpc bearer token auth
 	GRPCConfig    GRPCConfig    `yaml:"grpc"`                                          // grpc config
 
 	Git       GitConfig    `yaml:"git"`
@@ -21,6 +24,13 @@ type Config struct {
 	Docker    DockerConfig `yaml:"docker"`
 	Scheduler SchedConfig  `yaml:"scheduler"`
 	Virt      VirtConfig   `yaml:"virt"`
+
+	HealthCheck HealthCheckConfig `yaml:"healthcheck"`
+	Reconcile   ReconcileConfig   `yaml:"reconcile"`
+	NodeMonitor NodeMonitorConfig `yaml:"node_monitor"`
+	Event       EventConfig       `yaml:"event"`
+	Audit       AuditConfig       `yaml:"audit"`
+	Secret      SecretConfig      `yaml:"secret"`
 }
 
 // EtcdConfig holds eru-core etcd config
@@ -67,8 +77,86 @@ type LogConfig struct {
 
 // SchedConfig holds scheduler config
 type SchedConfig struct {
-	MaxShare  int `yaml:"maxshare" required:"true" default:"-1"`   // comlpex scheduler use maxshare
-	ShareBase int `yaml:"sharebase" required:"true" default:"100"` // how many pieces for one core
+	MaxShare  int               `yaml:"maxshare" required:"true" default:"-1"`   // comlpex scheduler use maxshare
+	ShareBase int               `yaml:"sharebase" required:"true" default:"100"` // how many pieces for one core
+	Type      string            `yaml:"type" default:"potassium"`                // potassium or scoring
+	Strategy  string            `yaml:"strategy" default:"spread"`               // scoring strategy, spread or binpack
+	Weights   ScoreWeights      `yaml:"weights"`                                 // scoring weights of resources
+	Pods      map[string]string `yaml:"pods"`                                    // scoring strategy of pods, override type and strategy
+}
+
+// ScoreWeights holds weights of resources usage in scoring
+type ScoreWeights struct {
+	CPU     float64 `yaml:"cpu" default:"1"`
+	Memory  float64 `yaml:"memory" default:"1"`
+	Storage float64 `yaml:"storage" default:"1"`
+	Volume  float64 `yaml:"volume" default:"1"`
+}
+
+// HealthCheckConfig holds core side health check config
+type HealthCheckConfig struct {
+	Enable      bool          `yaml:"enable"`                    // check containers health in core, no need of agent
+	Interval    time.Duration `yaml:"interval" default:"30s"`    // interval between two rounds of check
+	Timeout     time.Duration `yaml:"timeout" default:"5s"`      // timeout for each probe
+	TTL         int64         `yaml:"ttl" default:"60"`          // ttl of status written into store, in second
+	Concurrency int           `yaml:"concurrency" default:"100"` // max containers probed at the same time
+}
+
+// ReconcileConfig holds desired deployments reconciler config
+type ReconcileConfig struct {
+	Enable      bool          `yaml:"enable"`                     // converge containers to desired deployments
+	Interval    time.Duration `yaml:"interval" default:"60s"`     // interval between two rounds of reconcile
+	DecisionTTL time.Duration `yaml:"decision_ttl" default:"72h"` // how long autoscale decisions kept in store, 0 for forever
+}
+
+// NodeMonitorConfig holds node liveness monitor config
+type NodeMonitorConfig struct {
+	Enable    bool          `yaml:"enable"`                 // mark nodes down and up by engine info
+	Interval  time.Duration `yaml:"interval" default:"30s"` // interval between two rounds of probe
+	Timeout   time.Duration `yaml:"timeout" default:"5s"`   // timeout for each probe
+	Threshold int           `yaml:"threshold" default:"3"`  // continuous failures before node marked down
+}
+
+// EventConfig holds event log config
+type EventConfig struct {
+	TTL time.Duration `yaml:"ttl" default:"168h"` // how long events kept in store, 0 for forever
+}
+
+// AuditConfig holds audit log config
+type AuditConfig struct {
+	Sink string        `yaml:"sink"`                                  // file, etcd or syslog, empty for disabled
+	Path string        `yaml:"path" default:"/var/log/eru/audit.log"` // file of file sink
+	TTL  time.Duration `yaml:"ttl" default:"720h"`                    // how long logs kept in etcd, 0 for forever
+	Tag  string        `yaml:"tag" default:"eru-core"`                // tag of syslog sink
+}
+
+// RBACConfig holds role based access control config
+type RBACConfig struct {
+	Enable     bool          `yaml:"enable"`
+	Source     string        `yaml:"source" default:"config"` // config or etcd, policy in etcd is at /rbac/policy in json
+	Refresh    time.Duration `yaml:"refresh" default:"30s"`   // interval of reloading policy from etcd
+	RBACPolicy `yaml:",inline"`
+}
+
+// SecretConfig holds secrets config
+type SecretConfig struct {
+	Key string `yaml:"key"` // master key encrypting secrets in store, empty for disabled
+}
+
+// TLSConfig holds tls files
+// for server cert and key are required, client certs are verified by ca if set
+// for client server cert is verified by ca, cert and key are sent as client cert if set
+type TLSConfig struct {
+	CA           string `yaml:"ca"`
+	Cert         string `yaml:"cert"`
+	Key          string `yaml:"key"`
+	VerifyClient bool   `yaml:"verify_client"` // server only, reject clients without cert signed by ca
+	ServerName   string `yaml:"server_name"`   // client only, override name in server cert to verify
+}
+
+// TokenConfig holds bearer token config
+type TokenConfig struct {
+	Secret string `yaml:"secret"` // hmac secret signing tokens, empty for disabled
 }
 
 // AuthConfig contains authorization information for connecting to a Registry
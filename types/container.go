@@ -35,15 +35,23 @@ type Container struct {
 	Quota      float64           `json:"quota"`
 	Memory     int64             `json:"memory"`
 	Storage    int64             `json:"storage"`
+	Bandwidth  int64             `json:"bandwidth,omitempty"`
 	Hook       *Hook             `json:"hook"`
 	Privileged bool              `json:"privileged"`
 	SoftLimit  bool              `json:"softlimit"`
 	User       string            `json:"user"`
 	Env        []string          `json:"env"`
+	SecretEnv  map[string]string `json:"secret_env,omitempty"`  // references only, env name -> secret name
+	SecretData map[string]string `json:"secret_data,omitempty"` // references only, file path -> secret name
 	Image      string            `json:"image"`
+	Entrypoint *Entrypoint       `json:"entrypoint,omitempty"` // entrypoint deployed with, extra args included in command
+	Network    string            `json:"network,omitempty"`    // network mode
+	Networks   []string          `json:"networks,omitempty"`   // networks joined, ips not kept
 	Volumes    VolumeBindings    `json:"volumes"`
 	VolumePlan VolumePlan        `json:"volume_plan"`
+	Devices    DeviceMap         `json:"devices,omitempty"`
 	Labels     map[string]string `json:"labels"`
+	Priority   int               `json:"priority"`
 	StatusMeta *StatusMeta       `json:"-"`
 	Engine     engine.API        `json:"-"`
 }
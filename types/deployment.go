@@ -0,0 +1,11 @@
+package types
+
+// Deployment is the desired state of an app entrypoint in a pod
+// reconciler keeps alive containers count at Count
+type Deployment struct {
+	Appname    string         `json:"appname"`
+	Entrypoint string         `json:"entrypoint"`
+	Podname    string         `json:"podname"`
+	Count      int            `json:"count"`
+	Options    *DeployOptions `json:"options"`
+}
@@ -0,0 +1,78 @@
+package types
+
+import "sort"
+
+// DeviceMap is map from device type to device IDs
+// DeviceMap {"nvidia.com/gpu": ["0", "1"]}
+type DeviceMap map[string][]string
+
+// Total returns number of all devices
+func (d DeviceMap) Total() int {
+	total := 0
+	for _, IDs := range d {
+		total += len(IDs)
+	}
+	return total
+}
+
+// Copy returns a deep copy
+func (d DeviceMap) Copy() DeviceMap {
+	r := DeviceMap{}
+	for kind, IDs := range d {
+		r[kind] = append([]string{}, IDs...)
+	}
+	return r
+}
+
+// Add adds devices, duplicated IDs are ignored
+func (d DeviceMap) Add(d2 DeviceMap) {
+	for kind, IDs := range d2 {
+		exists := map[string]bool{}
+		for _, ID := range d[kind] {
+			exists[ID] = true
+		}
+		for _, ID := range IDs {
+			if !exists[ID] {
+				d[kind] = append(d[kind], ID)
+				exists[ID] = true
+			}
+		}
+		sort.Strings(d[kind])
+	}
+}
+
+// Sub removes devices, type is kept even if no device left
+func (d DeviceMap) Sub(d2 DeviceMap) {
+	for kind, IDs := range d2 {
+		if _, ok := d[kind]; !ok {
+			continue
+		}
+		removed := map[string]bool{}
+		for _, ID := range IDs {
+			removed[ID] = true
+		}
+		left := []string{}
+		for _, ID := range d[kind] {
+			if !removed[ID] {
+				left = append(left, ID)
+			}
+		}
+		d[kind] = left
+	}
+}
+
+// Contains returns whether all devices of d2 are in d
+func (d DeviceMap) Contains(d2 DeviceMap) bool {
+	for kind, IDs := range d2 {
+		exists := map[string]bool{}
+		for _, ID := range d[kind] {
+			exists[ID] = true
+		}
+		for _, ID := range IDs {
+			if !exists[ID] {
+				return false
+			}
+		}
+	}
+	return true
+}
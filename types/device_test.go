@@ -0,0 +1,26 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDeviceMap(t *testing.T) {
+	d := DeviceMap{"gpu": {"2", "0"}}
+	d.Add(DeviceMap{"gpu": {"1", "2"}, "fpga": {"/dev/fpga0"}})
+	assert.Equal(t, []string{"0", "1", "2"}, d["gpu"])
+	assert.Equal(t, 4, d.Total())
+	assert.True(t, d.Contains(DeviceMap{"gpu": {"1"}}))
+	assert.False(t, d.Contains(DeviceMap{"gpu": {"3"}}))
+	assert.False(t, d.Contains(DeviceMap{"tpu": {"0"}}))
+
+	c := d.Copy()
+	c.Sub(DeviceMap{"gpu": {"0", "1"}, "fpga": {"/dev/fpga0"}, "tpu": {"0"}})
+	assert.Equal(t, []string{"2"}, c["gpu"])
+	assert.Empty(t, c["fpga"])
+	assert.Contains(t, c, "fpga")
+	assert.NotContains(t, c, "tpu")
+	// origin not changed
+	assert.Equal(t, []string{"0", "1", "2"}, d["gpu"])
+}
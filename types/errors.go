@@ -3,22 +3,27 @@ package types
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 )
 
 // errors
 var (
-	ErrInsufficientCPU     = errors.New("cannot alloc a plan, not enough cpu")
-	ErrInsufficientMEM     = errors.New("cannot alloc a plan, not enough memory")
-	ErrInsufficientStorage = errors.New("cannot alloc a plan, not enough storage")
-	ErrInsufficientVolume  = errors.New("cannot alloc a plan, not enough volume")
-	ErrInsufficientCap     = errors.New("cannot alloc a each node plan, not enough capacity")
-	ErrInsufficientRes     = errors.New("not enough resource")
-	ErrInsufficientNodes   = errors.New("not enough nodes")
-	ErrAlreadyFilled       = errors.New("Cannot alloc a fill node plan, each node has enough containers")
-
-	ErrNegativeMemory  = errors.New("memory must be positive")
-	ErrNegativeStorage = errors.New("storage must be positive")
-	ErrNegativeQuota   = errors.New("quota must be positive")
+	ErrInsufficientCPU       = errors.New("cannot alloc a plan, not enough cpu")
+	ErrInsufficientMEM       = errors.New("cannot alloc a plan, not enough memory")
+	ErrInsufficientStorage   = errors.New("cannot alloc a plan, not enough storage")
+	ErrInsufficientBandwidth = errors.New("cannot alloc a plan, not enough bandwidth")
+	ErrInsufficientVolume    = errors.New("cannot alloc a plan, not enough volume")
+	ErrInsufficientCap       = errors.New("cannot alloc a each node plan, not enough capacity")
+	ErrInsufficientRes       = errors.New("not enough resource")
+	ErrInsufficientNodes     = errors.New("not enough nodes")
+	ErrAlreadyFilled         = errors.New("Cannot alloc a fill node plan, each node has enough containers")
+	ErrAffinityNotFit        = errors.New("no nodes fit affinity")
+
+	ErrNegativeMemory    = errors.New("memory must be positive")
+	ErrNegativeStorage   = errors.New("storage must be positive")
+	ErrNegativeBandwidth = errors.New("bandwidth must be positive")
+	ErrNegativeQuota     = errors.New("quota must be positive")
 
 	ErrZeroNodes = errors.New("no nodes provide to choose some")
 
@@ -29,15 +34,20 @@ var (
 	ErrKeyIsNotDir = errors.New("key is not a directory")
 	ErrKeyIsEmpty  = errors.New("key is empty")
 
-	ErrBadContainerID  = errors.New("container ID must be length of 64")
-	ErrBadDeployMethod = errors.New("deploy method not support yet")
-	ErrBadIPAddress    = errors.New("bad IP address")
-	ErrBadSCMType      = errors.New("unknown SCM type")
-	ErrBadMemory       = errors.New("bad `Memory` value")
-	ErrBadCPU          = errors.New("bad `CPU` value")
-	ErrBadStorage      = errors.New("bad `Storage` value")
-	ErrBadVolume       = errors.New("bad `Volume` value")
-	ErrBadCount        = errors.New("bad `Count` value")
+	ErrBadContainerID   = errors.New("container ID must be length of 64")
+	ErrBadDeployMethod  = errors.New("deploy method not support yet")
+	ErrBadIPAddress     = errors.New("bad IP address")
+	ErrBadSCMType       = errors.New("unknown SCM type")
+	ErrBadMemory        = errors.New("bad `Memory` value")
+	ErrBadCPU           = errors.New("bad `CPU` value")
+	ErrBadStorage       = errors.New("bad `Storage` value")
+	ErrBadBandwidth     = errors.New("bad `Bandwidth` value")
+	ErrBadVolume        = errors.New("bad `Volume` value")
+	ErrBadDevice        = errors.New("bad `Device` value")
+	ErrBadCount         = errors.New("bad `Count` value")
+	ErrBadSchedulerType = errors.New("unknown scheduler type or strategy")
+	ErrBadPriority      = errors.New("bad `Priority` value")
+	ErrBadHealthCheck   = errors.New("bad `HealthCheckTimeout` value")
 
 	ErrPodHasNodes = errors.New("pod has nodes")
 	ErrPodNoNodes  = errors.New("pod has no nodes")
@@ -45,10 +55,16 @@ var (
 	ErrCannotGetEngine = errors.New("cannot get engine")
 	ErrNilEngine       = errors.New("engine is nil")
 
-	ErrBadMeta         = errors.New("bad meta")
-	ErrInvaildPassword = errors.New("invaild password")
-	ErrInvaildUsername = errors.New("invaild username")
-	ErrNotFitLabels    = errors.New("not fit labels")
+	ErrBadMeta          = errors.New("bad meta")
+	ErrInvaildPassword  = errors.New("invaild password")
+	ErrInvaildUsername  = errors.New("invaild username")
+	ErrInvaildToken     = errors.New("invaild token")
+	ErrTokenExpired     = errors.New("token expired")
+	ErrUnauthenticated  = errors.New("unauthenticated")
+	ErrInsecureAuth     = errors.New("client cert optional but no other auth")
+	ErrBadCA            = errors.New("bad ca")
+	ErrNotFitLabels     = errors.New("not fit labels")
+	ErrBadLabelSelector = errors.New("bad label selector")
 
 	ErrNoImage                     = errors.New("no image")
 	ErrNoBuildPod                  = errors.New("No build pod set in config")
@@ -71,6 +87,33 @@ var (
 	ErrInvalidBind     = errors.New("invalid bind value")
 	ErrIgnoreContainer = errors.New("ignore this container")
 
+	ErrCreateRolledBack   = errors.New("create containers rolled back")
+	ErrRollbackFailed     = errors.New("rollback failed, container not removed")
+	ErrHealthCheckTimeout = errors.New("wait container healthy timeout")
+
+	ErrBadAutoscalePolicy = errors.New("bad autoscale policy")
+
+	ErrBadOvercommit = errors.New("bad overcommit ratio")
+	ErrBadQuota      = errors.New("bad quota")
+	ErrQuotaExceeded = errors.New("quota exceeded")
+
+	ErrRemoveContainerFailed = errors.New("remove container failed")
+	ErrReallocFailed         = errors.New("realloc resource failed")
+	ErrNodeInProcessing      = errors.New("node has containers being created")
+
+	ErrBadAuditAction = errors.New("bad audit action")
+
+	ErrAuditLogDisabled = errors.New("audit log disabled")
+	ErrBadAuditSink     = errors.New("bad audit sink")
+
+	ErrPermissionDenied = errors.New("permission denied")
+	ErrBadRBACSource    = errors.New("bad rbac source")
+
+	ErrSecretDisabled   = errors.New("secret disabled, master key not set")
+	ErrBadSecret        = errors.New("bad secret")
+	ErrSecretInUse      = errors.New("secret in use")
+	ErrSecretNotAllowed = errors.New("secret not allowed for app")
+
 	ErrInvalidGitURL        = errors.New("invalid git url format")
 	ErrInvalidContainerName = errors.New("invalid container name")
 )
@@ -79,3 +122,37 @@ var (
 func NewDetailedErr(err error, details interface{}) error {
 	return fmt.Errorf("%w: %v", err, details)
 }
+
+// ScheduleError is returned when scheduler can't make a plan
+// Reasons records why each node is rejected, keyed by nodename
+type ScheduleError struct {
+	Err     error
+	Reasons map[string]string
+}
+
+// NewScheduleError returns an error with rejection reasons of nodes
+func NewScheduleError(err error, reasons map[string]string) error {
+	return &ScheduleError{Err: err, Reasons: reasons}
+}
+
+// Error shows the error and reasons sorted by nodename
+func (e *ScheduleError) Error() string {
+	if len(e.Reasons) == 0 {
+		return e.Err.Error()
+	}
+	nodenames := []string{}
+	for nodename := range e.Reasons {
+		nodenames = append(nodenames, nodename)
+	}
+	sort.Strings(nodenames)
+	reasons := []string{}
+	for _, nodename := range nodenames {
+		reasons = append(reasons, fmt.Sprintf("%s: %s", nodename, e.Reasons[nodename]))
+	}
+	return fmt.Sprintf("%v, rejected nodes: %s", e.Err, strings.Join(reasons, "; "))
+}
+
+// Unwrap returns the origin error
+func (e *ScheduleError) Unwrap() error {
+	return e.Err
+}
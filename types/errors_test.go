@@ -17,3 +17,16 @@ func TestDetailedErr(t *testing.T) {
 	assert.True(t, errors.Is(dt, err))
 	assert.True(t, strings.Contains(dt.Error(), detail))
 }
+
+func TestScheduleErr(t *testing.T) {
+	err := NewScheduleError(ErrInsufficientMEM, nil)
+	assert.True(t, errors.Is(err, ErrInsufficientMEM))
+	assert.Equal(t, ErrInsufficientMEM.Error(), err.Error())
+
+	err = NewScheduleError(NewDetailedErr(ErrInsufficientRes, "need: 3"), map[string]string{"n2": "capacity 1", "n1": "memory short by 1 bytes"})
+	assert.True(t, errors.Is(err, ErrInsufficientRes))
+	assert.Equal(t, "not enough resource: need: 3, rejected nodes: n1: memory short by 1 bytes; n2: capacity 1", err.Error())
+	se := &ScheduleError{}
+	assert.True(t, errors.As(err, &se))
+	assert.Equal(t, "capacity 1", se.Reasons["n2"])
+}
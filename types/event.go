@@ -0,0 +1,43 @@
+package types
+
+// Event record an operation done by core
+type Event struct {
+	Kind         string `json:"kind"`   // container, node, pod or image
+	Action       string `json:"action"` // create, remove, set...
+	Target       string `json:"target"` // container ID, nodename, podname or image
+	ProcessIdent string `json:"process_ident"`
+	Appname      string `json:"appname,omitempty"`
+	Podname      string `json:"podname,omitempty"`
+	Nodename     string `json:"nodename,omitempty"`
+	Success      bool   `json:"success"`
+	Error        string `json:"error,omitempty"`
+	Time         int64  `json:"time"` // unix nano
+}
+
+// WatchEventsOptions filter events, empty field matches all
+type WatchEventsOptions struct {
+	Kinds    []string
+	Appname  string
+	Podname  string
+	Nodename string
+	Since    int64 // replay events after it, unix nano
+}
+
+// Matches check event fits options
+func (o *WatchEventsOptions) Matches(event *Event) bool {
+	if len(o.Kinds) > 0 {
+		found := false
+		for _, kind := range o.Kinds {
+			if kind == event.Kind {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return (o.Appname == "" || o.Appname == event.Appname) &&
+		(o.Podname == "" || o.Podname == event.Podname) &&
+		(o.Nodename == "" || o.Nodename == event.Nodename)
+}
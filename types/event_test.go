@@ -0,0 +1,17 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWatchEventsOptionsMatches(t *testing.T) {
+	event := &Event{Kind: "container", Action: "create", Appname: "app", Podname: "pod", Nodename: "node"}
+	assert.True(t, (&WatchEventsOptions{}).Matches(event))
+	assert.True(t, (&WatchEventsOptions{Kinds: []string{"node", "container"}, Appname: "app"}).Matches(event))
+	assert.False(t, (&WatchEventsOptions{Kinds: []string{"node"}}).Matches(event))
+	assert.False(t, (&WatchEventsOptions{Podname: "other"}).Matches(event))
+	assert.True(t, (&WatchEventsOptions{Podname: "pod", Nodename: "node"}).Matches(event))
+	assert.False(t, (&WatchEventsOptions{Nodename: "other"}).Matches(event))
+}
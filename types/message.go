@@ -84,18 +84,53 @@ type CreateContainerMessage struct {
 	Quota         float64
 	Memory        int64
 	VolumePlan    VolumePlan
+	Devices       DeviceMap
 	Storage       int64
+	Bandwidth     int64
 	Publish       map[string][]string
 	Hook          []*bytes.Buffer
+	RolledBack    bool
+	Evicted       *RemoveContainerMessage // container evicted for this deploy, others are empty
 }
 
 // ReplaceContainerMessage for replace method
 type ReplaceContainerMessage struct {
 	Create *CreateContainerMessage
 	Remove *RemoveContainerMessage
+	Batch  *ReplaceBatchMessage
 	Error  error
 }
 
+// DrainNodeMessage for drain node method
+// one message per evacuated container
+type DrainNodeMessage struct {
+	ContainerID    string
+	NewContainerID string
+	NewNodename    string
+	Remove         *RemoveContainerMessage
+	Success        bool
+	Error          error
+}
+
+// AuditNodeMessage for audit containers method
+// one message per node
+type AuditNodeMessage struct {
+	Nodename    string
+	Orphans     []string // in engine but not in store
+	Ghosts      []string // in store but not in engine
+	Handled     []string // orphans or ghosts handled by action
+	Unsupported bool     // engine can't list containers, orphans not audited, ghosts found by inspecting
+	Error       error
+}
+
+// ReplaceBatchMessage marks batch boundary of rolling replace
+type ReplaceBatchMessage struct {
+	Index int
+	Total int
+	Done  bool
+	IDs   []string // new containers created in this batch
+}
+
 // AttachContainerMessage for run and wait
 type AttachContainerMessage struct {
 	ContainerID string
@@ -197,13 +197,18 @@ type Node struct {
 	VolumeUsed     int64             `json:"volumeused"`
 	MemCap         int64             `json:"memcap"`
 	StorageCap     int64             `json:"storage_cap"`
+	Bandwidth      int64             `json:"bandwidth,omitempty"`
 	Available      bool              `json:"available"`
+	Unreachable    bool              `json:"unreachable,omitempty"` // marked down by node monitor
 	Labels         map[string]string `json:"labels"`
 	InitCPU        CPUMap            `json:"init_cpu"`
 	InitMemCap     int64             `json:"init_memcap"`
 	InitStorageCap int64             `json:"init_storage_cap"`
+	InitBandwidth  int64             `json:"init_bandwidth,omitempty"`
 	InitNUMAMemory NUMAMemory        `json:"init_numa_memory"`
 	InitVolume     VolumeMap         `json:"init_volume"`
+	Devices        DeviceMap         `json:"devices,omitempty"`
+	InitDevices    DeviceMap         `json:"init_devices,omitempty"`
 	Engine         engine.API        `json:"-"`
 }
 
@@ -214,6 +219,12 @@ func (n *Node) Init() {
 	if n.InitVolume == nil {
 		n.InitVolume = VolumeMap{}
 	}
+	if n.Devices == nil {
+		n.Devices = DeviceMap{}
+	}
+	if n.InitDevices == nil {
+		n.InitDevices = DeviceMap{}
+	}
 }
 
 // Info show node info
@@ -304,29 +315,64 @@ func (n *Node) AvailableStorage() int64 {
 	}
 }
 
+// BandwidthUsed calculates node's bandwidth usage value.
+func (n *Node) BandwidthUsed() int64 {
+	switch {
+	case n.InitBandwidth <= 0:
+		return 0
+	default:
+		return n.InitBandwidth - n.Bandwidth
+	}
+}
+
+// AvailableBandwidth calculates available bandwidth, unlimited if not declared.
+func (n *Node) AvailableBandwidth() int64 {
+	switch {
+	case n.InitBandwidth <= 0:
+		return math.MaxInt64
+	default:
+		return n.Bandwidth
+	}
+}
+
 // NodeInfo for deploy
 type NodeInfo struct {
 	Name          string
 	CPUMap        CPUMap
 	VolumeMap     VolumeMap
 	InitVolumeMap VolumeMap
+	DeviceMap     DeviceMap // 空闲的设备
 	NUMA          NUMA
 	NUMAMemory    NUMAMemory
 	MemCap        int64
 	StorageCap    int64
+	BandwidthCap  int64   // 空闲的网卡带宽
 	CPUUsed       float64 // CPU目前占用率
 	MemUsage      float64 // MEM目前占用率
 	StorageUsage  float64 // Current storage usage ratio
 	CPURate       float64 // 需要增加的 CPU 占用率
 	MemRate       float64 // 需要增加的内存占有率
 	StorageRate   float64 // Storage ratio which would be allocated
+	CPUOvercommit float64 // 非绑核 CPU 的超售比例, 0 为不限制
 
 	CPUPlan     []CPUMap
 	VolumePlans []VolumePlan // {{"AUTO:/data:rw:1024": "/mnt0:/data:rw:1024"}}
+	DevicePlans []DeviceMap  // {{"nvidia.com/gpu": ["0", "1"]}}
 	Capacity    int          // 可以部署几个
 	Count       int          // 上面有几个了
 	Deploy      int          // 最终部署几个
 	// 其他需要 filter 的字段
+	Labels    map[string]string // node labels, for spreading
+	Colocated map[string]int    // containers count of colocated apps
+	Score     float64           // score given by scoring scheduler
+}
+
+// DeployPlan is the scheduler's decision of a deployment, nothing allocated
+type DeployPlan struct {
+	Total      int
+	NodesInfo  []NodeInfo
+	Error      error
+	NodeErrors map[string]error
 }
 
 // NodeResource for node check
@@ -338,9 +384,21 @@ type NodeResource struct {
 	CPUPercent        float64
 	MemoryPercent     float64
 	StoragePercent    float64
+	BandwidthPercent  float64
 	NUMAMemoryPercent map[string]float64
 	VolumePercent     float64
-	Verification      bool
-	Details           []string
-	Containers        []*Container
+	// 按超售后的容量计算的占用率
+	CPUOvercommitPercent    float64
+	MemoryOvercommitPercent float64
+	Verification            bool
+	Details                 []string
+	Containers              []*Container
+}
+
+// NodeResourceFix is the result of recomputing node resource from its containers
+type NodeResourceFix struct {
+	Name              string
+	Diffs             []string // 记录值和重新计算值的差异
+	MissingContainers []string // containers in store but not found in engine
+	Fixed             bool     // false when dry run or nothing changed
 }
@@ -102,6 +102,16 @@ func TestStorage(t *testing.T) {
 	assert.Equal(t, node.AvailableStorage(), int64(1))
 }
 
+func TestBandwidth(t *testing.T) {
+	node := Node{}
+	assert.Equal(t, node.BandwidthUsed(), int64(0))
+	assert.Equal(t, node.AvailableBandwidth(), int64(math.MaxInt64))
+	node.InitBandwidth = 100
+	node.Bandwidth = 30
+	assert.Equal(t, node.BandwidthUsed(), int64(70))
+	assert.Equal(t, node.AvailableBandwidth(), int64(30))
+}
+
 func TestVolumeMap(t *testing.T) {
 	volume := VolumeMap{"/data": 1000}
 	assert.Equal(t, volume.Total(), int64(1000))
@@ -12,20 +12,24 @@ type DeployOptions struct {
 	CPUBind      bool              // Bind CPU or not ( old CPU piror )
 	Memory       int64             // Memory for container, in bytes
 	Storage      int64             // Storage for container, in bytes
+	Bandwidth    int64             // Network bandwidth for container, in bits per second
 	Count        int               // How many containers needed, e.g. 4
 	Env          []string          // Env for container
 	DNS          []string          // DNS for container
 	ExtraHosts   []string          // Extra hosts for container
 	Volumes      VolumeBindings    // Volumes for container
+	Devices      map[string]int    // How many devices of each type needed, e.g. {"nvidia.com/gpu": 1}
 	Networks     map[string]string // Network names and specified IPs
 	NetworkMode  string            // Network mode
 	User         string            // User for container
 	Debug        bool              // debug mode, use syslog as log driver
 	OpenStdin    bool              // OpenStdin for container
 	Labels       map[string]string // Labels for containers
-	NodeLabels   map[string]string // NodeLabels for filter node
+	NodeLabels   LabelSelector     // NodeLabels for filter node
 	DeployMethod string            // Deploy method
 	Data         map[string]string // For additional file data
+	SecretEnv    map[string]string // env name -> secret name, resolved when container created
+	SecretData   map[string]string // file path -> secret name, resolved when container created
 	SoftLimit    bool              // Soft limit memory
 	NodesLimit   int               // Limit nodes count
 	ProcessIdent string            // ProcessIdent ident this deploy
@@ -33,6 +37,17 @@ type DeployOptions struct {
 	AfterCreate  []string          // AfterCreate support run cmds after create
 	RawArgs      []byte            // RawArgs for raw args processing
 	Lambda       bool              // indicate is lambda container or not
+	AllOrNothing bool              // remove all created containers if any of them failed
+	WaitHealthy  int               // wait container healthy after started, in second
+	Affinity     *Affinity         // placement constraints
+	Priority     int               // containers with lower priority can be evicted when resource not enough
+}
+
+// Affinity placement constraints of containers
+type Affinity struct {
+	MaxPerNode  int      // max containers of this entrypoint on one node, 0 means no limit
+	SpreadLabel string   // spread containers evenly across values of this node label, e.g. rack
+	Colocate    []string // only deploy to nodes already running containers of these apps
 }
 
 // RunAndWaitOptions is options for running and waiting
@@ -59,16 +74,26 @@ type ListContainersOptions struct {
 	Entrypoint string
 	Nodename   string
 	Limit      int64
-	Labels     map[string]string
+	Labels     LabelSelector
 }
 
 // ReplaceOptions for replace container
 type ReplaceOptions struct {
 	DeployOptions
-	NetworkInherit bool
-	FilterLabels   map[string]string
-	Copy           map[string]string
-	IDs            []string
+	NetworkInherit     bool
+	FilterLabels       LabelSelector
+	Copy               map[string]string
+	IDs                []string
+	BatchSize          int  // containers replaced in one batch, use Count if not set
+	MaxUnavailable     int  // max containers being replaced at the same time in a batch
+	BatchInterval      int  // pause between batches, in second
+	HealthCheckTimeout int  // wait new containers healthy before next batch, in second
+	Rollback           bool // restart old container if new one not healthy in HealthCheckTimeout
+}
+
+// Rolling return true if any rolling update strategy given
+func (o *ReplaceOptions) Rolling() bool {
+	return o.BatchSize > 0 || o.MaxUnavailable > 0 || o.BatchInterval > 0 || o.HealthCheckTimeout > 0
 }
 
 // AddNodeOptions for adding node
@@ -83,10 +108,12 @@ type AddNodeOptions struct {
 	Share      int
 	Memory     int64
 	Storage    int64
+	Bandwidth  int64
 	Labels     map[string]string
 	Numa       NUMA
 	NumaMemory NUMAMemory
 	Volume     VolumeMap
+	Devices    DeviceMap
 }
 
 // SetNodeOptions for node set
@@ -96,10 +123,26 @@ type SetNodeOptions struct {
 	DeltaCPU        CPUMap
 	DeltaMemory     int64
 	DeltaStorage    int64
+	DeltaBandwidth  int64
 	DeltaNUMAMemory map[string]int64
 	DeltaVolume     VolumeMap
 	NUMA            map[string]string
 	Labels          map[string]string
+	Devices         DeviceMap // replace device IDs of types, empty IDs to remove the type
+}
+
+// DrainNodeOptions for node drain
+type DrainNodeOptions struct {
+	Nodename   string
+	CordonOnly bool // only mark node unavailable, containers untouched
+	DryRun     bool // only check containers can be evacuated, nothing changed
+}
+
+// AuditOptions for audit containers between engines and store
+type AuditOptions struct {
+	Podname      string // empty for all pods
+	OrphanAction string // "", adopt or remove containers only in engine
+	GhostAction  string // "", dissociate containers only in store
 }
 
 // ExecuteContainerOptions for executing commands in running container
@@ -4,14 +4,54 @@ package types
 type Pod struct {
 	Name string `json:"name"`
 	Desc string `json:"desc"`
+	// 超售比例, 内存为 0 时不超售, CPU 为 0 时不限制非绑核的 quota
+	CPUOvercommit    float64 `json:"cpu_overcommit,omitempty"`
+	MemoryOvercommit float64 `json:"memory_overcommit,omitempty"`
+}
+
+// MemoryRatio returns memory overcommit ratio, 1 if not set
+func (p *Pod) MemoryRatio() float64 {
+	if p.MemoryOvercommit <= 0 {
+		return 1
+	}
+	return p.MemoryOvercommit
+}
+
+// CPURatio returns cpu overcommit ratio, 1 if not set
+func (p *Pod) CPURatio() float64 {
+	if p.CPUOvercommit <= 0 {
+		return 1
+	}
+	return p.CPUOvercommit
+}
+
+// MemCap returns memory can still be allocated on node with overcommit
+func (p *Pod) MemCap(node *Node) int64 {
+	return node.MemCap + int64(float64(node.InitMemCap)*(p.MemoryRatio()-1))
+}
+
+// NUMAMemCap returns memory can still be allocated on numa node of node with overcommit
+func (p *Pod) NUMAMemCap(node *Node, nodeID string) int64 {
+	return node.NUMAMemory[nodeID] + int64(float64(node.InitNUMAMemory[nodeID])*(p.MemoryRatio()-1))
+}
+
+// CPUQuotaCap returns non-bound cpu quota can still be allocated on node with overcommit
+// ok is false if cpu quota is not limited
+func (p *Pod) CPUQuotaCap(node *Node) (cap float64, ok bool) {
+	if p.CPUOvercommit <= 0 {
+		return 0, false
+	}
+	return Round(float64(len(node.InitCPU))*p.CPUOvercommit - node.CPUUsed), true
 }
 
 // PodResource define pod resource
 type PodResource struct {
-	Name            string
-	CPUPercents     map[string]float64
-	MemoryPercents  map[string]float64
-	StoragePercents map[string]float64
-	Verifications   map[string]bool
-	Details         map[string]string
+	Name                     string
+	CPUPercents              map[string]float64
+	MemoryPercents           map[string]float64
+	StoragePercents          map[string]float64
+	CPUOvercommitPercents    map[string]float64
+	MemoryOvercommitPercents map[string]float64
+	Verifications            map[string]bool
+	Details                  map[string]string
 }
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPodOvercommit(t *testing.T) {
+	node := &Node{
+		InitCPU: CPUMap{"0": 100, "1": 100}, CPUUsed: 1.5, MemCap: 10, InitMemCap: 100,
+		NUMAMemory: NUMAMemory{"0": 5}, InitNUMAMemory: NUMAMemory{"0": 50},
+	}
+	pod := &Pod{}
+	assert.Equal(t, 1.0, pod.CPURatio())
+	assert.Equal(t, 1.0, pod.MemoryRatio())
+	assert.Equal(t, int64(10), pod.MemCap(node))
+	assert.Equal(t, int64(5), pod.NUMAMemCap(node, "0"))
+	_, ok := pod.CPUQuotaCap(node)
+	assert.False(t, ok)
+
+	pod = &Pod{CPUOvercommit: 2, MemoryOvercommit: 1.5}
+	assert.Equal(t, int64(60), pod.MemCap(node))
+	assert.Equal(t, int64(30), pod.NUMAMemCap(node, "0"))
+	cap, ok := pod.CPUQuotaCap(node)
+	assert.True(t, ok)
+	assert.Equal(t, 2.5, cap)
+}
@@ -0,0 +1,34 @@
+package types
+
+import "time"
+
+// Processing is containers of a deploy still being created on a node
+// resources are of each container, so that in-flight deploys can be counted by quota
+type Processing struct {
+	Appname    string  `json:"-"`
+	Entrypoint string  `json:"-"`
+	Nodename   string  `json:"-"`
+	Ident      string  `json:"-"`
+	Podname    string  `json:"podname"`
+	Count      int     `json:"count"`
+	CPU        float64 `json:"cpu"`
+	Memory     int64   `json:"memory"`
+	Storage    int64   `json:"storage"`
+	UpdatedAt  int64   `json:"updated_at"` // unix nano, updated whenever a container is done
+}
+
+// QuotaResource returns resource of all containers in processing
+func (p *Processing) QuotaResource() QuotaResource {
+	return QuotaResource{
+		CPU:     p.CPU * float64(p.Count),
+		Memory:  p.Memory * int64(p.Count),
+		Storage: p.Storage * int64(p.Count),
+		Count:   p.Count,
+	}
+}
+
+// Stale returns true if processing is not updated in timeout, it's left by a crashed deploy then
+// processing of old version has no time, it's always stale
+func (p *Processing) Stale(timeout time.Duration) bool {
+	return time.Since(time.Unix(0, p.UpdatedAt)) > timeout
+}
@@ -0,0 +1,67 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// QuotaResource is the amount of resource counted by quota
+// zero means unlimited when used as limit
+type QuotaResource struct {
+	CPU     float64 `json:"cpu"`
+	Memory  int64   `json:"memory"`
+	Storage int64   `json:"storage"`
+	Count   int     `json:"count"`
+}
+
+// Add adds r2 to r
+func (r *QuotaResource) Add(r2 QuotaResource) {
+	r.CPU += r2.CPU
+	r.Memory += r2.Memory
+	r.Storage += r2.Storage
+	r.Count += r2.Count
+}
+
+// Quota limits total resource used by an app
+// empty Podname means the quota covers all pods
+type Quota struct {
+	Appname string `json:"appname"`
+	Podname string `json:"podname"`
+	QuotaResource
+}
+
+// Covers returns whether containers in pod are counted by this quota
+func (q *Quota) Covers(podname string) bool {
+	return q.Podname == "" || q.Podname == podname
+}
+
+// Check returns ErrQuotaExceeded if used is beyond the quota
+func (q *Quota) Check(used QuotaResource) error {
+	exceeded := []string{}
+	if q.CPU > 0 && used.CPU > q.CPU+1e-9 {
+		exceeded = append(exceeded, fmt.Sprintf("cpu %v/%v", used.CPU, q.CPU))
+	}
+	if q.Memory > 0 && used.Memory > q.Memory {
+		exceeded = append(exceeded, fmt.Sprintf("memory %d/%d", used.Memory, q.Memory))
+	}
+	if q.Storage > 0 && used.Storage > q.Storage {
+		exceeded = append(exceeded, fmt.Sprintf("storage %d/%d", used.Storage, q.Storage))
+	}
+	if q.Count > 0 && used.Count > q.Count {
+		exceeded = append(exceeded, fmt.Sprintf("count %d/%d", used.Count, q.Count))
+	}
+	if len(exceeded) == 0 {
+		return nil
+	}
+	scope := q.Appname
+	if q.Podname != "" {
+		scope += "@" + q.Podname
+	}
+	return NewDetailedErr(ErrQuotaExceeded, fmt.Sprintf("%s %s", scope, strings.Join(exceeded, ", ")))
+}
+
+// QuotaUsage shows current usage against a quota
+type QuotaUsage struct {
+	Quota *Quota
+	Used  QuotaResource
+}
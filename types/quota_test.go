@@ -0,0 +1,26 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestQuota(t *testing.T) {
+	q := &Quota{Appname: "app", Podname: "p1", QuotaResource: QuotaResource{CPU: 1, Memory: 100, Count: 2}}
+	assert.True(t, q.Covers("p1"))
+	assert.False(t, q.Covers("p2"))
+
+	used := QuotaResource{CPU: 0.5, Memory: 50, Storage: 1000, Count: 1}
+	assert.NoError(t, q.Check(used))
+	used.Add(QuotaResource{CPU: 0.5, Memory: 60, Count: 2})
+	assert.Equal(t, 1.0, used.CPU)
+	err := q.Check(used)
+	assert.True(t, errors.Is(err, ErrQuotaExceeded))
+	assert.Contains(t, err.Error(), "app@p1 memory 110/100, count 3/2")
+	assert.NotContains(t, err.Error(), "cpu")
+
+	q.Podname = ""
+	assert.True(t, q.Covers("p2"))
+}
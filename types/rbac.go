@@ -0,0 +1,92 @@
+package types
+
+import "path"
+
+// RBACPolicy holds users and roles for grpc api
+type RBACPolicy struct {
+	Users []RBACUser `yaml:"users" json:"users"`
+	Roles []RBACRole `yaml:"roles" json:"roles"`
+}
+
+// RBACUser is a caller of core, authenticated by password
+type RBACUser struct {
+	Username string   `yaml:"username" json:"username"`
+	Password string   `yaml:"password" json:"password"`
+	Roles    []string `yaml:"roles" json:"roles"`
+}
+
+// RBACRole allows methods on pods and apps
+// methods are names like CreateContainer, glob like List* is supported
+// empty pods or apps means no limit on it
+type RBACRole struct {
+	Name    string   `yaml:"name" json:"name"`
+	Methods []string `yaml:"methods" json:"methods"`
+	Pods    []string `yaml:"pods" json:"pods,omitempty"`
+	Apps    []string `yaml:"apps" json:"apps,omitempty"`
+}
+
+// AllowMethod check method is allowed by role
+func (r *RBACRole) AllowMethod(method string) bool {
+	for _, pattern := range r.Methods {
+		if matched, err := path.Match(pattern, method); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// Scoped returns true if role is limited to some pods or apps
+func (r *RBACRole) Scoped() bool {
+	return len(r.Pods) > 0 || len(r.Apps) > 0
+}
+
+// AllowScope check all pods and apps are in scope of role
+// a request not bound to any pod can't pass a pod scoped role, so as apps
+func (r *RBACRole) AllowScope(podnames, appnames []string) bool {
+	return inScope(r.Pods, podnames) && inScope(r.Apps, appnames)
+}
+
+func inScope(scope, names []string) bool {
+	if len(scope) == 0 {
+		return true
+	}
+	if len(names) == 0 {
+		return false
+	}
+	for _, name := range names {
+		found := false
+		for _, s := range scope {
+			if s == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// GetUser find user by name
+func (p *RBACPolicy) GetUser(username string) *RBACUser {
+	for i := range p.Users {
+		if p.Users[i].Username == username {
+			return &p.Users[i]
+		}
+	}
+	return nil
+}
+
+// GetRoles find roles of user, unknown roles are ignored
+func (p *RBACPolicy) GetRoles(user *RBACUser) []*RBACRole {
+	roles := []*RBACRole{}
+	for _, name := range user.Roles {
+		for i := range p.Roles {
+			if p.Roles[i].Name == name {
+				roles = append(roles, &p.Roles[i])
+			}
+		}
+	}
+	return roles
+}
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRBACRole(t *testing.T) {
+	role := &RBACRole{Methods: []string{"List*", "Get*", "CreateContainer"}}
+	assert.True(t, role.AllowMethod("ListPods"))
+	assert.True(t, role.AllowMethod("CreateContainer"))
+	assert.False(t, role.AllowMethod("RemoveContainer"))
+	assert.False(t, role.Scoped())
+	assert.True(t, role.AllowScope(nil, nil))
+	assert.True(t, (&RBACRole{Methods: []string{"*"}}).AllowMethod("AddNode"))
+
+	role = &RBACRole{Pods: []string{"p1", "p2"}}
+	assert.True(t, role.Scoped())
+	assert.True(t, role.AllowScope([]string{"p1", "p2"}, nil))
+	assert.True(t, role.AllowScope([]string{"p1"}, []string{"app"}))
+	assert.False(t, role.AllowScope([]string{"p1", "p3"}, nil))
+	assert.False(t, role.AllowScope(nil, []string{"app"}))
+
+	role = &RBACRole{Pods: []string{"p1"}, Apps: []string{"app"}}
+	assert.True(t, role.AllowScope([]string{"p1"}, []string{"app"}))
+	assert.False(t, role.AllowScope([]string{"p1"}, []string{"app2"}))
+	assert.False(t, role.AllowScope([]string{"p1"}, nil))
+}
+
+func TestRBACPolicy(t *testing.T) {
+	policy := &RBACPolicy{
+		Users: []RBACUser{{Username: "u1", Roles: []string{"r1", "r3"}}},
+		Roles: []RBACRole{{Name: "r1"}, {Name: "r2"}},
+	}
+	user := policy.GetUser("u1")
+	assert.NotNil(t, user)
+	assert.Nil(t, policy.GetUser("u2"))
+	roles := policy.GetRoles(user)
+	assert.Len(t, roles, 1)
+	assert.Equal(t, "r1", roles[0].Name)
+}
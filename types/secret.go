@@ -0,0 +1,38 @@
+package types
+
+// Secret is a named value kept encrypted in store
+// containers reference secrets by name, values are resolved only when containers created
+type Secret struct {
+	Name      string   `json:"name"`
+	Value     []byte   `json:"value,omitempty"` // encrypted in store, never returned to client
+	Apps      []string `json:"apps,omitempty"`  // apps allowed to reference it, empty for all
+	Version   int64    `json:"version"`
+	UpdatedAt int64    `json:"updated_at"` // unix nano
+}
+
+// AllowApp check app can reference secret
+func (s *Secret) AllowApp(appname string) bool {
+	if len(s.Apps) == 0 {
+		return true
+	}
+	for _, app := range s.Apps {
+		if app == appname {
+			return true
+		}
+	}
+	return false
+}
+
+// SetSecretOptions for creating or updating a secret
+type SetSecretOptions struct {
+	Name  string
+	Value []byte
+	Apps  []string
+}
+
+// RotateSecretOptions for updating value of a secret
+type RotateSecretOptions struct {
+	Name    string
+	Value   []byte
+	Replace bool // replace containers referencing it, by desired deployments or their own records
+}
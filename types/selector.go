@@ -0,0 +1,187 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// label selector operators
+const (
+	SelectorEquals       = "="
+	SelectorNotEquals    = "!="
+	SelectorIn           = "in"
+	SelectorNotIn        = "notin"
+	SelectorExists       = "exists"
+	SelectorDoesNotExist = "!exists"
+)
+
+var (
+	selectorSetRegexp   = regexp.MustCompile(`^([^\s=!,()]+)\s+(in|notin)\s*\((.*)\)$`)
+	selectorExistRegexp = regexp.MustCompile(`^(!?)([^\s=!,()]+)(\s+(exists|!exists))?$`)
+)
+
+// LabelRequirement a single requirement on labels
+type LabelRequirement struct {
+	Key      string
+	Operator string
+	Values   []string
+}
+
+// Matches returns true if labels fit the requirement
+func (r LabelRequirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+	switch r.Operator {
+	case SelectorEquals:
+		return ok && value == r.Values[0]
+	case SelectorNotEquals:
+		return !ok || value != r.Values[0]
+	case SelectorIn:
+		return ok && contains(r.Values, value)
+	case SelectorNotIn:
+		return !ok || !contains(r.Values, value)
+	case SelectorExists:
+		return ok
+	case SelectorDoesNotExist:
+		return !ok
+	}
+	return false
+}
+
+func (r LabelRequirement) String() string {
+	switch r.Operator {
+	case SelectorEquals, SelectorNotEquals:
+		return r.Key + r.Operator + r.Values[0]
+	case SelectorIn, SelectorNotIn:
+		return fmt.Sprintf("%s %s (%s)", r.Key, r.Operator, strings.Join(r.Values, ","))
+	case SelectorDoesNotExist:
+		return "!" + r.Key
+	}
+	return r.Key
+}
+
+// LabelSelector all requirements must be matched
+// empty selector matches everything
+type LabelSelector []LabelRequirement
+
+// Matches returns true if labels fit all requirements
+func (s LabelSelector) Matches(labels map[string]string) bool {
+	for _, r := range s {
+		if !r.Matches(labels) {
+			return false
+		}
+	}
+	return true
+}
+
+func (s LabelSelector) String() string {
+	rs := []string{}
+	for _, r := range s {
+		rs = append(rs, r.String())
+	}
+	return strings.Join(rs, ",")
+}
+
+// MakeLabelSelector make selector from legacy key=value map
+func MakeLabelSelector(labels map[string]string) LabelSelector {
+	keys := []string{}
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	selector := LabelSelector{}
+	for _, key := range keys {
+		selector = append(selector, LabelRequirement{Key: key, Operator: SelectorEquals, Values: []string{labels[key]}})
+	}
+	return selector
+}
+
+// ParseLabelSelector parse selector expression
+// requirements are separated by comma, e.g.
+// `env=prod,tier!=cache,rack in (r1,r2),zone notin (z3),gpu,!debug`
+// `key exists` and `key !exists` are the same as `key` and `!key`
+func ParseLabelSelector(expr string) (LabelSelector, error) {
+	selector := LabelSelector{}
+	for _, term := range splitSelectorTerms(expr) {
+		term = strings.TrimSpace(term)
+		if term == "" {
+			continue
+		}
+		r, err := parseLabelRequirement(term)
+		if err != nil {
+			return nil, err
+		}
+		selector = append(selector, r)
+	}
+	return selector, nil
+}
+
+func parseLabelRequirement(term string) (LabelRequirement, error) {
+	if parts := strings.SplitN(term, "!=", 2); len(parts) == 2 {
+		return makeEqualityRequirement(term, parts[0], SelectorNotEquals, parts[1])
+	}
+	if parts := strings.SplitN(term, "==", 2); len(parts) == 2 {
+		return makeEqualityRequirement(term, parts[0], SelectorEquals, parts[1])
+	}
+	if parts := strings.SplitN(term, "=", 2); len(parts) == 2 {
+		return makeEqualityRequirement(term, parts[0], SelectorEquals, parts[1])
+	}
+	if m := selectorSetRegexp.FindStringSubmatch(term); m != nil {
+		values := []string{}
+		for _, v := range strings.Split(m[3], ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		if len(values) == 0 {
+			return LabelRequirement{}, NewDetailedErr(ErrBadLabelSelector, term)
+		}
+		return LabelRequirement{Key: m[1], Operator: m[2], Values: values}, nil
+	}
+	if m := selectorExistRegexp.FindStringSubmatch(term); m != nil {
+		op := SelectorExists
+		if (m[1] == "!") != (m[4] == SelectorDoesNotExist) {
+			op = SelectorDoesNotExist
+		}
+		return LabelRequirement{Key: m[2], Operator: op}, nil
+	}
+	return LabelRequirement{}, NewDetailedErr(ErrBadLabelSelector, term)
+}
+
+func makeEqualityRequirement(term, key, op, value string) (LabelRequirement, error) {
+	key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+	if key == "" || strings.ContainsAny(key, " ,()") || strings.ContainsAny(value, " ,()") {
+		return LabelRequirement{}, NewDetailedErr(ErrBadLabelSelector, term)
+	}
+	return LabelRequirement{Key: key, Operator: op, Values: []string{value}}, nil
+}
+
+// splitSelectorTerms split by comma not in parentheses
+func splitSelectorTerms(expr string) []string {
+	terms := []string{}
+	depth, begin := 0, 0
+	for i, c := range expr {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				terms = append(terms, expr[begin:i])
+				begin = i + 1
+			}
+		}
+	}
+	return append(terms, expr[begin:])
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLabelSelector(t *testing.T) {
+	s, err := ParseLabelSelector("")
+	assert.NoError(t, err)
+	assert.Empty(t, s)
+	assert.True(t, s.Matches(nil))
+
+	s, err = ParseLabelSelector("env=prod, tier!=cache,rack in (r1, r2),zone notin (z3),gpu,!debug,ssd exists,hdd !exists")
+	assert.NoError(t, err)
+	assert.Len(t, s, 8)
+	assert.Equal(t, LabelRequirement{Key: "rack", Operator: SelectorIn, Values: []string{"r1", "r2"}}, s[2])
+	assert.Equal(t, SelectorExists, s[4].Operator)
+	assert.Equal(t, SelectorDoesNotExist, s[5].Operator)
+	assert.Equal(t, SelectorExists, s[6].Operator)
+	assert.Equal(t, SelectorDoesNotExist, s[7].Operator)
+	assert.Equal(t, "env=prod,tier!=cache,rack in (r1,r2),zone notin (z3),gpu,!debug,ssd,!hdd", s.String())
+
+	labels := map[string]string{"env": "prod", "rack": "r1", "gpu": "", "ssd": "1"}
+	assert.True(t, s.Matches(labels))
+	labels["tier"] = "cache"
+	assert.False(t, s.Matches(labels))
+	delete(labels, "tier")
+	labels["zone"] = "z3"
+	assert.False(t, s.Matches(labels))
+	delete(labels, "zone")
+	labels["rack"] = "r3"
+	assert.False(t, s.Matches(labels))
+	labels["rack"] = "r2"
+	labels["debug"] = "1"
+	assert.False(t, s.Matches(labels))
+
+	for _, expr := range []string{"a in ()", "a in (b", "a b", "=b", "a=b c"} {
+		_, err = ParseLabelSelector(expr)
+		assert.True(t, errors.Is(err, ErrBadLabelSelector), expr)
+	}
+}
+
+func TestMakeLabelSelector(t *testing.T) {
+	s := MakeLabelSelector(map[string]string{"b": "2", "a": "1"})
+	assert.Equal(t, "a=1,b=2", s.String())
+	assert.True(t, s.Matches(map[string]string{"a": "1", "b": "2", "c": "3"}))
+	assert.False(t, s.Matches(map[string]string{"a": "1"}))
+	assert.True(t, MakeLabelSelector(nil).Matches(nil))
+}
@@ -58,5 +58,9 @@ docker:
 	assert.Equal(t, config.Docker.APIVersion, "1.32")
 	assert.Equal(t, config.Scheduler.MaxShare, -1)
 	assert.Equal(t, config.Scheduler.ShareBase, 100)
+	assert.Equal(t, config.Scheduler.Type, "potassium")
+	assert.Equal(t, config.Scheduler.Weights.CPU, 1.0)
+	assert.False(t, config.HealthCheck.Enable)
+	assert.Equal(t, config.HealthCheck.Interval, time.Duration(time.Second*30))
 	os.Remove(fname)
 }
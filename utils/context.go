@@ -0,0 +1,72 @@
+package utils
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+)
+
+type usernameKey struct{}
+
+type identityKey struct{}
+
+// identity keep username found by auth for interceptors outside of auth
+type identity struct {
+	sync.Mutex
+	username string
+}
+
+// ContextWithIdentity returns ctx able to keep username authenticated by inner interceptors
+// even if the request is denied later
+func ContextWithIdentity(ctx context.Context) context.Context {
+	return context.WithValue(ctx, identityKey{}, &identity{})
+}
+
+// SetIdentity keep username in identity of ctx, does nothing if ctx has no identity
+func SetIdentity(ctx context.Context, username string) {
+	if id, ok := ctx.Value(identityKey{}).(*identity); ok {
+		id.Lock()
+		defer id.Unlock()
+		id.username = username
+	}
+}
+
+// IdentityFromContext returns username kept in identity, or carried by ctx
+func IdentityFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(identityKey{}).(*identity); ok {
+		id.Lock()
+		defer id.Unlock()
+		if id.username != "" {
+			return id.username
+		}
+	}
+	return UsernameFromContext(ctx)
+}
+
+// ContextWithUsername returns ctx carrying authenticated username
+func ContextWithUsername(ctx context.Context, username string) context.Context {
+	SetIdentity(ctx, username)
+	return context.WithValue(ctx, usernameKey{}, username)
+}
+
+// UsernameFromContext returns authenticated username, empty if not authenticated
+func UsernameFromContext(ctx context.Context) string {
+	username, _ := ctx.Value(usernameKey{}).(string)
+	return username
+}
+
+// StreamWithContext returns stream whose Context is ctx, for interceptors to pass values to handlers
+func StreamWithContext(stream grpc.ServerStream, ctx context.Context) grpc.ServerStream {
+	return &contextStream{stream, ctx}
+}
+
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+// Context returns context replaced
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
@@ -0,0 +1,52 @@
+package utils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+
+	"github.com/projecteru2/core/types"
+)
+
+// Encrypt seal plaintext with AES-256-GCM, key is derived from master key by sha256
+// additional data is authenticated but not encrypted, it must be same when decrypted
+func Encrypt(masterKey string, plaintext, additional []byte) ([]byte, error) {
+	gcm, err := newGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	// nonce 放在密文前面
+	return gcm.Seal(nonce, nonce, plaintext, additional), nil
+}
+
+// Decrypt open ciphertext sealed by Encrypt
+func Decrypt(masterKey string, ciphertext, additional []byte) ([]byte, error) {
+	gcm, err := newGCM(masterKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, types.ErrBadSecret
+	}
+	nonce, sealed := ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():]
+	plaintext, err := gcm.Open(nil, nonce, sealed, additional)
+	if err != nil {
+		return nil, types.NewDetailedErr(types.ErrBadSecret, err)
+	}
+	return plaintext, nil
+}
+
+func newGCM(masterKey string) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(masterKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
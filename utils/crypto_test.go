@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEncrypt(t *testing.T) {
+	ciphertext, err := Encrypt("key", []byte("value"), []byte("name"))
+	assert.NoError(t, err)
+	assert.NotContains(t, string(ciphertext), "value")
+	plaintext, err := Decrypt("key", ciphertext, []byte("name"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("value"), plaintext)
+
+	// nonce is random
+	another, err := Encrypt("key", []byte("value"), []byte("name"))
+	assert.NoError(t, err)
+	assert.NotEqual(t, ciphertext, another)
+
+	// wrong key or additional data
+	_, err = Decrypt("other", ciphertext, []byte("name"))
+	assert.Error(t, err)
+	_, err = Decrypt("key", ciphertext, []byte("other"))
+	assert.Error(t, err)
+	_, err = Decrypt("key", []byte("short"), nil)
+	assert.Error(t, err)
+	ciphertext[len(ciphertext)-1] ^= 1
+	_, err = Decrypt("key", ciphertext, []byte("name"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,62 @@
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+
+	"github.com/projecteru2/core/types"
+)
+
+// NewServerTLSConfig return tls config of grpc server
+// client certs are verified by ca if given, and required if verify client is set
+func NewServerTLSConfig(config types.TLSConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(config.Cert, config.Key)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}
+	if config.CA == "" {
+		return tlsConfig, nil
+	}
+	if tlsConfig.ClientCAs, err = loadCertPool(config.CA); err != nil {
+		return nil, err
+	}
+	tlsConfig.ClientAuth = tls.VerifyClientCertIfGiven
+	if config.VerifyClient {
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
+// NewClientTLSConfig return tls config of grpc client
+// server cert is verified by ca if given, or by system roots
+func NewClientTLSConfig(config types.TLSConfig) (*tls.Config, error) {
+	tlsConfig := &tls.Config{ServerName: config.ServerName, MinVersion: tls.VersionTLS12}
+	var err error
+	if config.CA != "" {
+		if tlsConfig.RootCAs, err = loadCertPool(config.CA); err != nil {
+			return nil, err
+		}
+	}
+	if config.Cert != "" {
+		cert, err := tls.LoadX509KeyPair(config.Cert, config.Key)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+func loadCertPool(ca string) (*x509.CertPool, error) {
+	bytes, err := ioutil.ReadFile(ca)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bytes) {
+		return nil, types.NewDetailedErr(types.ErrBadCA, ca)
+	}
+	return pool, nil
+}
@@ -2,6 +2,7 @@ package utils
 
 import (
 	"archive/tar"
+	"bytes"
 	"crypto/rand"
 	"encoding/json"
 	"fmt"
@@ -164,12 +165,7 @@ func ShortID(containerID string) string {
 
 // FilterContainer filter container by labels
 func FilterContainer(extend map[string]string, labels map[string]string) bool {
-	for k, v := range labels {
-		if n, ok := extend[k]; !ok || n != v {
-			return false
-		}
-	}
-	return true
+	return types.MakeLabelSelector(labels).Matches(extend)
 }
 
 // CleanStatsdMetrics trans dot to _
@@ -199,19 +195,29 @@ func TempTarFile(path string, data []byte) (string, error) {
 	}
 	name := f.Name()
 	defer f.Close()
+	return name, writeTar(f, filename, data, 0755)
+}
 
-	tw := tar.NewWriter(f)
-	defer tw.Close()
+// TarBytes tar bytes in memory, for data shouldn't be written to disk
+func TarBytes(path string, data []byte, mode int64) (*bytes.Buffer, error) {
+	buf := &bytes.Buffer{}
+	return buf, writeTar(buf, filepath.Base(path), data, mode)
+}
+
+func writeTar(w io.Writer, filename string, data []byte, mode int64) error {
+	tw := tar.NewWriter(w)
 	hdr := &tar.Header{
 		Name: filename,
-		Mode: 0755,
+		Mode: mode,
 		Size: int64(len(data)),
 	}
 	if err := tw.WriteHeader(hdr); err != nil {
-		return name, err
+		return err
+	}
+	if _, err := tw.Write(data); err != nil {
+		return err
 	}
-	_, err = tw.Write(data)
-	return name, err
+	return tw.Close()
 }
 
 // Round for float64 to int
@@ -3,12 +3,14 @@ package utils
 import (
 	"archive/tar"
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"strconv"
 	"testing"
 
+	grpcmocks "github.com/projecteru2/core/3rdmocks"
 	"github.com/projecteru2/core/cluster"
 	"github.com/projecteru2/core/types"
 	"github.com/stretchr/testify/assert"
@@ -190,6 +192,19 @@ func TestTempTarFile(t *testing.T) {
 	os.Remove(fname)
 }
 
+func TestTarBytes(t *testing.T) {
+	buf, err := TarBytes("/etc/secret", []byte("test"), 0600)
+	assert.NoError(t, err)
+	tr := tar.NewReader(buf)
+	hdr, err := tr.Next()
+	assert.NoError(t, err)
+	assert.Equal(t, "secret", hdr.Name)
+	assert.Equal(t, int64(0600), hdr.Mode)
+	b, err := ioutil.ReadAll(tr)
+	assert.NoError(t, err)
+	assert.Equal(t, "test", string(b))
+}
+
 func TestRound(t *testing.T) {
 	f := func(f float64) string {
 		return strconv.FormatFloat(f, 'f', -1, 64)
@@ -212,3 +227,13 @@ func TestMin(t *testing.T) {
 	assert.Equal(t, 1, Min(a, b))
 	assert.Equal(t, 1, Min(b, a))
 }
+
+func TestStreamWithContext(t *testing.T) {
+	stream := &grpcmocks.ServerStream{}
+	stream.On("Context").Return(context.Background())
+	stream.On("RecvMsg", nil).Return(nil)
+	s := StreamWithContext(stream, ContextWithUsername(stream.Context(), "u1"))
+	assert.Equal(t, "u1", UsernameFromContext(s.Context()))
+	assert.NoError(t, s.RecvMsg(nil))
+	stream.AssertCalled(t, "RecvMsg", nil)
+}